@@ -26,10 +26,23 @@ type QueryClient interface {
 	Basket(ctx context.Context, in *QueryBasketRequest, opts ...grpc.CallOption) (*QueryBasketResponse, error)
 	// Baskets lists all baskets in the ecocredit module.
 	Baskets(ctx context.Context, in *QueryBasketsRequest, opts ...grpc.CallOption) (*QueryBasketsResponse, error)
-	// BasketBalances lists the balance of each credit batch in the basket.
+	// BasketBalances lists the balance of each credit batch in the basket. The
+	// balances are sorted by batch start date, oldest first, which is the order
+	// in which credits are taken from the basket.
 	BasketBalances(ctx context.Context, in *QueryBasketBalancesRequest, opts ...grpc.CallOption) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(ctx context.Context, in *QueryBasketBalanceRequest, opts ...grpc.CallOption) (*QueryBasketBalanceResponse, error)
+	// BasketCriteria queries the date criteria of a basket in human-readable
+	// form.
+	//
+	// Since Revision 2
+	BasketCriteria(ctx context.Context, in *QueryBasketCriteriaRequest, opts ...grpc.CallOption) (*QueryBasketCriteriaResponse, error)
+	// EligibleCredits queries the tradable credit balances of an account that
+	// can be put into a basket, applying the same credit type, credit class and
+	// date criteria checks as Msg/Put.
+	//
+	// Since Revision 2
+	EligibleCredits(ctx context.Context, in *QueryEligibleCreditsRequest, opts ...grpc.CallOption) (*QueryEligibleCreditsResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +89,24 @@ func (c *queryClient) BasketBalance(ctx context.Context, in *QueryBasketBalanceR
 	return out, nil
 }
 
+func (c *queryClient) BasketCriteria(ctx context.Context, in *QueryBasketCriteriaRequest, opts ...grpc.CallOption) (*QueryBasketCriteriaResponse, error) {
+	out := new(QueryBasketCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/BasketCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EligibleCredits(ctx context.Context, in *QueryEligibleCreditsRequest, opts ...grpc.CallOption) (*QueryEligibleCreditsResponse, error) {
+	out := new(QueryEligibleCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/EligibleCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -84,10 +115,23 @@ type QueryServer interface {
 	Basket(context.Context, *QueryBasketRequest) (*QueryBasketResponse, error)
 	// Baskets lists all baskets in the ecocredit module.
 	Baskets(context.Context, *QueryBasketsRequest) (*QueryBasketsResponse, error)
-	// BasketBalances lists the balance of each credit batch in the basket.
+	// BasketBalances lists the balance of each credit batch in the basket. The
+	// balances are sorted by batch start date, oldest first, which is the order
+	// in which credits are taken from the basket.
 	BasketBalances(context.Context, *QueryBasketBalancesRequest) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error)
+	// BasketCriteria queries the date criteria of a basket in human-readable
+	// form.
+	//
+	// Since Revision 2
+	BasketCriteria(context.Context, *QueryBasketCriteriaRequest) (*QueryBasketCriteriaResponse, error)
+	// EligibleCredits queries the tradable credit balances of an account that
+	// can be put into a basket, applying the same credit type, credit class and
+	// date criteria checks as Msg/Put.
+	//
+	// Since Revision 2
+	EligibleCredits(context.Context, *QueryEligibleCreditsRequest) (*QueryEligibleCreditsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +151,12 @@ func (UnimplementedQueryServer) BasketBalances(context.Context, *QueryBasketBala
 func (UnimplementedQueryServer) BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BasketBalance not implemented")
 }
+func (UnimplementedQueryServer) BasketCriteria(context.Context, *QueryBasketCriteriaRequest) (*QueryBasketCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketCriteria not implemented")
+}
+func (UnimplementedQueryServer) EligibleCredits(context.Context, *QueryEligibleCreditsRequest) (*QueryEligibleCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EligibleCredits not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +242,42 @@ func _Query_BasketBalance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BasketCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasketCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/BasketCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasketCriteria(ctx, req.(*QueryBasketCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EligibleCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEligibleCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EligibleCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/EligibleCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EligibleCredits(ctx, req.(*QueryEligibleCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +301,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BasketBalance",
 			Handler:    _Query_BasketBalance_Handler,
 		},
+		{
+			MethodName: "BasketCriteria",
+			Handler:    _Query_BasketCriteria_Handler,
+		},
+		{
+			MethodName: "EligibleCredits",
+			Handler:    _Query_EligibleCredits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/query.proto",
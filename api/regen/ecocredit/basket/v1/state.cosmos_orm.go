@@ -457,18 +457,139 @@ func NewBasketBalanceTable(db ormtable.Schema) (BasketBalanceTable, error) {
 	return basketBalanceTable{table}, nil
 }
 
+type BasketCreditTypeTable interface {
+	Insert(ctx context.Context, basketCreditType *BasketCreditType) error
+	Update(ctx context.Context, basketCreditType *BasketCreditType) error
+	Save(ctx context.Context, basketCreditType *BasketCreditType) error
+	Delete(ctx context.Context, basketCreditType *BasketCreditType) error
+	Has(ctx context.Context, basket_id uint64, credit_type_abbrev string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, basket_id uint64, credit_type_abbrev string) (*BasketCreditType, error)
+	List(ctx context.Context, prefixKey BasketCreditTypeIndexKey, opts ...ormlist.Option) (BasketCreditTypeIterator, error)
+	ListRange(ctx context.Context, from, to BasketCreditTypeIndexKey, opts ...ormlist.Option) (BasketCreditTypeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BasketCreditTypeIndexKey) error
+	DeleteRange(ctx context.Context, from, to BasketCreditTypeIndexKey) error
+
+	doNotImplement()
+}
+
+type BasketCreditTypeIterator struct {
+	ormtable.Iterator
+}
+
+func (i BasketCreditTypeIterator) Value() (*BasketCreditType, error) {
+	var basketCreditType BasketCreditType
+	err := i.UnmarshalMessage(&basketCreditType)
+	return &basketCreditType, err
+}
+
+type BasketCreditTypeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	basketCreditTypeIndexKey()
+}
+
+// primary key starting index..
+type BasketCreditTypePrimaryKey = BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey
+
+type BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey struct {
+	vs []interface{}
+}
+
+func (x BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey) id() uint32                { return 0 }
+func (x BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey) values() []interface{}     { return x.vs }
+func (x BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey) basketCreditTypeIndexKey() {}
+
+func (this BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey) WithBasketId(basket_id uint64) BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey {
+	this.vs = []interface{}{basket_id}
+	return this
+}
+
+func (this BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey) WithBasketIdCreditTypeAbbrev(basket_id uint64, credit_type_abbrev string) BasketCreditTypeBasketIdCreditTypeAbbrevIndexKey {
+	this.vs = []interface{}{basket_id, credit_type_abbrev}
+	return this
+}
+
+type basketCreditTypeTable struct {
+	table ormtable.Table
+}
+
+func (this basketCreditTypeTable) Insert(ctx context.Context, basketCreditType *BasketCreditType) error {
+	return this.table.Insert(ctx, basketCreditType)
+}
+
+func (this basketCreditTypeTable) Update(ctx context.Context, basketCreditType *BasketCreditType) error {
+	return this.table.Update(ctx, basketCreditType)
+}
+
+func (this basketCreditTypeTable) Save(ctx context.Context, basketCreditType *BasketCreditType) error {
+	return this.table.Save(ctx, basketCreditType)
+}
+
+func (this basketCreditTypeTable) Delete(ctx context.Context, basketCreditType *BasketCreditType) error {
+	return this.table.Delete(ctx, basketCreditType)
+}
+
+func (this basketCreditTypeTable) Has(ctx context.Context, basket_id uint64, credit_type_abbrev string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, basket_id, credit_type_abbrev)
+}
+
+func (this basketCreditTypeTable) Get(ctx context.Context, basket_id uint64, credit_type_abbrev string) (*BasketCreditType, error) {
+	var basketCreditType BasketCreditType
+	found, err := this.table.PrimaryKey().Get(ctx, &basketCreditType, basket_id, credit_type_abbrev)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &basketCreditType, nil
+}
+
+func (this basketCreditTypeTable) List(ctx context.Context, prefixKey BasketCreditTypeIndexKey, opts ...ormlist.Option) (BasketCreditTypeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BasketCreditTypeIterator{it}, err
+}
+
+func (this basketCreditTypeTable) ListRange(ctx context.Context, from, to BasketCreditTypeIndexKey, opts ...ormlist.Option) (BasketCreditTypeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BasketCreditTypeIterator{it}, err
+}
+
+func (this basketCreditTypeTable) DeleteBy(ctx context.Context, prefixKey BasketCreditTypeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this basketCreditTypeTable) DeleteRange(ctx context.Context, from, to BasketCreditTypeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this basketCreditTypeTable) doNotImplement() {}
+
+var _ BasketCreditTypeTable = basketCreditTypeTable{}
+
+func NewBasketCreditTypeTable(db ormtable.Schema) (BasketCreditTypeTable, error) {
+	table := db.GetTable(&BasketCreditType{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BasketCreditType{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return basketCreditTypeTable{table}, nil
+}
+
 type StateStore interface {
 	BasketTable() BasketTable
 	BasketClassTable() BasketClassTable
 	BasketBalanceTable() BasketBalanceTable
+	BasketCreditTypeTable() BasketCreditTypeTable
 
 	doNotImplement()
 }
 
 type stateStore struct {
-	basket        BasketTable
-	basketClass   BasketClassTable
-	basketBalance BasketBalanceTable
+	basket           BasketTable
+	basketClass      BasketClassTable
+	basketBalance    BasketBalanceTable
+	basketCreditType BasketCreditTypeTable
 }
 
 func (x stateStore) BasketTable() BasketTable {
@@ -483,6 +604,10 @@ func (x stateStore) BasketBalanceTable() BasketBalanceTable {
 	return x.basketBalance
 }
 
+func (x stateStore) BasketCreditTypeTable() BasketCreditTypeTable {
+	return x.basketCreditType
+}
+
 func (stateStore) doNotImplement() {}
 
 var _ StateStore = stateStore{}
@@ -503,9 +628,15 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		return nil, err
 	}
 
+	basketCreditTypeTable, err := NewBasketCreditTypeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return stateStore{
 		basketTable,
 		basketClassTable,
 		basketBalanceTable,
+		basketCreditTypeTable,
 	}, nil
 }
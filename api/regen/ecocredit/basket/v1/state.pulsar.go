@@ -24,6 +24,9 @@ var (
 	fd_Basket_date_criteria       protoreflect.FieldDescriptor
 	fd_Basket_exponent            protoreflect.FieldDescriptor
 	fd_Basket_curator             protoreflect.FieldDescriptor
+	fd_Basket_retire_on_put       protoreflect.FieldDescriptor
+	fd_Basket_min_take            protoreflect.FieldDescriptor
+	fd_Basket_closed              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +40,9 @@ func init() {
 	fd_Basket_date_criteria = md_Basket.Fields().ByName("date_criteria")
 	fd_Basket_exponent = md_Basket.Fields().ByName("exponent")
 	fd_Basket_curator = md_Basket.Fields().ByName("curator")
+	fd_Basket_retire_on_put = md_Basket.Fields().ByName("retire_on_put")
+	fd_Basket_min_take = md_Basket.Fields().ByName("min_take")
+	fd_Basket_closed = md_Basket.Fields().ByName("closed")
 }
 
 var _ protoreflect.Message = (*fastReflection_Basket)(nil)
@@ -152,6 +158,24 @@ func (x *fastReflection_Basket) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.RetireOnPut != false {
+		value := protoreflect.ValueOfBool(x.RetireOnPut)
+		if !f(fd_Basket_retire_on_put, value) {
+			return
+		}
+	}
+	if x.MinTake != "" {
+		value := protoreflect.ValueOfString(x.MinTake)
+		if !f(fd_Basket_min_take, value) {
+			return
+		}
+	}
+	if x.Closed != false {
+		value := protoreflect.ValueOfBool(x.Closed)
+		if !f(fd_Basket_closed, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +207,12 @@ func (x *fastReflection_Basket) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Exponent != uint32(0)
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		return len(x.Curator) != 0
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		return x.RetireOnPut != false
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		return x.MinTake != ""
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		return x.Closed != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -215,6 +245,12 @@ func (x *fastReflection_Basket) Clear(fd protoreflect.FieldDescriptor) {
 		x.Exponent = uint32(0)
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		x.Curator = nil
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		x.RetireOnPut = false
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		x.MinTake = ""
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		x.Closed = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -255,6 +291,15 @@ func (x *fastReflection_Basket) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		value := x.Curator
 		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		value := x.RetireOnPut
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		value := x.MinTake
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		value := x.Closed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -291,6 +336,12 @@ func (x *fastReflection_Basket) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Exponent = uint32(value.Uint())
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		x.Curator = value.Bytes()
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		x.RetireOnPut = value.Bool()
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		x.MinTake = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		x.Closed = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -330,6 +381,12 @@ func (x *fastReflection_Basket) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field exponent of message regen.ecocredit.basket.v1.Basket is not mutable"))
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.Basket is not mutable"))
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		panic(fmt.Errorf("field retire_on_put of message regen.ecocredit.basket.v1.Basket is not mutable"))
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		panic(fmt.Errorf("field min_take of message regen.ecocredit.basket.v1.Basket is not mutable"))
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		panic(fmt.Errorf("field closed of message regen.ecocredit.basket.v1.Basket is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -360,6 +417,12 @@ func (x *fastReflection_Basket) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.basket.v1.Basket.retire_on_put":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.basket.v1.Basket.min_take":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.Basket.closed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -458,6 +521,16 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RetireOnPut {
+			n += 2
+		}
+		l = len(x.MinTake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Closed {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -487,6 +560,33 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Closed {
+			i--
+			if x.Closed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.MinTake) > 0 {
+			i -= len(x.MinTake)
+			copy(dAtA[i:], x.MinTake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinTake)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.RetireOnPut {
+			i--
+			if x.RetireOnPut {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.Curator) > 0 {
 			i -= len(x.Curator)
 			copy(dAtA[i:], x.Curator)
@@ -822,6 +922,78 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 					x.Curator = []byte{}
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetireOnPut", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RetireOnPut = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinTake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Closed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1936,156 +2108,727 @@ func (x *fastReflection_BasketBalance) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/basket/v1/state.proto
+var (
+	md_BasketCreditType                    protoreflect.MessageDescriptor
+	fd_BasketCreditType_basket_id          protoreflect.FieldDescriptor
+	fd_BasketCreditType_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_BasketCreditType_weight             protoreflect.FieldDescriptor
+)
 
-// Revision 1
+func init() {
+	file_regen_ecocredit_basket_v1_state_proto_init()
+	md_BasketCreditType = File_regen_ecocredit_basket_v1_state_proto.Messages().ByName("BasketCreditType")
+	fd_BasketCreditType_basket_id = md_BasketCreditType.Fields().ByName("basket_id")
+	fd_BasketCreditType_credit_type_abbrev = md_BasketCreditType.Fields().ByName("credit_type_abbrev")
+	fd_BasketCreditType_weight = md_BasketCreditType.Fields().ByName("weight")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_BasketCreditType)(nil)
 
-// Basket represents a basket in state.
-type Basket struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_BasketCreditType BasketCreditType
 
-	// id is the uint64 ID of the basket. It is used internally for reducing
-	// storage space.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// basket_denom is the basket bank denom formed from name and credit type with
-	// the format `eco.<prefix><credit_type_abbrev>.<name>` where prefix is the
-	// prefix of the bank denom exponent, a standard SI unit derived from credit
-	// type precision, and mapped as follows:
-	//   0 - no prefix
-	//   1 - d (deci)
-	//   2 - c (centi)
-	//   3 - m (milli)
-	//   6 - u (micro)
-	//   9 - n (nano)
-	//   12 - p (pico)
-	//   15 - f (femto)
-	//   18 - a (atto)
-	//   21 - z (zepto)
-	//   24 - y (yocto)
-	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// name is the unique name of the basket specified in MsgCreate. Basket
-	// names must be unique across all credit types and choices of exponent
-	// above and beyond the uniqueness constraint on basket_denom.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// disable_auto_retire indicates whether or not the credits will be retired
-	// upon withdraw from the basket.
-	DisableAutoRetire bool `protobuf:"varint,4,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type this basket is
-	// able to hold.
-	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// date_criteria is the date criteria for batches admitted to the basket.
-	DateCriteria *DateCriteria `protobuf:"bytes,6,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
-	// Deprecated (Since Revision 1): This field is no longer used and will be
-	// removed in the next version. The value of credit type precision is always
-	// used as the exponent when converting credits to/from basket tokens. This
-	// field will be set to the value of credit type precision until removed.
-	//
-	// Deprecated: Do not use.
-	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	// curator is the address of the basket curator who is able to change certain
-	// basket settings.
-	//
-	// Since Revision 1
-	Curator []byte `protobuf:"bytes,8,opt,name=curator,proto3" json:"curator,omitempty"`
+func (x *BasketCreditType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BasketCreditType)(x)
 }
 
-func (x *Basket) Reset() {
-	*x = Basket{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_state_proto_msgTypes[0]
+func (x *BasketCreditType) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Basket) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_BasketCreditType_messageType fastReflection_BasketCreditType_messageType
+var _ protoreflect.MessageType = fastReflection_BasketCreditType_messageType{}
 
-func (*Basket) ProtoMessage() {}
+type fastReflection_BasketCreditType_messageType struct{}
 
-// Deprecated: Use Basket.ProtoReflect.Descriptor instead.
-func (*Basket) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_state_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_BasketCreditType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BasketCreditType)(nil)
 }
-
-func (x *Basket) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x fastReflection_BasketCreditType_messageType) New() protoreflect.Message {
+	return new(fastReflection_BasketCreditType)
 }
-
-func (x *Basket) GetBasketDenom() string {
-	if x != nil {
-		return x.BasketDenom
-	}
-	return ""
+func (x fastReflection_BasketCreditType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BasketCreditType
 }
 
-func (x *Basket) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BasketCreditType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BasketCreditType
 }
 
-func (x *Basket) GetDisableAutoRetire() bool {
-	if x != nil {
-		return x.DisableAutoRetire
-	}
-	return false
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BasketCreditType) Type() protoreflect.MessageType {
+	return _fastReflection_BasketCreditType_messageType
 }
 
-func (x *Basket) GetCreditTypeAbbrev() string {
-	if x != nil {
-		return x.CreditTypeAbbrev
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BasketCreditType) New() protoreflect.Message {
+	return new(fastReflection_BasketCreditType)
 }
 
-func (x *Basket) GetDateCriteria() *DateCriteria {
-	if x != nil {
-		return x.DateCriteria
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BasketCreditType) Interface() protoreflect.ProtoMessage {
+	return (*BasketCreditType)(x)
 }
 
-// Deprecated: Do not use.
-func (x *Basket) GetExponent() uint32 {
-	if x != nil {
-		return x.Exponent
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BasketCreditType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BasketId)
+		if !f(fd_BasketCreditType_basket_id, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *Basket) GetCurator() []byte {
-	if x != nil {
-		return x.Curator
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_BasketCreditType_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_BasketCreditType_weight, value) {
+			return
+		}
 	}
-	return nil
 }
 
-// BasketClass describes a credit class that can be deposited in a basket.
-type BasketClass struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// basket_id is the ID of the basket
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BasketCreditType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		return x.BasketId != uint64(0)
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BasketCreditType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		x.BasketId = uint64(0)
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BasketCreditType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		value := x.BasketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BasketCreditType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		x.BasketId = value.Uint()
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BasketCreditType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		panic(fmt.Errorf("field basket_id of message regen.ecocredit.basket.v1.BasketCreditType is not mutable"))
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.basket.v1.BasketCreditType is not mutable"))
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		panic(fmt.Errorf("field weight of message regen.ecocredit.basket.v1.BasketCreditType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BasketCreditType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.BasketCreditType.basket_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.basket.v1.BasketCreditType.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.BasketCreditType.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.BasketCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.BasketCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BasketCreditType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.BasketCreditType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BasketCreditType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BasketCreditType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BasketCreditType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BasketCreditType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BasketCreditType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BasketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BasketId))
+		}
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BasketCreditType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BasketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BasketId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BasketCreditType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BasketCreditType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BasketCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+				}
+				x.BasketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BasketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/basket/v1/state.proto
+
+// Revision 2
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Basket represents a basket in state.
+type Basket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the uint64 ID of the basket. It is used internally for reducing
+	// storage space.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// basket_denom is the basket bank denom formed from name and credit type with
+	// the format `eco.<prefix><credit_type_abbrev>.<name>` where prefix is the
+	// prefix of the bank denom exponent, a standard SI unit derived from credit
+	// type precision, and mapped as follows:
+	//   0 - no prefix
+	//   1 - d (deci)
+	//   2 - c (centi)
+	//   3 - m (milli)
+	//   6 - u (micro)
+	//   9 - n (nano)
+	//   12 - p (pico)
+	//   15 - f (femto)
+	//   18 - a (atto)
+	//   21 - z (zepto)
+	//   24 - y (yocto)
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// name is the unique name of the basket specified in MsgCreate. Basket
+	// names must be unique across all credit types and choices of exponent
+	// above and beyond the uniqueness constraint on basket_denom.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// disable_auto_retire indicates whether or not the credits will be retired
+	// upon withdraw from the basket.
+	DisableAutoRetire bool `protobuf:"varint,4,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type this basket is
+	// able to hold. For a basket holding more than one credit type, this is the
+	// first credit type provided in MsgCreate and the remaining credit types are
+	// stored in the BasketCreditType table.
+	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// date_criteria is the date criteria for batches admitted to the basket.
+	DateCriteria *DateCriteria `protobuf:"bytes,6,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
+	// Deprecated (Since Revision 1): This field is no longer used and will be
+	// removed in the next version. The value of credit type precision is always
+	// used as the exponent when converting credits to/from basket tokens. This
+	// field will be set to the value of credit type precision until removed.
+	//
+	// Deprecated: Do not use.
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// curator is the address of the basket curator who is able to change certain
+	// basket settings.
+	//
+	// Since Revision 1
+	Curator []byte `protobuf:"bytes,8,opt,name=curator,proto3" json:"curator,omitempty"`
+	// retire_on_put dictates whether credits put into the basket are retired
+	// immediately instead of being held by the basket.
+	//
+	// Since Revision 2
+	RetireOnPut bool `protobuf:"varint,9,opt,name=retire_on_put,json=retireOnPut,proto3" json:"retire_on_put,omitempty"`
+	// min_take is the minimum integer amount of basket tokens that can be taken
+	// from the basket in a single MsgTake. A minimum is not enforced if empty.
+	//
+	// Since Revision 2
+	MinTake string `protobuf:"bytes,10,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
+	// closed indicates whether the basket has been closed by the curator.
+	// Credits cannot be put into a closed basket, but holders of outstanding
+	// basket tokens can still take credits from it.
+	//
+	// Since Revision 2
+	Closed bool `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (x *Basket) Reset() {
+	*x = Basket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Basket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Basket) ProtoMessage() {}
+
+// Deprecated: Use Basket.ProtoReflect.Descriptor instead.
+func (*Basket) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Basket) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Basket) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *Basket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Basket) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *Basket) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *Basket) GetDateCriteria() *DateCriteria {
+	if x != nil {
+		return x.DateCriteria
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *Basket) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *Basket) GetCurator() []byte {
+	if x != nil {
+		return x.Curator
+	}
+	return nil
+}
+
+func (x *Basket) GetRetireOnPut() bool {
+	if x != nil {
+		return x.RetireOnPut
+	}
+	return false
+}
+
+func (x *Basket) GetMinTake() string {
+	if x != nil {
+		return x.MinTake
+	}
+	return ""
+}
+
+func (x *Basket) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+// BasketClass describes a credit class that can be deposited in a basket.
+type BasketClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_id is the ID of the basket
 	BasketId uint64 `protobuf:"varint,1,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
 	// class_id is the id of the credit class that is allowed to be deposited in
 	// the basket
@@ -2191,6 +2934,66 @@ func (x *BasketBalance) GetBatchStartDate() *timestamppb.Timestamp {
 	return nil
 }
 
+// BasketCreditType describes a credit type and its weight in a basket holding
+// more than one credit type. Baskets holding a single credit type do not have
+// any entries in this table.
+//
+// Since Revision 2
+type BasketCreditType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_id is the ID of the basket
+	BasketId uint64 `protobuf:"varint,1,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// weight is the decimal ratio at which credits of this credit type are
+	// taken from the basket
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *BasketCreditType) Reset() {
+	*x = BasketCreditType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasketCreditType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketCreditType) ProtoMessage() {}
+
+// Deprecated: Use BasketCreditType.ProtoReflect.Descriptor instead.
+func (*BasketCreditType) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BasketCreditType) GetBasketId() uint64 {
+	if x != nil {
+		return x.BasketId
+	}
+	return 0
+}
+
+func (x *BasketCreditType) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *BasketCreditType) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
 var File_regen_ecocredit_basket_v1_state_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_basket_v1_state_proto_rawDesc = []byte{
@@ -2204,7 +3007,7 @@ var file_regen_ecocredit_basket_v1_state_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x25, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65,
 	0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x06, 0x42, 0x61,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x03, 0x0a, 0x06, 0x42, 0x61,
 	0x73, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64,
 	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b,
@@ -2224,48 +3027,64 @@ var file_regen_ecocredit_basket_v1_state_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x65,
 	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74,
 	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x3a, 0x30, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2a, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
-	0x01, 0x12, 0x12, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x10, 0x01, 0x18, 0x01, 0x12, 0x0a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x02, 0x18,
-	0x01, 0x18, 0x01, 0x22, 0x65, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x1e, 0xf2, 0x9e, 0xd3, 0x8e,
-	0x03, 0x18, 0x0a, 0x14, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x22, 0xf0, 0x01, 0x0a, 0x0d, 0x42,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x41, 0xf2, 0x9e, 0xd3, 0x8e,
-	0x03, 0x3b, 0x0a, 0x17, 0x0a, 0x15, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x62,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x18, 0x03, 0x42, 0x80, 0x02,
-	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42,
-	0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45,
-	0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19,
-	0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c,
-	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65,
-	0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b,
-	0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x70,
+	0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x4f, 0x6e, 0x50, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x54, 0x61, 0x6b, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x3a, 0x30, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2a,
+	0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x12, 0x0a, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x10, 0x02, 0x18, 0x01, 0x18, 0x01, 0x22, 0x65, 0x0a, 0x0b, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x3a, 0x1e, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x18, 0x0a, 0x14, 0x0a, 0x12, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x22, 0xf0, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x3a, 0x41, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3b, 0x0a, 0x17, 0x0a, 0x15, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x10, 0x01, 0x18, 0x03, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x62,
+	0x62, 0x72, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x28, 0xf2, 0x9e,
+	0xd3, 0x8e, 0x03, 0x22, 0x0a, 0x1e, 0x0a, 0x1c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x2c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62,
+	0x62, 0x72, 0x65, 0x76, 0x18, 0x04, 0x42, 0x80, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2280,17 +3099,18 @@ func file_regen_ecocredit_basket_v1_state_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_basket_v1_state_proto_rawDescData
 }
 
-var file_regen_ecocredit_basket_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_regen_ecocredit_basket_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_regen_ecocredit_basket_v1_state_proto_goTypes = []interface{}{
 	(*Basket)(nil),                // 0: regen.ecocredit.basket.v1.Basket
 	(*BasketClass)(nil),           // 1: regen.ecocredit.basket.v1.BasketClass
 	(*BasketBalance)(nil),         // 2: regen.ecocredit.basket.v1.BasketBalance
-	(*DateCriteria)(nil),          // 3: regen.ecocredit.basket.v1.DateCriteria
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*BasketCreditType)(nil),      // 3: regen.ecocredit.basket.v1.BasketCreditType
+	(*DateCriteria)(nil),          // 4: regen.ecocredit.basket.v1.DateCriteria
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_regen_ecocredit_basket_v1_state_proto_depIdxs = []int32{
-	3, // 0: regen.ecocredit.basket.v1.Basket.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
-	4, // 1: regen.ecocredit.basket.v1.BasketBalance.batch_start_date:type_name -> google.protobuf.Timestamp
+	4, // 0: regen.ecocredit.basket.v1.Basket.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
+	5, // 1: regen.ecocredit.basket.v1.BasketBalance.batch_start_date:type_name -> google.protobuf.Timestamp
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -2341,6 +3161,18 @@ func file_regen_ecocredit_basket_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_basket_v1_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasketCreditType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2348,7 +3180,7 @@ func file_regen_ecocredit_basket_v1_state_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_basket_v1_state_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,6 +29,26 @@ type MsgClient interface {
 	// Take takes credits from a basket starting from the oldest
 	// credits first.
 	Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error)
+	// UpdateBasketAllowedClasses updates the credit classes allowed to be put
+	// into a basket. Only the basket curator can update the allowed classes.
+	// Classes that still have credits held in the basket cannot be removed.
+	//
+	// Since Revision 2
+	UpdateBasketAllowedClasses(ctx context.Context, in *MsgUpdateBasketAllowedClasses, opts ...grpc.CallOption) (*MsgUpdateBasketAllowedClassesResponse, error)
+	// CloseBasket closes a basket, preventing any further credits from being
+	// put into the basket. If no basket tokens are outstanding, the credits
+	// remaining in the basket are swept to the basket curator. Otherwise the
+	// credits remain in the basket so that token holders can still take them.
+	// Only the basket curator can close a basket.
+	//
+	// Since Revision 2
+	CloseBasket(ctx context.Context, in *MsgCloseBasket, opts ...grpc.CallOption) (*MsgCloseBasketResponse, error)
+	// UpdateBasketMinTake updates the minimum amount of basket tokens that can
+	// be taken from a basket in a single MsgTake. Only the basket curator can
+	// update the minimum take amount.
+	//
+	// Since Revision 2
+	UpdateBasketMinTake(ctx context.Context, in *MsgUpdateBasketMinTake, opts ...grpc.CallOption) (*MsgUpdateBasketMinTakeResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +86,33 @@ func (c *msgClient) Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateBasketAllowedClasses(ctx context.Context, in *MsgUpdateBasketAllowedClasses, opts ...grpc.CallOption) (*MsgUpdateBasketAllowedClassesResponse, error) {
+	out := new(MsgUpdateBasketAllowedClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketAllowedClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseBasket(ctx context.Context, in *MsgCloseBasket, opts ...grpc.CallOption) (*MsgCloseBasketResponse, error) {
+	out := new(MsgCloseBasketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/CloseBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketMinTake(ctx context.Context, in *MsgUpdateBasketMinTake, opts ...grpc.CallOption) (*MsgUpdateBasketMinTakeResponse, error) {
+	out := new(MsgUpdateBasketMinTakeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketMinTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -77,6 +124,26 @@ type MsgServer interface {
 	// Take takes credits from a basket starting from the oldest
 	// credits first.
 	Take(context.Context, *MsgTake) (*MsgTakeResponse, error)
+	// UpdateBasketAllowedClasses updates the credit classes allowed to be put
+	// into a basket. Only the basket curator can update the allowed classes.
+	// Classes that still have credits held in the basket cannot be removed.
+	//
+	// Since Revision 2
+	UpdateBasketAllowedClasses(context.Context, *MsgUpdateBasketAllowedClasses) (*MsgUpdateBasketAllowedClassesResponse, error)
+	// CloseBasket closes a basket, preventing any further credits from being
+	// put into the basket. If no basket tokens are outstanding, the credits
+	// remaining in the basket are swept to the basket curator. Otherwise the
+	// credits remain in the basket so that token holders can still take them.
+	// Only the basket curator can close a basket.
+	//
+	// Since Revision 2
+	CloseBasket(context.Context, *MsgCloseBasket) (*MsgCloseBasketResponse, error)
+	// UpdateBasketMinTake updates the minimum amount of basket tokens that can
+	// be taken from a basket in a single MsgTake. Only the basket curator can
+	// update the minimum take amount.
+	//
+	// Since Revision 2
+	UpdateBasketMinTake(context.Context, *MsgUpdateBasketMinTake) (*MsgUpdateBasketMinTakeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -93,6 +160,15 @@ func (UnimplementedMsgServer) Put(context.Context, *MsgPut) (*MsgPutResponse, er
 func (UnimplementedMsgServer) Take(context.Context, *MsgTake) (*MsgTakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Take not implemented")
 }
+func (UnimplementedMsgServer) UpdateBasketAllowedClasses(context.Context, *MsgUpdateBasketAllowedClasses) (*MsgUpdateBasketAllowedClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketAllowedClasses not implemented")
+}
+func (UnimplementedMsgServer) CloseBasket(context.Context, *MsgCloseBasket) (*MsgCloseBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBasket not implemented")
+}
+func (UnimplementedMsgServer) UpdateBasketMinTake(context.Context, *MsgUpdateBasketMinTake) (*MsgUpdateBasketMinTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketMinTake not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +236,60 @@ func _Msg_Take_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBasketAllowedClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketAllowedClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketAllowedClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketAllowedClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketAllowedClasses(ctx, req.(*MsgUpdateBasketAllowedClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/CloseBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseBasket(ctx, req.(*MsgCloseBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketMinTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketMinTake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketMinTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketMinTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketMinTake(ctx, req.(*MsgUpdateBasketMinTake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +309,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Take",
 			Handler:    _Msg_Take_Handler,
 		},
+		{
+			MethodName: "UpdateBasketAllowedClasses",
+			Handler:    _Msg_UpdateBasketAllowedClasses_Handler,
+		},
+		{
+			MethodName: "CloseBasket",
+			Handler:    _Msg_CloseBasket_Handler,
+		},
+		{
+			MethodName: "UpdateBasketMinTake",
+			Handler:    _Msg_UpdateBasketMinTake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/tx.proto",
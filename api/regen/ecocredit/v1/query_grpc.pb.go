@@ -27,6 +27,9 @@ type QueryClient interface {
 	// ClassesByAdmin queries for all credit classes with a specific admin
 	// address.
 	ClassesByAdmin(ctx context.Context, in *QueryClassesByAdminRequest, opts ...grpc.CallOption) (*QueryClassesByAdminResponse, error)
+	// ClassesByCreditType queries for all credit classes with a specific credit
+	// type abbreviation.
+	ClassesByCreditType(ctx context.Context, in *QueryClassesByCreditTypeRequest, opts ...grpc.CallOption) (*QueryClassesByCreditTypeResponse, error)
 	// Class queries for information on a credit class.
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// ClassIssuers queries for the addresses of the issuers for a credit class.
@@ -59,10 +62,63 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Balances queries all credit balances the given account holds.
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// CreditTypesByHolder queries the distinct credit types of all credit
+	// batches an account holds a balance in.
+	CreditTypesByHolder(ctx context.Context, in *QueryCreditTypesByHolderRequest, opts ...grpc.CallOption) (*QueryCreditTypesByHolderResponse, error)
 	// Supply queries the tradable and retired supply of a credit batch.
 	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
-	// CreditTypes returns the list of allowed types that credit classes can have.
-	// See Types/CreditType for more details.
+	// BatchSupply queries the full supply breakdown of a credit batch including
+	// the precision of the credit type of the credit batch.
+	BatchSupply(ctx context.Context, in *QueryBatchSupplyRequest, opts ...grpc.CallOption) (*QueryBatchSupplyResponse, error)
+	// ClassSupplyTotals queries for all credit classes with the total supply of
+	// credits summed across all credit batches issued within each credit class.
+	// The totals are computed at query time by iterating over credit batches
+	// ordered by batch denom, so pagination applies to the credit batches that
+	// are summed and a page holds at most 10,000 credit batches. A credit class
+	// whose credit batches span more than one page is included in each page with
+	// the totals of the credit batches within that page, and credit classes
+	// without credit batches are not included.
+	ClassSupplyTotals(ctx context.Context, in *QueryClassSupplyTotalsRequest, opts ...grpc.CallOption) (*QueryClassSupplyTotalsResponse, error)
+	// ClassSupply queries for the total supply of credits summed across all
+	// credit batches issued within a credit class. The totals are computed at
+	// query time by iterating over the credit batches of the credit class, so
+	// pagination applies to the credit batches that are summed and a page holds
+	// at most 10,000 credit batches. The totals of each page must be added to
+	// get the supply of a credit class with more credit batches.
+	ClassSupply(ctx context.Context, in *QueryClassSupplyRequest, opts ...grpc.CallOption) (*QueryClassSupplyResponse, error)
+	// SupplyReconciliation recomputes the supply of credit batches from the
+	// credit balances of all accounts and baskets and returns the credit batches
+	// whose recorded supply doesn't match. The supply is computed at query time
+	// by iterating over the balances of each credit batch, so the number of
+	// credit batches per request should be limited using pagination.
+	SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error)
+	// ExpiringCredits queries for all credit batches expiring within a time
+	// window and, optionally, the balances an account holds in those batches.
+	ExpiringCredits(ctx context.Context, in *QueryExpiringCreditsRequest, opts ...grpc.CallOption) (*QueryExpiringCreditsResponse, error)
+	// BatchesByIssuanceDate queries for all credit batches issued within a time
+	// window.
+	BatchesByIssuanceDate(ctx context.Context, in *QueryBatchesByIssuanceDateRequest, opts ...grpc.CallOption) (*QueryBatchesByIssuanceDateResponse, error)
+	// CreditsByOriginTx queries for the credits issued from an originating
+	// transaction based on the source and id of the originating transaction.
+	CreditsByOriginTx(ctx context.Context, in *QueryCreditsByOriginTxRequest, opts ...grpc.CallOption) (*QueryCreditsByOriginTxResponse, error)
+	// BeneficiaryRetirements queries for the retirements attributed to a
+	// beneficiary across all credit batches, regardless of which account retired
+	// the credits.
+	BeneficiaryRetirements(ctx context.Context, in *QueryBeneficiaryRetirementsRequest, opts ...grpc.CallOption) (*QueryBeneficiaryRetirementsResponse, error)
+	// BatchAnnotations queries for the annotations of a credit batch in the
+	// order they were appended.
+	BatchAnnotations(ctx context.Context, in *QueryBatchAnnotationsRequest, opts ...grpc.CallOption) (*QueryBatchAnnotationsResponse, error)
+	// BufferPool queries for the credits allocated to the buffer pool of a
+	// credit class, optionally filtered by credit batch.
+	BufferPool(ctx context.Context, in *QueryBufferPoolRequest, opts ...grpc.CallOption) (*QueryBufferPoolResponse, error)
+	// SimulateSend simulates sending credits without committing any state and
+	// returns the balances of the sender and recipient and the supply of each
+	// credit batch as they would be after the send. The simulation runs the
+	// same validation as Msg/Send and returns the same errors.
+	SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error)
+	// CreditTypes returns the list of allowed types that credit classes can have,
+	// including the name, unit and precision of each credit type, sorted by
+	// abbreviation. See Types/CreditType for more details.
 	CreditTypes(ctx context.Context, in *QueryCreditTypesRequest, opts ...grpc.CallOption) (*QueryCreditTypesResponse, error)
 	// Params queries the ecocredit module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -94,6 +150,15 @@ func (c *queryClient) ClassesByAdmin(ctx context.Context, in *QueryClassesByAdmi
 	return out, nil
 }
 
+func (c *queryClient) ClassesByCreditType(ctx context.Context, in *QueryClassesByCreditTypeRequest, opts ...grpc.CallOption) (*QueryClassesByCreditTypeResponse, error) {
+	out := new(QueryClassesByCreditTypeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassesByCreditType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error) {
 	out := new(QueryClassResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Class", in, out, opts...)
@@ -220,6 +285,15 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) CreditTypesByHolder(ctx context.Context, in *QueryCreditTypesByHolderRequest, opts ...grpc.CallOption) (*QueryCreditTypesByHolderResponse, error) {
+	out := new(QueryCreditTypesByHolderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditTypesByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
 	out := new(QuerySupplyResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Supply", in, out, opts...)
@@ -229,6 +303,105 @@ func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BatchSupply(ctx context.Context, in *QueryBatchSupplyRequest, opts ...grpc.CallOption) (*QueryBatchSupplyResponse, error) {
+	out := new(QueryBatchSupplyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassSupplyTotals(ctx context.Context, in *QueryClassSupplyTotalsRequest, opts ...grpc.CallOption) (*QueryClassSupplyTotalsResponse, error) {
+	out := new(QueryClassSupplyTotalsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassSupplyTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassSupply(ctx context.Context, in *QueryClassSupplyRequest, opts ...grpc.CallOption) (*QueryClassSupplyResponse, error) {
+	out := new(QueryClassSupplyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error) {
+	out := new(QuerySupplyReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SupplyReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringCredits(ctx context.Context, in *QueryExpiringCreditsRequest, opts ...grpc.CallOption) (*QueryExpiringCreditsResponse, error) {
+	out := new(QueryExpiringCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ExpiringCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchesByIssuanceDate(ctx context.Context, in *QueryBatchesByIssuanceDateRequest, opts ...grpc.CallOption) (*QueryBatchesByIssuanceDateResponse, error) {
+	out := new(QueryBatchesByIssuanceDateResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchesByIssuanceDate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreditsByOriginTx(ctx context.Context, in *QueryCreditsByOriginTxRequest, opts ...grpc.CallOption) (*QueryCreditsByOriginTxResponse, error) {
+	out := new(QueryCreditsByOriginTxResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditsByOriginTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BeneficiaryRetirements(ctx context.Context, in *QueryBeneficiaryRetirementsRequest, opts ...grpc.CallOption) (*QueryBeneficiaryRetirementsResponse, error) {
+	out := new(QueryBeneficiaryRetirementsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BeneficiaryRetirements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchAnnotations(ctx context.Context, in *QueryBatchAnnotationsRequest, opts ...grpc.CallOption) (*QueryBatchAnnotationsResponse, error) {
+	out := new(QueryBatchAnnotationsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchAnnotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BufferPool(ctx context.Context, in *QueryBufferPoolRequest, opts ...grpc.CallOption) (*QueryBufferPoolResponse, error) {
+	out := new(QueryBufferPoolResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BufferPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSend(ctx context.Context, in *QuerySimulateSendRequest, opts ...grpc.CallOption) (*QuerySimulateSendResponse, error) {
+	out := new(QuerySimulateSendResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SimulateSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CreditTypes(ctx context.Context, in *QueryCreditTypesRequest, opts ...grpc.CallOption) (*QueryCreditTypesResponse, error) {
 	out := new(QueryCreditTypesResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditTypes", in, out, opts...)
@@ -256,6 +429,9 @@ type QueryServer interface {
 	// ClassesByAdmin queries for all credit classes with a specific admin
 	// address.
 	ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error)
+	// ClassesByCreditType queries for all credit classes with a specific credit
+	// type abbreviation.
+	ClassesByCreditType(context.Context, *QueryClassesByCreditTypeRequest) (*QueryClassesByCreditTypeResponse, error)
 	// Class queries for information on a credit class.
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// ClassIssuers queries for the addresses of the issuers for a credit class.
@@ -288,10 +464,63 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Balances queries all credit balances the given account holds.
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// CreditTypesByHolder queries the distinct credit types of all credit
+	// batches an account holds a balance in.
+	CreditTypesByHolder(context.Context, *QueryCreditTypesByHolderRequest) (*QueryCreditTypesByHolderResponse, error)
 	// Supply queries the tradable and retired supply of a credit batch.
 	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
-	// CreditTypes returns the list of allowed types that credit classes can have.
-	// See Types/CreditType for more details.
+	// BatchSupply queries the full supply breakdown of a credit batch including
+	// the precision of the credit type of the credit batch.
+	BatchSupply(context.Context, *QueryBatchSupplyRequest) (*QueryBatchSupplyResponse, error)
+	// ClassSupplyTotals queries for all credit classes with the total supply of
+	// credits summed across all credit batches issued within each credit class.
+	// The totals are computed at query time by iterating over credit batches
+	// ordered by batch denom, so pagination applies to the credit batches that
+	// are summed and a page holds at most 10,000 credit batches. A credit class
+	// whose credit batches span more than one page is included in each page with
+	// the totals of the credit batches within that page, and credit classes
+	// without credit batches are not included.
+	ClassSupplyTotals(context.Context, *QueryClassSupplyTotalsRequest) (*QueryClassSupplyTotalsResponse, error)
+	// ClassSupply queries for the total supply of credits summed across all
+	// credit batches issued within a credit class. The totals are computed at
+	// query time by iterating over the credit batches of the credit class, so
+	// pagination applies to the credit batches that are summed and a page holds
+	// at most 10,000 credit batches. The totals of each page must be added to
+	// get the supply of a credit class with more credit batches.
+	ClassSupply(context.Context, *QueryClassSupplyRequest) (*QueryClassSupplyResponse, error)
+	// SupplyReconciliation recomputes the supply of credit batches from the
+	// credit balances of all accounts and baskets and returns the credit batches
+	// whose recorded supply doesn't match. The supply is computed at query time
+	// by iterating over the balances of each credit batch, so the number of
+	// credit batches per request should be limited using pagination.
+	SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error)
+	// ExpiringCredits queries for all credit batches expiring within a time
+	// window and, optionally, the balances an account holds in those batches.
+	ExpiringCredits(context.Context, *QueryExpiringCreditsRequest) (*QueryExpiringCreditsResponse, error)
+	// BatchesByIssuanceDate queries for all credit batches issued within a time
+	// window.
+	BatchesByIssuanceDate(context.Context, *QueryBatchesByIssuanceDateRequest) (*QueryBatchesByIssuanceDateResponse, error)
+	// CreditsByOriginTx queries for the credits issued from an originating
+	// transaction based on the source and id of the originating transaction.
+	CreditsByOriginTx(context.Context, *QueryCreditsByOriginTxRequest) (*QueryCreditsByOriginTxResponse, error)
+	// BeneficiaryRetirements queries for the retirements attributed to a
+	// beneficiary across all credit batches, regardless of which account retired
+	// the credits.
+	BeneficiaryRetirements(context.Context, *QueryBeneficiaryRetirementsRequest) (*QueryBeneficiaryRetirementsResponse, error)
+	// BatchAnnotations queries for the annotations of a credit batch in the
+	// order they were appended.
+	BatchAnnotations(context.Context, *QueryBatchAnnotationsRequest) (*QueryBatchAnnotationsResponse, error)
+	// BufferPool queries for the credits allocated to the buffer pool of a
+	// credit class, optionally filtered by credit batch.
+	BufferPool(context.Context, *QueryBufferPoolRequest) (*QueryBufferPoolResponse, error)
+	// SimulateSend simulates sending credits without committing any state and
+	// returns the balances of the sender and recipient and the supply of each
+	// credit batch as they would be after the send. The simulation runs the
+	// same validation as Msg/Send and returns the same errors.
+	SimulateSend(context.Context, *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error)
+	// CreditTypes returns the list of allowed types that credit classes can have,
+	// including the name, unit and precision of each credit type, sorted by
+	// abbreviation. See Types/CreditType for more details.
 	CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error)
 	// Params queries the ecocredit module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -308,6 +537,9 @@ func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (
 func (UnimplementedQueryServer) ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAdmin not implemented")
 }
+func (UnimplementedQueryServer) ClassesByCreditType(context.Context, *QueryClassesByCreditTypeRequest) (*QueryClassesByCreditTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByCreditType not implemented")
+}
 func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Class not implemented")
 }
@@ -350,9 +582,45 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (UnimplementedQueryServer) CreditTypesByHolder(context.Context, *QueryCreditTypesByHolderRequest) (*QueryCreditTypesByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditTypesByHolder not implemented")
+}
 func (UnimplementedQueryServer) Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
 }
+func (UnimplementedQueryServer) BatchSupply(context.Context, *QueryBatchSupplyRequest) (*QueryBatchSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSupply not implemented")
+}
+func (UnimplementedQueryServer) ClassSupplyTotals(context.Context, *QueryClassSupplyTotalsRequest) (*QueryClassSupplyTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassSupplyTotals not implemented")
+}
+func (UnimplementedQueryServer) ClassSupply(context.Context, *QueryClassSupplyRequest) (*QueryClassSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassSupply not implemented")
+}
+func (UnimplementedQueryServer) SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyReconciliation not implemented")
+}
+func (UnimplementedQueryServer) ExpiringCredits(context.Context, *QueryExpiringCreditsRequest) (*QueryExpiringCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringCredits not implemented")
+}
+func (UnimplementedQueryServer) BatchesByIssuanceDate(context.Context, *QueryBatchesByIssuanceDateRequest) (*QueryBatchesByIssuanceDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchesByIssuanceDate not implemented")
+}
+func (UnimplementedQueryServer) CreditsByOriginTx(context.Context, *QueryCreditsByOriginTxRequest) (*QueryCreditsByOriginTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditsByOriginTx not implemented")
+}
+func (UnimplementedQueryServer) BeneficiaryRetirements(context.Context, *QueryBeneficiaryRetirementsRequest) (*QueryBeneficiaryRetirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeneficiaryRetirements not implemented")
+}
+func (UnimplementedQueryServer) BatchAnnotations(context.Context, *QueryBatchAnnotationsRequest) (*QueryBatchAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAnnotations not implemented")
+}
+func (UnimplementedQueryServer) BufferPool(context.Context, *QueryBufferPoolRequest) (*QueryBufferPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BufferPool not implemented")
+}
+func (UnimplementedQueryServer) SimulateSend(context.Context, *QuerySimulateSendRequest) (*QuerySimulateSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSend not implemented")
+}
 func (UnimplementedQueryServer) CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreditTypes not implemented")
 }
@@ -408,6 +676,24 @@ func _Query_ClassesByAdmin_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassesByCreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByCreditTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByCreditType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassesByCreditType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByCreditType(ctx, req.(*QueryClassesByCreditTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClassRequest)
 	if err := dec(in); err != nil {
@@ -660,6 +946,24 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreditTypesByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditTypesByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditTypesByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditTypesByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditTypesByHolder(ctx, req.(*QueryCreditTypesByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySupplyRequest)
 	if err := dec(in); err != nil {
@@ -678,6 +982,204 @@ func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BatchSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchSupply(ctx, req.(*QueryBatchSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassSupplyTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassSupplyTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassSupplyTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassSupplyTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassSupplyTotals(ctx, req.(*QueryClassSupplyTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassSupply(ctx, req.(*QueryClassSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/SupplyReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyReconciliation(ctx, req.(*QuerySupplyReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ExpiringCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringCredits(ctx, req.(*QueryExpiringCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchesByIssuanceDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchesByIssuanceDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchesByIssuanceDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchesByIssuanceDate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchesByIssuanceDate(ctx, req.(*QueryBatchesByIssuanceDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditsByOriginTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditsByOriginTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditsByOriginTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditsByOriginTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditsByOriginTx(ctx, req.(*QueryCreditsByOriginTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BeneficiaryRetirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeneficiaryRetirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeneficiaryRetirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BeneficiaryRetirements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeneficiaryRetirements(ctx, req.(*QueryBeneficiaryRetirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchAnnotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchAnnotations(ctx, req.(*QueryBatchAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BufferPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBufferPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BufferPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BufferPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BufferPool(ctx, req.(*QueryBufferPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/SimulateSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSend(ctx, req.(*QuerySimulateSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CreditTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCreditTypesRequest)
 	if err := dec(in); err != nil {
@@ -729,6 +1231,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClassesByAdmin",
 			Handler:    _Query_ClassesByAdmin_Handler,
 		},
+		{
+			MethodName: "ClassesByCreditType",
+			Handler:    _Query_ClassesByCreditType_Handler,
+		},
 		{
 			MethodName: "Class",
 			Handler:    _Query_Class_Handler,
@@ -785,10 +1291,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "CreditTypesByHolder",
+			Handler:    _Query_CreditTypesByHolder_Handler,
+		},
 		{
 			MethodName: "Supply",
 			Handler:    _Query_Supply_Handler,
 		},
+		{
+			MethodName: "BatchSupply",
+			Handler:    _Query_BatchSupply_Handler,
+		},
+		{
+			MethodName: "ClassSupplyTotals",
+			Handler:    _Query_ClassSupplyTotals_Handler,
+		},
+		{
+			MethodName: "ClassSupply",
+			Handler:    _Query_ClassSupply_Handler,
+		},
+		{
+			MethodName: "SupplyReconciliation",
+			Handler:    _Query_SupplyReconciliation_Handler,
+		},
+		{
+			MethodName: "ExpiringCredits",
+			Handler:    _Query_ExpiringCredits_Handler,
+		},
+		{
+			MethodName: "BatchesByIssuanceDate",
+			Handler:    _Query_BatchesByIssuanceDate_Handler,
+		},
+		{
+			MethodName: "CreditsByOriginTx",
+			Handler:    _Query_CreditsByOriginTx_Handler,
+		},
+		{
+			MethodName: "BeneficiaryRetirements",
+			Handler:    _Query_BeneficiaryRetirements_Handler,
+		},
+		{
+			MethodName: "BatchAnnotations",
+			Handler:    _Query_BatchAnnotations_Handler,
+		},
+		{
+			MethodName: "BufferPool",
+			Handler:    _Query_BufferPool_Handler,
+		},
+		{
+			MethodName: "SimulateSend",
+			Handler:    _Query_SimulateSend_Handler,
+		},
 		{
 			MethodName: "CreditTypes",
 			Handler:    _Query_CreditTypes_Handler,
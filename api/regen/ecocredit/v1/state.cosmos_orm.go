@@ -806,6 +806,32 @@ func (this BatchIssuerIndexKey) WithIssuer(issuer []byte) BatchIssuerIndexKey {
 	return this
 }
 
+type BatchExpiryDateIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchExpiryDateIndexKey) id() uint32            { return 5 }
+func (x BatchExpiryDateIndexKey) values() []interface{} { return x.vs }
+func (x BatchExpiryDateIndexKey) batchIndexKey()        {}
+
+func (this BatchExpiryDateIndexKey) WithExpiryDate(expiry_date *timestamppb.Timestamp) BatchExpiryDateIndexKey {
+	this.vs = []interface{}{expiry_date}
+	return this
+}
+
+type BatchIssuanceDateIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchIssuanceDateIndexKey) id() uint32            { return 6 }
+func (x BatchIssuanceDateIndexKey) values() []interface{} { return x.vs }
+func (x BatchIssuanceDateIndexKey) batchIndexKey()        {}
+
+func (this BatchIssuanceDateIndexKey) WithIssuanceDate(issuance_date *timestamppb.Timestamp) BatchIssuanceDateIndexKey {
+	this.vs = []interface{}{issuance_date}
+	return this
+}
+
 type batchTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -1608,6 +1634,397 @@ func NewBatchOriginTxTable(db ormtable.Schema) (BatchOriginTxTable, error) {
 	return batchOriginTxTable{table}, nil
 }
 
+type BatchBufferTable interface {
+	Insert(ctx context.Context, batchBuffer *BatchBuffer) error
+	Update(ctx context.Context, batchBuffer *BatchBuffer) error
+	Save(ctx context.Context, batchBuffer *BatchBuffer) error
+	Delete(ctx context.Context, batchBuffer *BatchBuffer) error
+	Has(ctx context.Context, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, batch_key uint64) (*BatchBuffer, error)
+	List(ctx context.Context, prefixKey BatchBufferIndexKey, opts ...ormlist.Option) (BatchBufferIterator, error)
+	ListRange(ctx context.Context, from, to BatchBufferIndexKey, opts ...ormlist.Option) (BatchBufferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchBufferIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchBufferIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchBufferIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchBufferIterator) Value() (*BatchBuffer, error) {
+	var batchBuffer BatchBuffer
+	err := i.UnmarshalMessage(&batchBuffer)
+	return &batchBuffer, err
+}
+
+type BatchBufferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchBufferIndexKey()
+}
+
+// primary key starting index..
+type BatchBufferPrimaryKey = BatchBufferBatchKeyIndexKey
+
+type BatchBufferBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchBufferBatchKeyIndexKey) id() uint32            { return 0 }
+func (x BatchBufferBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchBufferBatchKeyIndexKey) batchBufferIndexKey()  {}
+
+func (this BatchBufferBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchBufferBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type BatchBufferClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchBufferClassKeyIndexKey) id() uint32            { return 1 }
+func (x BatchBufferClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchBufferClassKeyIndexKey) batchBufferIndexKey()  {}
+
+func (this BatchBufferClassKeyIndexKey) WithClassKey(class_key uint64) BatchBufferClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type batchBufferTable struct {
+	table ormtable.Table
+}
+
+func (this batchBufferTable) Insert(ctx context.Context, batchBuffer *BatchBuffer) error {
+	return this.table.Insert(ctx, batchBuffer)
+}
+
+func (this batchBufferTable) Update(ctx context.Context, batchBuffer *BatchBuffer) error {
+	return this.table.Update(ctx, batchBuffer)
+}
+
+func (this batchBufferTable) Save(ctx context.Context, batchBuffer *BatchBuffer) error {
+	return this.table.Save(ctx, batchBuffer)
+}
+
+func (this batchBufferTable) Delete(ctx context.Context, batchBuffer *BatchBuffer) error {
+	return this.table.Delete(ctx, batchBuffer)
+}
+
+func (this batchBufferTable) Has(ctx context.Context, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, batch_key)
+}
+
+func (this batchBufferTable) Get(ctx context.Context, batch_key uint64) (*BatchBuffer, error) {
+	var batchBuffer BatchBuffer
+	found, err := this.table.PrimaryKey().Get(ctx, &batchBuffer, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchBuffer, nil
+}
+
+func (this batchBufferTable) List(ctx context.Context, prefixKey BatchBufferIndexKey, opts ...ormlist.Option) (BatchBufferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchBufferIterator{it}, err
+}
+
+func (this batchBufferTable) ListRange(ctx context.Context, from, to BatchBufferIndexKey, opts ...ormlist.Option) (BatchBufferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchBufferIterator{it}, err
+}
+
+func (this batchBufferTable) DeleteBy(ctx context.Context, prefixKey BatchBufferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchBufferTable) DeleteRange(ctx context.Context, from, to BatchBufferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchBufferTable) doNotImplement() {}
+
+var _ BatchBufferTable = batchBufferTable{}
+
+func NewBatchBufferTable(db ormtable.Schema) (BatchBufferTable, error) {
+	table := db.GetTable(&BatchBuffer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchBuffer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchBufferTable{table}, nil
+}
+
+type BatchAnnotationTable interface {
+	Insert(ctx context.Context, batchAnnotation *BatchAnnotation) error
+	InsertReturningID(ctx context.Context, batchAnnotation *BatchAnnotation) (uint64, error)
+	Update(ctx context.Context, batchAnnotation *BatchAnnotation) error
+	Save(ctx context.Context, batchAnnotation *BatchAnnotation) error
+	Delete(ctx context.Context, batchAnnotation *BatchAnnotation) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BatchAnnotation, error)
+	List(ctx context.Context, prefixKey BatchAnnotationIndexKey, opts ...ormlist.Option) (BatchAnnotationIterator, error)
+	ListRange(ctx context.Context, from, to BatchAnnotationIndexKey, opts ...ormlist.Option) (BatchAnnotationIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchAnnotationIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchAnnotationIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchAnnotationIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchAnnotationIterator) Value() (*BatchAnnotation, error) {
+	var batchAnnotation BatchAnnotation
+	err := i.UnmarshalMessage(&batchAnnotation)
+	return &batchAnnotation, err
+}
+
+type BatchAnnotationIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchAnnotationIndexKey()
+}
+
+// primary key starting index..
+type BatchAnnotationPrimaryKey = BatchAnnotationIdIndexKey
+
+type BatchAnnotationIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAnnotationIdIndexKey) id() uint32               { return 0 }
+func (x BatchAnnotationIdIndexKey) values() []interface{}    { return x.vs }
+func (x BatchAnnotationIdIndexKey) batchAnnotationIndexKey() {}
+
+func (this BatchAnnotationIdIndexKey) WithId(id uint64) BatchAnnotationIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BatchAnnotationBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAnnotationBatchKeyIndexKey) id() uint32               { return 1 }
+func (x BatchAnnotationBatchKeyIndexKey) values() []interface{}    { return x.vs }
+func (x BatchAnnotationBatchKeyIndexKey) batchAnnotationIndexKey() {}
+
+func (this BatchAnnotationBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchAnnotationBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchAnnotationTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this batchAnnotationTable) Insert(ctx context.Context, batchAnnotation *BatchAnnotation) error {
+	return this.table.Insert(ctx, batchAnnotation)
+}
+
+func (this batchAnnotationTable) Update(ctx context.Context, batchAnnotation *BatchAnnotation) error {
+	return this.table.Update(ctx, batchAnnotation)
+}
+
+func (this batchAnnotationTable) Save(ctx context.Context, batchAnnotation *BatchAnnotation) error {
+	return this.table.Save(ctx, batchAnnotation)
+}
+
+func (this batchAnnotationTable) Delete(ctx context.Context, batchAnnotation *BatchAnnotation) error {
+	return this.table.Delete(ctx, batchAnnotation)
+}
+
+func (this batchAnnotationTable) InsertReturningID(ctx context.Context, batchAnnotation *BatchAnnotation) (uint64, error) {
+	return this.table.InsertReturningID(ctx, batchAnnotation)
+}
+
+func (this batchAnnotationTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this batchAnnotationTable) Get(ctx context.Context, id uint64) (*BatchAnnotation, error) {
+	var batchAnnotation BatchAnnotation
+	found, err := this.table.PrimaryKey().Get(ctx, &batchAnnotation, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchAnnotation, nil
+}
+
+func (this batchAnnotationTable) List(ctx context.Context, prefixKey BatchAnnotationIndexKey, opts ...ormlist.Option) (BatchAnnotationIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchAnnotationIterator{it}, err
+}
+
+func (this batchAnnotationTable) ListRange(ctx context.Context, from, to BatchAnnotationIndexKey, opts ...ormlist.Option) (BatchAnnotationIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchAnnotationIterator{it}, err
+}
+
+func (this batchAnnotationTable) DeleteBy(ctx context.Context, prefixKey BatchAnnotationIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchAnnotationTable) DeleteRange(ctx context.Context, from, to BatchAnnotationIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchAnnotationTable) doNotImplement() {}
+
+var _ BatchAnnotationTable = batchAnnotationTable{}
+
+func NewBatchAnnotationTable(db ormtable.Schema) (BatchAnnotationTable, error) {
+	table := db.GetTable(&BatchAnnotation{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchAnnotation{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchAnnotationTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type BatchRetirementTable interface {
+	Insert(ctx context.Context, batchRetirement *BatchRetirement) error
+	InsertReturningID(ctx context.Context, batchRetirement *BatchRetirement) (uint64, error)
+	Update(ctx context.Context, batchRetirement *BatchRetirement) error
+	Save(ctx context.Context, batchRetirement *BatchRetirement) error
+	Delete(ctx context.Context, batchRetirement *BatchRetirement) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BatchRetirement, error)
+	List(ctx context.Context, prefixKey BatchRetirementIndexKey, opts ...ormlist.Option) (BatchRetirementIterator, error)
+	ListRange(ctx context.Context, from, to BatchRetirementIndexKey, opts ...ormlist.Option) (BatchRetirementIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchRetirementIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchRetirementIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchRetirementIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchRetirementIterator) Value() (*BatchRetirement, error) {
+	var batchRetirement BatchRetirement
+	err := i.UnmarshalMessage(&batchRetirement)
+	return &batchRetirement, err
+}
+
+type BatchRetirementIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchRetirementIndexKey()
+}
+
+// primary key starting index..
+type BatchRetirementPrimaryKey = BatchRetirementIdIndexKey
+
+type BatchRetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchRetirementIdIndexKey) id() uint32               { return 0 }
+func (x BatchRetirementIdIndexKey) values() []interface{}    { return x.vs }
+func (x BatchRetirementIdIndexKey) batchRetirementIndexKey() {}
+
+func (this BatchRetirementIdIndexKey) WithId(id uint64) BatchRetirementIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BatchRetirementBeneficiaryIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchRetirementBeneficiaryIndexKey) id() uint32               { return 1 }
+func (x BatchRetirementBeneficiaryIndexKey) values() []interface{}    { return x.vs }
+func (x BatchRetirementBeneficiaryIndexKey) batchRetirementIndexKey() {}
+
+func (this BatchRetirementBeneficiaryIndexKey) WithBeneficiary(beneficiary string) BatchRetirementBeneficiaryIndexKey {
+	this.vs = []interface{}{beneficiary}
+	return this
+}
+
+type batchRetirementTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this batchRetirementTable) Insert(ctx context.Context, batchRetirement *BatchRetirement) error {
+	return this.table.Insert(ctx, batchRetirement)
+}
+
+func (this batchRetirementTable) Update(ctx context.Context, batchRetirement *BatchRetirement) error {
+	return this.table.Update(ctx, batchRetirement)
+}
+
+func (this batchRetirementTable) Save(ctx context.Context, batchRetirement *BatchRetirement) error {
+	return this.table.Save(ctx, batchRetirement)
+}
+
+func (this batchRetirementTable) Delete(ctx context.Context, batchRetirement *BatchRetirement) error {
+	return this.table.Delete(ctx, batchRetirement)
+}
+
+func (this batchRetirementTable) InsertReturningID(ctx context.Context, batchRetirement *BatchRetirement) (uint64, error) {
+	return this.table.InsertReturningID(ctx, batchRetirement)
+}
+
+func (this batchRetirementTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this batchRetirementTable) Get(ctx context.Context, id uint64) (*BatchRetirement, error) {
+	var batchRetirement BatchRetirement
+	found, err := this.table.PrimaryKey().Get(ctx, &batchRetirement, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchRetirement, nil
+}
+
+func (this batchRetirementTable) List(ctx context.Context, prefixKey BatchRetirementIndexKey, opts ...ormlist.Option) (BatchRetirementIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchRetirementIterator{it}, err
+}
+
+func (this batchRetirementTable) ListRange(ctx context.Context, from, to BatchRetirementIndexKey, opts ...ormlist.Option) (BatchRetirementIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchRetirementIterator{it}, err
+}
+
+func (this batchRetirementTable) DeleteBy(ctx context.Context, prefixKey BatchRetirementIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchRetirementTable) DeleteRange(ctx context.Context, from, to BatchRetirementIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchRetirementTable) doNotImplement() {}
+
+var _ BatchRetirementTable = batchRetirementTable{}
+
+func NewBatchRetirementTable(db ormtable.Schema) (BatchRetirementTable, error) {
+	table := db.GetTable(&BatchRetirement{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchRetirement{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchRetirementTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type StateStore interface {
 	CreditTypeTable() CreditTypeTable
 	ClassTable() ClassTable
@@ -1620,6 +2037,9 @@ type StateStore interface {
 	BatchBalanceTable() BatchBalanceTable
 	BatchSupplyTable() BatchSupplyTable
 	BatchOriginTxTable() BatchOriginTxTable
+	BatchBufferTable() BatchBufferTable
+	BatchAnnotationTable() BatchAnnotationTable
+	BatchRetirementTable() BatchRetirementTable
 
 	doNotImplement()
 }
@@ -1636,6 +2056,9 @@ type stateStore struct {
 	batchBalance    BatchBalanceTable
 	batchSupply     BatchSupplyTable
 	batchOriginTx   BatchOriginTxTable
+	batchBuffer     BatchBufferTable
+	batchAnnotation BatchAnnotationTable
+	batchRetirement BatchRetirementTable
 }
 
 func (x stateStore) CreditTypeTable() CreditTypeTable {
@@ -1682,6 +2105,18 @@ func (x stateStore) BatchOriginTxTable() BatchOriginTxTable {
 	return x.batchOriginTx
 }
 
+func (x stateStore) BatchBufferTable() BatchBufferTable {
+	return x.batchBuffer
+}
+
+func (x stateStore) BatchAnnotationTable() BatchAnnotationTable {
+	return x.batchAnnotation
+}
+
+func (x stateStore) BatchRetirementTable() BatchRetirementTable {
+	return x.batchRetirement
+}
+
 func (stateStore) doNotImplement() {}
 
 var _ StateStore = stateStore{}
@@ -1742,6 +2177,21 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		return nil, err
 	}
 
+	batchBufferTable, err := NewBatchBufferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchAnnotationTable, err := NewBatchAnnotationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchRetirementTable, err := NewBatchRetirementTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return stateStore{
 		creditTypeTable,
 		classTable,
@@ -1754,5 +2204,8 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		batchBalanceTable,
 		batchSupplyTable,
 		batchOriginTxTable,
+		batchBufferTable,
+		batchAnnotationTable,
+		batchRetirementTable,
 	}, nil
 }
@@ -58,12 +58,21 @@ type MsgClient interface {
 	// MsgSealBatch seals an "open" credit batch. Once a credit batch is sealed
 	// (i.e. once "open" is set to false), credits can no longer be dynamically
 	// minted to the credit batch. A sealed credit batch cannot be unsealed and
-	// only the credit batch issuer can seal a credit batch.
+	// only the credit batch issuer can seal a credit batch. Sealing a credit
+	// batch that is already sealed fails.
 	SealBatch(ctx context.Context, in *MsgSealBatch, opts ...grpc.CallOption) (*MsgSealBatchResponse, error)
+	// AnnotateBatch appends a timestamped annotation to a credit batch. Only the
+	// credit batch issuer can annotate a credit batch. Annotations do not
+	// overwrite the metadata or any previous annotations of the credit batch.
+	AnnotateBatch(ctx context.Context, in *MsgAnnotateBatch, opts ...grpc.CallOption) (*MsgAnnotateBatchResponse, error)
 	// Send sends a specified amount of tradable credits from the credit owner's
 	// account to another account. Sent credits can either remain tradable or be
 	// retired upon receipt.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// MultiSend sends credits from the credit owner's account to multiple
+	// accounts in a single message. All transfers are executed atomically: if
+	// any transfer fails, none of the transfers are applied.
+	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
 	// Retire retires a specified amount of tradable credits, removing the amount
 	// from the credit owner's tradable balance and adding it to their retired
 	// balance. Retiring credits is permanent and implies the credits are being
@@ -80,6 +89,13 @@ type MsgClient interface {
 	// UpdateClassIssuers updates the credit class issuer list. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassIssuers(ctx context.Context, in *MsgUpdateClassIssuers, opts ...grpc.CallOption) (*MsgUpdateClassIssuersResponse, error)
+	// AddClassIssuer adds an issuer to the credit class issuer list. Only the
+	// admin of the credit class can add an issuer.
+	AddClassIssuer(ctx context.Context, in *MsgAddClassIssuer, opts ...grpc.CallOption) (*MsgAddClassIssuerResponse, error)
+	// RemoveClassIssuer removes an issuer from the credit class issuer list. Only
+	// the admin of the credit class can remove an issuer and a credit class must
+	// always have at least one issuer.
+	RemoveClassIssuer(ctx context.Context, in *MsgRemoveClassIssuer, opts ...grpc.CallOption) (*MsgRemoveClassIssuerResponse, error)
 	// UpdateClassMetadata updates the credit class metadata. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassMetadata(ctx context.Context, in *MsgUpdateClassMetadata, opts ...grpc.CallOption) (*MsgUpdateClassMetadataResponse, error)
@@ -95,6 +111,25 @@ type MsgClient interface {
 	// BridgeReceive processes credits being sent from an external registry or
 	// network to Regen Ledger.
 	BridgeReceive(ctx context.Context, in *MsgBridgeReceive, opts ...grpc.CallOption) (*MsgBridgeReceiveResponse, error)
+	// SetClassFee updates the credit class creation fee. Only the module
+	// authority (the governance module account) can update the credit class
+	// fee. No other parameters are modified.
+	SetClassFee(ctx context.Context, in *MsgSetClassFee, opts ...grpc.CallOption) (*MsgSetClassFeeResponse, error)
+	// UpdateCreditTypePrecision increases the precision of a credit type. The
+	// balances and supplies of credit batches are unchanged. Only the module
+	// authority (the governance module account) can update the precision of a
+	// credit type, the precision can never be decreased, and the precision
+	// cannot be updated while any basket or sell order holds credits of the
+	// credit type.
+	UpdateCreditTypePrecision(ctx context.Context, in *MsgUpdateCreditTypePrecision, opts ...grpc.CallOption) (*MsgUpdateCreditTypePrecisionResponse, error)
+	// DeleteClass deletes a credit class and its issuers. Only the module
+	// authority (the governance module account) can delete a credit class and
+	// the credit class cannot be deleted while any project references it.
+	DeleteClass(ctx context.Context, in *MsgDeleteClass, opts ...grpc.CallOption) (*MsgDeleteClassResponse, error)
+	// AddCreditType adds a new credit type to the network. Only the module
+	// authority (the governance module account) can add a credit type and the
+	// abbreviation and name of the credit type must be unique.
+	AddCreditType(ctx context.Context, in *MsgAddCreditType, opts ...grpc.CallOption) (*MsgAddCreditTypeResponse, error)
 }
 
 type msgClient struct {
@@ -150,6 +185,15 @@ func (c *msgClient) SealBatch(ctx context.Context, in *MsgSealBatch, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) AnnotateBatch(ctx context.Context, in *MsgAnnotateBatch, opts ...grpc.CallOption) (*MsgAnnotateBatchResponse, error) {
+	out := new(MsgAnnotateBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AnnotateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
 	out := new(MsgSendResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Send", in, out, opts...)
@@ -159,6 +203,15 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
+	out := new(MsgMultiSendResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/MultiSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Retire(ctx context.Context, in *MsgRetire, opts ...grpc.CallOption) (*MsgRetireResponse, error) {
 	out := new(MsgRetireResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Retire", in, out, opts...)
@@ -195,6 +248,24 @@ func (c *msgClient) UpdateClassIssuers(ctx context.Context, in *MsgUpdateClassIs
 	return out, nil
 }
 
+func (c *msgClient) AddClassIssuer(ctx context.Context, in *MsgAddClassIssuer, opts ...grpc.CallOption) (*MsgAddClassIssuerResponse, error) {
+	out := new(MsgAddClassIssuerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AddClassIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveClassIssuer(ctx context.Context, in *MsgRemoveClassIssuer, opts ...grpc.CallOption) (*MsgRemoveClassIssuerResponse, error) {
+	out := new(MsgRemoveClassIssuerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RemoveClassIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateClassMetadata(ctx context.Context, in *MsgUpdateClassMetadata, opts ...grpc.CallOption) (*MsgUpdateClassMetadataResponse, error) {
 	out := new(MsgUpdateClassMetadataResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassMetadata", in, out, opts...)
@@ -240,6 +311,42 @@ func (c *msgClient) BridgeReceive(ctx context.Context, in *MsgBridgeReceive, opt
 	return out, nil
 }
 
+func (c *msgClient) SetClassFee(ctx context.Context, in *MsgSetClassFee, opts ...grpc.CallOption) (*MsgSetClassFeeResponse, error) {
+	out := new(MsgSetClassFeeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SetClassFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCreditTypePrecision(ctx context.Context, in *MsgUpdateCreditTypePrecision, opts ...grpc.CallOption) (*MsgUpdateCreditTypePrecisionResponse, error) {
+	out := new(MsgUpdateCreditTypePrecisionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateCreditTypePrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteClass(ctx context.Context, in *MsgDeleteClass, opts ...grpc.CallOption) (*MsgDeleteClassResponse, error) {
+	out := new(MsgDeleteClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/DeleteClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddCreditType(ctx context.Context, in *MsgAddCreditType, opts ...grpc.CallOption) (*MsgAddCreditTypeResponse, error) {
+	out := new(MsgAddCreditTypeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AddCreditType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -280,12 +387,21 @@ type MsgServer interface {
 	// MsgSealBatch seals an "open" credit batch. Once a credit batch is sealed
 	// (i.e. once "open" is set to false), credits can no longer be dynamically
 	// minted to the credit batch. A sealed credit batch cannot be unsealed and
-	// only the credit batch issuer can seal a credit batch.
+	// only the credit batch issuer can seal a credit batch. Sealing a credit
+	// batch that is already sealed fails.
 	SealBatch(context.Context, *MsgSealBatch) (*MsgSealBatchResponse, error)
+	// AnnotateBatch appends a timestamped annotation to a credit batch. Only the
+	// credit batch issuer can annotate a credit batch. Annotations do not
+	// overwrite the metadata or any previous annotations of the credit batch.
+	AnnotateBatch(context.Context, *MsgAnnotateBatch) (*MsgAnnotateBatchResponse, error)
 	// Send sends a specified amount of tradable credits from the credit owner's
 	// account to another account. Sent credits can either remain tradable or be
 	// retired upon receipt.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend sends credits from the credit owner's account to multiple
+	// accounts in a single message. All transfers are executed atomically: if
+	// any transfer fails, none of the transfers are applied.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
 	// Retire retires a specified amount of tradable credits, removing the amount
 	// from the credit owner's tradable balance and adding it to their retired
 	// balance. Retiring credits is permanent and implies the credits are being
@@ -302,6 +418,13 @@ type MsgServer interface {
 	// UpdateClassIssuers updates the credit class issuer list. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassIssuers(context.Context, *MsgUpdateClassIssuers) (*MsgUpdateClassIssuersResponse, error)
+	// AddClassIssuer adds an issuer to the credit class issuer list. Only the
+	// admin of the credit class can add an issuer.
+	AddClassIssuer(context.Context, *MsgAddClassIssuer) (*MsgAddClassIssuerResponse, error)
+	// RemoveClassIssuer removes an issuer from the credit class issuer list. Only
+	// the admin of the credit class can remove an issuer and a credit class must
+	// always have at least one issuer.
+	RemoveClassIssuer(context.Context, *MsgRemoveClassIssuer) (*MsgRemoveClassIssuerResponse, error)
 	// UpdateClassMetadata updates the credit class metadata. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassMetadata(context.Context, *MsgUpdateClassMetadata) (*MsgUpdateClassMetadataResponse, error)
@@ -317,6 +440,25 @@ type MsgServer interface {
 	// BridgeReceive processes credits being sent from an external registry or
 	// network to Regen Ledger.
 	BridgeReceive(context.Context, *MsgBridgeReceive) (*MsgBridgeReceiveResponse, error)
+	// SetClassFee updates the credit class creation fee. Only the module
+	// authority (the governance module account) can update the credit class
+	// fee. No other parameters are modified.
+	SetClassFee(context.Context, *MsgSetClassFee) (*MsgSetClassFeeResponse, error)
+	// UpdateCreditTypePrecision increases the precision of a credit type. The
+	// balances and supplies of credit batches are unchanged. Only the module
+	// authority (the governance module account) can update the precision of a
+	// credit type, the precision can never be decreased, and the precision
+	// cannot be updated while any basket or sell order holds credits of the
+	// credit type.
+	UpdateCreditTypePrecision(context.Context, *MsgUpdateCreditTypePrecision) (*MsgUpdateCreditTypePrecisionResponse, error)
+	// DeleteClass deletes a credit class and its issuers. Only the module
+	// authority (the governance module account) can delete a credit class and
+	// the credit class cannot be deleted while any project references it.
+	DeleteClass(context.Context, *MsgDeleteClass) (*MsgDeleteClassResponse, error)
+	// AddCreditType adds a new credit type to the network. Only the module
+	// authority (the governance module account) can add a credit type and the
+	// abbreviation and name of the credit type must be unique.
+	AddCreditType(context.Context, *MsgAddCreditType) (*MsgAddCreditTypeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -339,9 +481,15 @@ func (UnimplementedMsgServer) MintBatchCredits(context.Context, *MsgMintBatchCre
 func (UnimplementedMsgServer) SealBatch(context.Context, *MsgSealBatch) (*MsgSealBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SealBatch not implemented")
 }
+func (UnimplementedMsgServer) AnnotateBatch(context.Context, *MsgAnnotateBatch) (*MsgAnnotateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateBatch not implemented")
+}
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+}
 func (UnimplementedMsgServer) Retire(context.Context, *MsgRetire) (*MsgRetireResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Retire not implemented")
 }
@@ -354,6 +502,12 @@ func (UnimplementedMsgServer) UpdateClassAdmin(context.Context, *MsgUpdateClassA
 func (UnimplementedMsgServer) UpdateClassIssuers(context.Context, *MsgUpdateClassIssuers) (*MsgUpdateClassIssuersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassIssuers not implemented")
 }
+func (UnimplementedMsgServer) AddClassIssuer(context.Context, *MsgAddClassIssuer) (*MsgAddClassIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClassIssuer not implemented")
+}
+func (UnimplementedMsgServer) RemoveClassIssuer(context.Context, *MsgRemoveClassIssuer) (*MsgRemoveClassIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClassIssuer not implemented")
+}
 func (UnimplementedMsgServer) UpdateClassMetadata(context.Context, *MsgUpdateClassMetadata) (*MsgUpdateClassMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassMetadata not implemented")
 }
@@ -369,6 +523,18 @@ func (UnimplementedMsgServer) Bridge(context.Context, *MsgBridge) (*MsgBridgeRes
 func (UnimplementedMsgServer) BridgeReceive(context.Context, *MsgBridgeReceive) (*MsgBridgeReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeReceive not implemented")
 }
+func (UnimplementedMsgServer) SetClassFee(context.Context, *MsgSetClassFee) (*MsgSetClassFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClassFee not implemented")
+}
+func (UnimplementedMsgServer) UpdateCreditTypePrecision(context.Context, *MsgUpdateCreditTypePrecision) (*MsgUpdateCreditTypePrecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditTypePrecision not implemented")
+}
+func (UnimplementedMsgServer) DeleteClass(context.Context, *MsgDeleteClass) (*MsgDeleteClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClass not implemented")
+}
+func (UnimplementedMsgServer) AddCreditType(context.Context, *MsgAddCreditType) (*MsgAddCreditTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCreditType not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -472,6 +638,24 @@ func _Msg_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AnnotateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnotateBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnotateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AnnotateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnotateBatch(ctx, req.(*MsgAnnotateBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSend)
 	if err := dec(in); err != nil {
@@ -490,6 +674,24 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/MultiSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Retire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRetire)
 	if err := dec(in); err != nil {
@@ -562,6 +764,42 @@ func _Msg_UpdateClassIssuers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddClassIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddClassIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddClassIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AddClassIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddClassIssuer(ctx, req.(*MsgAddClassIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveClassIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveClassIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveClassIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveClassIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveClassIssuer(ctx, req.(*MsgRemoveClassIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateClassMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateClassMetadata)
 	if err := dec(in); err != nil {
@@ -652,6 +890,78 @@ func _Msg_BridgeReceive_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetClassFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SetClassFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassFee(ctx, req.(*MsgSetClassFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCreditTypePrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCreditTypePrecision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCreditTypePrecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateCreditTypePrecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCreditTypePrecision(ctx, req.(*MsgUpdateCreditTypePrecision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/DeleteClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteClass(ctx, req.(*MsgDeleteClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCreditType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCreditType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AddCreditType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCreditType(ctx, req.(*MsgAddCreditType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -679,10 +989,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SealBatch",
 			Handler:    _Msg_SealBatch_Handler,
 		},
+		{
+			MethodName: "AnnotateBatch",
+			Handler:    _Msg_AnnotateBatch_Handler,
+		},
 		{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "MultiSend",
+			Handler:    _Msg_MultiSend_Handler,
+		},
 		{
 			MethodName: "Retire",
 			Handler:    _Msg_Retire_Handler,
@@ -699,6 +1017,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClassIssuers",
 			Handler:    _Msg_UpdateClassIssuers_Handler,
 		},
+		{
+			MethodName: "AddClassIssuer",
+			Handler:    _Msg_AddClassIssuer_Handler,
+		},
+		{
+			MethodName: "RemoveClassIssuer",
+			Handler:    _Msg_RemoveClassIssuer_Handler,
+		},
 		{
 			MethodName: "UpdateClassMetadata",
 			Handler:    _Msg_UpdateClassMetadata_Handler,
@@ -719,6 +1045,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeReceive",
 			Handler:    _Msg_BridgeReceive_Handler,
 		},
+		{
+			MethodName: "SetClassFee",
+			Handler:    _Msg_SetClassFee_Handler,
+		},
+		{
+			MethodName: "UpdateCreditTypePrecision",
+			Handler:    _Msg_UpdateCreditTypePrecision_Handler,
+		},
+		{
+			MethodName: "DeleteClass",
+			Handler:    _Msg_DeleteClass_Handler,
+		},
+		{
+			MethodName: "AddCreditType",
+			Handler:    _Msg_AddCreditType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/tx.proto",
@@ -3,12 +3,15 @@ package groupv1alpha1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	v1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	_ "github.com/gogo/protobuf/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -6804,27 +6807,25 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryProposalsByGroupAccountRequest            protoreflect.MessageDescriptor
-	fd_QueryProposalsByGroupAccountRequest_address    protoreflect.FieldDescriptor
-	fd_QueryProposalsByGroupAccountRequest_pagination protoreflect.FieldDescriptor
+	md_QueryProposalProposersRequest             protoreflect.MessageDescriptor
+	fd_QueryProposalProposersRequest_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryProposalsByGroupAccountRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalsByGroupAccountRequest")
-	fd_QueryProposalsByGroupAccountRequest_address = md_QueryProposalsByGroupAccountRequest.Fields().ByName("address")
-	fd_QueryProposalsByGroupAccountRequest_pagination = md_QueryProposalsByGroupAccountRequest.Fields().ByName("pagination")
+	md_QueryProposalProposersRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalProposersRequest")
+	fd_QueryProposalProposersRequest_proposal_id = md_QueryProposalProposersRequest.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupAccountRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalProposersRequest)(nil)
 
-type fastReflection_QueryProposalsByGroupAccountRequest QueryProposalsByGroupAccountRequest
+type fastReflection_QueryProposalProposersRequest QueryProposalProposersRequest
 
-func (x *QueryProposalsByGroupAccountRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupAccountRequest)(x)
+func (x *QueryProposalProposersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalProposersRequest)(x)
 }
 
-func (x *QueryProposalsByGroupAccountRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalProposersRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6836,43 +6837,43 @@ func (x *QueryProposalsByGroupAccountRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsByGroupAccountRequest_messageType fastReflection_QueryProposalsByGroupAccountRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupAccountRequest_messageType{}
+var _fastReflection_QueryProposalProposersRequest_messageType fastReflection_QueryProposalProposersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalProposersRequest_messageType{}
 
-type fastReflection_QueryProposalsByGroupAccountRequest_messageType struct{}
+type fastReflection_QueryProposalProposersRequest_messageType struct{}
 
-func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupAccountRequest)(nil)
+func (x fastReflection_QueryProposalProposersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalProposersRequest)(nil)
 }
-func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupAccountRequest)
+func (x fastReflection_QueryProposalProposersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalProposersRequest)
 }
-func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupAccountRequest
+func (x fastReflection_QueryProposalProposersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalProposersRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupAccountRequest
+func (x *fastReflection_QueryProposalProposersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalProposersRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsByGroupAccountRequest_messageType
+func (x *fastReflection_QueryProposalProposersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalProposersRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupAccountRequest)
+func (x *fastReflection_QueryProposalProposersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalProposersRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsByGroupAccountRequest)(x)
+func (x *fastReflection_QueryProposalProposersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalProposersRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6880,16 +6881,10 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryProposalsByGroupAccountRequest_address, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsByGroupAccountRequest_pagination, value) {
+func (x *fastReflection_QueryProposalProposersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryProposalProposersRequest_proposal_id, value) {
 			return
 		}
 	}
@@ -6906,17 +6901,15 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalProposersRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		return x.Address != ""
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		return x.Pagination != nil
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6926,17 +6919,15 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalProposersRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		x.Address = ""
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		x.Pagination = nil
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6946,19 +6937,16 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6972,17 +6960,15 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalProposersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		x.Address = value.Interface().(string)
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6996,48 +6982,40 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		panic(fmt.Errorf("field address of message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryProposalProposersRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
-		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalProposersRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalProposersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalsByGroupAccountRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalProposersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7045,7 +7023,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalProposersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7056,7 +7034,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalProposersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7068,7 +7046,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) IsValid() bool {
+func (x *fastReflection_QueryProposalProposersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7078,9 +7056,9 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalProposersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
+		x := input.Message.Interface().(*QueryProposalProposersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7092,13 +7070,8 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7110,7 +7083,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
+		x := input.Message.Interface().(*QueryProposalProposersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7129,26 +7102,10 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7161,7 +7118,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
+		x := input.Message.Interface().(*QueryProposalProposersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7193,49 +7150,17 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalProposersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalProposersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var msglen int
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7245,28 +7170,11 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7302,79 +7210,72 @@ func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *pro
 	}
 }
 
-var _ protoreflect.List = (*_QueryProposalsByGroupAccountResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryProposalProposersResponse_1_list)(nil)
 
-type _QueryProposalsByGroupAccountResponse_1_list struct {
-	list *[]*Proposal
+type _QueryProposalProposersResponse_1_list struct {
+	list *[]string
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) Len() int {
+func (x *_QueryProposalProposersResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_QueryProposalProposersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
+func (x *_QueryProposalProposersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
+func (x *_QueryProposalProposersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Proposal)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_QueryProposalProposersResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryProposalProposersResponse at list field Proposers as it is not of Message kind"))
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_QueryProposalProposersResponse_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Proposal)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_QueryProposalProposersResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_QueryProposalsByGroupAccountResponse_1_list) IsValid() bool {
+func (x *_QueryProposalProposersResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryProposalsByGroupAccountResponse            protoreflect.MessageDescriptor
-	fd_QueryProposalsByGroupAccountResponse_proposals  protoreflect.FieldDescriptor
-	fd_QueryProposalsByGroupAccountResponse_pagination protoreflect.FieldDescriptor
+	md_QueryProposalProposersResponse           protoreflect.MessageDescriptor
+	fd_QueryProposalProposersResponse_proposers protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryProposalsByGroupAccountResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalsByGroupAccountResponse")
-	fd_QueryProposalsByGroupAccountResponse_proposals = md_QueryProposalsByGroupAccountResponse.Fields().ByName("proposals")
-	fd_QueryProposalsByGroupAccountResponse_pagination = md_QueryProposalsByGroupAccountResponse.Fields().ByName("pagination")
+	md_QueryProposalProposersResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalProposersResponse")
+	fd_QueryProposalProposersResponse_proposers = md_QueryProposalProposersResponse.Fields().ByName("proposers")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupAccountResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalProposersResponse)(nil)
 
-type fastReflection_QueryProposalsByGroupAccountResponse QueryProposalsByGroupAccountResponse
+type fastReflection_QueryProposalProposersResponse QueryProposalProposersResponse
 
-func (x *QueryProposalsByGroupAccountResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupAccountResponse)(x)
+func (x *QueryProposalProposersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalProposersResponse)(x)
 }
 
-func (x *QueryProposalsByGroupAccountResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalProposersResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7386,43 +7287,43 @@ func (x *QueryProposalsByGroupAccountResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsByGroupAccountResponse_messageType fastReflection_QueryProposalsByGroupAccountResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupAccountResponse_messageType{}
+var _fastReflection_QueryProposalProposersResponse_messageType fastReflection_QueryProposalProposersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalProposersResponse_messageType{}
 
-type fastReflection_QueryProposalsByGroupAccountResponse_messageType struct{}
+type fastReflection_QueryProposalProposersResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupAccountResponse)(nil)
+func (x fastReflection_QueryProposalProposersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalProposersResponse)(nil)
 }
-func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupAccountResponse)
+func (x fastReflection_QueryProposalProposersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalProposersResponse)
 }
-func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupAccountResponse
+func (x fastReflection_QueryProposalProposersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalProposersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupAccountResponse
+func (x *fastReflection_QueryProposalProposersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalProposersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsByGroupAccountResponse_messageType
+func (x *fastReflection_QueryProposalProposersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalProposersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupAccountResponse)
+func (x *fastReflection_QueryProposalProposersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalProposersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsByGroupAccountResponse)(x)
+func (x *fastReflection_QueryProposalProposersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalProposersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7430,16 +7331,10 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Proposals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals})
-		if !f(fd_QueryProposalsByGroupAccountResponse_proposals, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsByGroupAccountResponse_pagination, value) {
+func (x *fastReflection_QueryProposalProposersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Proposers) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProposalProposersResponse_1_list{list: &x.Proposers})
+		if !f(fd_QueryProposalProposersResponse_proposers, value) {
 			return
 		}
 	}
@@ -7456,17 +7351,15 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalProposersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
-		return len(x.Proposals) != 0
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		return x.Pagination != nil
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
+		return len(x.Proposers) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7476,17 +7369,15 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalProposersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
-		x.Proposals = nil
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		x.Pagination = nil
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
+		x.Proposers = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7496,22 +7387,19 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
-		if len(x.Proposals) == 0 {
-			return protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{})
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
+		if len(x.Proposers) == 0 {
+			return protoreflect.ValueOfList(&_QueryProposalProposersResponse_1_list{})
 		}
-		listValue := &_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals}
+		listValue := &_QueryProposalProposersResponse_1_list{list: &x.Proposers}
 		return protoreflect.ValueOfList(listValue)
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7525,19 +7413,17 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalProposersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
 		lv := value.List()
-		clv := lv.(*_QueryProposalsByGroupAccountResponse_1_list)
-		x.Proposals = *clv.list
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+		clv := lv.(*_QueryProposalProposersResponse_1_list)
+		x.Proposers = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7551,53 +7437,45 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
-		if x.Proposals == nil {
-			x.Proposals = []*Proposal{}
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
+		if x.Proposers == nil {
+			x.Proposers = []string{}
 		}
-		value := &_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals}
+		value := &_QueryProposalProposersResponse_1_list{list: &x.Proposers}
 		return protoreflect.ValueOfList(value)
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalProposersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
-		list := []*Proposal{}
-		return protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{list: &list})
-	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalProposersResponse.proposers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryProposalProposersResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalProposersResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalProposersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalProposersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalsByGroupAccountResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalProposersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7605,7 +7483,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalProposersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7616,7 +7494,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalProposersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7628,7 +7506,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) IsValid() bool {
+func (x *fastReflection_QueryProposalProposersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7638,9 +7516,9 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalProposersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
+		x := input.Message.Interface().(*QueryProposalProposersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7652,16 +7530,12 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		if len(x.Proposals) > 0 {
-			for _, e := range x.Proposals {
-				l = options.Size(e)
+		if len(x.Proposers) > 0 {
+			for _, s := range x.Proposers {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7672,7 +7546,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
+		x := input.Message.Interface().(*QueryProposalProposersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7691,32 +7565,11 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Proposals) > 0 {
-			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Proposals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Proposers) > 0 {
+			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proposers[iNdEx])
+				copy(dAtA[i:], x.Proposers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -7732,7 +7585,7 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
+		x := input.Message.Interface().(*QueryProposalProposersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7764,51 +7617,17 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalProposersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalProposersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Proposals = append(x.Proposals, &Proposal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7818,27 +7637,23 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7876,27 +7691,29 @@ func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryVoteByProposalVoterRequest             protoreflect.MessageDescriptor
-	fd_QueryVoteByProposalVoterRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVoteByProposalVoterRequest_voter       protoreflect.FieldDescriptor
+	md_QueryProposalsByGroupAccountRequest            protoreflect.MessageDescriptor
+	fd_QueryProposalsByGroupAccountRequest_address    protoreflect.FieldDescriptor
+	fd_QueryProposalsByGroupAccountRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryProposalsByGroupAccountRequest_status     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVoteByProposalVoterRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVoteByProposalVoterRequest")
-	fd_QueryVoteByProposalVoterRequest_proposal_id = md_QueryVoteByProposalVoterRequest.Fields().ByName("proposal_id")
-	fd_QueryVoteByProposalVoterRequest_voter = md_QueryVoteByProposalVoterRequest.Fields().ByName("voter")
+	md_QueryProposalsByGroupAccountRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalsByGroupAccountRequest")
+	fd_QueryProposalsByGroupAccountRequest_address = md_QueryProposalsByGroupAccountRequest.Fields().ByName("address")
+	fd_QueryProposalsByGroupAccountRequest_pagination = md_QueryProposalsByGroupAccountRequest.Fields().ByName("pagination")
+	fd_QueryProposalsByGroupAccountRequest_status = md_QueryProposalsByGroupAccountRequest.Fields().ByName("status")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupAccountRequest)(nil)
 
-type fastReflection_QueryVoteByProposalVoterRequest QueryVoteByProposalVoterRequest
+type fastReflection_QueryProposalsByGroupAccountRequest QueryProposalsByGroupAccountRequest
 
-func (x *QueryVoteByProposalVoterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterRequest)(x)
+func (x *QueryProposalsByGroupAccountRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupAccountRequest)(x)
 }
 
-func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalsByGroupAccountRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7908,43 +7725,43 @@ func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteByProposalVoterRequest_messageType fastReflection_QueryVoteByProposalVoterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterRequest_messageType{}
+var _fastReflection_QueryProposalsByGroupAccountRequest_messageType fastReflection_QueryProposalsByGroupAccountRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupAccountRequest_messageType{}
 
-type fastReflection_QueryVoteByProposalVoterRequest_messageType struct{}
+type fastReflection_QueryProposalsByGroupAccountRequest_messageType struct{}
 
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupAccountRequest)(nil)
 }
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterRequest)
+func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupAccountRequest)
 }
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterRequest
+func (x fastReflection_QueryProposalsByGroupAccountRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupAccountRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterRequest
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupAccountRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteByProposalVoterRequest_messageType
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsByGroupAccountRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterRequest)
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupAccountRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteByProposalVoterRequest)(x)
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsByGroupAccountRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7952,16 +7769,22 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVoteByProposalVoterRequest_proposal_id, value) {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryProposalsByGroupAccountRequest_address, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVoteByProposalVoterRequest_voter, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsByGroupAccountRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_QueryProposalsByGroupAccountRequest_status, value) {
 			return
 		}
 	}
@@ -7978,17 +7801,19 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
-		return x.Voter != ""
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
+		return x.Address != ""
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		return x.Pagination != nil
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		return x.Status != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7998,17 +7823,19 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		x.ProposalId = uint64(0)
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
-		x.Voter = ""
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
+		x.Address = ""
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		x.Pagination = nil
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		x.Status = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8018,19 +7845,22 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
-		value := x.Voter
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8044,17 +7874,19 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		x.ProposalId = value.Uint()
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
-		x.Voter = value.Interface().(string)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
+		x.Address = value.Interface().(string)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		x.Status = (Proposal_Status)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8068,44 +7900,52 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryVoteByProposalVoterRequest is not mutable"))
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
-		panic(fmt.Errorf("field voter of message regen.group.v1alpha1.QueryVoteByProposalVoterRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		panic(fmt.Errorf("field status of message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.address":
 		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVoteByProposalVoterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalsByGroupAccountRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8113,7 +7953,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8124,7 +7964,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8136,7 +7976,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8146,9 +7986,9 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalsByGroupAccountRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8160,13 +8000,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
-		}
-		l = len(x.Voter)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8177,7 +8021,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8196,17 +8040,31 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8219,7 +8077,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8251,17 +8109,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				x.ProposalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8271,16 +8129,29 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8290,24 +8161,47 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= Proposal_Status(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8343,26 +8237,79 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 	}
 }
 
+var _ protoreflect.List = (*_QueryProposalsByGroupAccountResponse_1_list)(nil)
+
+type _QueryProposalsByGroupAccountResponse_1_list struct {
+	list *[]*Proposal
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Proposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Proposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupAccountResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryVoteByProposalVoterResponse      protoreflect.MessageDescriptor
-	fd_QueryVoteByProposalVoterResponse_vote protoreflect.FieldDescriptor
+	md_QueryProposalsByGroupAccountResponse            protoreflect.MessageDescriptor
+	fd_QueryProposalsByGroupAccountResponse_proposals  protoreflect.FieldDescriptor
+	fd_QueryProposalsByGroupAccountResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVoteByProposalVoterResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVoteByProposalVoterResponse")
-	fd_QueryVoteByProposalVoterResponse_vote = md_QueryVoteByProposalVoterResponse.Fields().ByName("vote")
+	md_QueryProposalsByGroupAccountResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalsByGroupAccountResponse")
+	fd_QueryProposalsByGroupAccountResponse_proposals = md_QueryProposalsByGroupAccountResponse.Fields().ByName("proposals")
+	fd_QueryProposalsByGroupAccountResponse_pagination = md_QueryProposalsByGroupAccountResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupAccountResponse)(nil)
 
-type fastReflection_QueryVoteByProposalVoterResponse QueryVoteByProposalVoterResponse
+type fastReflection_QueryProposalsByGroupAccountResponse QueryProposalsByGroupAccountResponse
 
-func (x *QueryVoteByProposalVoterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterResponse)(x)
+func (x *QueryProposalsByGroupAccountResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupAccountResponse)(x)
 }
 
-func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalsByGroupAccountResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8374,43 +8321,43 @@ func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteByProposalVoterResponse_messageType fastReflection_QueryVoteByProposalVoterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterResponse_messageType{}
+var _fastReflection_QueryProposalsByGroupAccountResponse_messageType fastReflection_QueryProposalsByGroupAccountResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupAccountResponse_messageType{}
 
-type fastReflection_QueryVoteByProposalVoterResponse_messageType struct{}
+type fastReflection_QueryProposalsByGroupAccountResponse_messageType struct{}
 
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupAccountResponse)(nil)
 }
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterResponse)
+func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupAccountResponse)
 }
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterResponse
+func (x fastReflection_QueryProposalsByGroupAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupAccountResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterResponse
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupAccountResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteByProposalVoterResponse_messageType
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsByGroupAccountResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterResponse)
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupAccountResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteByProposalVoterResponse)(x)
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsByGroupAccountResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8418,10 +8365,16 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Vote != nil {
-		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
-		if !f(fd_QueryVoteByProposalVoterResponse_vote, value) {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Proposals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals})
+		if !f(fd_QueryProposalsByGroupAccountResponse_proposals, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsByGroupAccountResponse_pagination, value) {
 			return
 		}
 	}
@@ -8438,15 +8391,17 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		return x.Vote != nil
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		return len(x.Proposals) != 0
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8456,15 +8411,17 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		x.Vote = nil
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		x.Proposals = nil
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8474,16 +8431,22 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		value := x.Vote
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		if len(x.Proposals) == 0 {
+			return protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{})
+		}
+		listValue := &_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8497,15 +8460,19 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		x.Vote = value.Message().Interface().(*Vote)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		lv := value.List()
+		clv := lv.(*_QueryProposalsByGroupAccountResponse_1_list)
+		x.Proposals = *clv.list
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8519,44 +8486,53 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		if x.Vote == nil {
-			x.Vote = new(Vote)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		if x.Proposals == nil {
+			x.Proposals = []*Proposal{}
 		}
-		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		value := &_QueryProposalsByGroupAccountResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(value)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
-		m := new(Vote)
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals":
+		list := []*Proposal{}
+		return protoreflect.ValueOfList(&_QueryProposalsByGroupAccountResponse_1_list{list: &list})
+	case "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalsByGroupAccountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVoteByProposalVoterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalsByGroupAccountResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8564,7 +8540,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8575,7 +8551,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8587,7 +8563,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8597,9 +8573,9 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalsByGroupAccountResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8611,8 +8587,14 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if x.Vote != nil {
-			l = options.Size(x.Vote)
+		if len(x.Proposals) > 0 {
+			for _, e := range x.Proposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8625,7 +8607,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8644,8 +8626,8 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Vote != nil {
-			encoded, err := options.Marshal(x.Vote)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8656,7 +8638,23 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposals) > 0 {
+			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8669,7 +8667,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryProposalsByGroupAccountResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8701,15 +8699,15 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8736,10 +8734,44 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Vote == nil {
-					x.Vote = &Vote{}
+				x.Proposals = append(x.Proposals, &Proposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8779,27 +8811,27 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryVotesByProposalRequest             protoreflect.MessageDescriptor
-	fd_QueryVotesByProposalRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVotesByProposalRequest_pagination  protoreflect.FieldDescriptor
+	md_QueryVoteByProposalVoterRequest             protoreflect.MessageDescriptor
+	fd_QueryVoteByProposalVoterRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVoteByProposalVoterRequest_voter       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVotesByProposalRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByProposalRequest")
-	fd_QueryVotesByProposalRequest_proposal_id = md_QueryVotesByProposalRequest.Fields().ByName("proposal_id")
-	fd_QueryVotesByProposalRequest_pagination = md_QueryVotesByProposalRequest.Fields().ByName("pagination")
+	md_QueryVoteByProposalVoterRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVoteByProposalVoterRequest")
+	fd_QueryVoteByProposalVoterRequest_proposal_id = md_QueryVoteByProposalVoterRequest.Fields().ByName("proposal_id")
+	fd_QueryVoteByProposalVoterRequest_voter = md_QueryVoteByProposalVoterRequest.Fields().ByName("voter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
 
-type fastReflection_QueryVotesByProposalRequest QueryVotesByProposalRequest
+type fastReflection_QueryVoteByProposalVoterRequest QueryVoteByProposalVoterRequest
 
-func (x *QueryVotesByProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalRequest)(x)
+func (x *QueryVoteByProposalVoterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterRequest)(x)
 }
 
-func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8811,43 +8843,43 @@ func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByProposalRequest_messageType fastReflection_QueryVotesByProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalRequest_messageType{}
+var _fastReflection_QueryVoteByProposalVoterRequest_messageType fastReflection_QueryVoteByProposalVoterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterRequest_messageType{}
 
-type fastReflection_QueryVotesByProposalRequest_messageType struct{}
+type fastReflection_QueryVoteByProposalVoterRequest_messageType struct{}
 
-func (x fastReflection_QueryVotesByProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalRequest)(nil)
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
 }
-func (x fastReflection_QueryVotesByProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalRequest)
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterRequest)
 }
-func (x fastReflection_QueryVotesByProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalRequest
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalRequest
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByProposalRequest_messageType
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteByProposalVoterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalRequest)
+func (x *fastReflection_QueryVoteByProposalVoterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByProposalRequest)(x)
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteByProposalVoterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8855,16 +8887,16 @@ func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVotesByProposalRequest_proposal_id, value) {
+		if !f(fd_QueryVoteByProposalVoterRequest_proposal_id, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByProposalRequest_pagination, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVoteByProposalVoterRequest_voter, value) {
 			return
 		}
 	}
@@ -8881,17 +8913,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		return x.Pagination != nil
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		return x.Voter != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8901,17 +8933,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
 		x.ProposalId = uint64(0)
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		x.Pagination = nil
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8921,19 +8953,19 @@ func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8947,17 +8979,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
 		x.ProposalId = value.Uint()
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8971,48 +9003,44 @@ func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryVotesByProposalRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryVoteByProposalVoterRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		panic(fmt.Errorf("field voter of message regen.group.v1alpha1.QueryVoteByProposalVoterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterRequest.voter":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVoteByProposalVoterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9020,7 +9048,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9031,7 +9059,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9043,7 +9071,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9053,9 +9081,9 @@ func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9070,8 +9098,8 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Voter)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9084,7 +9112,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9103,17 +9131,10 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -9133,7 +9154,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9165,10 +9186,10 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9192,9 +9213,9 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9204,27 +9225,23 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9261,79 +9278,26 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryVotesByProposalResponse_1_list)(nil)
-
-type _QueryVotesByProposalResponse_1_list struct {
-	list *[]*Vote
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Vote)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Vote)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVotesByProposalResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryVotesByProposalResponse            protoreflect.MessageDescriptor
-	fd_QueryVotesByProposalResponse_votes      protoreflect.FieldDescriptor
-	fd_QueryVotesByProposalResponse_pagination protoreflect.FieldDescriptor
+	md_QueryVoteByProposalVoterResponse      protoreflect.MessageDescriptor
+	fd_QueryVoteByProposalVoterResponse_vote protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVotesByProposalResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByProposalResponse")
-	fd_QueryVotesByProposalResponse_votes = md_QueryVotesByProposalResponse.Fields().ByName("votes")
-	fd_QueryVotesByProposalResponse_pagination = md_QueryVotesByProposalResponse.Fields().ByName("pagination")
+	md_QueryVoteByProposalVoterResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVoteByProposalVoterResponse")
+	fd_QueryVoteByProposalVoterResponse_vote = md_QueryVoteByProposalVoterResponse.Fields().ByName("vote")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
 
-type fastReflection_QueryVotesByProposalResponse QueryVotesByProposalResponse
+type fastReflection_QueryVoteByProposalVoterResponse QueryVoteByProposalVoterResponse
 
-func (x *QueryVotesByProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalResponse)(x)
+func (x *QueryVoteByProposalVoterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterResponse)(x)
 }
 
-func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9345,43 +9309,43 @@ func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByProposalResponse_messageType fastReflection_QueryVotesByProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalResponse_messageType{}
+var _fastReflection_QueryVoteByProposalVoterResponse_messageType fastReflection_QueryVoteByProposalVoterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterResponse_messageType{}
 
-type fastReflection_QueryVotesByProposalResponse_messageType struct{}
+type fastReflection_QueryVoteByProposalVoterResponse_messageType struct{}
 
-func (x fastReflection_QueryVotesByProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalResponse)(nil)
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
 }
-func (x fastReflection_QueryVotesByProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalResponse)
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterResponse)
 }
-func (x fastReflection_QueryVotesByProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalResponse
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalResponse
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByProposalResponse_messageType
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteByProposalVoterResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalResponse)
+func (x *fastReflection_QueryVoteByProposalVoterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByProposalResponse)(x)
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteByProposalVoterResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9389,16 +9353,10 @@ func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Votes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &x.Votes})
-		if !f(fd_QueryVotesByProposalResponse_votes, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByProposalResponse_pagination, value) {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Vote != nil {
+		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		if !f(fd_QueryVoteByProposalVoterResponse_vote, value) {
 			return
 		}
 	}
@@ -9415,17 +9373,15 @@ func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		return len(x.Votes) != 0
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		return x.Pagination != nil
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		return x.Vote != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9435,17 +9391,15 @@ func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		x.Votes = nil
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		x.Pagination = nil
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		x.Vote = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9455,22 +9409,16 @@ func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		if len(x.Votes) == 0 {
-			return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{})
-		}
-		listValue := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
-		return protoreflect.ValueOfList(listValue)
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		value := x.Pagination
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		value := x.Vote
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9484,19 +9432,15 @@ func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		lv := value.List()
-		clv := lv.(*_QueryVotesByProposalResponse_1_list)
-		x.Votes = *clv.list
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		x.Vote = value.Message().Interface().(*Vote)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9510,53 +9454,44 @@ func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		if x.Votes == nil {
-			x.Votes = []*Vote{}
-		}
-		value := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
-		return protoreflect.ValueOfList(value)
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		if x.Vote == nil {
+			x.Vote = new(Vote)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
-		list := []*Vote{}
-		return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &list})
-	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote":
+		m := new(Vote)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVoteByProposalVoterResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVoteByProposalVoterResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9564,7 +9499,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9575,7 +9510,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9587,7 +9522,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9597,9 +9532,9 @@ func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9611,14 +9546,8 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.Votes) > 0 {
-			for _, e := range x.Votes {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Vote != nil {
+			l = options.Size(x.Vote)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9631,7 +9560,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9650,8 +9579,8 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Vote != nil {
+			encoded, err := options.Marshal(x.Vote)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9662,23 +9591,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Votes) > 0 {
-			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Votes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9691,7 +9604,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9723,49 +9636,15 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Votes = append(x.Votes, &Vote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9792,10 +9671,10 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Vote == nil {
+					x.Vote = &Vote{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9835,27 +9714,27 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryVotesByVoterRequest            protoreflect.MessageDescriptor
-	fd_QueryVotesByVoterRequest_voter      protoreflect.FieldDescriptor
-	fd_QueryVotesByVoterRequest_pagination protoreflect.FieldDescriptor
+	md_QueryVotesByProposalRequest             protoreflect.MessageDescriptor
+	fd_QueryVotesByProposalRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVotesByProposalRequest_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVotesByVoterRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByVoterRequest")
-	fd_QueryVotesByVoterRequest_voter = md_QueryVotesByVoterRequest.Fields().ByName("voter")
-	fd_QueryVotesByVoterRequest_pagination = md_QueryVotesByVoterRequest.Fields().ByName("pagination")
+	md_QueryVotesByProposalRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByProposalRequest")
+	fd_QueryVotesByProposalRequest_proposal_id = md_QueryVotesByProposalRequest.Fields().ByName("proposal_id")
+	fd_QueryVotesByProposalRequest_pagination = md_QueryVotesByProposalRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalRequest)(nil)
 
-type fastReflection_QueryVotesByVoterRequest QueryVotesByVoterRequest
+type fastReflection_QueryVotesByProposalRequest QueryVotesByProposalRequest
 
-func (x *QueryVotesByVoterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterRequest)(x)
+func (x *QueryVotesByProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalRequest)(x)
 }
 
-func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9867,43 +9746,43 @@ func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByVoterRequest_messageType fastReflection_QueryVotesByVoterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterRequest_messageType{}
+var _fastReflection_QueryVotesByProposalRequest_messageType fastReflection_QueryVotesByProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalRequest_messageType{}
 
-type fastReflection_QueryVotesByVoterRequest_messageType struct{}
+type fastReflection_QueryVotesByProposalRequest_messageType struct{}
 
-func (x fastReflection_QueryVotesByVoterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterRequest)(nil)
+func (x fastReflection_QueryVotesByProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalRequest)(nil)
 }
-func (x fastReflection_QueryVotesByVoterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterRequest)
+func (x fastReflection_QueryVotesByProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalRequest)
 }
-func (x fastReflection_QueryVotesByVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterRequest
+func (x fastReflection_QueryVotesByProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByVoterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterRequest
+func (x *fastReflection_QueryVotesByProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByVoterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByVoterRequest_messageType
+func (x *fastReflection_QueryVotesByProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByProposalRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByVoterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterRequest)
+func (x *fastReflection_QueryVotesByProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByVoterRequest)(x)
+func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByProposalRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9911,16 +9790,16 @@ func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVotesByVoterRequest_voter, value) {
+func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryVotesByProposalRequest_proposal_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByVoterRequest_pagination, value) {
+		if !f(fd_QueryVotesByProposalRequest_pagination, value) {
 			return
 		}
 	}
@@ -9937,17 +9816,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		return x.Voter != ""
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9957,17 +9836,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		x.Voter = ""
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9977,19 +9856,19 @@ func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10003,17 +9882,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		x.Voter = value.Interface().(string)
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10027,48 +9906,48 @@ func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		panic(fmt.Errorf("field voter of message regen.group.v1alpha1.QueryVotesByVoterRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryVotesByProposalRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
-		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.group.v1alpha1.QueryVotesByProposalRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalRequest"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByVoterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByProposalRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10076,7 +9955,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10087,7 +9966,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10099,7 +9978,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
+func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10109,9 +9988,9 @@ func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10123,9 +10002,8 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Voter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -10141,7 +10019,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10174,12 +10052,10 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10192,7 +10068,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10224,17 +10100,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var stringLen uint64
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10244,24 +10120,11 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -10333,79 +10196,79 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryVotesByVoterResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryVotesByProposalResponse_1_list)(nil)
 
-type _QueryVotesByVoterResponse_1_list struct {
+type _QueryVotesByProposalResponse_1_list struct {
 	list *[]*Vote
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Len() int {
+func (x *_QueryVotesByProposalResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryVotesByProposalResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryVotesByProposalResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Vote)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryVotesByProposalResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Vote)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryVotesByProposalResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(Vote)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Truncate(n int) {
+func (x *_QueryVotesByProposalResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryVotesByProposalResponse_1_list) NewElement() protoreflect.Value {
 	v := new(Vote)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) IsValid() bool {
+func (x *_QueryVotesByProposalResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryVotesByVoterResponse            protoreflect.MessageDescriptor
-	fd_QueryVotesByVoterResponse_votes      protoreflect.FieldDescriptor
-	fd_QueryVotesByVoterResponse_pagination protoreflect.FieldDescriptor
+	md_QueryVotesByProposalResponse            protoreflect.MessageDescriptor
+	fd_QueryVotesByProposalResponse_votes      protoreflect.FieldDescriptor
+	fd_QueryVotesByProposalResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_query_proto_init()
-	md_QueryVotesByVoterResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByVoterResponse")
-	fd_QueryVotesByVoterResponse_votes = md_QueryVotesByVoterResponse.Fields().ByName("votes")
-	fd_QueryVotesByVoterResponse_pagination = md_QueryVotesByVoterResponse.Fields().ByName("pagination")
+	md_QueryVotesByProposalResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByProposalResponse")
+	fd_QueryVotesByProposalResponse_votes = md_QueryVotesByProposalResponse.Fields().ByName("votes")
+	fd_QueryVotesByProposalResponse_pagination = md_QueryVotesByProposalResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalResponse)(nil)
 
-type fastReflection_QueryVotesByVoterResponse QueryVotesByVoterResponse
+type fastReflection_QueryVotesByProposalResponse QueryVotesByProposalResponse
 
-func (x *QueryVotesByVoterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterResponse)(x)
+func (x *QueryVotesByProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalResponse)(x)
 }
 
-func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10417,43 +10280,43 @@ func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByVoterResponse_messageType fastReflection_QueryVotesByVoterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterResponse_messageType{}
+var _fastReflection_QueryVotesByProposalResponse_messageType fastReflection_QueryVotesByProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalResponse_messageType{}
 
-type fastReflection_QueryVotesByVoterResponse_messageType struct{}
+type fastReflection_QueryVotesByProposalResponse_messageType struct{}
 
-func (x fastReflection_QueryVotesByVoterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterResponse)(nil)
+func (x fastReflection_QueryVotesByProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalResponse)(nil)
 }
-func (x fastReflection_QueryVotesByVoterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterResponse)
+func (x fastReflection_QueryVotesByProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalResponse)
 }
-func (x fastReflection_QueryVotesByVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterResponse
+func (x fastReflection_QueryVotesByProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByVoterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterResponse
+func (x *fastReflection_QueryVotesByProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByVoterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByVoterResponse_messageType
+func (x *fastReflection_QueryVotesByProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByVoterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterResponse)
+func (x *fastReflection_QueryVotesByProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByVoterResponse)(x)
+func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10461,16 +10324,16 @@ func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Votes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &x.Votes})
-		if !f(fd_QueryVotesByVoterResponse_votes, value) {
+		value := protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &x.Votes})
+		if !f(fd_QueryVotesByProposalResponse_votes, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByVoterResponse_pagination, value) {
+		if !f(fd_QueryVotesByProposalResponse_pagination, value) {
 			return
 		}
 	}
@@ -10487,17 +10350,17 @@ func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		return len(x.Votes) != 0
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10507,17 +10370,17 @@ func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		x.Votes = nil
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10527,22 +10390,22 @@ func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		if len(x.Votes) == 0 {
-			return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{})
 		}
-		listValue := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		listValue := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
 		return protoreflect.ValueOfList(listValue)
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10556,19 +10419,19 @@ func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		lv := value.List()
-		clv := lv.(*_QueryVotesByVoterResponse_1_list)
+		clv := lv.(*_QueryVotesByProposalResponse_1_list)
 		x.Votes = *clv.list
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10582,53 +10445,53 @@ func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		if x.Votes == nil {
 			x.Votes = []*Vote{}
 		}
-		value := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		value := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
 		return protoreflect.ValueOfList(value)
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.votes":
 		list := []*Vote{}
-		return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &list})
-	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &list})
+	case "regen.group.v1alpha1.QueryVotesByProposalResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByVoterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10636,7 +10499,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10647,7 +10510,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10659,7 +10522,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
+func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10669,9 +10532,9 @@ func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10703,7 +10566,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10763,7 +10626,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10795,10 +10658,10 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -10906,975 +10769,8376 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/group/v1alpha1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryVotesByVoterRequest            protoreflect.MessageDescriptor
+	fd_QueryVotesByVoterRequest_voter      protoreflect.FieldDescriptor
+	fd_QueryVotesByVoterRequest_pagination protoreflect.FieldDescriptor
 )
 
-// QueryGroupInfoRequest is the Query/GroupInfo request type.
-type QueryGroupInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// group_id is the unique ID of the group.
-	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-}
-
-func (x *QueryGroupInfoRequest) Reset() {
-	*x = QueryGroupInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *QueryGroupInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryGroupInfoRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryGroupInfoRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupInfoRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{0}
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryVotesByVoterRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByVoterRequest")
+	fd_QueryVotesByVoterRequest_voter = md_QueryVotesByVoterRequest.Fields().ByName("voter")
+	fd_QueryVotesByVoterRequest_pagination = md_QueryVotesByVoterRequest.Fields().ByName("pagination")
 }
 
-func (x *QueryGroupInfoRequest) GetGroupId() uint64 {
-	if x != nil {
-		return x.GroupId
-	}
-	return 0
-}
+var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterRequest)(nil)
 
-// QueryGroupInfoResponse is the Query/GroupInfo response type.
-type QueryGroupInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_QueryVotesByVoterRequest QueryVotesByVoterRequest
 
-	// info is the GroupInfo for the group.
-	Info *GroupInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+func (x *QueryVotesByVoterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterRequest)(x)
 }
 
-func (x *QueryGroupInfoResponse) Reset() {
-	*x = QueryGroupInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[1]
+func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryGroupInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryGroupInfoResponse) ProtoMessage() {}
+var _fastReflection_QueryVotesByVoterRequest_messageType fastReflection_QueryVotesByVoterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterRequest_messageType{}
 
-// Deprecated: Use QueryGroupInfoResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupInfoResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{1}
-}
+type fastReflection_QueryVotesByVoterRequest_messageType struct{}
 
-func (x *QueryGroupInfoResponse) GetInfo() *GroupInfo {
-	if x != nil {
-		return x.Info
-	}
-	return nil
+func (x fastReflection_QueryVotesByVoterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterRequest)(nil)
 }
-
-// QueryGroupAccountInfoRequest is the Query/GroupAccountInfo request type.
-type QueryGroupAccountInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// address is the account address of the group account.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (x fastReflection_QueryVotesByVoterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterRequest)
 }
-
-func (x *QueryGroupAccountInfoRequest) Reset() {
-	*x = QueryGroupAccountInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_QueryVotesByVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterRequest
 }
 
-func (x *QueryGroupAccountInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVotesByVoterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterRequest
 }
 
-func (*QueryGroupAccountInfoRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryGroupAccountInfoRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{2}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVotesByVoterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByVoterRequest_messageType
 }
 
-func (x *QueryGroupAccountInfoRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVotesByVoterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterRequest)
 }
 
-// QueryGroupAccountInfoResponse is the Query/GroupAccountInfo response type.
-type QueryGroupAccountInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// info is the GroupAccountInfo for the group account.
-	Info *GroupAccountInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByVoterRequest)(x)
 }
 
-func (x *QueryGroupAccountInfoResponse) Reset() {
-	*x = QueryGroupAccountInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVotesByVoterRequest_voter, value) {
+			return
+		}
 	}
-}
-
-func (x *QueryGroupAccountInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryGroupAccountInfoResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryGroupAccountInfoResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *QueryGroupAccountInfoResponse) GetInfo() *GroupAccountInfo {
-	if x != nil {
-		return x.Info
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByVoterRequest_pagination, value) {
+			return
+		}
 	}
-	return nil
 }
 
-// QueryGroupMembersRequest is the Query/GroupMembersRequest request type.
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		return x.Voter != ""
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		x.Voter = ""
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		x.Voter = value.Interface().(string)
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		panic(fmt.Errorf("field voter of message regen.group.v1alpha1.QueryVotesByVoterRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVotesByVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.voter":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.QueryVotesByVoterRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByVoterRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVotesByVoterResponse_1_list)(nil)
+
+type _QueryVotesByVoterResponse_1_list struct {
+	list *[]*Vote
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Vote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Vote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVotesByVoterResponse            protoreflect.MessageDescriptor
+	fd_QueryVotesByVoterResponse_votes      protoreflect.FieldDescriptor
+	fd_QueryVotesByVoterResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryVotesByVoterResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryVotesByVoterResponse")
+	fd_QueryVotesByVoterResponse_votes = md_QueryVotesByVoterResponse.Fields().ByName("votes")
+	fd_QueryVotesByVoterResponse_pagination = md_QueryVotesByVoterResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterResponse)(nil)
+
+type fastReflection_QueryVotesByVoterResponse QueryVotesByVoterResponse
+
+func (x *QueryVotesByVoterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterResponse)(x)
+}
+
+func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVotesByVoterResponse_messageType fastReflection_QueryVotesByVoterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterResponse_messageType{}
+
+type fastReflection_QueryVotesByVoterResponse_messageType struct{}
+
+func (x fastReflection_QueryVotesByVoterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterResponse)(nil)
+}
+func (x fastReflection_QueryVotesByVoterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterResponse)
+}
+func (x fastReflection_QueryVotesByVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVotesByVoterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVotesByVoterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByVoterResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVotesByVoterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByVoterResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &x.Votes})
+		if !f(fd_QueryVotesByVoterResponse_votes, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByVoterResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		return len(x.Votes) != 0
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		x.Votes = nil
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{})
+		}
+		listValue := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		lv := value.List()
+		clv := lv.(*_QueryVotesByVoterResponse_1_list)
+		x.Votes = *clv.list
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		if x.Votes == nil {
+			x.Votes = []*Vote{}
+		}
+		value := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVotesByVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.votes":
+		list := []*Vote{}
+		return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &list})
+	case "regen.group.v1alpha1.QueryVotesByVoterResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryVotesByVoterResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryVotesByVoterResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &Vote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupParticipationRequest          protoreflect.MessageDescriptor
+	fd_QueryGroupParticipationRequest_group_id protoreflect.FieldDescriptor
+	fd_QueryGroupParticipationRequest_window   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupParticipationRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupParticipationRequest")
+	fd_QueryGroupParticipationRequest_group_id = md_QueryGroupParticipationRequest.Fields().ByName("group_id")
+	fd_QueryGroupParticipationRequest_window = md_QueryGroupParticipationRequest.Fields().ByName("window")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupParticipationRequest)(nil)
+
+type fastReflection_QueryGroupParticipationRequest QueryGroupParticipationRequest
+
+func (x *QueryGroupParticipationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupParticipationRequest)(x)
+}
+
+func (x *QueryGroupParticipationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupParticipationRequest_messageType fastReflection_QueryGroupParticipationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupParticipationRequest_messageType{}
+
+type fastReflection_QueryGroupParticipationRequest_messageType struct{}
+
+func (x fastReflection_QueryGroupParticipationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupParticipationRequest)(nil)
+}
+func (x fastReflection_QueryGroupParticipationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupParticipationRequest)
+}
+func (x fastReflection_QueryGroupParticipationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupParticipationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupParticipationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupParticipationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupParticipationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupParticipationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupParticipationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupParticipationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupParticipationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupParticipationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupParticipationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryGroupParticipationRequest_group_id, value) {
+			return
+		}
+	}
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_QueryGroupParticipationRequest_window, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupParticipationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		return x.GroupId != uint64(0)
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		return x.Window != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		x.GroupId = uint64(0)
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		x.Window = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupParticipationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		x.GroupId = value.Uint()
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		x.Window = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		panic(fmt.Errorf("field group_id of message regen.group.v1alpha1.QueryGroupParticipationRequest is not mutable"))
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		panic(fmt.Errorf("field window of message regen.group.v1alpha1.QueryGroupParticipationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupParticipationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.group.v1alpha1.QueryGroupParticipationRequest.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupParticipationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupParticipationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupParticipationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupParticipationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupParticipationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupParticipationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupParticipationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupParticipationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupParticipationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupParticipationResponse                    protoreflect.MessageDescriptor
+	fd_QueryGroupParticipationResponse_participation_rate protoreflect.FieldDescriptor
+	fd_QueryGroupParticipationResponse_proposal_count     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupParticipationResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupParticipationResponse")
+	fd_QueryGroupParticipationResponse_participation_rate = md_QueryGroupParticipationResponse.Fields().ByName("participation_rate")
+	fd_QueryGroupParticipationResponse_proposal_count = md_QueryGroupParticipationResponse.Fields().ByName("proposal_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupParticipationResponse)(nil)
+
+type fastReflection_QueryGroupParticipationResponse QueryGroupParticipationResponse
+
+func (x *QueryGroupParticipationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupParticipationResponse)(x)
+}
+
+func (x *QueryGroupParticipationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupParticipationResponse_messageType fastReflection_QueryGroupParticipationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupParticipationResponse_messageType{}
+
+type fastReflection_QueryGroupParticipationResponse_messageType struct{}
+
+func (x fastReflection_QueryGroupParticipationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupParticipationResponse)(nil)
+}
+func (x fastReflection_QueryGroupParticipationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupParticipationResponse)
+}
+func (x fastReflection_QueryGroupParticipationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupParticipationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupParticipationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupParticipationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupParticipationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupParticipationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupParticipationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupParticipationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupParticipationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupParticipationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupParticipationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParticipationRate != "" {
+		value := protoreflect.ValueOfString(x.ParticipationRate)
+		if !f(fd_QueryGroupParticipationResponse_participation_rate, value) {
+			return
+		}
+	}
+	if x.ProposalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalCount)
+		if !f(fd_QueryGroupParticipationResponse_proposal_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupParticipationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		return x.ParticipationRate != ""
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		return x.ProposalCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		x.ParticipationRate = ""
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		x.ProposalCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupParticipationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		value := x.ParticipationRate
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		value := x.ProposalCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		x.ParticipationRate = value.Interface().(string)
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		x.ProposalCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		panic(fmt.Errorf("field participation_rate of message regen.group.v1alpha1.QueryGroupParticipationResponse is not mutable"))
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		panic(fmt.Errorf("field proposal_count of message regen.group.v1alpha1.QueryGroupParticipationResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupParticipationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.participation_rate":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.QueryGroupParticipationResponse.proposal_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupParticipationResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupParticipationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupParticipationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupParticipationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupParticipationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupParticipationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupParticipationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupParticipationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ParticipationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProposalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupParticipationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ParticipationRate) > 0 {
+			i -= len(x.ParticipationRate)
+			copy(dAtA[i:], x.ParticipationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParticipationRate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupParticipationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupParticipationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCount", wireType)
+				}
+				x.ProposalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProposalFullRequest             protoreflect.MessageDescriptor
+	fd_QueryProposalFullRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryProposalFullRequest_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryProposalFullRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalFullRequest")
+	fd_QueryProposalFullRequest_proposal_id = md_QueryProposalFullRequest.Fields().ByName("proposal_id")
+	fd_QueryProposalFullRequest_pagination = md_QueryProposalFullRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalFullRequest)(nil)
+
+type fastReflection_QueryProposalFullRequest QueryProposalFullRequest
+
+func (x *QueryProposalFullRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalFullRequest)(x)
+}
+
+func (x *QueryProposalFullRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalFullRequest_messageType fastReflection_QueryProposalFullRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalFullRequest_messageType{}
+
+type fastReflection_QueryProposalFullRequest_messageType struct{}
+
+func (x fastReflection_QueryProposalFullRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalFullRequest)(nil)
+}
+func (x fastReflection_QueryProposalFullRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalFullRequest)
+}
+func (x fastReflection_QueryProposalFullRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalFullRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalFullRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalFullRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalFullRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalFullRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalFullRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalFullRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalFullRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalFullRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalFullRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryProposalFullRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalFullRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalFullRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalFullRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryProposalFullRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalFullRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.group.v1alpha1.QueryProposalFullRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalFullRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalFullRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalFullRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalFullRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalFullRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalFullRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalFullRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalFullRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalFullRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalFullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryProposalFullResponse_4_list)(nil)
+
+type _QueryProposalFullResponse_4_list struct {
+	list *[]*Vote
+}
+
+func (x *_QueryProposalFullResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProposalFullResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProposalFullResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProposalFullResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProposalFullResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(Vote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalFullResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProposalFullResponse_4_list) NewElement() protoreflect.Value {
+	v := new(Vote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalFullResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryProposalFullResponse                 protoreflect.MessageDescriptor
+	fd_QueryProposalFullResponse_proposal        protoreflect.FieldDescriptor
+	fd_QueryProposalFullResponse_decision_policy protoreflect.FieldDescriptor
+	fd_QueryProposalFullResponse_tally           protoreflect.FieldDescriptor
+	fd_QueryProposalFullResponse_votes           protoreflect.FieldDescriptor
+	fd_QueryProposalFullResponse_pagination      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryProposalFullResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryProposalFullResponse")
+	fd_QueryProposalFullResponse_proposal = md_QueryProposalFullResponse.Fields().ByName("proposal")
+	fd_QueryProposalFullResponse_decision_policy = md_QueryProposalFullResponse.Fields().ByName("decision_policy")
+	fd_QueryProposalFullResponse_tally = md_QueryProposalFullResponse.Fields().ByName("tally")
+	fd_QueryProposalFullResponse_votes = md_QueryProposalFullResponse.Fields().ByName("votes")
+	fd_QueryProposalFullResponse_pagination = md_QueryProposalFullResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalFullResponse)(nil)
+
+type fastReflection_QueryProposalFullResponse QueryProposalFullResponse
+
+func (x *QueryProposalFullResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalFullResponse)(x)
+}
+
+func (x *QueryProposalFullResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalFullResponse_messageType fastReflection_QueryProposalFullResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalFullResponse_messageType{}
+
+type fastReflection_QueryProposalFullResponse_messageType struct{}
+
+func (x fastReflection_QueryProposalFullResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalFullResponse)(nil)
+}
+func (x fastReflection_QueryProposalFullResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalFullResponse)
+}
+func (x fastReflection_QueryProposalFullResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalFullResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalFullResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalFullResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalFullResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalFullResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalFullResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalFullResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalFullResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalFullResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalFullResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposal != nil {
+		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		if !f(fd_QueryProposalFullResponse_proposal, value) {
+			return
+		}
+	}
+	if x.DecisionPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+		if !f(fd_QueryProposalFullResponse_decision_policy, value) {
+			return
+		}
+	}
+	if x.Tally != nil {
+		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		if !f(fd_QueryProposalFullResponse_tally, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProposalFullResponse_4_list{list: &x.Votes})
+		if !f(fd_QueryProposalFullResponse_votes, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalFullResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalFullResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		return x.Proposal != nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		return x.DecisionPolicy != nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		return x.Tally != nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		return len(x.Votes) != 0
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		x.Proposal = nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		x.DecisionPolicy = nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		x.Tally = nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		x.Votes = nil
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalFullResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		value := x.Proposal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		value := x.DecisionPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		value := x.Tally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_QueryProposalFullResponse_4_list{})
+		}
+		listValue := &_QueryProposalFullResponse_4_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		x.Proposal = value.Message().Interface().(*Proposal)
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		x.Tally = value.Message().Interface().(*Tally)
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		lv := value.List()
+		clv := lv.(*_QueryProposalFullResponse_4_list)
+		x.Votes = *clv.list
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		if x.Proposal == nil {
+			x.Proposal = new(Proposal)
+		}
+		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		if x.DecisionPolicy == nil {
+			x.DecisionPolicy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		if x.Tally == nil {
+			x.Tally = new(Tally)
+		}
+		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		if x.Votes == nil {
+			x.Votes = []*Vote{}
+		}
+		value := &_QueryProposalFullResponse_4_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalFullResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryProposalFullResponse.proposal":
+		m := new(Proposal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.decision_policy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.tally":
+		m := new(Tally)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryProposalFullResponse.votes":
+		list := []*Vote{}
+		return protoreflect.ValueOfList(&_QueryProposalFullResponse_4_list{list: &list})
+	case "regen.group.v1alpha1.QueryProposalFullResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryProposalFullResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryProposalFullResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalFullResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryProposalFullResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalFullResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalFullResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalFullResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalFullResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalFullResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Proposal != nil {
+			l = options.Size(x.Proposal)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecisionPolicy != nil {
+			l = options.Size(x.DecisionPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Tally != nil {
+			l = options.Size(x.Tally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalFullResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Tally != nil {
+			encoded, err := options.Marshal(x.Tally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DecisionPolicy != nil {
+			encoded, err := options.Marshal(x.DecisionPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Proposal != nil {
+			encoded, err := options.Marshal(x.Proposal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalFullResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalFullResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalFullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proposal == nil {
+					x.Proposal = &Proposal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DecisionPolicy == nil {
+					x.DecisionPolicy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tally == nil {
+					x.Tally = &Tally{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &Vote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupExportRequest          protoreflect.MessageDescriptor
+	fd_QueryGroupExportRequest_group_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupExportRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupExportRequest")
+	fd_QueryGroupExportRequest_group_id = md_QueryGroupExportRequest.Fields().ByName("group_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupExportRequest)(nil)
+
+type fastReflection_QueryGroupExportRequest QueryGroupExportRequest
+
+func (x *QueryGroupExportRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupExportRequest)(x)
+}
+
+func (x *QueryGroupExportRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupExportRequest_messageType fastReflection_QueryGroupExportRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupExportRequest_messageType{}
+
+type fastReflection_QueryGroupExportRequest_messageType struct{}
+
+func (x fastReflection_QueryGroupExportRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupExportRequest)(nil)
+}
+func (x fastReflection_QueryGroupExportRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupExportRequest)
+}
+func (x fastReflection_QueryGroupExportRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupExportRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupExportRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupExportRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupExportRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupExportRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupExportRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupExportRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupExportRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupExportRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupExportRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryGroupExportRequest_group_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupExportRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		return x.GroupId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		x.GroupId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupExportRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		x.GroupId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		panic(fmt.Errorf("field group_id of message regen.group.v1alpha1.QueryGroupExportRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupExportRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupExportRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupExportRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupExportRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupExportRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupExportRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupExportRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupExportRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupExportRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupExportRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupExportResponse         protoreflect.MessageDescriptor
+	fd_QueryGroupExportResponse_genesis protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupExportResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupExportResponse")
+	fd_QueryGroupExportResponse_genesis = md_QueryGroupExportResponse.Fields().ByName("genesis")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupExportResponse)(nil)
+
+type fastReflection_QueryGroupExportResponse QueryGroupExportResponse
+
+func (x *QueryGroupExportResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupExportResponse)(x)
+}
+
+func (x *QueryGroupExportResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupExportResponse_messageType fastReflection_QueryGroupExportResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupExportResponse_messageType{}
+
+type fastReflection_QueryGroupExportResponse_messageType struct{}
+
+func (x fastReflection_QueryGroupExportResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupExportResponse)(nil)
+}
+func (x fastReflection_QueryGroupExportResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupExportResponse)
+}
+func (x fastReflection_QueryGroupExportResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupExportResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupExportResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupExportResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupExportResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupExportResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupExportResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupExportResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupExportResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupExportResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupExportResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Genesis != nil {
+		value := protoreflect.ValueOfMessage(x.Genesis.ProtoReflect())
+		if !f(fd_QueryGroupExportResponse_genesis, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupExportResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		return x.Genesis != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		x.Genesis = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupExportResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		value := x.Genesis
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		x.Genesis = value.Message().Interface().(*GenesisState)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		if x.Genesis == nil {
+			x.Genesis = new(GenesisState)
+		}
+		return protoreflect.ValueOfMessage(x.Genesis.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupExportResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupExportResponse.genesis":
+		m := new(GenesisState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupExportResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupExportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupExportResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupExportResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupExportResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupExportResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupExportResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupExportResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupExportResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Genesis != nil {
+			l = options.Size(x.Genesis)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupExportResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Genesis != nil {
+			encoded, err := options.Marshal(x.Genesis)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupExportResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupExportResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Genesis == nil {
+					x.Genesis = &GenesisState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Genesis); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequiredYesWeightRequest             protoreflect.MessageDescriptor
+	fd_QueryRequiredYesWeightRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryRequiredYesWeightRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryRequiredYesWeightRequest")
+	fd_QueryRequiredYesWeightRequest_proposal_id = md_QueryRequiredYesWeightRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequiredYesWeightRequest)(nil)
+
+type fastReflection_QueryRequiredYesWeightRequest QueryRequiredYesWeightRequest
+
+func (x *QueryRequiredYesWeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequiredYesWeightRequest)(x)
+}
+
+func (x *QueryRequiredYesWeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequiredYesWeightRequest_messageType fastReflection_QueryRequiredYesWeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequiredYesWeightRequest_messageType{}
+
+type fastReflection_QueryRequiredYesWeightRequest_messageType struct{}
+
+func (x fastReflection_QueryRequiredYesWeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequiredYesWeightRequest)(nil)
+}
+func (x fastReflection_QueryRequiredYesWeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequiredYesWeightRequest)
+}
+func (x fastReflection_QueryRequiredYesWeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequiredYesWeightRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequiredYesWeightRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequiredYesWeightRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequiredYesWeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequiredYesWeightRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequiredYesWeightRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryRequiredYesWeightRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryRequiredYesWeightRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequiredYesWeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequiredYesWeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryRequiredYesWeightRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequiredYesWeightRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequiredYesWeightRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequiredYesWeightRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequiredYesWeightRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequiredYesWeightRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequiredYesWeightRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequiredYesWeightRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequiredYesWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequiredYesWeightResponse                     protoreflect.MessageDescriptor
+	fd_QueryRequiredYesWeightResponse_required_yes_weight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryRequiredYesWeightResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryRequiredYesWeightResponse")
+	fd_QueryRequiredYesWeightResponse_required_yes_weight = md_QueryRequiredYesWeightResponse.Fields().ByName("required_yes_weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequiredYesWeightResponse)(nil)
+
+type fastReflection_QueryRequiredYesWeightResponse QueryRequiredYesWeightResponse
+
+func (x *QueryRequiredYesWeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequiredYesWeightResponse)(x)
+}
+
+func (x *QueryRequiredYesWeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequiredYesWeightResponse_messageType fastReflection_QueryRequiredYesWeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequiredYesWeightResponse_messageType{}
+
+type fastReflection_QueryRequiredYesWeightResponse_messageType struct{}
+
+func (x fastReflection_QueryRequiredYesWeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequiredYesWeightResponse)(nil)
+}
+func (x fastReflection_QueryRequiredYesWeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequiredYesWeightResponse)
+}
+func (x fastReflection_QueryRequiredYesWeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequiredYesWeightResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequiredYesWeightResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequiredYesWeightResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequiredYesWeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequiredYesWeightResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequiredYesWeightResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequiredYesWeight != "" {
+		value := protoreflect.ValueOfString(x.RequiredYesWeight)
+		if !f(fd_QueryRequiredYesWeightResponse_required_yes_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		return x.RequiredYesWeight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		x.RequiredYesWeight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		value := x.RequiredYesWeight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		x.RequiredYesWeight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		panic(fmt.Errorf("field required_yes_weight of message regen.group.v1alpha1.QueryRequiredYesWeightResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequiredYesWeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryRequiredYesWeightResponse.required_yes_weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryRequiredYesWeightResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryRequiredYesWeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequiredYesWeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryRequiredYesWeightResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequiredYesWeightResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequiredYesWeightResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequiredYesWeightResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequiredYesWeightResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequiredYesWeightResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RequiredYesWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequiredYesWeightResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RequiredYesWeight) > 0 {
+			i -= len(x.RequiredYesWeight)
+			copy(dAtA[i:], x.RequiredYesWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredYesWeight)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequiredYesWeightResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequiredYesWeightResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequiredYesWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredYesWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredYesWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupAccountAddressRequest          protoreflect.MessageDescriptor
+	fd_QueryGroupAccountAddressRequest_group_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupAccountAddressRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupAccountAddressRequest")
+	fd_QueryGroupAccountAddressRequest_group_id = md_QueryGroupAccountAddressRequest.Fields().ByName("group_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupAccountAddressRequest)(nil)
+
+type fastReflection_QueryGroupAccountAddressRequest QueryGroupAccountAddressRequest
+
+func (x *QueryGroupAccountAddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupAccountAddressRequest)(x)
+}
+
+func (x *QueryGroupAccountAddressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupAccountAddressRequest_messageType fastReflection_QueryGroupAccountAddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupAccountAddressRequest_messageType{}
+
+type fastReflection_QueryGroupAccountAddressRequest_messageType struct{}
+
+func (x fastReflection_QueryGroupAccountAddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupAccountAddressRequest)(nil)
+}
+func (x fastReflection_QueryGroupAccountAddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupAccountAddressRequest)
+}
+func (x fastReflection_QueryGroupAccountAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupAccountAddressRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupAccountAddressRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupAccountAddressRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupAccountAddressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupAccountAddressRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupAccountAddressRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryGroupAccountAddressRequest_group_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		return x.GroupId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		x.GroupId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		x.GroupId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		panic(fmt.Errorf("field group_id of message regen.group.v1alpha1.QueryGroupAccountAddressRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupAccountAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupAccountAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupAccountAddressRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupAccountAddressRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupAccountAddressRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupAccountAddressRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupAccountAddressRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupAccountAddressRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupAccountAddressRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupAccountAddressRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupAccountAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGroupAccountAddressResponse         protoreflect.MessageDescriptor
+	fd_QueryGroupAccountAddressResponse_address protoreflect.FieldDescriptor
+	fd_QueryGroupAccountAddressResponse_salt    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryGroupAccountAddressResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryGroupAccountAddressResponse")
+	fd_QueryGroupAccountAddressResponse_address = md_QueryGroupAccountAddressResponse.Fields().ByName("address")
+	fd_QueryGroupAccountAddressResponse_salt = md_QueryGroupAccountAddressResponse.Fields().ByName("salt")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGroupAccountAddressResponse)(nil)
+
+type fastReflection_QueryGroupAccountAddressResponse QueryGroupAccountAddressResponse
+
+func (x *QueryGroupAccountAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupAccountAddressResponse)(x)
+}
+
+func (x *QueryGroupAccountAddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGroupAccountAddressResponse_messageType fastReflection_QueryGroupAccountAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupAccountAddressResponse_messageType{}
+
+type fastReflection_QueryGroupAccountAddressResponse_messageType struct{}
+
+func (x fastReflection_QueryGroupAccountAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupAccountAddressResponse)(nil)
+}
+func (x fastReflection_QueryGroupAccountAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupAccountAddressResponse)
+}
+func (x fastReflection_QueryGroupAccountAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupAccountAddressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupAccountAddressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupAccountAddressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupAccountAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupAccountAddressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupAccountAddressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryGroupAccountAddressResponse_address, value) {
+			return
+		}
+	}
+	if x.Salt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Salt)
+		if !f(fd_QueryGroupAccountAddressResponse_salt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		return x.Address != ""
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		return x.Salt != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		x.Address = ""
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		x.Salt = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		value := x.Salt
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		x.Address = value.Interface().(string)
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		x.Salt = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.QueryGroupAccountAddressResponse is not mutable"))
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		panic(fmt.Errorf("field salt of message regen.group.v1alpha1.QueryGroupAccountAddressResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupAccountAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.address":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.QueryGroupAccountAddressResponse.salt":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryGroupAccountAddressResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryGroupAccountAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupAccountAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryGroupAccountAddressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupAccountAddressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupAccountAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupAccountAddressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupAccountAddressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupAccountAddressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Salt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Salt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupAccountAddressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Salt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Salt))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupAccountAddressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupAccountAddressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupAccountAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				x.Salt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Salt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTallyResultRequest             protoreflect.MessageDescriptor
+	fd_QueryTallyResultRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryTallyResultRequest = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryTallyResultRequest")
+	fd_QueryTallyResultRequest_proposal_id = md_QueryTallyResultRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTallyResultRequest)(nil)
+
+type fastReflection_QueryTallyResultRequest QueryTallyResultRequest
+
+func (x *QueryTallyResultRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultRequest)(x)
+}
+
+func (x *QueryTallyResultRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTallyResultRequest_messageType fastReflection_QueryTallyResultRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTallyResultRequest_messageType{}
+
+type fastReflection_QueryTallyResultRequest_messageType struct{}
+
+func (x fastReflection_QueryTallyResultRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultRequest)(nil)
+}
+func (x fastReflection_QueryTallyResultRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultRequest)
+}
+func (x fastReflection_QueryTallyResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTallyResultRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTallyResultRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTallyResultRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTallyResultRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTallyResultRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTallyResultRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTallyResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryTallyResultRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTallyResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTallyResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message regen.group.v1alpha1.QueryTallyResultRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTallyResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTallyResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryTallyResultRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTallyResultRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTallyResultRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTallyResultResponse         protoreflect.MessageDescriptor
+	fd_QueryTallyResultResponse_tally   protoreflect.FieldDescriptor
+	fd_QueryTallyResultResponse_passing protoreflect.FieldDescriptor
+	fd_QueryTallyResultResponse_final   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_query_proto_init()
+	md_QueryTallyResultResponse = File_regen_group_v1alpha1_query_proto.Messages().ByName("QueryTallyResultResponse")
+	fd_QueryTallyResultResponse_tally = md_QueryTallyResultResponse.Fields().ByName("tally")
+	fd_QueryTallyResultResponse_passing = md_QueryTallyResultResponse.Fields().ByName("passing")
+	fd_QueryTallyResultResponse_final = md_QueryTallyResultResponse.Fields().ByName("final")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTallyResultResponse)(nil)
+
+type fastReflection_QueryTallyResultResponse QueryTallyResultResponse
+
+func (x *QueryTallyResultResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultResponse)(x)
+}
+
+func (x *QueryTallyResultResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTallyResultResponse_messageType fastReflection_QueryTallyResultResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTallyResultResponse_messageType{}
+
+type fastReflection_QueryTallyResultResponse_messageType struct{}
+
+func (x fastReflection_QueryTallyResultResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultResponse)(nil)
+}
+func (x fastReflection_QueryTallyResultResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultResponse)
+}
+func (x fastReflection_QueryTallyResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTallyResultResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTallyResultResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTallyResultResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTallyResultResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTallyResultResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTallyResultResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTallyResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tally != nil {
+		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		if !f(fd_QueryTallyResultResponse_tally, value) {
+			return
+		}
+	}
+	if x.Passing != false {
+		value := protoreflect.ValueOfBool(x.Passing)
+		if !f(fd_QueryTallyResultResponse_passing, value) {
+			return
+		}
+	}
+	if x.Final != false {
+		value := protoreflect.ValueOfBool(x.Final)
+		if !f(fd_QueryTallyResultResponse_final, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTallyResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		return x.Tally != nil
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		return x.Passing != false
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		return x.Final != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		x.Tally = nil
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		x.Passing = false
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		x.Final = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTallyResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		value := x.Tally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		value := x.Passing
+		return protoreflect.ValueOfBool(value)
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		value := x.Final
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		x.Tally = value.Message().Interface().(*Tally)
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		x.Passing = value.Bool()
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		x.Final = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		if x.Tally == nil {
+			x.Tally = new(Tally)
+		}
+		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		panic(fmt.Errorf("field passing of message regen.group.v1alpha1.QueryTallyResultResponse is not mutable"))
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		panic(fmt.Errorf("field final of message regen.group.v1alpha1.QueryTallyResultResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTallyResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.QueryTallyResultResponse.tally":
+		m := new(Tally)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.QueryTallyResultResponse.passing":
+		return protoreflect.ValueOfBool(false)
+	case "regen.group.v1alpha1.QueryTallyResultResponse.final":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTallyResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.QueryTallyResultResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTallyResultResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTallyResultResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tally != nil {
+			l = options.Size(x.Tally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Passing {
+			n += 2
+		}
+		if x.Final {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Final {
+			i--
+			if x.Final {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Passing {
+			i--
+			if x.Passing {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Tally != nil {
+			encoded, err := options.Marshal(x.Tally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tally == nil {
+					x.Tally = &Tally{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passing", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Passing = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Final = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/group/v1alpha1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryGroupInfoRequest is the Query/GroupInfo request type.
+type QueryGroupInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *QueryGroupInfoRequest) Reset() {
+	*x = QueryGroupInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupInfoRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupInfoRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryGroupInfoRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// QueryGroupInfoResponse is the Query/GroupInfo response type.
+type QueryGroupInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// info is the GroupInfo for the group.
+	Info *GroupInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *QueryGroupInfoResponse) Reset() {
+	*x = QueryGroupInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupInfoResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupInfoResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryGroupInfoResponse) GetInfo() *GroupInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// QueryGroupAccountInfoRequest is the Query/GroupAccountInfo request type.
+type QueryGroupAccountInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the account address of the group account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryGroupAccountInfoRequest) Reset() {
+	*x = QueryGroupAccountInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountInfoRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountInfoRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryGroupAccountInfoRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryGroupAccountInfoResponse is the Query/GroupAccountInfo response type.
+type QueryGroupAccountInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// info is the GroupAccountInfo for the group account.
+	Info *GroupAccountInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *QueryGroupAccountInfoResponse) Reset() {
+	*x = QueryGroupAccountInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountInfoResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountInfoResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryGroupAccountInfoResponse) GetInfo() *GroupAccountInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// QueryGroupMembersRequest is the Query/GroupMembersRequest request type.
 type QueryGroupMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// group_id is the unique ID of the group.
-	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupMembersRequest) Reset() {
+	*x = QueryGroupMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupMembersRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryGroupMembersRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *QueryGroupMembersRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupMembersResponse is the Query/GroupMembersResponse response type.
+type QueryGroupMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// members are the members of the group with given group_id.
+	Members []*GroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupMembersResponse) Reset() {
+	*x = QueryGroupMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupMembersResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryGroupMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *QueryGroupMembersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupsByAdminRequest is the Query/GroupsByAdminRequest request type.
+type QueryGroupsByAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the account address of a group's admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupsByAdminRequest) Reset() {
+	*x = QueryGroupsByAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupsByAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupsByAdminRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupsByAdminRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupsByAdminRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGroupsByAdminRequest) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *QueryGroupsByAdminRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupsByAdminResponse is the Query/GroupsByAdminResponse response type.
+type QueryGroupsByAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups are the groups info with the provided admin.
+	Groups []*GroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupsByAdminResponse) Reset() {
+	*x = QueryGroupsByAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupsByAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupsByAdminResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupsByAdminResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupsByAdminResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryGroupsByAdminResponse) GetGroups() []*GroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *QueryGroupsByAdminResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupAccountsByGroupRequest is the Query/GroupAccountsByGroup request
+// type.
+type QueryGroupAccountsByGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_id is the unique ID of the group account's group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupAccountsByGroupRequest) Reset() {
+	*x = QueryGroupAccountsByGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountsByGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountsByGroupRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountsByGroupRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountsByGroupRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryGroupAccountsByGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *QueryGroupAccountsByGroupRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupAccountsByGroupResponse is the Query/GroupAccountsByGroup response
+// type.
+type QueryGroupAccountsByGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_accounts are the group accounts info associated with the provided
+	// group.
+	GroupAccounts []*GroupAccountInfo `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupAccountsByGroupResponse) Reset() {
+	*x = QueryGroupAccountsByGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountsByGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountsByGroupResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountsByGroupResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountsByGroupResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryGroupAccountsByGroupResponse) GetGroupAccounts() []*GroupAccountInfo {
+	if x != nil {
+		return x.GroupAccounts
+	}
+	return nil
+}
+
+func (x *QueryGroupAccountsByGroupResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupAccountsByAdminRequest is the Query/GroupAccountsByAdmin request
+// type.
+type QueryGroupAccountsByAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the admin address of the group account.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupAccountsByAdminRequest) Reset() {
+	*x = QueryGroupAccountsByAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountsByAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountsByAdminRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountsByAdminRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountsByAdminRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryGroupAccountsByAdminRequest) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *QueryGroupAccountsByAdminRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupAccountsByAdminResponse is the Query/GroupAccountsByAdmin response
+// type.
+type QueryGroupAccountsByAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_accounts are the group accounts info with provided admin.
+	GroupAccounts []*GroupAccountInfo `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryGroupAccountsByAdminResponse) Reset() {
+	*x = QueryGroupAccountsByAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupAccountsByAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupAccountsByAdminResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupAccountsByAdminResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountsByAdminResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryGroupAccountsByAdminResponse) GetGroupAccounts() []*GroupAccountInfo {
+	if x != nil {
+		return x.GroupAccounts
+	}
+	return nil
+}
+
+func (x *QueryGroupAccountsByAdminResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryProposalRequest is the Query/Proposal request type.
+type QueryProposalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *QueryProposalRequest) Reset() {
+	*x = QueryProposalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryProposalRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalResponse is the Query/Proposal response type.
+type QueryProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal is the proposal info.
+	Proposal *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (x *QueryProposalResponse) Reset() {
+	*x = QueryProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryProposalResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+// QueryProposalProposersRequest is the Query/ProposalProposers request type.
+type QueryProposalProposersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *QueryProposalProposersRequest) Reset() {
+	*x = QueryProposalProposersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalProposersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalProposersRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalProposersRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalProposersRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryProposalProposersRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalProposersResponse is the Query/ProposalProposers response type.
+type QueryProposalProposersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposers are the account addresses of the proposers.
+	Proposers []string `protobuf:"bytes,1,rep,name=proposers,proto3" json:"proposers,omitempty"`
+}
+
+func (x *QueryProposalProposersResponse) Reset() {
+	*x = QueryProposalProposersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalProposersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalProposersResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalProposersResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalProposersResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryProposalProposersResponse) GetProposers() []string {
+	if x != nil {
+		return x.Proposers
+	}
+	return nil
+}
+
+// QueryProposalsByGroupAccountRequest is the Query/ProposalByGroupAccount
+// request type.
+type QueryProposalsByGroupAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the group account address related to proposals.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status is an optional filter on the status of the proposals. If
+	// unspecified, proposals with any status are returned.
+	Status Proposal_Status `protobuf:"varint,3,opt,name=status,proto3,enum=regen.group.v1alpha1.Proposal_Status" json:"status,omitempty"`
+}
+
+func (x *QueryProposalsByGroupAccountRequest) Reset() {
+	*x = QueryProposalsByGroupAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalsByGroupAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalsByGroupAccountRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalsByGroupAccountRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalsByGroupAccountRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryProposalsByGroupAccountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryProposalsByGroupAccountRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryProposalsByGroupAccountRequest) GetStatus() Proposal_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Proposal_STATUS_UNSPECIFIED
+}
+
+// QueryProposalsByGroupAccountResponse is the Query/ProposalByGroupAccount
+// response type.
+type QueryProposalsByGroupAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposals are the proposals with given group account.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGroupMembersRequest) Reset() {
-	*x = QueryGroupMembersRequest{}
+func (x *QueryProposalsByGroupAccountResponse) Reset() {
+	*x = QueryProposalsByGroupAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[4]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupMembersRequest) String() string {
+func (x *QueryProposalsByGroupAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupMembersRequest) ProtoMessage() {}
+func (*QueryProposalsByGroupAccountResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupMembersRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryProposalsByGroupAccountResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalsByGroupAccountResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryGroupMembersRequest) GetGroupId() uint64 {
+func (x *QueryProposalsByGroupAccountResponse) GetProposals() []*Proposal {
 	if x != nil {
-		return x.GroupId
+		return x.Proposals
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryGroupMembersRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryProposalsByGroupAccountResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGroupMembersResponse is the Query/GroupMembersResponse response type.
-type QueryGroupMembersResponse struct {
+// QueryVoteByProposalVoterResponse is the Query/VoteByProposalVoter request
+// type.
+type QueryVoteByProposalVoterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// members are the members of the group with given group_id.
-	Members []*GroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is a proposal voter account address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (x *QueryGroupMembersResponse) Reset() {
-	*x = QueryGroupMembersResponse{}
+func (x *QueryVoteByProposalVoterRequest) Reset() {
+	*x = QueryVoteByProposalVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[5]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupMembersResponse) String() string {
+func (x *QueryVoteByProposalVoterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupMembersResponse) ProtoMessage() {}
+func (*QueryVoteByProposalVoterRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupMembersResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryVoteByProposalVoterRequest.ProtoReflect.Descriptor instead.
+func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryGroupMembersResponse) GetMembers() []*GroupMember {
+func (x *QueryVoteByProposalVoterRequest) GetProposalId() uint64 {
 	if x != nil {
-		return x.Members
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryGroupMembersResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryVoteByProposalVoterRequest) GetVoter() string {
 	if x != nil {
-		return x.Pagination
+		return x.Voter
+	}
+	return ""
+}
+
+// QueryVoteByProposalVoterResponse is the Query/VoteByProposalVoter response
+// type.
+type QueryVoteByProposalVoterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vote is the vote with given proposal_id and voter.
+	Vote *Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+}
+
+func (x *QueryVoteByProposalVoterResponse) Reset() {
+	*x = QueryVoteByProposalVoterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVoteByProposalVoterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVoteByProposalVoterResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVoteByProposalVoterResponse.ProtoReflect.Descriptor instead.
+func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryVoteByProposalVoterResponse) GetVote() *Vote {
+	if x != nil {
+		return x.Vote
 	}
 	return nil
 }
 
-// QueryGroupsByAdminRequest is the Query/GroupsByAdminRequest request type.
-type QueryGroupsByAdminRequest struct {
+// QueryVotesByProposalResponse is the Query/VotesByProposal request type.
+type QueryVotesByProposalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// admin is the account address of a group's admin.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGroupsByAdminRequest) Reset() {
-	*x = QueryGroupsByAdminRequest{}
+func (x *QueryVotesByProposalRequest) Reset() {
+	*x = QueryVotesByProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[6]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupsByAdminRequest) String() string {
+func (x *QueryVotesByProposalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupsByAdminRequest) ProtoMessage() {}
+func (*QueryVotesByProposalRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupsByAdminRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupsByAdminRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryVotesByProposalRequest.ProtoReflect.Descriptor instead.
+func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryGroupsByAdminRequest) GetAdmin() string {
+func (x *QueryVotesByProposalRequest) GetProposalId() uint64 {
 	if x != nil {
-		return x.Admin
+		return x.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryGroupsByAdminRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryVotesByProposalRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGroupsByAdminResponse is the Query/GroupsByAdminResponse response type.
-type QueryGroupsByAdminResponse struct {
+// QueryVotesByProposalResponse is the Query/VotesByProposal response type.
+type QueryVotesByProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// groups are the groups info with the provided admin.
-	Groups []*GroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// votes are the list of votes for given proposal_id.
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGroupsByAdminResponse) Reset() {
-	*x = QueryGroupsByAdminResponse{}
+func (x *QueryVotesByProposalResponse) Reset() {
+	*x = QueryVotesByProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[7]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupsByAdminResponse) String() string {
+func (x *QueryVotesByProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupsByAdminResponse) ProtoMessage() {}
+func (*QueryVotesByProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupsByAdminResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupsByAdminResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryVotesByProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryGroupsByAdminResponse) GetGroups() []*GroupInfo {
+func (x *QueryVotesByProposalResponse) GetVotes() []*Vote {
 	if x != nil {
-		return x.Groups
+		return x.Votes
 	}
 	return nil
 }
 
-func (x *QueryGroupsByAdminResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryVotesByProposalResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGroupAccountsByGroupRequest is the Query/GroupAccountsByGroup request
-// type.
-type QueryGroupAccountsByGroupRequest struct {
+// QueryVotesByVoterResponse is the Query/VotesByVoter request type.
+type QueryVotesByVoterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// group_id is the unique ID of the group account's group.
-	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// voter is a proposal voter account address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGroupAccountsByGroupRequest) Reset() {
-	*x = QueryGroupAccountsByGroupRequest{}
+func (x *QueryVotesByVoterRequest) Reset() {
+	*x = QueryVotesByVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[8]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupAccountsByGroupRequest) String() string {
+func (x *QueryVotesByVoterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupAccountsByGroupRequest) ProtoMessage() {}
+func (*QueryVotesByVoterRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupAccountsByGroupRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountsByGroupRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryVotesByVoterRequest.ProtoReflect.Descriptor instead.
+func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryGroupAccountsByGroupRequest) GetGroupId() uint64 {
+func (x *QueryVotesByVoterRequest) GetVoter() string {
 	if x != nil {
-		return x.GroupId
+		return x.Voter
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryGroupAccountsByGroupRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryVotesByVoterRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGroupAccountsByGroupResponse is the Query/GroupAccountsByGroup response
-// type.
-type QueryGroupAccountsByGroupResponse struct {
+// QueryVotesByVoterResponse is the Query/VotesByVoter response type.
+type QueryVotesByVoterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// group_accounts are the group accounts info associated with the provided
-	// group.
-	GroupAccounts []*GroupAccountInfo `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts,omitempty"`
+	// votes are the list of votes by given voter.
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGroupAccountsByGroupResponse) Reset() {
-	*x = QueryGroupAccountsByGroupResponse{}
+func (x *QueryVotesByVoterResponse) Reset() {
+	*x = QueryVotesByVoterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[9]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupAccountsByGroupResponse) String() string {
+func (x *QueryVotesByVoterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupAccountsByGroupResponse) ProtoMessage() {}
+func (*QueryVotesByVoterResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupAccountsByGroupResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountsByGroupResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryVotesByVoterResponse.ProtoReflect.Descriptor instead.
+func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryGroupAccountsByGroupResponse) GetGroupAccounts() []*GroupAccountInfo {
+func (x *QueryVotesByVoterResponse) GetVotes() []*Vote {
 	if x != nil {
-		return x.GroupAccounts
+		return x.Votes
 	}
 	return nil
 }
 
-func (x *QueryGroupAccountsByGroupResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryVotesByVoterResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryGroupAccountsByAdminRequest is the Query/GroupAccountsByAdmin request
-// type.
-type QueryGroupAccountsByAdminRequest struct {
+// QueryGroupParticipationRequest is the Query/GroupParticipation request type.
+type QueryGroupParticipationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// admin is the admin address of the group account.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// window is the number of most recent proposals of the group, across all
+	// group accounts, used to compute the participation rate. The window cannot
+	// exceed 100 proposals.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (x *QueryGroupAccountsByAdminRequest) Reset() {
-	*x = QueryGroupAccountsByAdminRequest{}
+func (x *QueryGroupParticipationRequest) Reset() {
+	*x = QueryGroupParticipationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[10]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupAccountsByAdminRequest) String() string {
+func (x *QueryGroupParticipationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupAccountsByAdminRequest) ProtoMessage() {}
+func (*QueryGroupParticipationRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupAccountsByAdminRequest.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountsByAdminRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryGroupParticipationRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryGroupAccountsByAdminRequest) GetAdmin() string {
+func (x *QueryGroupParticipationRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Admin
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryGroupAccountsByAdminRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryGroupParticipationRequest) GetWindow() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Window
 	}
-	return nil
+	return 0
 }
 
-// QueryGroupAccountsByAdminResponse is the Query/GroupAccountsByAdmin response
+// QueryGroupParticipationResponse is the Query/GroupParticipation response
 // type.
-type QueryGroupAccountsByAdminResponse struct {
+type QueryGroupParticipationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// group_accounts are the group accounts info with provided admin.
-	GroupAccounts []*GroupAccountInfo `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// participation_rate is the average fraction of the group total weight that
+	// voted on the proposals within the window.
+	ParticipationRate string `protobuf:"bytes,1,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	// proposal_count is the number of proposals within the window, which is less
+	// than the requested window if the group has fewer proposals.
+	ProposalCount uint64 `protobuf:"varint,2,opt,name=proposal_count,json=proposalCount,proto3" json:"proposal_count,omitempty"`
 }
 
-func (x *QueryGroupAccountsByAdminResponse) Reset() {
-	*x = QueryGroupAccountsByAdminResponse{}
+func (x *QueryGroupParticipationResponse) Reset() {
+	*x = QueryGroupParticipationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[11]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGroupAccountsByAdminResponse) String() string {
+func (x *QueryGroupParticipationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGroupAccountsByAdminResponse) ProtoMessage() {}
+func (*QueryGroupParticipationResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGroupAccountsByAdminResponse.ProtoReflect.Descriptor instead.
-func (*QueryGroupAccountsByAdminResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryGroupParticipationResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryGroupAccountsByAdminResponse) GetGroupAccounts() []*GroupAccountInfo {
+func (x *QueryGroupParticipationResponse) GetParticipationRate() string {
 	if x != nil {
-		return x.GroupAccounts
+		return x.ParticipationRate
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryGroupAccountsByAdminResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryGroupParticipationResponse) GetProposalCount() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.ProposalCount
 	}
-	return nil
+	return 0
 }
 
-// QueryProposalRequest is the Query/Proposal request type.
-type QueryProposalRequest struct {
+// QueryProposalFullRequest is the Query/ProposalFull request type.
+type QueryProposalFullRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id is the unique ID of a proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the votes of the proposal.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryProposalRequest) Reset() {
-	*x = QueryProposalRequest{}
+func (x *QueryProposalFullRequest) Reset() {
+	*x = QueryProposalFullRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[12]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalRequest) String() string {
+func (x *QueryProposalFullRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalRequest) ProtoMessage() {}
+func (*QueryProposalFullRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryProposalRequest.ProtoReflect.Descriptor instead.
-func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryProposalFullRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalFullRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryProposalRequest) GetProposalId() uint64 {
+func (x *QueryProposalFullRequest) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-// QueryProposalResponse is the Query/Proposal response type.
-type QueryProposalResponse struct {
+func (x *QueryProposalFullRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryProposalFullResponse is the Query/ProposalFull response type.
+type QueryProposalFullResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal is the proposal info.
 	Proposal *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
-}
-
-func (x *QueryProposalResponse) Reset() {
-	*x = QueryProposalResponse{}
+	// decision_policy is the current decision policy of the group account of the
+	// proposal.
+	DecisionPolicy *anypb.Any `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// tally is the sum of all weighted votes for the proposal, which is final
+	// once the proposal is closed.
+	Tally *Tally `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
+	// votes are the list of votes for the proposal.
+	Votes []*Vote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
+	// pagination defines the pagination of the votes in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryProposalFullResponse) Reset() {
+	*x = QueryProposalFullResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[13]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalResponse) String() string {
+func (x *QueryProposalFullResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalResponse) ProtoMessage() {}
+func (*QueryProposalFullResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
-func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryProposalFullResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalFullResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryProposalResponse) GetProposal() *Proposal {
+func (x *QueryProposalFullResponse) GetProposal() *Proposal {
 	if x != nil {
 		return x.Proposal
 	}
 	return nil
 }
 
-// QueryProposalsByGroupAccountRequest is the Query/ProposalByGroupAccount
-// request type.
-type QueryProposalsByGroupAccountRequest struct {
+func (x *QueryProposalFullResponse) GetDecisionPolicy() *anypb.Any {
+	if x != nil {
+		return x.DecisionPolicy
+	}
+	return nil
+}
+
+func (x *QueryProposalFullResponse) GetTally() *Tally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+func (x *QueryProposalFullResponse) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *QueryProposalFullResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGroupExportRequest is the Query/GroupExport request type.
+type QueryGroupExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the group account address related to proposals.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *QueryProposalsByGroupAccountRequest) Reset() {
-	*x = QueryProposalsByGroupAccountRequest{}
+func (x *QueryGroupExportRequest) Reset() {
+	*x = QueryGroupExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[14]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalsByGroupAccountRequest) String() string {
+func (x *QueryGroupExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalsByGroupAccountRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryProposalsByGroupAccountRequest.ProtoReflect.Descriptor instead.
-func (*QueryProposalsByGroupAccountRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{14}
-}
+func (*QueryGroupExportRequest) ProtoMessage() {}
 
-func (x *QueryProposalsByGroupAccountRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use QueryGroupExportRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupExportRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryProposalsByGroupAccountRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryGroupExportRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-// QueryProposalsByGroupAccountResponse is the Query/ProposalByGroupAccount
-// response type.
-type QueryProposalsByGroupAccountResponse struct {
+// QueryGroupExportResponse is the Query/GroupExport response type.
+type QueryGroupExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposals are the proposals with given group account.
-	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// genesis is the state of the group in the genesis format. The sequences
+	// are not set as they are not scoped to a single group.
+	Genesis *GenesisState `protobuf:"bytes,1,opt,name=genesis,proto3" json:"genesis,omitempty"`
 }
 
-func (x *QueryProposalsByGroupAccountResponse) Reset() {
-	*x = QueryProposalsByGroupAccountResponse{}
+func (x *QueryGroupExportResponse) Reset() {
+	*x = QueryGroupExportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[15]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalsByGroupAccountResponse) String() string {
+func (x *QueryGroupExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalsByGroupAccountResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryProposalsByGroupAccountResponse.ProtoReflect.Descriptor instead.
-func (*QueryProposalsByGroupAccountResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{15}
-}
+func (*QueryGroupExportResponse) ProtoMessage() {}
 
-func (x *QueryProposalsByGroupAccountResponse) GetProposals() []*Proposal {
-	if x != nil {
-		return x.Proposals
-	}
-	return nil
+// Deprecated: Use QueryGroupExportResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupExportResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryProposalsByGroupAccountResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryGroupExportResponse) GetGenesis() *GenesisState {
 	if x != nil {
-		return x.Pagination
+		return x.Genesis
 	}
 	return nil
 }
 
-// QueryVoteByProposalVoterResponse is the Query/VoteByProposalVoter request
-// type.
-type QueryVoteByProposalVoterRequest struct {
+// QueryRequiredYesWeightRequest is the Query/RequiredYesWeight request type.
+type QueryRequiredYesWeightRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id is the unique ID of a proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter is a proposal voter account address.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (x *QueryVoteByProposalVoterRequest) Reset() {
-	*x = QueryVoteByProposalVoterRequest{}
+func (x *QueryRequiredYesWeightRequest) Reset() {
+	*x = QueryRequiredYesWeightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[16]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVoteByProposalVoterRequest) String() string {
+func (x *QueryRequiredYesWeightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVoteByProposalVoterRequest) ProtoMessage() {}
+func (*QueryRequiredYesWeightRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryVoteByProposalVoterRequest.ProtoReflect.Descriptor instead.
-func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryRequiredYesWeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequiredYesWeightRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryVoteByProposalVoterRequest) GetProposalId() uint64 {
+func (x *QueryRequiredYesWeightRequest) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *QueryVoteByProposalVoterRequest) GetVoter() string {
-	if x != nil {
-		return x.Voter
-	}
-	return ""
-}
-
-// QueryVoteByProposalVoterResponse is the Query/VoteByProposalVoter response
-// type.
-type QueryVoteByProposalVoterResponse struct {
+// QueryRequiredYesWeightResponse is the Query/RequiredYesWeight response type.
+type QueryRequiredYesWeightResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// vote is the vote with given proposal_id and voter.
-	Vote *Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	// required_yes_weight is the remaining yes weight needed for the proposal to
+	// pass, which is zero if the proposal is already passing.
+	RequiredYesWeight string `protobuf:"bytes,1,opt,name=required_yes_weight,json=requiredYesWeight,proto3" json:"required_yes_weight,omitempty"`
 }
 
-func (x *QueryVoteByProposalVoterResponse) Reset() {
-	*x = QueryVoteByProposalVoterResponse{}
+func (x *QueryRequiredYesWeightResponse) Reset() {
+	*x = QueryRequiredYesWeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[17]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVoteByProposalVoterResponse) String() string {
+func (x *QueryRequiredYesWeightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVoteByProposalVoterResponse) ProtoMessage() {}
+func (*QueryRequiredYesWeightResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVoteByProposalVoterResponse.ProtoReflect.Descriptor instead.
-func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryRequiredYesWeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequiredYesWeightResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryVoteByProposalVoterResponse) GetVote() *Vote {
+func (x *QueryRequiredYesWeightResponse) GetRequiredYesWeight() string {
 	if x != nil {
-		return x.Vote
+		return x.RequiredYesWeight
 	}
-	return nil
+	return ""
 }
 
-// QueryVotesByProposalResponse is the Query/VotesByProposal request type.
-type QueryVotesByProposalRequest struct {
+// QueryGroupAccountAddressRequest is the Query/GroupAccountAddress request
+// type.
+type QueryGroupAccountAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_id is the unique ID of a proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *QueryVotesByProposalRequest) Reset() {
-	*x = QueryVotesByProposalRequest{}
+func (x *QueryGroupAccountAddressRequest) Reset() {
+	*x = QueryGroupAccountAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[18]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesByProposalRequest) String() string {
+func (x *QueryGroupAccountAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesByProposalRequest) ProtoMessage() {}
+func (*QueryGroupAccountAddressRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryVotesByProposalRequest.ProtoReflect.Descriptor instead.
-func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryGroupAccountAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountAddressRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryVotesByProposalRequest) GetProposalId() uint64 {
+func (x *QueryGroupAccountAddressRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.ProposalId
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *QueryVotesByProposalRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-// QueryVotesByProposalResponse is the Query/VotesByProposal response type.
-type QueryVotesByProposalResponse struct {
+// QueryGroupAccountAddressResponse is the Query/GroupAccountAddress response
+// type.
+type QueryGroupAccountAddressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// votes are the list of votes for given proposal_id.
-	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address is the account address that would be assigned to the next group
+	// account created for the group. It remains valid until another group
+	// account is created, for this or any other group.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// salt is the salt used together with the group id to derive the address.
+	Salt uint64 `protobuf:"varint,2,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (x *QueryVotesByProposalResponse) Reset() {
-	*x = QueryVotesByProposalResponse{}
+func (x *QueryGroupAccountAddressResponse) Reset() {
+	*x = QueryGroupAccountAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[19]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesByProposalResponse) String() string {
+func (x *QueryGroupAccountAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesByProposalResponse) ProtoMessage() {}
+func (*QueryGroupAccountAddressResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVotesByProposalResponse.ProtoReflect.Descriptor instead.
-func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryGroupAccountAddressResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupAccountAddressResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryVotesByProposalResponse) GetVotes() []*Vote {
+func (x *QueryGroupAccountAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.Votes
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryVotesByProposalResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryGroupAccountAddressResponse) GetSalt() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Salt
 	}
-	return nil
+	return 0
 }
 
-// QueryVotesByVoterResponse is the Query/VotesByVoter request type.
-type QueryVotesByVoterRequest struct {
+// QueryTallyResultRequest is the Query/TallyResult request type.
+type QueryTallyResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// voter is a proposal voter account address.
-	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (x *QueryVotesByVoterRequest) Reset() {
-	*x = QueryVotesByVoterRequest{}
+func (x *QueryTallyResultRequest) Reset() {
+	*x = QueryTallyResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[20]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesByVoterRequest) String() string {
+func (x *QueryTallyResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesByVoterRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryVotesByVoterRequest.ProtoReflect.Descriptor instead.
-func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{20}
-}
+func (*QueryTallyResultRequest) ProtoMessage() {}
 
-func (x *QueryVotesByVoterRequest) GetVoter() string {
-	if x != nil {
-		return x.Voter
-	}
-	return ""
+// Deprecated: Use QueryTallyResultRequest.ProtoReflect.Descriptor instead.
+func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryVotesByVoterRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryTallyResultRequest) GetProposalId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-// QueryVotesByVoterResponse is the Query/VotesByVoter response type.
-type QueryVotesByVoterResponse struct {
+// QueryTallyResultResponse is the Query/TallyResult response type.
+type QueryTallyResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// votes are the list of votes by given voter.
-	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// tally is the sum of all weighted votes for the proposal. It is the final
+	// tally result if the proposal is closed, and the live tally computed from
+	// the recorded votes otherwise.
+	Tally *Tally `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// passing is true if the decision policy of the group account currently
+	// allows the proposal, or if the proposal was accepted once closed.
+	Passing bool `protobuf:"varint,2,opt,name=passing,proto3" json:"passing,omitempty"`
+	// final is true if the proposal result can no longer change.
+	Final bool `protobuf:"varint,3,opt,name=final,proto3" json:"final,omitempty"`
 }
-
-func (x *QueryVotesByVoterResponse) Reset() {
-	*x = QueryVotesByVoterResponse{}
+
+func (x *QueryTallyResultResponse) Reset() {
+	*x = QueryTallyResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[21]
+		mi := &file_regen_group_v1alpha1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesByVoterResponse) String() string {
+func (x *QueryTallyResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesByVoterResponse) ProtoMessage() {}
+func (*QueryTallyResultResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVotesByVoterResponse.ProtoReflect.Descriptor instead.
-func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryTallyResultResponse.ProtoReflect.Descriptor instead.
+func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_query_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *QueryVotesByVoterResponse) GetVotes() []*Vote {
+func (x *QueryTallyResultResponse) GetTally() *Tally {
 	if x != nil {
-		return x.Votes
+		return x.Tally
 	}
 	return nil
 }
 
-func (x *QueryVotesByVoterResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryTallyResultResponse) GetPassing() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Passing
 	}
-	return nil
+	return false
+}
+
+func (x *QueryTallyResultResponse) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
 }
 
 var File_regen_group_v1alpha1_query_proto protoreflect.FileDescriptor
@@ -11885,11 +19149,18 @@ var file_regen_group_v1alpha1_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x14, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x20, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f,
 	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
 	0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
 	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
@@ -11993,213 +19264,389 @@ var file_regen_group_v1alpha1_query_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
 	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a,
-	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x76, 0x6f,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
-	0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52,
-	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0x40, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x78, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56,
-	0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
-	0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f,
-	0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x32, 0xcd, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x9c, 0x01, 0x0a,
-	0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
-	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0xb4, 0x01, 0x0a, 0x10,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x32, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x12, 0x2f, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xad, 0x01,
+	0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a,
+	0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x76,
+	0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f,
+	0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x78, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79,
+	0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x77, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x83, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x02, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x58,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x22, 0x40, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x59, 0x65, 0x73, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x1e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x59, 0x65, 0x73, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x59, 0x65, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x20, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0x3a, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x32, 0xec,
+	0x19, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x9c, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
 	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0xa7, 0x01,
-	0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0xb4, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa8,
+	0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f,
-	0x7b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2f, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42,
+	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e,
 	0x12, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
 	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x72, 0x65, 0x67, 0x65,
+	0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x72, 0x65, 0x67, 0x65,
 	0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x14,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x7d, 0x2f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0xca, 0x01, 0x0a, 0x13, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x35, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0xd2, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f,
 	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x33, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39,
+	0x12, 0x37, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x13, 0x56, 0x6f,
+	0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x12, 0x35, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f,
+	0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x31, 0x2e, 0x72, 0x65, 0x67, 0x65,
 	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x72, 0x65, 0x67,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x9d, 0x01,
+	0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x2e,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xc0, 0x01,
+	0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0xab, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x46, 0x75, 0x6c,
+	0x6c, 0x12, 0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x72, 0x65, 0x67,
 	0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xd2, 0x01, 0x0a, 0x17, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x3a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0xc9,
-	0x01, 0x0a, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
-	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f,
-	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74,
-	0x65, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x0f, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x31,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0xa4,
+	0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d,
 	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73,
-	0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x32, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f,
-	0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f,
-	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f,
-	0x74, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65,
-	0x72, 0x7d, 0x42, 0xe6, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42,
-	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x47,
-	0x58, 0xaa, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e,
-	0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2,
-	0x02, 0x20, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x16, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xc9, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x59, 0x65, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x59, 0x65, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x59, 0x65, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0xa9, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x2d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
+	0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c,
+	0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x42, 0xe6, 0x01,
+	0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x47, 0x58, 0xaa, 0x02, 0x14, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0xca, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12214,7 +19661,7 @@ func file_regen_group_v1alpha1_query_proto_rawDescGZIP() []byte {
 	return file_regen_group_v1alpha1_query_proto_rawDescData
 }
 
-var file_regen_group_v1alpha1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_regen_group_v1alpha1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_regen_group_v1alpha1_query_proto_goTypes = []interface{}{
 	(*QueryGroupInfoRequest)(nil),                // 0: regen.group.v1alpha1.QueryGroupInfoRequest
 	(*QueryGroupInfoResponse)(nil),               // 1: regen.group.v1alpha1.QueryGroupInfoResponse
@@ -12230,75 +19677,116 @@ var file_regen_group_v1alpha1_query_proto_goTypes = []interface{}{
 	(*QueryGroupAccountsByAdminResponse)(nil),    // 11: regen.group.v1alpha1.QueryGroupAccountsByAdminResponse
 	(*QueryProposalRequest)(nil),                 // 12: regen.group.v1alpha1.QueryProposalRequest
 	(*QueryProposalResponse)(nil),                // 13: regen.group.v1alpha1.QueryProposalResponse
-	(*QueryProposalsByGroupAccountRequest)(nil),  // 14: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest
-	(*QueryProposalsByGroupAccountResponse)(nil), // 15: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse
-	(*QueryVoteByProposalVoterRequest)(nil),      // 16: regen.group.v1alpha1.QueryVoteByProposalVoterRequest
-	(*QueryVoteByProposalVoterResponse)(nil),     // 17: regen.group.v1alpha1.QueryVoteByProposalVoterResponse
-	(*QueryVotesByProposalRequest)(nil),          // 18: regen.group.v1alpha1.QueryVotesByProposalRequest
-	(*QueryVotesByProposalResponse)(nil),         // 19: regen.group.v1alpha1.QueryVotesByProposalResponse
-	(*QueryVotesByVoterRequest)(nil),             // 20: regen.group.v1alpha1.QueryVotesByVoterRequest
-	(*QueryVotesByVoterResponse)(nil),            // 21: regen.group.v1alpha1.QueryVotesByVoterResponse
-	(*GroupInfo)(nil),                            // 22: regen.group.v1alpha1.GroupInfo
-	(*GroupAccountInfo)(nil),                     // 23: regen.group.v1alpha1.GroupAccountInfo
-	(*v1beta1.PageRequest)(nil),                  // 24: cosmos.base.query.v1beta1.PageRequest
-	(*GroupMember)(nil),                          // 25: regen.group.v1alpha1.GroupMember
-	(*v1beta1.PageResponse)(nil),                 // 26: cosmos.base.query.v1beta1.PageResponse
-	(*Proposal)(nil),                             // 27: regen.group.v1alpha1.Proposal
-	(*Vote)(nil),                                 // 28: regen.group.v1alpha1.Vote
+	(*QueryProposalProposersRequest)(nil),        // 14: regen.group.v1alpha1.QueryProposalProposersRequest
+	(*QueryProposalProposersResponse)(nil),       // 15: regen.group.v1alpha1.QueryProposalProposersResponse
+	(*QueryProposalsByGroupAccountRequest)(nil),  // 16: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest
+	(*QueryProposalsByGroupAccountResponse)(nil), // 17: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse
+	(*QueryVoteByProposalVoterRequest)(nil),      // 18: regen.group.v1alpha1.QueryVoteByProposalVoterRequest
+	(*QueryVoteByProposalVoterResponse)(nil),     // 19: regen.group.v1alpha1.QueryVoteByProposalVoterResponse
+	(*QueryVotesByProposalRequest)(nil),          // 20: regen.group.v1alpha1.QueryVotesByProposalRequest
+	(*QueryVotesByProposalResponse)(nil),         // 21: regen.group.v1alpha1.QueryVotesByProposalResponse
+	(*QueryVotesByVoterRequest)(nil),             // 22: regen.group.v1alpha1.QueryVotesByVoterRequest
+	(*QueryVotesByVoterResponse)(nil),            // 23: regen.group.v1alpha1.QueryVotesByVoterResponse
+	(*QueryGroupParticipationRequest)(nil),       // 24: regen.group.v1alpha1.QueryGroupParticipationRequest
+	(*QueryGroupParticipationResponse)(nil),      // 25: regen.group.v1alpha1.QueryGroupParticipationResponse
+	(*QueryProposalFullRequest)(nil),             // 26: regen.group.v1alpha1.QueryProposalFullRequest
+	(*QueryProposalFullResponse)(nil),            // 27: regen.group.v1alpha1.QueryProposalFullResponse
+	(*QueryGroupExportRequest)(nil),              // 28: regen.group.v1alpha1.QueryGroupExportRequest
+	(*QueryGroupExportResponse)(nil),             // 29: regen.group.v1alpha1.QueryGroupExportResponse
+	(*QueryRequiredYesWeightRequest)(nil),        // 30: regen.group.v1alpha1.QueryRequiredYesWeightRequest
+	(*QueryRequiredYesWeightResponse)(nil),       // 31: regen.group.v1alpha1.QueryRequiredYesWeightResponse
+	(*QueryGroupAccountAddressRequest)(nil),      // 32: regen.group.v1alpha1.QueryGroupAccountAddressRequest
+	(*QueryGroupAccountAddressResponse)(nil),     // 33: regen.group.v1alpha1.QueryGroupAccountAddressResponse
+	(*QueryTallyResultRequest)(nil),              // 34: regen.group.v1alpha1.QueryTallyResultRequest
+	(*QueryTallyResultResponse)(nil),             // 35: regen.group.v1alpha1.QueryTallyResultResponse
+	(*GroupInfo)(nil),                            // 36: regen.group.v1alpha1.GroupInfo
+	(*GroupAccountInfo)(nil),                     // 37: regen.group.v1alpha1.GroupAccountInfo
+	(*v1beta1.PageRequest)(nil),                  // 38: cosmos.base.query.v1beta1.PageRequest
+	(*GroupMember)(nil),                          // 39: regen.group.v1alpha1.GroupMember
+	(*v1beta1.PageResponse)(nil),                 // 40: cosmos.base.query.v1beta1.PageResponse
+	(*Proposal)(nil),                             // 41: regen.group.v1alpha1.Proposal
+	(Proposal_Status)(0),                         // 42: regen.group.v1alpha1.Proposal.Status
+	(*Vote)(nil),                                 // 43: regen.group.v1alpha1.Vote
+	(*anypb.Any)(nil),                            // 44: google.protobuf.Any
+	(*Tally)(nil),                                // 45: regen.group.v1alpha1.Tally
+	(*GenesisState)(nil),                         // 46: regen.group.v1alpha1.GenesisState
 }
 var file_regen_group_v1alpha1_query_proto_depIdxs = []int32{
-	22, // 0: regen.group.v1alpha1.QueryGroupInfoResponse.info:type_name -> regen.group.v1alpha1.GroupInfo
-	23, // 1: regen.group.v1alpha1.QueryGroupAccountInfoResponse.info:type_name -> regen.group.v1alpha1.GroupAccountInfo
-	24, // 2: regen.group.v1alpha1.QueryGroupMembersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	25, // 3: regen.group.v1alpha1.QueryGroupMembersResponse.members:type_name -> regen.group.v1alpha1.GroupMember
-	26, // 4: regen.group.v1alpha1.QueryGroupMembersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	24, // 5: regen.group.v1alpha1.QueryGroupsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 6: regen.group.v1alpha1.QueryGroupsByAdminResponse.groups:type_name -> regen.group.v1alpha1.GroupInfo
-	26, // 7: regen.group.v1alpha1.QueryGroupsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	24, // 8: regen.group.v1alpha1.QueryGroupAccountsByGroupRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	23, // 9: regen.group.v1alpha1.QueryGroupAccountsByGroupResponse.group_accounts:type_name -> regen.group.v1alpha1.GroupAccountInfo
-	26, // 10: regen.group.v1alpha1.QueryGroupAccountsByGroupResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	24, // 11: regen.group.v1alpha1.QueryGroupAccountsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	23, // 12: regen.group.v1alpha1.QueryGroupAccountsByAdminResponse.group_accounts:type_name -> regen.group.v1alpha1.GroupAccountInfo
-	26, // 13: regen.group.v1alpha1.QueryGroupAccountsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 14: regen.group.v1alpha1.QueryProposalResponse.proposal:type_name -> regen.group.v1alpha1.Proposal
-	24, // 15: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 16: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals:type_name -> regen.group.v1alpha1.Proposal
-	26, // 17: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 18: regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote:type_name -> regen.group.v1alpha1.Vote
-	24, // 19: regen.group.v1alpha1.QueryVotesByProposalRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 20: regen.group.v1alpha1.QueryVotesByProposalResponse.votes:type_name -> regen.group.v1alpha1.Vote
-	26, // 21: regen.group.v1alpha1.QueryVotesByProposalResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	24, // 22: regen.group.v1alpha1.QueryVotesByVoterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 23: regen.group.v1alpha1.QueryVotesByVoterResponse.votes:type_name -> regen.group.v1alpha1.Vote
-	26, // 24: regen.group.v1alpha1.QueryVotesByVoterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 25: regen.group.v1alpha1.Query.GroupInfo:input_type -> regen.group.v1alpha1.QueryGroupInfoRequest
-	2,  // 26: regen.group.v1alpha1.Query.GroupAccountInfo:input_type -> regen.group.v1alpha1.QueryGroupAccountInfoRequest
-	4,  // 27: regen.group.v1alpha1.Query.GroupMembers:input_type -> regen.group.v1alpha1.QueryGroupMembersRequest
-	6,  // 28: regen.group.v1alpha1.Query.GroupsByAdmin:input_type -> regen.group.v1alpha1.QueryGroupsByAdminRequest
-	8,  // 29: regen.group.v1alpha1.Query.GroupAccountsByGroup:input_type -> regen.group.v1alpha1.QueryGroupAccountsByGroupRequest
-	10, // 30: regen.group.v1alpha1.Query.GroupAccountsByAdmin:input_type -> regen.group.v1alpha1.QueryGroupAccountsByAdminRequest
-	12, // 31: regen.group.v1alpha1.Query.Proposal:input_type -> regen.group.v1alpha1.QueryProposalRequest
-	14, // 32: regen.group.v1alpha1.Query.ProposalsByGroupAccount:input_type -> regen.group.v1alpha1.QueryProposalsByGroupAccountRequest
-	16, // 33: regen.group.v1alpha1.Query.VoteByProposalVoter:input_type -> regen.group.v1alpha1.QueryVoteByProposalVoterRequest
-	18, // 34: regen.group.v1alpha1.Query.VotesByProposal:input_type -> regen.group.v1alpha1.QueryVotesByProposalRequest
-	20, // 35: regen.group.v1alpha1.Query.VotesByVoter:input_type -> regen.group.v1alpha1.QueryVotesByVoterRequest
-	1,  // 36: regen.group.v1alpha1.Query.GroupInfo:output_type -> regen.group.v1alpha1.QueryGroupInfoResponse
-	3,  // 37: regen.group.v1alpha1.Query.GroupAccountInfo:output_type -> regen.group.v1alpha1.QueryGroupAccountInfoResponse
-	5,  // 38: regen.group.v1alpha1.Query.GroupMembers:output_type -> regen.group.v1alpha1.QueryGroupMembersResponse
-	7,  // 39: regen.group.v1alpha1.Query.GroupsByAdmin:output_type -> regen.group.v1alpha1.QueryGroupsByAdminResponse
-	9,  // 40: regen.group.v1alpha1.Query.GroupAccountsByGroup:output_type -> regen.group.v1alpha1.QueryGroupAccountsByGroupResponse
-	11, // 41: regen.group.v1alpha1.Query.GroupAccountsByAdmin:output_type -> regen.group.v1alpha1.QueryGroupAccountsByAdminResponse
-	13, // 42: regen.group.v1alpha1.Query.Proposal:output_type -> regen.group.v1alpha1.QueryProposalResponse
-	15, // 43: regen.group.v1alpha1.Query.ProposalsByGroupAccount:output_type -> regen.group.v1alpha1.QueryProposalsByGroupAccountResponse
-	17, // 44: regen.group.v1alpha1.Query.VoteByProposalVoter:output_type -> regen.group.v1alpha1.QueryVoteByProposalVoterResponse
-	19, // 45: regen.group.v1alpha1.Query.VotesByProposal:output_type -> regen.group.v1alpha1.QueryVotesByProposalResponse
-	21, // 46: regen.group.v1alpha1.Query.VotesByVoter:output_type -> regen.group.v1alpha1.QueryVotesByVoterResponse
-	36, // [36:47] is the sub-list for method output_type
-	25, // [25:36] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	36, // 0: regen.group.v1alpha1.QueryGroupInfoResponse.info:type_name -> regen.group.v1alpha1.GroupInfo
+	37, // 1: regen.group.v1alpha1.QueryGroupAccountInfoResponse.info:type_name -> regen.group.v1alpha1.GroupAccountInfo
+	38, // 2: regen.group.v1alpha1.QueryGroupMembersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	39, // 3: regen.group.v1alpha1.QueryGroupMembersResponse.members:type_name -> regen.group.v1alpha1.GroupMember
+	40, // 4: regen.group.v1alpha1.QueryGroupMembersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 5: regen.group.v1alpha1.QueryGroupsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	36, // 6: regen.group.v1alpha1.QueryGroupsByAdminResponse.groups:type_name -> regen.group.v1alpha1.GroupInfo
+	40, // 7: regen.group.v1alpha1.QueryGroupsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 8: regen.group.v1alpha1.QueryGroupAccountsByGroupRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 9: regen.group.v1alpha1.QueryGroupAccountsByGroupResponse.group_accounts:type_name -> regen.group.v1alpha1.GroupAccountInfo
+	40, // 10: regen.group.v1alpha1.QueryGroupAccountsByGroupResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 11: regen.group.v1alpha1.QueryGroupAccountsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 12: regen.group.v1alpha1.QueryGroupAccountsByAdminResponse.group_accounts:type_name -> regen.group.v1alpha1.GroupAccountInfo
+	40, // 13: regen.group.v1alpha1.QueryGroupAccountsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	41, // 14: regen.group.v1alpha1.QueryProposalResponse.proposal:type_name -> regen.group.v1alpha1.Proposal
+	38, // 15: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 16: regen.group.v1alpha1.QueryProposalsByGroupAccountRequest.status:type_name -> regen.group.v1alpha1.Proposal.Status
+	41, // 17: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.proposals:type_name -> regen.group.v1alpha1.Proposal
+	40, // 18: regen.group.v1alpha1.QueryProposalsByGroupAccountResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	43, // 19: regen.group.v1alpha1.QueryVoteByProposalVoterResponse.vote:type_name -> regen.group.v1alpha1.Vote
+	38, // 20: regen.group.v1alpha1.QueryVotesByProposalRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 21: regen.group.v1alpha1.QueryVotesByProposalResponse.votes:type_name -> regen.group.v1alpha1.Vote
+	40, // 22: regen.group.v1alpha1.QueryVotesByProposalResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 23: regen.group.v1alpha1.QueryVotesByVoterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 24: regen.group.v1alpha1.QueryVotesByVoterResponse.votes:type_name -> regen.group.v1alpha1.Vote
+	40, // 25: regen.group.v1alpha1.QueryVotesByVoterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 26: regen.group.v1alpha1.QueryProposalFullRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 27: regen.group.v1alpha1.QueryProposalFullResponse.proposal:type_name -> regen.group.v1alpha1.Proposal
+	44, // 28: regen.group.v1alpha1.QueryProposalFullResponse.decision_policy:type_name -> google.protobuf.Any
+	45, // 29: regen.group.v1alpha1.QueryProposalFullResponse.tally:type_name -> regen.group.v1alpha1.Tally
+	43, // 30: regen.group.v1alpha1.QueryProposalFullResponse.votes:type_name -> regen.group.v1alpha1.Vote
+	40, // 31: regen.group.v1alpha1.QueryProposalFullResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	46, // 32: regen.group.v1alpha1.QueryGroupExportResponse.genesis:type_name -> regen.group.v1alpha1.GenesisState
+	45, // 33: regen.group.v1alpha1.QueryTallyResultResponse.tally:type_name -> regen.group.v1alpha1.Tally
+	0,  // 34: regen.group.v1alpha1.Query.GroupInfo:input_type -> regen.group.v1alpha1.QueryGroupInfoRequest
+	2,  // 35: regen.group.v1alpha1.Query.GroupAccountInfo:input_type -> regen.group.v1alpha1.QueryGroupAccountInfoRequest
+	4,  // 36: regen.group.v1alpha1.Query.GroupMembers:input_type -> regen.group.v1alpha1.QueryGroupMembersRequest
+	6,  // 37: regen.group.v1alpha1.Query.GroupsByAdmin:input_type -> regen.group.v1alpha1.QueryGroupsByAdminRequest
+	8,  // 38: regen.group.v1alpha1.Query.GroupAccountsByGroup:input_type -> regen.group.v1alpha1.QueryGroupAccountsByGroupRequest
+	10, // 39: regen.group.v1alpha1.Query.GroupAccountsByAdmin:input_type -> regen.group.v1alpha1.QueryGroupAccountsByAdminRequest
+	32, // 40: regen.group.v1alpha1.Query.GroupAccountAddress:input_type -> regen.group.v1alpha1.QueryGroupAccountAddressRequest
+	12, // 41: regen.group.v1alpha1.Query.Proposal:input_type -> regen.group.v1alpha1.QueryProposalRequest
+	16, // 42: regen.group.v1alpha1.Query.ProposalsByGroupAccount:input_type -> regen.group.v1alpha1.QueryProposalsByGroupAccountRequest
+	14, // 43: regen.group.v1alpha1.Query.ProposalProposers:input_type -> regen.group.v1alpha1.QueryProposalProposersRequest
+	18, // 44: regen.group.v1alpha1.Query.VoteByProposalVoter:input_type -> regen.group.v1alpha1.QueryVoteByProposalVoterRequest
+	20, // 45: regen.group.v1alpha1.Query.VotesByProposal:input_type -> regen.group.v1alpha1.QueryVotesByProposalRequest
+	22, // 46: regen.group.v1alpha1.Query.VotesByVoter:input_type -> regen.group.v1alpha1.QueryVotesByVoterRequest
+	24, // 47: regen.group.v1alpha1.Query.GroupParticipation:input_type -> regen.group.v1alpha1.QueryGroupParticipationRequest
+	26, // 48: regen.group.v1alpha1.Query.ProposalFull:input_type -> regen.group.v1alpha1.QueryProposalFullRequest
+	28, // 49: regen.group.v1alpha1.Query.GroupExport:input_type -> regen.group.v1alpha1.QueryGroupExportRequest
+	30, // 50: regen.group.v1alpha1.Query.RequiredYesWeight:input_type -> regen.group.v1alpha1.QueryRequiredYesWeightRequest
+	34, // 51: regen.group.v1alpha1.Query.TallyResult:input_type -> regen.group.v1alpha1.QueryTallyResultRequest
+	1,  // 52: regen.group.v1alpha1.Query.GroupInfo:output_type -> regen.group.v1alpha1.QueryGroupInfoResponse
+	3,  // 53: regen.group.v1alpha1.Query.GroupAccountInfo:output_type -> regen.group.v1alpha1.QueryGroupAccountInfoResponse
+	5,  // 54: regen.group.v1alpha1.Query.GroupMembers:output_type -> regen.group.v1alpha1.QueryGroupMembersResponse
+	7,  // 55: regen.group.v1alpha1.Query.GroupsByAdmin:output_type -> regen.group.v1alpha1.QueryGroupsByAdminResponse
+	9,  // 56: regen.group.v1alpha1.Query.GroupAccountsByGroup:output_type -> regen.group.v1alpha1.QueryGroupAccountsByGroupResponse
+	11, // 57: regen.group.v1alpha1.Query.GroupAccountsByAdmin:output_type -> regen.group.v1alpha1.QueryGroupAccountsByAdminResponse
+	33, // 58: regen.group.v1alpha1.Query.GroupAccountAddress:output_type -> regen.group.v1alpha1.QueryGroupAccountAddressResponse
+	13, // 59: regen.group.v1alpha1.Query.Proposal:output_type -> regen.group.v1alpha1.QueryProposalResponse
+	17, // 60: regen.group.v1alpha1.Query.ProposalsByGroupAccount:output_type -> regen.group.v1alpha1.QueryProposalsByGroupAccountResponse
+	15, // 61: regen.group.v1alpha1.Query.ProposalProposers:output_type -> regen.group.v1alpha1.QueryProposalProposersResponse
+	19, // 62: regen.group.v1alpha1.Query.VoteByProposalVoter:output_type -> regen.group.v1alpha1.QueryVoteByProposalVoterResponse
+	21, // 63: regen.group.v1alpha1.Query.VotesByProposal:output_type -> regen.group.v1alpha1.QueryVotesByProposalResponse
+	23, // 64: regen.group.v1alpha1.Query.VotesByVoter:output_type -> regen.group.v1alpha1.QueryVotesByVoterResponse
+	25, // 65: regen.group.v1alpha1.Query.GroupParticipation:output_type -> regen.group.v1alpha1.QueryGroupParticipationResponse
+	27, // 66: regen.group.v1alpha1.Query.ProposalFull:output_type -> regen.group.v1alpha1.QueryProposalFullResponse
+	29, // 67: regen.group.v1alpha1.Query.GroupExport:output_type -> regen.group.v1alpha1.QueryGroupExportResponse
+	31, // 68: regen.group.v1alpha1.Query.RequiredYesWeight:output_type -> regen.group.v1alpha1.QueryRequiredYesWeightResponse
+	35, // 69: regen.group.v1alpha1.Query.TallyResult:output_type -> regen.group.v1alpha1.QueryTallyResultResponse
+	52, // [52:70] is the sub-list for method output_type
+	34, // [34:52] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_regen_group_v1alpha1_query_proto_init() }
@@ -12307,6 +19795,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 		return
 	}
 	file_regen_group_v1alpha1_types_proto_init()
+	file_regen_group_v1alpha1_genesis_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_regen_group_v1alpha1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGroupInfoRequest); i {
@@ -12477,7 +19966,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsByGroupAccountRequest); i {
+			switch v := v.(*QueryProposalProposersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12489,7 +19978,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsByGroupAccountResponse); i {
+			switch v := v.(*QueryProposalProposersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12501,7 +19990,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteByProposalVoterRequest); i {
+			switch v := v.(*QueryProposalsByGroupAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12513,7 +20002,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteByProposalVoterResponse); i {
+			switch v := v.(*QueryProposalsByGroupAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12525,7 +20014,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByProposalRequest); i {
+			switch v := v.(*QueryVoteByProposalVoterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12537,7 +20026,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByProposalResponse); i {
+			switch v := v.(*QueryVoteByProposalVoterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12549,7 +20038,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByVoterRequest); i {
+			switch v := v.(*QueryVotesByProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12561,6 +20050,30 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVotesByProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVotesByVoterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryVotesByVoterResponse); i {
 			case 0:
 				return &v.state
@@ -12572,6 +20085,150 @@ func file_regen_group_v1alpha1_query_proto_init() {
 				return nil
 			}
 		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupParticipationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupParticipationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProposalFullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProposalFullResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequiredYesWeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequiredYesWeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupAccountAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupAccountAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTallyResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTallyResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12579,7 +20236,7 @@ func file_regen_group_v1alpha1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_group_v1alpha1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,16 +34,39 @@ type QueryClient interface {
 	GroupAccountsByGroup(ctx context.Context, in *QueryGroupAccountsByGroupRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByGroupResponse, error)
 	// GroupsByAdmin queries group accounts by admin address.
 	GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAccountsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByAdminResponse, error)
+	// GroupAccountAddress queries the address that would be assigned to the next
+	// group account created for a group.
+	GroupAccountAddress(ctx context.Context, in *QueryGroupAccountAddressRequest, opts ...grpc.CallOption) (*QueryGroupAccountAddressResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalProposers queries the proposers of a proposal based on proposal id.
+	ProposalProposers(ctx context.Context, in *QueryProposalProposersRequest, opts ...grpc.CallOption) (*QueryProposalProposersResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+	// GroupParticipation queries the average voting participation of a group
+	// over a window of its most recent proposals.
+	GroupParticipation(ctx context.Context, in *QueryGroupParticipationRequest, opts ...grpc.CallOption) (*QueryGroupParticipationResponse, error)
+	// ProposalFull queries a proposal together with the decision policy of its
+	// group account, its tally and its votes based on proposal id.
+	ProposalFull(ctx context.Context, in *QueryProposalFullRequest, opts ...grpc.CallOption) (*QueryProposalFullResponse, error)
+	// GroupExport exports the full state of a single group (the group, its
+	// members, its group accounts, their proposals and the votes on those
+	// proposals) in the genesis format.
+	GroupExport(ctx context.Context, in *QueryGroupExportRequest, opts ...grpc.CallOption) (*QueryGroupExportResponse, error)
+	// RequiredYesWeight queries the remaining yes weight needed for a proposal to
+	// pass given its current tally and the decision policy of its group account.
+	// The query fails if the group account has been modified since the proposal
+	// was submitted, as the proposal can no longer pass.
+	RequiredYesWeight(ctx context.Context, in *QueryRequiredYesWeightRequest, opts ...grpc.CallOption) (*QueryRequiredYesWeightResponse, error)
+	// TallyResult queries the tally of a proposal, which is computed from the
+	// recorded votes if the proposal is still open for voting.
+	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 }
 
 type queryClient struct {
@@ -108,6 +131,15 @@ func (c *queryClient) GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAc
 	return out, nil
 }
 
+func (c *queryClient) GroupAccountAddress(ctx context.Context, in *QueryGroupAccountAddressRequest, opts ...grpc.CallOption) (*QueryGroupAccountAddressResponse, error) {
+	out := new(QueryGroupAccountAddressResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GroupAccountAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
 	out := new(QueryProposalResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/Proposal", in, out, opts...)
@@ -126,6 +158,15 @@ func (c *queryClient) ProposalsByGroupAccount(ctx context.Context, in *QueryProp
 	return out, nil
 }
 
+func (c *queryClient) ProposalProposers(ctx context.Context, in *QueryProposalProposersRequest, opts ...grpc.CallOption) (*QueryProposalProposersResponse, error) {
+	out := new(QueryProposalProposersResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ProposalProposers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error) {
 	out := new(QueryVoteByProposalVoterResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/VoteByProposalVoter", in, out, opts...)
@@ -153,6 +194,51 @@ func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterReq
 	return out, nil
 }
 
+func (c *queryClient) GroupParticipation(ctx context.Context, in *QueryGroupParticipationRequest, opts ...grpc.CallOption) (*QueryGroupParticipationResponse, error) {
+	out := new(QueryGroupParticipationResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GroupParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalFull(ctx context.Context, in *QueryProposalFullRequest, opts ...grpc.CallOption) (*QueryProposalFullResponse, error) {
+	out := new(QueryProposalFullResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ProposalFull", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupExport(ctx context.Context, in *QueryGroupExportRequest, opts ...grpc.CallOption) (*QueryGroupExportResponse, error) {
+	out := new(QueryGroupExportResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GroupExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredYesWeight(ctx context.Context, in *QueryRequiredYesWeightRequest, opts ...grpc.CallOption) (*QueryRequiredYesWeightResponse, error) {
+	out := new(QueryRequiredYesWeightResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/RequiredYesWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
+	out := new(QueryTallyResultResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/TallyResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -169,16 +255,39 @@ type QueryServer interface {
 	GroupAccountsByGroup(context.Context, *QueryGroupAccountsByGroupRequest) (*QueryGroupAccountsByGroupResponse, error)
 	// GroupsByAdmin queries group accounts by admin address.
 	GroupAccountsByAdmin(context.Context, *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error)
+	// GroupAccountAddress queries the address that would be assigned to the next
+	// group account created for a group.
+	GroupAccountAddress(context.Context, *QueryGroupAccountAddressRequest) (*QueryGroupAccountAddressResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(context.Context, *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalProposers queries the proposers of a proposal based on proposal id.
+	ProposalProposers(context.Context, *QueryProposalProposersRequest) (*QueryProposalProposersResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(context.Context, *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+	// GroupParticipation queries the average voting participation of a group
+	// over a window of its most recent proposals.
+	GroupParticipation(context.Context, *QueryGroupParticipationRequest) (*QueryGroupParticipationResponse, error)
+	// ProposalFull queries a proposal together with the decision policy of its
+	// group account, its tally and its votes based on proposal id.
+	ProposalFull(context.Context, *QueryProposalFullRequest) (*QueryProposalFullResponse, error)
+	// GroupExport exports the full state of a single group (the group, its
+	// members, its group accounts, their proposals and the votes on those
+	// proposals) in the genesis format.
+	GroupExport(context.Context, *QueryGroupExportRequest) (*QueryGroupExportResponse, error)
+	// RequiredYesWeight queries the remaining yes weight needed for a proposal to
+	// pass given its current tally and the decision policy of its group account.
+	// The query fails if the group account has been modified since the proposal
+	// was submitted, as the proposal can no longer pass.
+	RequiredYesWeight(context.Context, *QueryRequiredYesWeightRequest) (*QueryRequiredYesWeightResponse, error)
+	// TallyResult queries the tally of a proposal, which is computed from the
+	// recorded votes if the proposal is still open for voting.
+	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -204,12 +313,18 @@ func (UnimplementedQueryServer) GroupAccountsByGroup(context.Context, *QueryGrou
 func (UnimplementedQueryServer) GroupAccountsByAdmin(context.Context, *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupAccountsByAdmin not implemented")
 }
+func (UnimplementedQueryServer) GroupAccountAddress(context.Context, *QueryGroupAccountAddressRequest) (*QueryGroupAccountAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAccountAddress not implemented")
+}
 func (UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
 }
 func (UnimplementedQueryServer) ProposalsByGroupAccount(context.Context, *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByGroupAccount not implemented")
 }
+func (UnimplementedQueryServer) ProposalProposers(context.Context, *QueryProposalProposersRequest) (*QueryProposalProposersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalProposers not implemented")
+}
 func (UnimplementedQueryServer) VoteByProposalVoter(context.Context, *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteByProposalVoter not implemented")
 }
@@ -219,6 +334,21 @@ func (UnimplementedQueryServer) VotesByProposal(context.Context, *QueryVotesByPr
 func (UnimplementedQueryServer) VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
 }
+func (UnimplementedQueryServer) GroupParticipation(context.Context, *QueryGroupParticipationRequest) (*QueryGroupParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupParticipation not implemented")
+}
+func (UnimplementedQueryServer) ProposalFull(context.Context, *QueryProposalFullRequest) (*QueryProposalFullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalFull not implemented")
+}
+func (UnimplementedQueryServer) GroupExport(context.Context, *QueryGroupExportRequest) (*QueryGroupExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExport not implemented")
+}
+func (UnimplementedQueryServer) RequiredYesWeight(context.Context, *QueryRequiredYesWeightRequest) (*QueryRequiredYesWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredYesWeight not implemented")
+}
+func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -340,6 +470,24 @@ func _Query_GroupAccountsByAdmin_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupAccountAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccountAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GroupAccountAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccountAddress(ctx, req.(*QueryGroupAccountAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +524,24 @@ func _Query_ProposalsByGroupAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalProposers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalProposersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalProposers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ProposalProposers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalProposers(ctx, req.(*QueryProposalProposersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VoteByProposalVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteByProposalVoterRequest)
 	if err := dec(in); err != nil {
@@ -430,6 +596,96 @@ func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GroupParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupParticipation(ctx, req.(*QueryGroupParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalFull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalFullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalFull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ProposalFull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalFull(ctx, req.(*QueryProposalFullRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GroupExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupExport(ctx, req.(*QueryGroupExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredYesWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredYesWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredYesWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/RequiredYesWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredYesWeight(ctx, req.(*QueryRequiredYesWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/TallyResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -461,6 +717,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupAccountsByAdmin",
 			Handler:    _Query_GroupAccountsByAdmin_Handler,
 		},
+		{
+			MethodName: "GroupAccountAddress",
+			Handler:    _Query_GroupAccountAddress_Handler,
+		},
 		{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
@@ -469,6 +729,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProposalsByGroupAccount",
 			Handler:    _Query_ProposalsByGroupAccount_Handler,
 		},
+		{
+			MethodName: "ProposalProposers",
+			Handler:    _Query_ProposalProposers_Handler,
+		},
 		{
 			MethodName: "VoteByProposalVoter",
 			Handler:    _Query_VoteByProposalVoter_Handler,
@@ -481,6 +745,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VotesByVoter",
 			Handler:    _Query_VotesByVoter_Handler,
 		},
+		{
+			MethodName: "GroupParticipation",
+			Handler:    _Query_GroupParticipation_Handler,
+		},
+		{
+			MethodName: "ProposalFull",
+			Handler:    _Query_ProposalFull_Handler,
+		},
+		{
+			MethodName: "GroupExport",
+			Handler:    _Query_GroupExport_Handler,
+		},
+		{
+			MethodName: "RequiredYesWeight",
+			Handler:    _Query_RequiredYesWeight_Handler,
+		},
+		{
+			MethodName: "TallyResult",
+			Handler:    _Query_TallyResult_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/group/v1alpha1/query.proto",
@@ -4816,31 +4816,2172 @@ func (x *fastReflection_MsgCreateGroupAccountResponse) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreateGroupWithPolicy_2_list)(nil)
+
+type _MsgCreateGroupWithPolicy_2_list struct {
+	list *[]*Member
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(Member)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(Member)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateGroupWithPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCreateGroupWithPolicy                        protoreflect.MessageDescriptor
+	fd_MsgCreateGroupWithPolicy_admin                  protoreflect.FieldDescriptor
+	fd_MsgCreateGroupWithPolicy_members                protoreflect.FieldDescriptor
+	fd_MsgCreateGroupWithPolicy_group_metadata         protoreflect.FieldDescriptor
+	fd_MsgCreateGroupWithPolicy_group_account_metadata protoreflect.FieldDescriptor
+	fd_MsgCreateGroupWithPolicy_decision_policy        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_tx_proto_init()
+	md_MsgCreateGroupWithPolicy = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgCreateGroupWithPolicy")
+	fd_MsgCreateGroupWithPolicy_admin = md_MsgCreateGroupWithPolicy.Fields().ByName("admin")
+	fd_MsgCreateGroupWithPolicy_members = md_MsgCreateGroupWithPolicy.Fields().ByName("members")
+	fd_MsgCreateGroupWithPolicy_group_metadata = md_MsgCreateGroupWithPolicy.Fields().ByName("group_metadata")
+	fd_MsgCreateGroupWithPolicy_group_account_metadata = md_MsgCreateGroupWithPolicy.Fields().ByName("group_account_metadata")
+	fd_MsgCreateGroupWithPolicy_decision_policy = md_MsgCreateGroupWithPolicy.Fields().ByName("decision_policy")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateGroupWithPolicy)(nil)
+
+type fastReflection_MsgCreateGroupWithPolicy MsgCreateGroupWithPolicy
+
+func (x *MsgCreateGroupWithPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateGroupWithPolicy)(x)
+}
+
+func (x *MsgCreateGroupWithPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateGroupWithPolicy_messageType fastReflection_MsgCreateGroupWithPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateGroupWithPolicy_messageType{}
+
+type fastReflection_MsgCreateGroupWithPolicy_messageType struct{}
+
+func (x fastReflection_MsgCreateGroupWithPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateGroupWithPolicy)(nil)
+}
+func (x fastReflection_MsgCreateGroupWithPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateGroupWithPolicy)
+}
+func (x fastReflection_MsgCreateGroupWithPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateGroupWithPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateGroupWithPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateGroupWithPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateGroupWithPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateGroupWithPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateGroupWithPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_MsgCreateGroupWithPolicy_admin, value) {
+			return
+		}
+	}
+	if len(x.Members) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateGroupWithPolicy_2_list{list: &x.Members})
+		if !f(fd_MsgCreateGroupWithPolicy_members, value) {
+			return
+		}
+	}
+	if len(x.GroupMetadata) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupMetadata)
+		if !f(fd_MsgCreateGroupWithPolicy_group_metadata, value) {
+			return
+		}
+	}
+	if len(x.GroupAccountMetadata) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupAccountMetadata)
+		if !f(fd_MsgCreateGroupWithPolicy_group_account_metadata, value) {
+			return
+		}
+	}
+	if x.DecisionPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+		if !f(fd_MsgCreateGroupWithPolicy_decision_policy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		return x.Admin != ""
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		return len(x.Members) != 0
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		return len(x.GroupMetadata) != 0
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		return len(x.GroupAccountMetadata) != 0
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		return x.DecisionPolicy != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		x.Admin = ""
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		x.Members = nil
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		x.GroupMetadata = nil
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		x.GroupAccountMetadata = nil
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		x.DecisionPolicy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		if len(x.Members) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateGroupWithPolicy_2_list{})
+		}
+		listValue := &_MsgCreateGroupWithPolicy_2_list{list: &x.Members}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		value := x.GroupMetadata
+		return protoreflect.ValueOfBytes(value)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		value := x.GroupAccountMetadata
+		return protoreflect.ValueOfBytes(value)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		value := x.DecisionPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		x.Admin = value.Interface().(string)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		lv := value.List()
+		clv := lv.(*_MsgCreateGroupWithPolicy_2_list)
+		x.Members = *clv.list
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		x.GroupMetadata = value.Bytes()
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		x.GroupAccountMetadata = value.Bytes()
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		if x.Members == nil {
+			x.Members = []*Member{}
+		}
+		value := &_MsgCreateGroupWithPolicy_2_list{list: &x.Members}
+		return protoreflect.ValueOfList(value)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		if x.DecisionPolicy == nil {
+			x.DecisionPolicy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgCreateGroupWithPolicy is not mutable"))
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		panic(fmt.Errorf("field group_metadata of message regen.group.v1alpha1.MsgCreateGroupWithPolicy is not mutable"))
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		panic(fmt.Errorf("field group_account_metadata of message regen.group.v1alpha1.MsgCreateGroupWithPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateGroupWithPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.admin":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.members":
+		list := []*Member{}
+		return protoreflect.ValueOfList(&_MsgCreateGroupWithPolicy_2_list{list: &list})
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_metadata":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.group_account_metadata":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicy"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateGroupWithPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgCreateGroupWithPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateGroupWithPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateGroupWithPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateGroupWithPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Members) > 0 {
+			for _, e := range x.Members {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.GroupMetadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GroupAccountMetadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecisionPolicy != nil {
+			l = options.Size(x.DecisionPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DecisionPolicy != nil {
+			encoded, err := options.Marshal(x.DecisionPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.GroupAccountMetadata) > 0 {
+			i -= len(x.GroupAccountMetadata)
+			copy(dAtA[i:], x.GroupAccountMetadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupAccountMetadata)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GroupMetadata) > 0 {
+			i -= len(x.GroupMetadata)
+			copy(dAtA[i:], x.GroupMetadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupMetadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Members) > 0 {
+			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Members[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateGroupWithPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateGroupWithPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Members = append(x.Members, &Member{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupMetadata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupMetadata = append(x.GroupMetadata[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupMetadata == nil {
+					x.GroupMetadata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupAccountMetadata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupAccountMetadata = append(x.GroupAccountMetadata[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupAccountMetadata == nil {
+					x.GroupAccountMetadata = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DecisionPolicy == nil {
+					x.DecisionPolicy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateGroupWithPolicyResponse                       protoreflect.MessageDescriptor
+	fd_MsgCreateGroupWithPolicyResponse_group_id              protoreflect.FieldDescriptor
+	fd_MsgCreateGroupWithPolicyResponse_group_account_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_tx_proto_init()
+	md_MsgCreateGroupWithPolicyResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgCreateGroupWithPolicyResponse")
+	fd_MsgCreateGroupWithPolicyResponse_group_id = md_MsgCreateGroupWithPolicyResponse.Fields().ByName("group_id")
+	fd_MsgCreateGroupWithPolicyResponse_group_account_address = md_MsgCreateGroupWithPolicyResponse.Fields().ByName("group_account_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateGroupWithPolicyResponse)(nil)
+
+type fastReflection_MsgCreateGroupWithPolicyResponse MsgCreateGroupWithPolicyResponse
+
+func (x *MsgCreateGroupWithPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateGroupWithPolicyResponse)(x)
+}
+
+func (x *MsgCreateGroupWithPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateGroupWithPolicyResponse_messageType fastReflection_MsgCreateGroupWithPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateGroupWithPolicyResponse_messageType{}
+
+type fastReflection_MsgCreateGroupWithPolicyResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateGroupWithPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateGroupWithPolicyResponse)(nil)
+}
+func (x fastReflection_MsgCreateGroupWithPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateGroupWithPolicyResponse)
+}
+func (x fastReflection_MsgCreateGroupWithPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateGroupWithPolicyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateGroupWithPolicyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateGroupWithPolicyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateGroupWithPolicyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateGroupWithPolicyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_MsgCreateGroupWithPolicyResponse_group_id, value) {
+			return
+		}
+	}
+	if x.GroupAccountAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupAccountAddress)
+		if !f(fd_MsgCreateGroupWithPolicyResponse_group_account_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		return x.GroupId != uint64(0)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		return x.GroupAccountAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		x.GroupId = uint64(0)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		x.GroupAccountAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		value := x.GroupAccountAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		x.GroupId = value.Uint()
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		x.GroupAccountAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		panic(fmt.Errorf("field group_id of message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse is not mutable"))
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		panic(fmt.Errorf("field group_account_address of message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse.group_account_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateGroupWithPolicyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		l = len(x.GroupAccountAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GroupAccountAddress) > 0 {
+			i -= len(x.GroupAccountAddress)
+			copy(dAtA[i:], x.GroupAccountAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupAccountAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateGroupWithPolicyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateGroupWithPolicyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateGroupWithPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupAccountAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupAccountAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateGroupAccountAdmin           protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupAccountAdmin_admin     protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountAdmin_address   protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountAdmin_new_admin protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_tx_proto_init()
+	md_MsgUpdateGroupAccountAdmin = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountAdmin")
+	fd_MsgUpdateGroupAccountAdmin_admin = md_MsgUpdateGroupAccountAdmin.Fields().ByName("admin")
+	fd_MsgUpdateGroupAccountAdmin_address = md_MsgUpdateGroupAccountAdmin.Fields().ByName("address")
+	fd_MsgUpdateGroupAccountAdmin_new_admin = md_MsgUpdateGroupAccountAdmin.Fields().ByName("new_admin")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountAdmin)(nil)
+
+type fastReflection_MsgUpdateGroupAccountAdmin MsgUpdateGroupAccountAdmin
+
+func (x *MsgUpdateGroupAccountAdmin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountAdmin)(x)
+}
+
+func (x *MsgUpdateGroupAccountAdmin) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateGroupAccountAdmin_messageType fastReflection_MsgUpdateGroupAccountAdmin_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountAdmin_messageType{}
+
+type fastReflection_MsgUpdateGroupAccountAdmin_messageType struct{}
+
+func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountAdmin)(nil)
+}
+func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountAdmin)
+}
+func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountAdmin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountAdmin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountAdmin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountAdmin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountAdmin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_MsgUpdateGroupAccountAdmin_admin, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MsgUpdateGroupAccountAdmin_address, value) {
+			return
+		}
+	}
+	if x.NewAdmin != "" {
+		value := protoreflect.ValueOfString(x.NewAdmin)
+		if !f(fd_MsgUpdateGroupAccountAdmin_new_admin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		return x.Admin != ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		return x.Address != ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		return x.NewAdmin != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		x.Admin = ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		x.Address = ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		x.NewAdmin = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		value := x.NewAdmin
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		x.Admin = value.Interface().(string)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		x.Address = value.Interface().(string)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		x.NewAdmin = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		panic(fmt.Errorf("field new_admin of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountAdmin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewAdmin) > 0 {
+			i -= len(x.NewAdmin)
+			copy(dAtA[i:], x.NewAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAdmin)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateGroupAccountAdminResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_group_v1alpha1_tx_proto_init()
+	md_MsgUpdateGroupAccountAdminResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountAdminResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountAdminResponse)(nil)
+
+type fastReflection_MsgUpdateGroupAccountAdminResponse MsgUpdateGroupAccountAdminResponse
+
+func (x *MsgUpdateGroupAccountAdminResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountAdminResponse)(x)
+}
+
+func (x *MsgUpdateGroupAccountAdminResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateGroupAccountAdminResponse_messageType fastReflection_MsgUpdateGroupAccountAdminResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountAdminResponse_messageType{}
+
+type fastReflection_MsgUpdateGroupAccountAdminResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountAdminResponse)(nil)
+}
+func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountAdminResponse)
+}
+func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountAdminResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountAdminResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountAdminResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountAdminResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountAdminResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+		}
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_MsgUpdateGroupAccountAdmin           protoreflect.MessageDescriptor
-	fd_MsgUpdateGroupAccountAdmin_admin     protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountAdmin_address   protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountAdmin_new_admin protoreflect.FieldDescriptor
+	md_MsgUpdateGroupAccountDecisionPolicy                 protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicy_admin           protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicy_address         protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountAdmin = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountAdmin")
-	fd_MsgUpdateGroupAccountAdmin_admin = md_MsgUpdateGroupAccountAdmin.Fields().ByName("admin")
-	fd_MsgUpdateGroupAccountAdmin_address = md_MsgUpdateGroupAccountAdmin.Fields().ByName("address")
-	fd_MsgUpdateGroupAccountAdmin_new_admin = md_MsgUpdateGroupAccountAdmin.Fields().ByName("new_admin")
+	md_MsgUpdateGroupAccountDecisionPolicy = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicy")
+	fd_MsgUpdateGroupAccountDecisionPolicy_admin = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("admin")
+	fd_MsgUpdateGroupAccountDecisionPolicy_address = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("address")
+	fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("decision_policy")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountAdmin)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(nil)
 
-type fastReflection_MsgUpdateGroupAccountAdmin MsgUpdateGroupAccountAdmin
+type fastReflection_MsgUpdateGroupAccountDecisionPolicy MsgUpdateGroupAccountDecisionPolicy
 
-func (x *MsgUpdateGroupAccountAdmin) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountAdmin)(x)
+func (x *MsgUpdateGroupAccountDecisionPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(x)
 }
 
-func (x *MsgUpdateGroupAccountAdmin) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[10]
+func (x *MsgUpdateGroupAccountDecisionPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4851,43 +6992,43 @@ func (x *MsgUpdateGroupAccountAdmin) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountAdmin_messageType fastReflection_MsgUpdateGroupAccountAdmin_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountAdmin_messageType{}
+var _fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountAdmin_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountAdmin)(nil)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountAdmin)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicy)
 }
-func (x fastReflection_MsgUpdateGroupAccountAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountAdmin
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountAdmin
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountAdmin_messageType
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountAdmin)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountAdmin)(x)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountDecisionPolicy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4895,22 +7036,22 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Admin != "" {
 		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_MsgUpdateGroupAccountAdmin_admin, value) {
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_admin, value) {
 			return
 		}
 	}
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgUpdateGroupAccountAdmin_address, value) {
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_address, value) {
 			return
 		}
 	}
-	if x.NewAdmin != "" {
-		value := protoreflect.ValueOfString(x.NewAdmin)
-		if !f(fd_MsgUpdateGroupAccountAdmin_new_admin, value) {
+	if x.DecisionPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy, value) {
 			return
 		}
 	}
@@ -4927,19 +7068,19 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
 		return x.Admin != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
 		return x.Address != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
-		return x.NewAdmin != ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		return x.DecisionPolicy != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4949,19 +7090,19 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
 		x.Admin = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
 		x.Address = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
-		x.NewAdmin = ""
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		x.DecisionPolicy = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4971,22 +7112,22 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
-		value := x.NewAdmin
-		return protoreflect.ValueOfString(value)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		value := x.DecisionPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5000,19 +7141,19 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
 		x.Admin = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
 		x.Address = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
-		x.NewAdmin = value.Interface().(string)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5026,48 +7167,52 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
-		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
-		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
-		panic(fmt.Errorf("field new_admin of message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		if x.DecisionPolicy == nil {
+			x.DecisionPolicy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.admin":
-		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
 		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin.new_admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
 		return protoreflect.ValueOfString("")
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountAdmin", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5075,7 +7220,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5086,7 +7231,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5098,7 +7243,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -5108,9 +7253,9 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5130,8 +7275,8 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NewAdmin)
-		if l > 0 {
+		if x.DecisionPolicy != nil {
+			l = options.Size(x.DecisionPolicy)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5144,7 +7289,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5163,10 +7308,17 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewAdmin) > 0 {
-			i -= len(x.NewAdmin)
-			copy(dAtA[i:], x.NewAdmin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAdmin)))
+		if x.DecisionPolicy != nil {
+			encoded, err := options.Marshal(x.DecisionPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -5195,7 +7347,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdmin)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5227,10 +7379,10 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5299,9 +7451,9 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5311,23 +7463,27 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewAdmin = string(dAtA[iNdEx:postIndex])
+				if x.DecisionPolicy == nil {
+					x.DecisionPolicy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5365,24 +7521,24 @@ func (x *fastReflection_MsgUpdateGroupAccountAdmin) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgUpdateGroupAccountAdminResponse protoreflect.MessageDescriptor
+	md_MsgUpdateGroupAccountDecisionPolicyResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountAdminResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountAdminResponse")
+	md_MsgUpdateGroupAccountDecisionPolicyResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicyResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountAdminResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(nil)
 
-type fastReflection_MsgUpdateGroupAccountAdminResponse MsgUpdateGroupAccountAdminResponse
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse MsgUpdateGroupAccountDecisionPolicyResponse
 
-func (x *MsgUpdateGroupAccountAdminResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountAdminResponse)(x)
+func (x *MsgUpdateGroupAccountDecisionPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(x)
 }
 
-func (x *MsgUpdateGroupAccountAdminResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[11]
+func (x *MsgUpdateGroupAccountDecisionPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,43 +7549,43 @@ func (x *MsgUpdateGroupAccountAdminResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountAdminResponse_messageType fastReflection_MsgUpdateGroupAccountAdminResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountAdminResponse_messageType{}
+var _fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountAdminResponse_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountAdminResponse)(nil)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountAdminResponse)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)
 }
-func (x fastReflection_MsgUpdateGroupAccountAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountAdminResponse
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountAdminResponse
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountAdminResponse_messageType
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountAdminResponse)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountAdminResponse)(x)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountDecisionPolicyResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5437,7 +7593,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5451,13 +7607,13 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5467,13 +7623,13 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5483,13 +7639,13 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5503,13 +7659,13 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5523,36 +7679,36 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5560,7 +7716,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5571,7 +7727,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5583,7 +7739,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5593,9 +7749,9 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5617,7 +7773,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5647,7 +7803,7 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountAdminResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5679,10 +7835,10 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5721,30 +7877,30 @@ func (x *fastReflection_MsgUpdateGroupAccountAdminResponse) ProtoMethods() *prot
 }
 
 var (
-	md_MsgUpdateGroupAccountDecisionPolicy                 protoreflect.MessageDescriptor
-	fd_MsgUpdateGroupAccountDecisionPolicy_admin           protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountDecisionPolicy_address         protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy protoreflect.FieldDescriptor
+	md_MsgUpdateGroupAccountMetadata          protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupAccountMetadata_admin    protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountMetadata_address  protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountMetadata_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountDecisionPolicy = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicy")
-	fd_MsgUpdateGroupAccountDecisionPolicy_admin = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("admin")
-	fd_MsgUpdateGroupAccountDecisionPolicy_address = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("address")
-	fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy = md_MsgUpdateGroupAccountDecisionPolicy.Fields().ByName("decision_policy")
+	md_MsgUpdateGroupAccountMetadata = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountMetadata")
+	fd_MsgUpdateGroupAccountMetadata_admin = md_MsgUpdateGroupAccountMetadata.Fields().ByName("admin")
+	fd_MsgUpdateGroupAccountMetadata_address = md_MsgUpdateGroupAccountMetadata.Fields().ByName("address")
+	fd_MsgUpdateGroupAccountMetadata_metadata = md_MsgUpdateGroupAccountMetadata.Fields().ByName("metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountMetadata)(nil)
 
-type fastReflection_MsgUpdateGroupAccountDecisionPolicy MsgUpdateGroupAccountDecisionPolicy
+type fastReflection_MsgUpdateGroupAccountMetadata MsgUpdateGroupAccountMetadata
 
-func (x *MsgUpdateGroupAccountDecisionPolicy) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(x)
+func (x *MsgUpdateGroupAccountMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountMetadata)(x)
 }
 
-func (x *MsgUpdateGroupAccountDecisionPolicy) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[12]
+func (x *MsgUpdateGroupAccountMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5755,43 +7911,43 @@ func (x *MsgUpdateGroupAccountDecisionPolicy) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType{}
+var _fastReflection_MsgUpdateGroupAccountMetadata_messageType fastReflection_MsgUpdateGroupAccountMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountMetadata_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountMetadata_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicy)(nil)
+func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountMetadata)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicy)
+func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountMetadata)
 }
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountDecisionPolicy
+func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountDecisionPolicy
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountDecisionPolicy_messageType
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicy)
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountDecisionPolicy)(x)
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5799,22 +7955,22 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Admin != "" {
 		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_admin, value) {
+		if !f(fd_MsgUpdateGroupAccountMetadata_admin, value) {
 			return
 		}
 	}
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_address, value) {
+		if !f(fd_MsgUpdateGroupAccountMetadata_address, value) {
 			return
 		}
 	}
-	if x.DecisionPolicy != nil {
-		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
-		if !f(fd_MsgUpdateGroupAccountDecisionPolicy_decision_policy, value) {
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Metadata)
+		if !f(fd_MsgUpdateGroupAccountMetadata_metadata, value) {
 			return
 		}
 	}
@@ -5831,19 +7987,19 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
 		return x.Admin != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
 		return x.Address != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		return x.DecisionPolicy != nil
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		return len(x.Metadata) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5853,19 +8009,19 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
 		x.Admin = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
 		x.Address = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		x.DecisionPolicy = nil
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		x.Metadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5875,22 +8031,22 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		value := x.DecisionPolicy
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5904,19 +8060,19 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
 		x.Admin = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
 		x.Address = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		x.Metadata = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5930,52 +8086,48 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		if x.DecisionPolicy == nil {
-			x.DecisionPolicy = new(anypb.Any)
-		}
-		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
-		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy is not mutable"))
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
-		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		panic(fmt.Errorf("field metadata of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
 		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
 		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5983,7 +8135,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5994,7 +8146,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6006,7 +8158,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -6016,9 +8168,9 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6038,8 +8190,8 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DecisionPolicy != nil {
-			l = options.Size(x.DecisionPolicy)
+		l = len(x.Metadata)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6052,7 +8204,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6071,17 +8223,10 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DecisionPolicy != nil {
-			encoded, err := options.Marshal(x.DecisionPolicy)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6110,7 +8255,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicy)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6142,10 +8287,10 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicy: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6214,9 +8359,9 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6226,26 +8371,24 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.DecisionPolicy == nil {
-					x.DecisionPolicy = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Metadata = append(x.Metadata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Metadata == nil {
+					x.Metadata = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6284,24 +8427,24 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicy) ProtoMethods() *pro
 }
 
 var (
-	md_MsgUpdateGroupAccountDecisionPolicyResponse protoreflect.MessageDescriptor
+	md_MsgUpdateGroupAccountMetadataResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountDecisionPolicyResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicyResponse")
+	md_MsgUpdateGroupAccountMetadataResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountMetadataResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(nil)
 
-type fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse MsgUpdateGroupAccountDecisionPolicyResponse
+type fastReflection_MsgUpdateGroupAccountMetadataResponse MsgUpdateGroupAccountMetadataResponse
 
-func (x *MsgUpdateGroupAccountDecisionPolicyResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(x)
+func (x *MsgUpdateGroupAccountMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(x)
 }
 
-func (x *MsgUpdateGroupAccountDecisionPolicyResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[13]
+func (x *MsgUpdateGroupAccountMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6312,43 +8455,43 @@ func (x *MsgUpdateGroupAccountDecisionPolicyResponse) slowProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType{}
+var _fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)(nil)
+func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)
+func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountMetadataResponse)
 }
-func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountDecisionPolicyResponse
+func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountDecisionPolicyResponse
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse_messageType
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse)
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountDecisionPolicyResponse)(x)
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6356,7 +8499,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6370,13 +8513,13 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Range(f fun
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6386,13 +8529,13 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Has(fd prot
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6402,13 +8545,13 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Clear(fd pr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6422,13 +8565,13 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Get(descrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6442,36 +8585,36 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Set(fd prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6479,7 +8622,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) WhichOneof(
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6490,7 +8633,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) GetUnknown(
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6502,7 +8645,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) SetUnknown(
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6512,9 +8655,9 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) IsValid() b
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6536,7 +8679,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethod
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6566,7 +8709,7 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethod
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6598,10 +8741,10 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethod
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadataResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6640,30 +8783,30 @@ func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMethod
 }
 
 var (
-	md_MsgUpdateGroupAccountMetadata          protoreflect.MessageDescriptor
-	fd_MsgUpdateGroupAccountMetadata_admin    protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountMetadata_address  protoreflect.FieldDescriptor
-	fd_MsgUpdateGroupAccountMetadata_metadata protoreflect.FieldDescriptor
+	md_MsgUpdateGroupAccountDecisionPolicyMetadata          protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_admin    protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_address  protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_metadata protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountMetadata = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountMetadata")
-	fd_MsgUpdateGroupAccountMetadata_admin = md_MsgUpdateGroupAccountMetadata.Fields().ByName("admin")
-	fd_MsgUpdateGroupAccountMetadata_address = md_MsgUpdateGroupAccountMetadata.Fields().ByName("address")
-	fd_MsgUpdateGroupAccountMetadata_metadata = md_MsgUpdateGroupAccountMetadata.Fields().ByName("metadata")
+	md_MsgUpdateGroupAccountDecisionPolicyMetadata = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicyMetadata")
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_admin = md_MsgUpdateGroupAccountDecisionPolicyMetadata.Fields().ByName("admin")
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_address = md_MsgUpdateGroupAccountDecisionPolicyMetadata.Fields().ByName("address")
+	fd_MsgUpdateGroupAccountDecisionPolicyMetadata_metadata = md_MsgUpdateGroupAccountDecisionPolicyMetadata.Fields().ByName("metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata)(nil)
 
-type fastReflection_MsgUpdateGroupAccountMetadata MsgUpdateGroupAccountMetadata
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata MsgUpdateGroupAccountDecisionPolicyMetadata
 
-func (x *MsgUpdateGroupAccountMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountMetadata)(x)
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata)(x)
 }
 
-func (x *MsgUpdateGroupAccountMetadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[14]
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6674,43 +8817,43 @@ func (x *MsgUpdateGroupAccountMetadata) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountMetadata_messageType fastReflection_MsgUpdateGroupAccountMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountMetadata_messageType{}
+var _fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountMetadata_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountMetadata)(nil)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountMetadata)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata)
 }
-func (x fastReflection_MsgUpdateGroupAccountMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountMetadata
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountMetadata
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountMetadata_messageType
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountMetadata)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountMetadata)(x)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountDecisionPolicyMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6718,22 +8861,22 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Admin != "" {
 		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_MsgUpdateGroupAccountMetadata_admin, value) {
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicyMetadata_admin, value) {
 			return
 		}
 	}
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgUpdateGroupAccountMetadata_address, value) {
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicyMetadata_address, value) {
 			return
 		}
 	}
 	if len(x.Metadata) != 0 {
 		value := protoreflect.ValueOfBytes(x.Metadata)
-		if !f(fd_MsgUpdateGroupAccountMetadata_metadata, value) {
+		if !f(fd_MsgUpdateGroupAccountDecisionPolicyMetadata_metadata, value) {
 			return
 		}
 	}
@@ -6750,19 +8893,19 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
 		return x.Admin != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
 		return x.Address != ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
 		return len(x.Metadata) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6772,19 +8915,19 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
 		x.Admin = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
 		x.Address = ""
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
 		x.Metadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6794,22 +8937,22 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
 		value := x.Metadata
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6823,19 +8966,19 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
 		x.Admin = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
 		x.Address = value.Interface().(string)
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
 		x.Metadata = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6849,48 +8992,48 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
-		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
-		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
-		panic(fmt.Errorf("field metadata of message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
+		panic(fmt.Errorf("field admin of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
+		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata is not mutable"))
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
+		panic(fmt.Errorf("field metadata of message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.admin":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.admin":
 		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.address":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.address":
 		return protoreflect.ValueOfString("")
-	case "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata.metadata":
+	case "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata.metadata":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6898,7 +9041,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6909,7 +9052,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6921,7 +9064,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -6931,9 +9074,9 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6967,7 +9110,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7018,7 +9161,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadata)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7050,10 +9193,10 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7190,24 +9333,24 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadata) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgUpdateGroupAccountMetadataResponse protoreflect.MessageDescriptor
+	md_MsgUpdateGroupAccountDecisionPolicyMetadataResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_regen_group_v1alpha1_tx_proto_init()
-	md_MsgUpdateGroupAccountMetadataResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountMetadataResponse")
+	md_MsgUpdateGroupAccountDecisionPolicyMetadataResponse = File_regen_group_v1alpha1_tx_proto.Messages().ByName("MsgUpdateGroupAccountDecisionPolicyMetadataResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(nil)
 
-type fastReflection_MsgUpdateGroupAccountMetadataResponse MsgUpdateGroupAccountMetadataResponse
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse MsgUpdateGroupAccountDecisionPolicyMetadataResponse
 
-func (x *MsgUpdateGroupAccountMetadataResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(x)
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(x)
 }
 
-func (x *MsgUpdateGroupAccountMetadataResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[15]
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7218,43 +9361,43 @@ func (x *MsgUpdateGroupAccountMetadataResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType{}
+var _fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType{}
 
-type fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType struct{}
+type fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateGroupAccountMetadataResponse)(nil)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(nil)
 }
-func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountMetadataResponse)
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
 }
-func (x fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountMetadataResponse
+func (x fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateGroupAccountMetadataResponse
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupAccountDecisionPolicyMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateGroupAccountMetadataResponse_messageType
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateGroupAccountMetadataResponse)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateGroupAccountMetadataResponse)(x)
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7262,7 +9405,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7276,13 +9419,13 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7292,13 +9435,13 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7308,13 +9451,13 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7328,13 +9471,13 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7348,36 +9491,36 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse"))
 		}
-		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7385,7 +9528,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7396,7 +9539,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7408,7 +9551,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7418,9 +9561,9 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupAccountDecisionPolicyMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7442,7 +9585,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7472,7 +9615,7 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateGroupAccountMetadataResponse)
+		x := input.Message.Interface().(*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7504,10 +9647,10 @@ func (x *fastReflection_MsgUpdateGroupAccountMetadataResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadataResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadataResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7643,12 +9786,13 @@ func (x *_MsgCreateProposal_4_list) IsValid() bool {
 }
 
 var (
-	md_MsgCreateProposal           protoreflect.MessageDescriptor
-	fd_MsgCreateProposal_address   protoreflect.FieldDescriptor
-	fd_MsgCreateProposal_proposers protoreflect.FieldDescriptor
-	fd_MsgCreateProposal_metadata  protoreflect.FieldDescriptor
-	fd_MsgCreateProposal_msgs      protoreflect.FieldDescriptor
-	fd_MsgCreateProposal_exec      protoreflect.FieldDescriptor
+	md_MsgCreateProposal                protoreflect.MessageDescriptor
+	fd_MsgCreateProposal_address        protoreflect.FieldDescriptor
+	fd_MsgCreateProposal_proposers      protoreflect.FieldDescriptor
+	fd_MsgCreateProposal_metadata       protoreflect.FieldDescriptor
+	fd_MsgCreateProposal_msgs           protoreflect.FieldDescriptor
+	fd_MsgCreateProposal_exec           protoreflect.FieldDescriptor
+	fd_MsgCreateProposal_exec_predicate protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -7659,6 +9803,7 @@ func init() {
 	fd_MsgCreateProposal_metadata = md_MsgCreateProposal.Fields().ByName("metadata")
 	fd_MsgCreateProposal_msgs = md_MsgCreateProposal.Fields().ByName("msgs")
 	fd_MsgCreateProposal_exec = md_MsgCreateProposal.Fields().ByName("exec")
+	fd_MsgCreateProposal_exec_predicate = md_MsgCreateProposal.Fields().ByName("exec_predicate")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateProposal)(nil)
@@ -7670,7 +9815,7 @@ func (x *MsgCreateProposal) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgCreateProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[16]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7756,6 +9901,12 @@ func (x *fastReflection_MsgCreateProposal) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.ExecPredicate != nil {
+		value := protoreflect.ValueOfMessage(x.ExecPredicate.ProtoReflect())
+		if !f(fd_MsgCreateProposal_exec_predicate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7781,6 +9932,8 @@ func (x *fastReflection_MsgCreateProposal) Has(fd protoreflect.FieldDescriptor)
 		return len(x.Msgs) != 0
 	case "regen.group.v1alpha1.MsgCreateProposal.exec":
 		return x.Exec != 0
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		return x.ExecPredicate != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateProposal"))
@@ -7807,6 +9960,8 @@ func (x *fastReflection_MsgCreateProposal) Clear(fd protoreflect.FieldDescriptor
 		x.Msgs = nil
 	case "regen.group.v1alpha1.MsgCreateProposal.exec":
 		x.Exec = 0
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		x.ExecPredicate = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateProposal"))
@@ -7844,6 +9999,9 @@ func (x *fastReflection_MsgCreateProposal) Get(descriptor protoreflect.FieldDesc
 	case "regen.group.v1alpha1.MsgCreateProposal.exec":
 		value := x.Exec
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		value := x.ExecPredicate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateProposal"))
@@ -7878,6 +10036,8 @@ func (x *fastReflection_MsgCreateProposal) Set(fd protoreflect.FieldDescriptor,
 		x.Msgs = *clv.list
 	case "regen.group.v1alpha1.MsgCreateProposal.exec":
 		x.Exec = (Exec)(value.Enum())
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		x.ExecPredicate = value.Message().Interface().(*ExecPredicate)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateProposal"))
@@ -7910,6 +10070,11 @@ func (x *fastReflection_MsgCreateProposal) Mutable(fd protoreflect.FieldDescript
 		}
 		value := &_MsgCreateProposal_4_list{list: &x.Msgs}
 		return protoreflect.ValueOfList(value)
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		if x.ExecPredicate == nil {
+			x.ExecPredicate = new(ExecPredicate)
+		}
+		return protoreflect.ValueOfMessage(x.ExecPredicate.ProtoReflect())
 	case "regen.group.v1alpha1.MsgCreateProposal.address":
 		panic(fmt.Errorf("field address of message regen.group.v1alpha1.MsgCreateProposal is not mutable"))
 	case "regen.group.v1alpha1.MsgCreateProposal.metadata":
@@ -7941,6 +10106,9 @@ func (x *fastReflection_MsgCreateProposal) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfList(&_MsgCreateProposal_4_list{list: &list})
 	case "regen.group.v1alpha1.MsgCreateProposal.exec":
 		return protoreflect.ValueOfEnum(0)
+	case "regen.group.v1alpha1.MsgCreateProposal.exec_predicate":
+		m := new(ExecPredicate)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.group.v1alpha1.MsgCreateProposal"))
@@ -8033,6 +10201,10 @@ func (x *fastReflection_MsgCreateProposal) ProtoMethods() *protoiface.Methods {
 		if x.Exec != 0 {
 			n += 1 + runtime.Sov(uint64(x.Exec))
 		}
+		if x.ExecPredicate != nil {
+			l = options.Size(x.ExecPredicate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8062,6 +10234,20 @@ func (x *fastReflection_MsgCreateProposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ExecPredicate != nil {
+			encoded, err := options.Marshal(x.ExecPredicate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.Exec != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
 			i--
@@ -8306,6 +10492,42 @@ func (x *fastReflection_MsgCreateProposal) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecPredicate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecPredicate == nil {
+					x.ExecPredicate = &ExecPredicate{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecPredicate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8361,7 +10583,7 @@ func (x *MsgCreateProposalResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgCreateProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[17]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8773,7 +10995,7 @@ func (x *MsgVote) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgVote) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[18]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9393,7 +11615,7 @@ func (x *MsgVoteResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgVoteResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[19]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9753,7 +11975,7 @@ func (x *MsgExec) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[20]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10217,7 +12439,7 @@ func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[21]
+	mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10991,72 +13213,194 @@ func (x *MsgCreateGroupAccount) String() string {
 
 func (*MsgCreateGroupAccount) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateGroupAccount.ProtoReflect.Descriptor instead.
-func (*MsgCreateGroupAccount) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgCreateGroupAccount.ProtoReflect.Descriptor instead.
+func (*MsgCreateGroupAccount) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgCreateGroupAccount) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *MsgCreateGroupAccount) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MsgCreateGroupAccount) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MsgCreateGroupAccount) GetDecisionPolicy() *anypb.Any {
+	if x != nil {
+		return x.DecisionPolicy
+	}
+	return nil
+}
+
+// MsgCreateGroupAccountResponse is the Msg/CreateGroupAccount response type.
+type MsgCreateGroupAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the account address of the newly created group account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *MsgCreateGroupAccountResponse) Reset() {
+	*x = MsgCreateGroupAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateGroupAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateGroupAccountResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateGroupAccountResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateGroupAccountResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgCreateGroupAccountResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// MsgCreateGroupWithPolicy is the Msg/CreateGroupWithPolicy request type.
+type MsgCreateGroupWithPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the account address of the group and group account admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// members defines the group members.
+	Members []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// group_metadata is any arbitrary metadata to attached to the group.
+	GroupMetadata []byte `protobuf:"bytes,3,opt,name=group_metadata,json=groupMetadata,proto3" json:"group_metadata,omitempty"`
+	// group_account_metadata is any arbitrary metadata to attached to the group
+	// account.
+	GroupAccountMetadata []byte `protobuf:"bytes,4,opt,name=group_account_metadata,json=groupAccountMetadata,proto3" json:"group_account_metadata,omitempty"`
+	// decision_policy specifies the group account's decision policy.
+	DecisionPolicy *anypb.Any `protobuf:"bytes,5,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (x *MsgCreateGroupWithPolicy) Reset() {
+	*x = MsgCreateGroupWithPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateGroupWithPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateGroupWithPolicy) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateGroupWithPolicy.ProtoReflect.Descriptor instead.
+func (*MsgCreateGroupWithPolicy) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgCreateGroupAccount) GetAdmin() string {
+func (x *MsgCreateGroupWithPolicy) GetAdmin() string {
 	if x != nil {
 		return x.Admin
 	}
 	return ""
 }
 
-func (x *MsgCreateGroupAccount) GetGroupId() uint64 {
+func (x *MsgCreateGroupWithPolicy) GetMembers() []*Member {
 	if x != nil {
-		return x.GroupId
+		return x.Members
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgCreateGroupAccount) GetMetadata() []byte {
+func (x *MsgCreateGroupWithPolicy) GetGroupMetadata() []byte {
 	if x != nil {
-		return x.Metadata
+		return x.GroupMetadata
 	}
 	return nil
 }
 
-func (x *MsgCreateGroupAccount) GetDecisionPolicy() *anypb.Any {
+func (x *MsgCreateGroupWithPolicy) GetGroupAccountMetadata() []byte {
+	if x != nil {
+		return x.GroupAccountMetadata
+	}
+	return nil
+}
+
+func (x *MsgCreateGroupWithPolicy) GetDecisionPolicy() *anypb.Any {
 	if x != nil {
 		return x.DecisionPolicy
 	}
 	return nil
 }
 
-// MsgCreateGroupAccountResponse is the Msg/CreateGroupAccount response type.
-type MsgCreateGroupAccountResponse struct {
+// MsgCreateGroupWithPolicyResponse is the Msg/CreateGroupWithPolicy response
+// type.
+type MsgCreateGroupWithPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the account address of the newly created group account.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the unique ID of the newly created group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// group_account_address is the account address of the newly created group
+	// account.
+	GroupAccountAddress string `protobuf:"bytes,2,opt,name=group_account_address,json=groupAccountAddress,proto3" json:"group_account_address,omitempty"`
 }
 
-func (x *MsgCreateGroupAccountResponse) Reset() {
-	*x = MsgCreateGroupAccountResponse{}
+func (x *MsgCreateGroupWithPolicyResponse) Reset() {
+	*x = MsgCreateGroupWithPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[9]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateGroupAccountResponse) String() string {
+func (x *MsgCreateGroupWithPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateGroupAccountResponse) ProtoMessage() {}
+func (*MsgCreateGroupWithPolicyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateGroupAccountResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateGroupAccountResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgCreateGroupWithPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateGroupWithPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MsgCreateGroupAccountResponse) GetAddress() string {
+func (x *MsgCreateGroupWithPolicyResponse) GetGroupId() uint64 {
 	if x != nil {
-		return x.Address
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MsgCreateGroupWithPolicyResponse) GetGroupAccountAddress() string {
+	if x != nil {
+		return x.GroupAccountAddress
 	}
 	return ""
 }
@@ -11078,7 +13422,7 @@ type MsgUpdateGroupAccountAdmin struct {
 func (x *MsgUpdateGroupAccountAdmin) Reset() {
 	*x = MsgUpdateGroupAccountAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[10]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11092,7 +13436,7 @@ func (*MsgUpdateGroupAccountAdmin) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountAdmin.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountAdmin) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{10}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MsgUpdateGroupAccountAdmin) GetAdmin() string {
@@ -11127,7 +13471,7 @@ type MsgUpdateGroupAccountAdminResponse struct {
 func (x *MsgUpdateGroupAccountAdminResponse) Reset() {
 	*x = MsgUpdateGroupAccountAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[11]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11141,7 +13485,7 @@ func (*MsgUpdateGroupAccountAdminResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountAdminResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountAdminResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{11}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{13}
 }
 
 // MsgUpdateGroupAccountDecisionPolicy is the
@@ -11162,7 +13506,7 @@ type MsgUpdateGroupAccountDecisionPolicy struct {
 func (x *MsgUpdateGroupAccountDecisionPolicy) Reset() {
 	*x = MsgUpdateGroupAccountDecisionPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[12]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11176,7 +13520,7 @@ func (*MsgUpdateGroupAccountDecisionPolicy) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountDecisionPolicy.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountDecisionPolicy) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{12}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MsgUpdateGroupAccountDecisionPolicy) GetAdmin() string {
@@ -11211,7 +13555,7 @@ type MsgUpdateGroupAccountDecisionPolicyResponse struct {
 func (x *MsgUpdateGroupAccountDecisionPolicyResponse) Reset() {
 	*x = MsgUpdateGroupAccountDecisionPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[13]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11225,7 +13569,7 @@ func (*MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountDecisionPolicyResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountDecisionPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{13}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{15}
 }
 
 // MsgUpdateGroupAccountMetadata is the Msg/UpdateGroupAccountMetadata request
@@ -11246,7 +13590,7 @@ type MsgUpdateGroupAccountMetadata struct {
 func (x *MsgUpdateGroupAccountMetadata) Reset() {
 	*x = MsgUpdateGroupAccountMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[14]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11260,7 +13604,7 @@ func (*MsgUpdateGroupAccountMetadata) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountMetadata.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountMetadata) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{14}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgUpdateGroupAccountMetadata) GetAdmin() string {
@@ -11295,7 +13639,7 @@ type MsgUpdateGroupAccountMetadataResponse struct {
 func (x *MsgUpdateGroupAccountMetadataResponse) Reset() {
 	*x = MsgUpdateGroupAccountMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[15]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11309,7 +13653,91 @@ func (*MsgUpdateGroupAccountMetadataResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateGroupAccountMetadataResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateGroupAccountMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{15}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+// MsgUpdateGroupAccountDecisionPolicyMetadata is the
+// Msg/UpdateGroupAccountDecisionPolicyMetadata request type.
+type MsgUpdateGroupAccountDecisionPolicyMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address is the group account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is the updated decision policy metadata.
+	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) Reset() {
+	*x = MsgUpdateGroupAccountDecisionPolicyMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupAccountDecisionPolicyMetadata) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupAccountDecisionPolicyMetadata.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupAccountDecisionPolicyMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadata) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MsgUpdateGroupAccountDecisionPolicyMetadataResponse is the
+// Msg/UpdateGroupAccountDecisionPolicyMetadata response type.
+type MsgUpdateGroupAccountDecisionPolicyMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Reset() {
+	*x = MsgUpdateGroupAccountDecisionPolicyMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupAccountDecisionPolicyMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 // MsgCreateProposal is the Msg/CreateProposal request type.
@@ -11331,12 +13759,15 @@ type MsgCreateProposal struct {
 	// whether it should be executed immediately on creation or not.
 	// If so, proposers signatures are considered as Yes votes.
 	Exec Exec `protobuf:"varint,5,opt,name=exec,proto3,enum=regen.group.v1alpha1.Exec" json:"exec,omitempty"`
+	// exec_predicate is an optional condition that must hold at execution time
+	// for the proposal msgs to be executed.
+	ExecPredicate *ExecPredicate `protobuf:"bytes,6,opt,name=exec_predicate,json=execPredicate,proto3" json:"exec_predicate,omitempty"`
 }
 
 func (x *MsgCreateProposal) Reset() {
 	*x = MsgCreateProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[16]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11350,7 +13781,7 @@ func (*MsgCreateProposal) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateProposal.ProtoReflect.Descriptor instead.
 func (*MsgCreateProposal) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{16}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgCreateProposal) GetAddress() string {
@@ -11388,6 +13819,13 @@ func (x *MsgCreateProposal) GetExec() Exec {
 	return Exec_EXEC_UNSPECIFIED
 }
 
+func (x *MsgCreateProposal) GetExecPredicate() *ExecPredicate {
+	if x != nil {
+		return x.ExecPredicate
+	}
+	return nil
+}
+
 // MsgCreateProposalResponse is the Msg/CreateProposal response type.
 type MsgCreateProposalResponse struct {
 	state         protoimpl.MessageState
@@ -11401,7 +13839,7 @@ type MsgCreateProposalResponse struct {
 func (x *MsgCreateProposalResponse) Reset() {
 	*x = MsgCreateProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[17]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11415,7 +13853,7 @@ func (*MsgCreateProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgCreateProposalResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{17}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgCreateProposalResponse) GetProposalId() uint64 {
@@ -11447,7 +13885,7 @@ type MsgVote struct {
 func (x *MsgVote) Reset() {
 	*x = MsgVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[18]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11461,7 +13899,7 @@ func (*MsgVote) ProtoMessage() {}
 
 // Deprecated: Use MsgVote.ProtoReflect.Descriptor instead.
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{18}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MsgVote) GetProposalId() uint64 {
@@ -11509,7 +13947,7 @@ type MsgVoteResponse struct {
 func (x *MsgVoteResponse) Reset() {
 	*x = MsgVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[19]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11523,7 +13961,7 @@ func (*MsgVoteResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgVoteResponse.ProtoReflect.Descriptor instead.
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{19}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{23}
 }
 
 // MsgExec is the Msg/Exec request type.
@@ -11541,7 +13979,7 @@ type MsgExec struct {
 func (x *MsgExec) Reset() {
 	*x = MsgExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[20]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11555,7 +13993,7 @@ func (*MsgExec) ProtoMessage() {}
 
 // Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{20}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MsgExec) GetProposalId() uint64 {
@@ -11582,7 +14020,7 @@ type MsgExecResponse struct {
 func (x *MsgExecResponse) Reset() {
 	*x = MsgExecResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[21]
+		mi := &file_regen_group_v1alpha1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11596,7 +14034,7 @@ func (*MsgExecResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgExecResponse.ProtoReflect.Descriptor instead.
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{21}
+	return file_regen_group_v1alpha1_tx_proto_rawDescGZIP(), []int{25}
 }
 
 var File_regen_group_v1alpha1_tx_proto protoreflect.FileDescriptor
@@ -11667,173 +14105,235 @@ var file_regen_group_v1alpha1_tx_proto_rawDesc = []byte{
 	0x39, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
 	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a, 0x1a, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x23,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x2d, 0x0a, 0x2b,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x1d, 0x4d,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa4, 0x02, 0x0a, 0x18, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x3c, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0x88, 0xa0, 0x1f,
+	0x00, 0x22, 0x71, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22,
+	0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a,
+	0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4,
+	0x2d, 0x0e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x2d, 0x0a, 0x2b, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x27, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x2b, 0x4d,
 	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xc7, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x6d,
-	0x73, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52,
-	0x04, 0x65, 0x78, 0x65, 0x63, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x3c, 0x0a, 0x19, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x4d, 0x73,
-	0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x06,
-	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e,
-	0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x22, 0x11,
-	0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x42, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
-	0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x54,
-	0x52, 0x59, 0x10, 0x01, 0x32, 0x89, 0x0a, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x61, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x76, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x31, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x34,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x33, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x02,
+	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65,
+	0x63, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0d,
+	0x65, 0x78, 0x65, 0x63, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x22, 0x3c, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x07, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x11, 0x0a,
+	0x0f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x54, 0x52, 0x59, 0x10, 0x01, 0x32, 0xc5, 0x0c, 0x0a,
+	0x03, 0x4d, 0x73, 0x67, 0x12, 0x61, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x31,
 	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x79, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
 	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x38, 0x2e, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x2e, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x1a, 0x41, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x34, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x36, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x30, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x1a, 0x38, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x39, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x41, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x8e, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x2e, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x72,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x25, 0x2e, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1d, 0x2e, 0x72, 0x65, 0x67,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0xb8, 0x01, 0x0a, 0x28, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x41,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x49, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x27,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x1d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x1a,
+	0x25, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1d,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x25, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0xe3, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67,
 	0x65, 0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0xe3, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x47, 0x58, 0xaa, 0x02, 0x14, 0x52,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xca, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x52, 0x65, 0x67,
-	0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16,
-	0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x47, 0x58,
+	0xaa, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02,
+	0x20, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x16, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -11849,71 +14349,83 @@ func file_regen_group_v1alpha1_tx_proto_rawDescGZIP() []byte {
 }
 
 var file_regen_group_v1alpha1_tx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_regen_group_v1alpha1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_regen_group_v1alpha1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_regen_group_v1alpha1_tx_proto_goTypes = []interface{}{
-	(Exec)(0),                                           // 0: regen.group.v1alpha1.Exec
-	(*MsgCreateGroup)(nil),                              // 1: regen.group.v1alpha1.MsgCreateGroup
-	(*MsgCreateGroupResponse)(nil),                      // 2: regen.group.v1alpha1.MsgCreateGroupResponse
-	(*MsgUpdateGroupMembers)(nil),                       // 3: regen.group.v1alpha1.MsgUpdateGroupMembers
-	(*MsgUpdateGroupMembersResponse)(nil),               // 4: regen.group.v1alpha1.MsgUpdateGroupMembersResponse
-	(*MsgUpdateGroupAdmin)(nil),                         // 5: regen.group.v1alpha1.MsgUpdateGroupAdmin
-	(*MsgUpdateGroupAdminResponse)(nil),                 // 6: regen.group.v1alpha1.MsgUpdateGroupAdminResponse
-	(*MsgUpdateGroupMetadata)(nil),                      // 7: regen.group.v1alpha1.MsgUpdateGroupMetadata
-	(*MsgUpdateGroupMetadataResponse)(nil),              // 8: regen.group.v1alpha1.MsgUpdateGroupMetadataResponse
-	(*MsgCreateGroupAccount)(nil),                       // 9: regen.group.v1alpha1.MsgCreateGroupAccount
-	(*MsgCreateGroupAccountResponse)(nil),               // 10: regen.group.v1alpha1.MsgCreateGroupAccountResponse
-	(*MsgUpdateGroupAccountAdmin)(nil),                  // 11: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin
-	(*MsgUpdateGroupAccountAdminResponse)(nil),          // 12: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse
-	(*MsgUpdateGroupAccountDecisionPolicy)(nil),         // 13: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy
-	(*MsgUpdateGroupAccountDecisionPolicyResponse)(nil), // 14: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse
-	(*MsgUpdateGroupAccountMetadata)(nil),               // 15: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata
-	(*MsgUpdateGroupAccountMetadataResponse)(nil),       // 16: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse
-	(*MsgCreateProposal)(nil),                           // 17: regen.group.v1alpha1.MsgCreateProposal
-	(*MsgCreateProposalResponse)(nil),                   // 18: regen.group.v1alpha1.MsgCreateProposalResponse
-	(*MsgVote)(nil),                                     // 19: regen.group.v1alpha1.MsgVote
-	(*MsgVoteResponse)(nil),                             // 20: regen.group.v1alpha1.MsgVoteResponse
-	(*MsgExec)(nil),                                     // 21: regen.group.v1alpha1.MsgExec
-	(*MsgExecResponse)(nil),                             // 22: regen.group.v1alpha1.MsgExecResponse
-	(*Member)(nil),                                      // 23: regen.group.v1alpha1.Member
-	(*anypb.Any)(nil),                                   // 24: google.protobuf.Any
-	(Choice)(0),                                         // 25: regen.group.v1alpha1.Choice
+	(Exec)(0),                                                   // 0: regen.group.v1alpha1.Exec
+	(*MsgCreateGroup)(nil),                                      // 1: regen.group.v1alpha1.MsgCreateGroup
+	(*MsgCreateGroupResponse)(nil),                              // 2: regen.group.v1alpha1.MsgCreateGroupResponse
+	(*MsgUpdateGroupMembers)(nil),                               // 3: regen.group.v1alpha1.MsgUpdateGroupMembers
+	(*MsgUpdateGroupMembersResponse)(nil),                       // 4: regen.group.v1alpha1.MsgUpdateGroupMembersResponse
+	(*MsgUpdateGroupAdmin)(nil),                                 // 5: regen.group.v1alpha1.MsgUpdateGroupAdmin
+	(*MsgUpdateGroupAdminResponse)(nil),                         // 6: regen.group.v1alpha1.MsgUpdateGroupAdminResponse
+	(*MsgUpdateGroupMetadata)(nil),                              // 7: regen.group.v1alpha1.MsgUpdateGroupMetadata
+	(*MsgUpdateGroupMetadataResponse)(nil),                      // 8: regen.group.v1alpha1.MsgUpdateGroupMetadataResponse
+	(*MsgCreateGroupAccount)(nil),                               // 9: regen.group.v1alpha1.MsgCreateGroupAccount
+	(*MsgCreateGroupAccountResponse)(nil),                       // 10: regen.group.v1alpha1.MsgCreateGroupAccountResponse
+	(*MsgCreateGroupWithPolicy)(nil),                            // 11: regen.group.v1alpha1.MsgCreateGroupWithPolicy
+	(*MsgCreateGroupWithPolicyResponse)(nil),                    // 12: regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse
+	(*MsgUpdateGroupAccountAdmin)(nil),                          // 13: regen.group.v1alpha1.MsgUpdateGroupAccountAdmin
+	(*MsgUpdateGroupAccountAdminResponse)(nil),                  // 14: regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse
+	(*MsgUpdateGroupAccountDecisionPolicy)(nil),                 // 15: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy
+	(*MsgUpdateGroupAccountDecisionPolicyResponse)(nil),         // 16: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse
+	(*MsgUpdateGroupAccountMetadata)(nil),                       // 17: regen.group.v1alpha1.MsgUpdateGroupAccountMetadata
+	(*MsgUpdateGroupAccountMetadataResponse)(nil),               // 18: regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse
+	(*MsgUpdateGroupAccountDecisionPolicyMetadata)(nil),         // 19: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata
+	(*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(nil), // 20: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse
+	(*MsgCreateProposal)(nil),                                   // 21: regen.group.v1alpha1.MsgCreateProposal
+	(*MsgCreateProposalResponse)(nil),                           // 22: regen.group.v1alpha1.MsgCreateProposalResponse
+	(*MsgVote)(nil),                                             // 23: regen.group.v1alpha1.MsgVote
+	(*MsgVoteResponse)(nil),                                     // 24: regen.group.v1alpha1.MsgVoteResponse
+	(*MsgExec)(nil),                                             // 25: regen.group.v1alpha1.MsgExec
+	(*MsgExecResponse)(nil),                                     // 26: regen.group.v1alpha1.MsgExecResponse
+	(*Member)(nil),                                              // 27: regen.group.v1alpha1.Member
+	(*anypb.Any)(nil),                                           // 28: google.protobuf.Any
+	(*ExecPredicate)(nil),                                       // 29: regen.group.v1alpha1.ExecPredicate
+	(Choice)(0),                                                 // 30: regen.group.v1alpha1.Choice
 }
 var file_regen_group_v1alpha1_tx_proto_depIdxs = []int32{
-	23, // 0: regen.group.v1alpha1.MsgCreateGroup.members:type_name -> regen.group.v1alpha1.Member
-	23, // 1: regen.group.v1alpha1.MsgUpdateGroupMembers.member_updates:type_name -> regen.group.v1alpha1.Member
-	24, // 2: regen.group.v1alpha1.MsgCreateGroupAccount.decision_policy:type_name -> google.protobuf.Any
-	24, // 3: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
-	24, // 4: regen.group.v1alpha1.MsgCreateProposal.msgs:type_name -> google.protobuf.Any
-	0,  // 5: regen.group.v1alpha1.MsgCreateProposal.exec:type_name -> regen.group.v1alpha1.Exec
-	25, // 6: regen.group.v1alpha1.MsgVote.choice:type_name -> regen.group.v1alpha1.Choice
-	0,  // 7: regen.group.v1alpha1.MsgVote.exec:type_name -> regen.group.v1alpha1.Exec
-	1,  // 8: regen.group.v1alpha1.Msg.CreateGroup:input_type -> regen.group.v1alpha1.MsgCreateGroup
-	3,  // 9: regen.group.v1alpha1.Msg.UpdateGroupMembers:input_type -> regen.group.v1alpha1.MsgUpdateGroupMembers
-	5,  // 10: regen.group.v1alpha1.Msg.UpdateGroupAdmin:input_type -> regen.group.v1alpha1.MsgUpdateGroupAdmin
-	7,  // 11: regen.group.v1alpha1.Msg.UpdateGroupMetadata:input_type -> regen.group.v1alpha1.MsgUpdateGroupMetadata
-	9,  // 12: regen.group.v1alpha1.Msg.CreateGroupAccount:input_type -> regen.group.v1alpha1.MsgCreateGroupAccount
-	11, // 13: regen.group.v1alpha1.Msg.UpdateGroupAccountAdmin:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountAdmin
-	13, // 14: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicy:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy
-	15, // 15: regen.group.v1alpha1.Msg.UpdateGroupAccountMetadata:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountMetadata
-	17, // 16: regen.group.v1alpha1.Msg.CreateProposal:input_type -> regen.group.v1alpha1.MsgCreateProposal
-	19, // 17: regen.group.v1alpha1.Msg.Vote:input_type -> regen.group.v1alpha1.MsgVote
-	21, // 18: regen.group.v1alpha1.Msg.Exec:input_type -> regen.group.v1alpha1.MsgExec
-	2,  // 19: regen.group.v1alpha1.Msg.CreateGroup:output_type -> regen.group.v1alpha1.MsgCreateGroupResponse
-	4,  // 20: regen.group.v1alpha1.Msg.UpdateGroupMembers:output_type -> regen.group.v1alpha1.MsgUpdateGroupMembersResponse
-	6,  // 21: regen.group.v1alpha1.Msg.UpdateGroupAdmin:output_type -> regen.group.v1alpha1.MsgUpdateGroupAdminResponse
-	8,  // 22: regen.group.v1alpha1.Msg.UpdateGroupMetadata:output_type -> regen.group.v1alpha1.MsgUpdateGroupMetadataResponse
-	10, // 23: regen.group.v1alpha1.Msg.CreateGroupAccount:output_type -> regen.group.v1alpha1.MsgCreateGroupAccountResponse
-	12, // 24: regen.group.v1alpha1.Msg.UpdateGroupAccountAdmin:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse
-	14, // 25: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicy:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse
-	16, // 26: regen.group.v1alpha1.Msg.UpdateGroupAccountMetadata:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse
-	18, // 27: regen.group.v1alpha1.Msg.CreateProposal:output_type -> regen.group.v1alpha1.MsgCreateProposalResponse
-	20, // 28: regen.group.v1alpha1.Msg.Vote:output_type -> regen.group.v1alpha1.MsgVoteResponse
-	22, // 29: regen.group.v1alpha1.Msg.Exec:output_type -> regen.group.v1alpha1.MsgExecResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	27, // 0: regen.group.v1alpha1.MsgCreateGroup.members:type_name -> regen.group.v1alpha1.Member
+	27, // 1: regen.group.v1alpha1.MsgUpdateGroupMembers.member_updates:type_name -> regen.group.v1alpha1.Member
+	28, // 2: regen.group.v1alpha1.MsgCreateGroupAccount.decision_policy:type_name -> google.protobuf.Any
+	27, // 3: regen.group.v1alpha1.MsgCreateGroupWithPolicy.members:type_name -> regen.group.v1alpha1.Member
+	28, // 4: regen.group.v1alpha1.MsgCreateGroupWithPolicy.decision_policy:type_name -> google.protobuf.Any
+	28, // 5: regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
+	28, // 6: regen.group.v1alpha1.MsgCreateProposal.msgs:type_name -> google.protobuf.Any
+	0,  // 7: regen.group.v1alpha1.MsgCreateProposal.exec:type_name -> regen.group.v1alpha1.Exec
+	29, // 8: regen.group.v1alpha1.MsgCreateProposal.exec_predicate:type_name -> regen.group.v1alpha1.ExecPredicate
+	30, // 9: regen.group.v1alpha1.MsgVote.choice:type_name -> regen.group.v1alpha1.Choice
+	0,  // 10: regen.group.v1alpha1.MsgVote.exec:type_name -> regen.group.v1alpha1.Exec
+	1,  // 11: regen.group.v1alpha1.Msg.CreateGroup:input_type -> regen.group.v1alpha1.MsgCreateGroup
+	3,  // 12: regen.group.v1alpha1.Msg.UpdateGroupMembers:input_type -> regen.group.v1alpha1.MsgUpdateGroupMembers
+	5,  // 13: regen.group.v1alpha1.Msg.UpdateGroupAdmin:input_type -> regen.group.v1alpha1.MsgUpdateGroupAdmin
+	7,  // 14: regen.group.v1alpha1.Msg.UpdateGroupMetadata:input_type -> regen.group.v1alpha1.MsgUpdateGroupMetadata
+	9,  // 15: regen.group.v1alpha1.Msg.CreateGroupAccount:input_type -> regen.group.v1alpha1.MsgCreateGroupAccount
+	11, // 16: regen.group.v1alpha1.Msg.CreateGroupWithPolicy:input_type -> regen.group.v1alpha1.MsgCreateGroupWithPolicy
+	13, // 17: regen.group.v1alpha1.Msg.UpdateGroupAccountAdmin:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountAdmin
+	15, // 18: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicy:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy
+	17, // 19: regen.group.v1alpha1.Msg.UpdateGroupAccountMetadata:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountMetadata
+	19, // 20: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicyMetadata:input_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata
+	21, // 21: regen.group.v1alpha1.Msg.CreateProposal:input_type -> regen.group.v1alpha1.MsgCreateProposal
+	23, // 22: regen.group.v1alpha1.Msg.Vote:input_type -> regen.group.v1alpha1.MsgVote
+	25, // 23: regen.group.v1alpha1.Msg.Exec:input_type -> regen.group.v1alpha1.MsgExec
+	2,  // 24: regen.group.v1alpha1.Msg.CreateGroup:output_type -> regen.group.v1alpha1.MsgCreateGroupResponse
+	4,  // 25: regen.group.v1alpha1.Msg.UpdateGroupMembers:output_type -> regen.group.v1alpha1.MsgUpdateGroupMembersResponse
+	6,  // 26: regen.group.v1alpha1.Msg.UpdateGroupAdmin:output_type -> regen.group.v1alpha1.MsgUpdateGroupAdminResponse
+	8,  // 27: regen.group.v1alpha1.Msg.UpdateGroupMetadata:output_type -> regen.group.v1alpha1.MsgUpdateGroupMetadataResponse
+	10, // 28: regen.group.v1alpha1.Msg.CreateGroupAccount:output_type -> regen.group.v1alpha1.MsgCreateGroupAccountResponse
+	12, // 29: regen.group.v1alpha1.Msg.CreateGroupWithPolicy:output_type -> regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse
+	14, // 30: regen.group.v1alpha1.Msg.UpdateGroupAccountAdmin:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse
+	16, // 31: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicy:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse
+	18, // 32: regen.group.v1alpha1.Msg.UpdateGroupAccountMetadata:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse
+	20, // 33: regen.group.v1alpha1.Msg.UpdateGroupAccountDecisionPolicyMetadata:output_type -> regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse
+	22, // 34: regen.group.v1alpha1.Msg.CreateProposal:output_type -> regen.group.v1alpha1.MsgCreateProposalResponse
+	24, // 35: regen.group.v1alpha1.Msg.Vote:output_type -> regen.group.v1alpha1.MsgVoteResponse
+	26, // 36: regen.group.v1alpha1.Msg.Exec:output_type -> regen.group.v1alpha1.MsgExecResponse
+	24, // [24:37] is the sub-list for method output_type
+	11, // [11:24] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_regen_group_v1alpha1_tx_proto_init() }
@@ -12044,7 +14556,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountAdmin); i {
+			switch v := v.(*MsgCreateGroupWithPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12056,7 +14568,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountAdminResponse); i {
+			switch v := v.(*MsgCreateGroupWithPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12068,7 +14580,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountDecisionPolicy); i {
+			switch v := v.(*MsgUpdateGroupAccountAdmin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12080,7 +14592,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountDecisionPolicyResponse); i {
+			switch v := v.(*MsgUpdateGroupAccountAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12092,7 +14604,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountMetadata); i {
+			switch v := v.(*MsgUpdateGroupAccountDecisionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12104,7 +14616,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateGroupAccountMetadataResponse); i {
+			switch v := v.(*MsgUpdateGroupAccountDecisionPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12116,7 +14628,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateProposal); i {
+			switch v := v.(*MsgUpdateGroupAccountMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12128,7 +14640,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateProposalResponse); i {
+			switch v := v.(*MsgUpdateGroupAccountMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12140,7 +14652,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVote); i {
+			switch v := v.(*MsgUpdateGroupAccountDecisionPolicyMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12152,7 +14664,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVoteResponse); i {
+			switch v := v.(*MsgUpdateGroupAccountDecisionPolicyMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12164,7 +14676,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExec); i {
+			switch v := v.(*MsgCreateProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12176,6 +14688,54 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			}
 		}
 		file_regen_group_v1alpha1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_group_v1alpha1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgExecResponse); i {
 			case 0:
 				return &v.state
@@ -12194,7 +14754,7 @@ func file_regen_group_v1alpha1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_group_v1alpha1_tx_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   22,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,9 @@ type MsgClient interface {
 	UpdateGroupMetadata(ctx context.Context, in *MsgUpdateGroupMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupMetadataResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccount, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error)
+	// CreateGroupWithPolicy creates a new group with a group account using the
+	// given DecisionPolicy in a single transaction.
+	CreateGroupWithPolicy(ctx context.Context, in *MsgCreateGroupWithPolicy, opts ...grpc.CallOption) (*MsgCreateGroupWithPolicyResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
 	UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error)
 	// UpdateGroupAccountDecisionPolicy allows a group account decision policy to
@@ -43,6 +46,9 @@ type MsgClient interface {
 	UpdateGroupAccountDecisionPolicy(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdateGroupAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// UpdateGroupAccountDecisionPolicyMetadata updates the metadata of a group
+	// account decision policy.
+	UpdateGroupAccountDecisionPolicyMetadata(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(ctx context.Context, in *MsgCreateProposal, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -104,6 +110,15 @@ func (c *msgClient) CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAc
 	return out, nil
 }
 
+func (c *msgClient) CreateGroupWithPolicy(ctx context.Context, in *MsgCreateGroupWithPolicy, opts ...grpc.CallOption) (*MsgCreateGroupWithPolicyResponse, error) {
+	out := new(MsgCreateGroupWithPolicyResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CreateGroupWithPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error) {
 	out := new(MsgUpdateGroupAccountAdminResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/UpdateGroupAccountAdmin", in, out, opts...)
@@ -131,6 +146,15 @@ func (c *msgClient) UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdat
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupAccountDecisionPolicyMetadata(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error) {
+	out := new(MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/UpdateGroupAccountDecisionPolicyMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateProposal(ctx context.Context, in *MsgCreateProposal, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error) {
 	out := new(MsgCreateProposalResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CreateProposal", in, out, opts...)
@@ -176,6 +200,9 @@ type MsgServer interface {
 	UpdateGroupMetadata(context.Context, *MsgUpdateGroupMetadata) (*MsgUpdateGroupMetadataResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(context.Context, *MsgCreateGroupAccount) (*MsgCreateGroupAccountResponse, error)
+	// CreateGroupWithPolicy creates a new group with a group account using the
+	// given DecisionPolicy in a single transaction.
+	CreateGroupWithPolicy(context.Context, *MsgCreateGroupWithPolicy) (*MsgCreateGroupWithPolicyResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
 	UpdateGroupAccountAdmin(context.Context, *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error)
 	// UpdateGroupAccountDecisionPolicy allows a group account decision policy to
@@ -183,6 +210,9 @@ type MsgServer interface {
 	UpdateGroupAccountDecisionPolicy(context.Context, *MsgUpdateGroupAccountDecisionPolicy) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(context.Context, *MsgUpdateGroupAccountMetadata) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// UpdateGroupAccountDecisionPolicyMetadata updates the metadata of a group
+	// account decision policy.
+	UpdateGroupAccountDecisionPolicyMetadata(context.Context, *MsgUpdateGroupAccountDecisionPolicyMetadata) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(context.Context, *MsgCreateProposal) (*MsgCreateProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -211,6 +241,9 @@ func (UnimplementedMsgServer) UpdateGroupMetadata(context.Context, *MsgUpdateGro
 func (UnimplementedMsgServer) CreateGroupAccount(context.Context, *MsgCreateGroupAccount) (*MsgCreateGroupAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupAccount not implemented")
 }
+func (UnimplementedMsgServer) CreateGroupWithPolicy(context.Context, *MsgCreateGroupWithPolicy) (*MsgCreateGroupWithPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupWithPolicy not implemented")
+}
 func (UnimplementedMsgServer) UpdateGroupAccountAdmin(context.Context, *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountAdmin not implemented")
 }
@@ -220,6 +253,9 @@ func (UnimplementedMsgServer) UpdateGroupAccountDecisionPolicy(context.Context,
 func (UnimplementedMsgServer) UpdateGroupAccountMetadata(context.Context, *MsgUpdateGroupAccountMetadata) (*MsgUpdateGroupAccountMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateGroupAccountDecisionPolicyMetadata(context.Context, *MsgUpdateGroupAccountDecisionPolicyMetadata) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountDecisionPolicyMetadata not implemented")
+}
 func (UnimplementedMsgServer) CreateProposal(context.Context, *MsgCreateProposal) (*MsgCreateProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProposal not implemented")
 }
@@ -332,6 +368,24 @@ func _Msg_CreateGroupAccount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGroupWithPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroupWithPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroupWithPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/CreateGroupWithPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroupWithPolicy(ctx, req.(*MsgCreateGroupWithPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateGroupAccountAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateGroupAccountAdmin)
 	if err := dec(in); err != nil {
@@ -386,6 +440,24 @@ func _Msg_UpdateGroupAccountMetadata_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupAccountDecisionPolicyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAccountDecisionPolicyMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicyMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/UpdateGroupAccountDecisionPolicyMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicyMetadata(ctx, req.(*MsgUpdateGroupAccountDecisionPolicyMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateProposal)
 	if err := dec(in); err != nil {
@@ -467,6 +539,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGroupAccount",
 			Handler:    _Msg_CreateGroupAccount_Handler,
 		},
+		{
+			MethodName: "CreateGroupWithPolicy",
+			Handler:    _Msg_CreateGroupWithPolicy_Handler,
+		},
 		{
 			MethodName: "UpdateGroupAccountAdmin",
 			Handler:    _Msg_UpdateGroupAccountAdmin_Handler,
@@ -479,6 +555,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupAccountMetadata",
 			Handler:    _Msg_UpdateGroupAccountMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupAccountDecisionPolicyMetadata",
+			Handler:    _Msg_UpdateGroupAccountDecisionPolicyMetadata_Handler,
+		},
 		{
 			MethodName: "CreateProposal",
 			Handler:    _Msg_CreateProposal_Handler,
@@ -0,0 +1,73 @@
+//go:build experimental
+// +build experimental
+
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/x/group"
+	groupsim "github.com/regen-network/regen-ledger/x/group/simulation"
+)
+
+func TestGroupProposalLifecycleOperation(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	regenApp := NewRegenApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{}, emptyWasmOpts)
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(encCfg.Marshaler), "", " ")
+	require.NoError(t, err)
+	regenApp.InitChain(abci.RequestInitChain{
+		ChainId:       "regen-sim",
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+
+	header := tmproto.Header{Height: 1, ChainID: "regen-sim", Time: time.Unix(1640995200, 0)}
+	regenApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := regenApp.BaseApp.NewContext(false, header)
+
+	// fixed seed so that the generated members, weights and policies are deterministic
+	r := rand.New(rand.NewSource(1))
+	accounts := simtypes.RandomAccounts(r, 5)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000000))
+	for _, acc := range accounts {
+		regenApp.AccountKeeper.SetAccount(ctx, regenApp.AccountKeeper.NewAccountWithAddress(ctx, acc.Address))
+		require.NoError(t, regenApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, regenApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, acc.Address, coins))
+	}
+
+	queryHelper := &baseapp.QueryServiceTestHelper{GRPCQueryRouter: regenApp.GRPCQueryRouter(), Ctx: ctx}
+	queryClient := group.NewQueryClient(queryHelper)
+
+	op := groupsim.SimulateProposalLifecycle(regenApp.AccountKeeper, regenApp.BankKeeper, queryClient,
+		codec.NewProtoCodec(regenApp.InterfaceRegistry()))
+
+	for i := 0; i < 3; i++ {
+		opMsg, futureOps, err := op(r, regenApp.BaseApp, ctx, accounts, header.ChainID)
+		require.NoError(t, err)
+		require.True(t, opMsg.OK, opMsg.Comment)
+		require.Equal(t, groupsim.TypeMsgExec, opMsg.Name)
+		require.Len(t, futureOps, 0)
+	}
+
+	groupRes, err := queryClient.GroupInfo(ctx.Context(), &group.QueryGroupInfoRequest{GroupId: 3})
+	require.NoError(t, err)
+	require.NotNil(t, groupRes.Info)
+}
@@ -72,7 +72,7 @@ func NewNonNegativeFixedDecFromString(s string, max uint32) (Dec, error) {
 	if err != nil {
 		return Dec{}, err
 	}
-	if d.NumDecimalPlaces() > max {
+	if !IsWithinPrecision(d, max) {
 		return Dec{}, fmt.Errorf("%s exceeds maximum decimal places: %d", s, max)
 	}
 	return d, nil
@@ -94,7 +94,7 @@ func NewPositiveFixedDecFromString(s string, max uint32) (Dec, error) {
 	if err != nil {
 		return Dec{}, err
 	}
-	if d.NumDecimalPlaces() > max {
+	if !IsWithinPrecision(d, max) {
 		return Dec{}, fmt.Errorf("%s exceeds maximum decimal places: %d", s, max)
 	}
 	return d, nil
@@ -70,3 +70,44 @@ func SafeAddBalance(x Dec, y Dec) (Dec, error) {
 
 	return z, nil
 }
+
+// SafeMulBalance multiplies the values of x and y and returns the result with arbitrary precision.
+// Returns with ErrInvalidRequest error if either x or y is negative.
+func SafeMulBalance(x Dec, y Dec) (Dec, error) {
+	var z Dec
+
+	if x.IsNegative() || y.IsNegative() {
+		return z, errors.Wrap(
+			errors.ErrInvalidRequest,
+			fmt.Sprintf("MulBalance() requires two non-negative Dec parameters, but received %s and %s", x, y))
+	}
+
+	_, err := exactContext.Mul(&z.dec, &x.dec, &y.dec)
+	if err != nil {
+		return z, errors.Wrap(err, "decimal multiplication error")
+	}
+
+	return z, nil
+}
+
+// IsWithinPrecision returns true if x has no more decimal places than precision. Trailing zeros count as decimal
+// places, consistent with NewNonNegativeFixedDecFromString and NewPositiveFixedDecFromString.
+func IsWithinPrecision(x Dec, precision uint32) bool {
+	return x.NumDecimalPlaces() <= precision
+}
+
+// Truncate rounds x towards zero to the given number of decimal places. If x is already within precision or is not
+// finite, x is returned unchanged.
+func Truncate(x Dec, precision uint32) Dec {
+	if IsWithinPrecision(x, precision) || !x.IsFinite() {
+		return x
+	}
+
+	var z Dec
+	ctx := apd.BaseContext.WithPrecision(uint32(x.dec.NumDigits()))
+	ctx.Rounding = apd.RoundDown
+	if _, err := ctx.Quantize(&z.dec, &x.dec, -int32(precision)); err != nil {
+		return x
+	}
+	return z
+}
@@ -0,0 +1,99 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWithinPrecision(t *testing.T) {
+	tcs := []struct {
+		x         string
+		precision uint32
+		expected  bool
+	}{
+		{"1", 0, true},
+		{"1.5", 0, false},
+		{"1.5", 1, true},
+		{"1.123456", 6, true},
+		{"1.1234567", 6, false},
+		{"-1.123456", 6, true},
+		{"1.100", 1, false},
+		{"0.000001", 6, true},
+		{"0.0000001", 6, false},
+		{"1e3", 0, true},
+	}
+
+	for _, tc := range tcs {
+		x, err := NewDecFromString(tc.x)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, IsWithinPrecision(x, tc.precision), "%s with precision %d", tc.x, tc.precision)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tcs := []struct {
+		x         string
+		precision uint32
+		expected  string
+	}{
+		{"1", 0, "1"},
+		{"1.9", 0, "1"},
+		{"1.123456", 6, "1.123456"},
+		{"1.1234569", 6, "1.123456"},
+		{"1.9999999", 6, "1.999999"},
+		{"-1.9999999", 6, "-1.999999"},
+		{"0.0000009", 6, "0.000000"},
+		{"1.5", 6, "1.5"},
+		{"123456789012345678901234567890.123456789", 3, "123456789012345678901234567890.123"},
+	}
+
+	for _, tc := range tcs {
+		x, err := NewDecFromString(tc.x)
+		require.NoError(t, err)
+		y := Truncate(x, tc.precision)
+		require.Equal(t, tc.expected, y.String(), "%s with precision %d", tc.x, tc.precision)
+		require.True(t, IsWithinPrecision(y, tc.precision))
+	}
+
+	nan, err := NewDecFromString("NaN")
+	require.NoError(t, err)
+	require.False(t, Truncate(nan, 6).IsFinite())
+}
+
+func TestSafeMulBalance(t *testing.T) {
+	tcs := []struct {
+		name     string
+		x        string
+		y        string
+		expected string
+		expErr   string
+	}{
+		{"integers", "3", "4", "12", ""},
+		{"decimals", "1.5", "0.25", "0.375", ""},
+		{"exact precision", "123456789012345678901234567890.123456", "1000.000001", "123456789135802467913580246791358.023890123456", ""},
+		{"zero x", "0", "1.5", "0.0", ""},
+		{"zero y", "1.5", "0", "0.0", ""},
+		{"negative x", "-1", "1", "", "requires two non-negative Dec parameters"},
+		{"negative y", "1", "-1", "", "requires two non-negative Dec parameters"},
+		{"overflow", "1e99999", "1e99999", "", "decimal multiplication error"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			x, err := NewDecFromString(tc.x)
+			require.NoError(t, err)
+			y, err := NewDecFromString(tc.y)
+			require.NoError(t, err)
+
+			z, err := SafeMulBalance(x, y)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, z.String())
+		})
+	}
+}
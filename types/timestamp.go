@@ -8,8 +8,13 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/regen-network/regen-ledger/types/math"
 )
 
+// secondsPerYear is the number of seconds in a Julian year of 365.25 days.
+const secondsPerYear = 31557600
+
 // GogoToProtobufTimestamp converts a gogo timestamp to a protobuf timestamp.
 func GogoToProtobufTimestamp(ts *gogotypes.Timestamp) *timestamppb.Timestamp {
 	if ts == nil {
@@ -51,3 +56,34 @@ func ParseDate(field string, date string) (time.Time, error) {
 	}
 	return t, nil
 }
+
+// YearsBetweenTimestamps returns the number of fractional years, using a year
+// of 365.25 days, from one protobuf timestamp to another. The result is
+// negative if to is before from and zero if the timestamps are equal.
+func YearsBetweenTimestamps(from, to *timestamppb.Timestamp) (math.Dec, error) {
+	if err := from.CheckValid(); err != nil {
+		return math.Dec{}, errors.Wrap(err, "from")
+	}
+	if err := to.CheckValid(); err != nil {
+		return math.Dec{}, errors.Wrap(err, "to")
+	}
+
+	seconds := to.Seconds - from.Seconds
+	nanos := int64(to.Nanos) - int64(from.Nanos)
+	if seconds == 0 && nanos == 0 {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	delta, err := math.NewDecFromInt64(seconds).Add(math.NewDecFinite(nanos, -9))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	years, err := delta.Quo(math.NewDecFromInt64(secondsPerYear))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	years, _ = years.Reduce()
+	return years, nil
+}
@@ -123,3 +123,77 @@ func TestParseDate(t *testing.T) {
 		})
 	}
 }
+
+func TestYearsBetweenTimestamps(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := 365*24*time.Hour + 6*time.Hour
+
+	tests := []struct {
+		name   string
+		from   *timestamppb.Timestamp
+		to     *timestamppb.Timestamp
+		want   string
+		expErr string
+	}{
+		{
+			name: "equal timestamps",
+			from: timestamppb.New(start),
+			to:   timestamppb.New(start),
+			want: "0",
+		},
+		{
+			name: "one year",
+			from: timestamppb.New(start),
+			to:   timestamppb.New(start.Add(year)),
+			want: "1",
+		},
+		{
+			name: "half a year",
+			from: timestamppb.New(start),
+			to:   timestamppb.New(start.Add(year / 2)),
+			want: "0.5",
+		},
+		{
+			name: "ten years",
+			from: timestamppb.New(start),
+			to:   timestamppb.New(start.Add(10 * year)),
+			want: "10",
+		},
+		{
+			name: "to before from",
+			from: timestamppb.New(start.Add(2 * year)),
+			to:   timestamppb.New(start),
+			want: "-2",
+		},
+		{
+			name: "nanos",
+			from: timestamppb.New(start),
+			to:   timestamppb.New(start.Add(year + 500*time.Millisecond)),
+			want: "1.000000015844043907014475118513448",
+		},
+		{
+			name:   "nil from",
+			from:   nil,
+			to:     timestamppb.New(start),
+			expErr: "from",
+		},
+		{
+			name:   "invalid to",
+			from:   timestamppb.New(start),
+			to:     &timestamppb.Timestamp{Nanos: -1},
+			expErr: "to",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := types.YearsBetweenTimestamps(tt.from, tt.to)
+			if tt.expErr != "" {
+				require.ErrorContains(t, err, tt.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
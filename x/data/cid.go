@@ -0,0 +1,190 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+const (
+	// cidVersion1 is the version of the CIDs produced by ContentHash.ToCID.
+	cidVersion1 uint64 = 1
+
+	// cidMultibasePrefixBase32 is the multibase prefix of lowercase base32
+	// (RFC 4648, no padding), the default string encoding of CIDv1.
+	cidMultibasePrefixBase32 = "b"
+
+	// MulticodecRaw is the multicodec code for raw binary data.
+	MulticodecRaw uint64 = 0x55
+
+	// MulticodecRDFC1 is the multicodec code for an RDF dataset canonicalized
+	// with the RDF Dataset Canonicalization algorithm (URDNA2015).
+	MulticodecRDFC1 uint64 = 0xb403
+
+	// MultihashBlake2b256 is the multihash code for BLAKE2b-256.
+	MultihashBlake2b256 uint64 = 0xb220
+
+	// MultihashSha256 is the multihash code for SHA-256.
+	MultihashSha256 uint64 = 0x12
+)
+
+var cidBase32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var digestAlgorithmToMultihash = map[DigestAlgorithm]uint64{
+	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256: MultihashBlake2b256,
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA256:      MultihashSha256,
+}
+
+var multihashToDigestAlgorithm = map[uint64]DigestAlgorithm{}
+
+var canonicalizationAlgorithmToMulticodec = map[GraphCanonicalizationAlgorithm]uint64{
+	GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015: MulticodecRDFC1,
+}
+
+var multicodecToCanonicalizationAlgorithm = map[uint64]GraphCanonicalizationAlgorithm{}
+
+func init() {
+	for da, code := range digestAlgorithmToMultihash {
+		multihashToDigestAlgorithm[code] = da
+	}
+	for ca, code := range canonicalizationAlgorithmToMulticodec {
+		multicodecToCanonicalizationAlgorithm[code] = ca
+	}
+}
+
+// ToCID converts the ContentHash to a base32 encoded CIDv1 (IPFS content identifier) so that
+// the data can be resolved against IPFS gateways. See ContentHash_Raw.ToCID and
+// ContentHash_Graph.ToCID for more details on specific formatting.
+func (ch ContentHash) ToCID() (string, error) {
+	if chr := ch.GetRaw(); chr != nil {
+		return chr.ToCID()
+	} else if chg := ch.GetGraph(); chg != nil {
+		return chg.ToCID()
+	}
+	return "", fmt.Errorf("invalid %T", ch)
+}
+
+// ToCID converts the ContentHash_Raw to a CIDv1 using the raw multicodec. The media type
+// is not part of the CID and is therefore not preserved when parsing the CID.
+func (chr ContentHash_Raw) ToCID() (string, error) {
+	err := chr.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeCID(MulticodecRaw, chr.DigestAlgorithm, chr.Hash)
+}
+
+// ToCID converts the ContentHash_Graph to a CIDv1 using the multicodec of the
+// canonicalization algorithm. Only graphs without a merkle tree are supported.
+func (chg ContentHash_Graph) ToCID() (string, error) {
+	err := chg.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	if chg.MerkleTree != GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED {
+		return "", ErrInvalidCID.Wrapf("unsupported %T %s", chg.MerkleTree, chg.MerkleTree)
+	}
+
+	codec, ok := canonicalizationAlgorithmToMulticodec[chg.CanonicalizationAlgorithm]
+	if !ok {
+		return "", ErrInvalidCID.Wrapf("missing multicodec for %T %s", chg.CanonicalizationAlgorithm, chg.CanonicalizationAlgorithm)
+	}
+
+	return encodeCID(codec, chg.DigestAlgorithm, chg.Hash)
+}
+
+// encodeCID encodes a CIDv1 based on the following pattern:
+// b{base32(concat(varint(1), varint(codec), varint(multihash), varint(len(hash)), hash))}
+func encodeCID(codec uint64, digestAlgorithm DigestAlgorithm, hash []byte) (string, error) {
+	mh, ok := digestAlgorithmToMultihash[digestAlgorithm]
+	if !ok {
+		return "", ErrInvalidCID.Wrapf("missing multihash for %T %s", digestAlgorithm, digestAlgorithm)
+	}
+
+	bz := make([]byte, 4*binary.MaxVarintLen64+len(hash))
+	n := binary.PutUvarint(bz, cidVersion1)
+	n += binary.PutUvarint(bz[n:], codec)
+	n += binary.PutUvarint(bz[n:], mh)
+	n += binary.PutUvarint(bz[n:], uint64(len(hash)))
+	n += copy(bz[n:], hash)
+	bz = bz[:n]
+
+	return cidMultibasePrefixBase32 + strings.ToLower(cidBase32Encoding.EncodeToString(bz)), nil
+}
+
+// ContentHashFromCID parses a base32 encoded CIDv1 into a ContentHash struct. CIDs using the
+// raw multicodec are parsed as ContentHash_Raw and CIDs using the multicodec of a graph
+// canonicalization algorithm are parsed as ContentHash_Graph.
+func ContentHashFromCID(cid string) (*ContentHash, error) {
+	if !strings.HasPrefix(cid, cidMultibasePrefixBase32) {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: only base32 encoded CIDv1 is supported", cid)
+	}
+
+	bz, err := cidBase32Encoding.DecodeString(strings.ToUpper(cid[len(cidMultibasePrefixBase32):]))
+	if err != nil {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: %s", cid, err)
+	}
+
+	rdr := bytes.NewReader(bz)
+
+	version, err := binary.ReadUvarint(rdr)
+	if err != nil {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: %s", cid, err)
+	}
+	if version != cidVersion1 {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: unsupported version %d", cid, version)
+	}
+
+	codec, err := binary.ReadUvarint(rdr)
+	if err != nil {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: %s", cid, err)
+	}
+
+	mh, err := binary.ReadUvarint(rdr)
+	if err != nil {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: %s", cid, err)
+	}
+
+	digestAlg, ok := multihashToDigestAlgorithm[mh]
+	if !ok {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: unsupported multihash 0x%x", cid, mh)
+	}
+
+	length, err := binary.ReadUvarint(rdr)
+	if err != nil {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: %s", cid, err)
+	}
+
+	hash := bz[len(bz)-rdr.Len():]
+	if uint64(len(hash)) != length {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: expected %d hash bytes, got %d", cid, length, len(hash))
+	}
+
+	err = digestAlg.Validate(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if codec == MulticodecRaw {
+		return &ContentHash{Raw: &ContentHash_Raw{
+			Hash:            hash,
+			DigestAlgorithm: digestAlg,
+		}}, nil
+	}
+
+	c14Alg, ok := multicodecToCanonicalizationAlgorithm[codec]
+	if !ok {
+		return nil, ErrInvalidCID.Wrapf("failed to parse CID %s: unsupported multicodec 0x%x", cid, codec)
+	}
+
+	return &ContentHash{Graph: &ContentHash_Graph{
+		Hash:                      hash,
+		DigestAlgorithm:           digestAlg,
+		CanonicalizationAlgorithm: c14Alg,
+		MerkleTree:                GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED,
+	}}, nil
+}
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentHash_ToCID(t *testing.T) {
+	hash := []byte("abcdefghijklmnopqrstuvwxyz123456")
+
+	tests := []struct {
+		name string
+		ch   ContentHash
+		want string
+	}{
+		{
+			"valid raw",
+			ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				MediaType:       RawMediaType_RAW_MEDIA_TYPE_UNSPECIFIED,
+			}},
+			"bafk2bzacebqwey3emvtgo2djnjvwy3lon5yhc4ttor2xm53ypf5dcmrtgq2tm",
+		},
+		{
+			"valid sha256 raw",
+			ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA256,
+				MediaType:       RawMediaType_RAW_MEDIA_TYPE_UNSPECIFIED,
+			}},
+			"bafkreidbmjrwizlgm5ugs2tlnrww433qofzhg5dvoz3xq6l2gezdgnbvgy",
+		},
+		{
+			"valid graph",
+			ContentHash{Graph: &ContentHash_Graph{
+				Hash:                      hash,
+				DigestAlgorithm:           DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+				MerkleTree:                GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED,
+			}},
+			"bagb6qava4qbcaylcmnsgkzthnbuwu23mnvxg64drojzxi5lwo54hs6rrgiztinjw",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cid, err := tt.ch.ToCID()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, cid)
+		})
+	}
+}
+
+func TestContentHashFromCID(t *testing.T) {
+	hash := []byte("abcdefghijklmnopqrstuvwxyz123456")
+
+	tests := []struct {
+		name    string
+		cid     string
+		want    *ContentHash
+		wantErr string
+	}{
+		{
+			name: "valid raw",
+			cid:  "bafk2bzacebqwey3emvtgo2djnjvwy3lon5yhc4ttor2xm53ypf5dcmrtgq2tm",
+			want: &ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+			}},
+		},
+		{
+			name: "valid sha256 raw",
+			cid:  "bafkreidbmjrwizlgm5ugs2tlnrww433qofzhg5dvoz3xq6l2gezdgnbvgy",
+			want: &ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA256,
+			}},
+		},
+		{
+			name: "valid graph",
+			cid:  "bagb6qava4qbcaylcmnsgkzthnbuwu23mnvxg64drojzxi5lwo54hs6rrgiztinjw",
+			want: &ContentHash{Graph: &ContentHash_Graph{
+				Hash:                      hash,
+				DigestAlgorithm:           DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+			}},
+		},
+		{
+			name:    "unsupported multibase",
+			cid:     "zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA",
+			wantErr: "only base32 encoded CIDv1 is supported",
+		},
+		{
+			name:    "unsupported version",
+			cid:     "baal2bzacebqwey3emvtgo2djnjvwy3lon5yhc4ttor2xm53ypf5dcmrtgq2tm",
+			wantErr: "unsupported version 0",
+		},
+		{
+			name:    "unsupported multihash",
+			cid:     "bafkrgqdbmjrwizlgm5ugs2tlnrww433qofzhg5dvoz3xq6l2gezdgnbvgzqwey3emvtgo2djnjvwy3lon5yhc4ttor2xm53ypf5dcmrtgq2tm",
+			wantErr: "unsupported multihash 0x13",
+		},
+		{
+			name:    "truncated hash",
+			cid:     "bafk2bzacebqwey3emvtgo2djnjvwy3lon5yhc4ttor2xm53ypf5dcmrtgq",
+			wantErr: "expected 32 hash bytes, got 30",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, err := ContentHashFromCID(tt.cid)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ch)
+		})
+	}
+}
+
+func TestContentHash_CIDRoundTrip(t *testing.T) {
+	hash := []byte("abcdefghijklmnopqrstuvwxyz123456")
+
+	tests := []struct {
+		name string
+		ch   *ContentHash
+	}{
+		{
+			"raw",
+			&ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+			}},
+		},
+		{
+			"graph",
+			&ContentHash{Graph: &ContentHash_Graph{
+				Hash:                      hash,
+				DigestAlgorithm:           DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cid, err := tt.ch.ToCID()
+			require.NoError(t, err)
+
+			ch, err := ContentHashFromCID(cid)
+			require.NoError(t, err)
+			require.Equal(t, tt.ch, ch)
+		})
+	}
+}
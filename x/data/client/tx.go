@@ -32,6 +32,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgAttestCmd(),
 		MsgDefineResolverCmd(),
 		MsgRegisterResolverCmd(),
+		MsgRevokeAttestationCmd(),
 	)
 
 	return cmd
@@ -125,6 +126,48 @@ Attest to the veracity of more than one entry using a comma-separated (no spaces
 	return cmd
 }
 
+// MsgRevokeAttestationCmd creates a CLI command for Msg/RevokeAttestation.
+func MsgRevokeAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke-attestation [iri]",
+		Short:   `Revoke an attestation to anchored data.`,
+		Long:    `Revoke an attestation to anchored data. The data MUST be of graph type (rdf file extension).`,
+		Example: "regen tx data revoke-attestation regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			iri := args[0]
+			if len(iri) == 0 {
+				return sdkerrors.ErrInvalidRequest.Wrap("iri cannot be empty")
+			}
+
+			content, err := data.ParseIRI(iri)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid iri: %s", err.Error())
+			}
+
+			graph := content.GetGraph()
+			if graph == nil {
+				return sdkerrors.ErrInvalidRequest.Wrap("can only revoke attestations to graph data types")
+			}
+
+			msg := data.MsgRevokeAttestation{
+				Attestor:    clientCtx.GetFromAddress().String(),
+				ContentHash: graph,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgDefineResolverCmd creates a CLI command for Msg/DefineResolver.
 func MsgDefineResolverCmd() *cobra.Command {
 	cmd := &cobra.Command{
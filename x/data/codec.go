@@ -16,6 +16,7 @@ func RegisterTypes(registry types.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAnchor{}, "regen-ledger/MsgAnchor", nil)
 	cdc.RegisterConcrete(&MsgAttest{}, "regen-ledger/MsgAttest", nil)
+	cdc.RegisterConcrete(&MsgRevokeAttestation{}, "regen-ledger/MsgRevokeAttestation", nil)
 	cdc.RegisterConcrete(&MsgDefineResolver{}, "regen-ledger/MsgDefineResolver", nil)
 	cdc.RegisterConcrete(&MsgRegisterResolver{}, "regen-ledger/MsgRegisterResolver", nil)
 }
@@ -11,4 +11,5 @@ var (
 	ErrResolverURLExists           = sdkerrors.Register(DataCodespace, 4, "resolver URL already exists")
 	ErrResolverUndefined           = sdkerrors.Register(DataCodespace, 5, "resolver undefined")
 	ErrUnauthorizedResolverManager = sdkerrors.Register(DataCodespace, 6, "unauthorized resolver manager")
+	ErrInvalidCID                  = sdkerrors.Register(DataCodespace, 7, "invalid CID")
 )
@@ -124,6 +124,61 @@ func (m *EventAttest) GetAttestor() string {
 	return ""
 }
 
+// EventRevokeAttestation is an event emitted when an attestation is revoked.
+type EventRevokeAttestation struct {
+	// iri is the IRI of the data for which the attestation was revoked.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
+	// attestor is the address of the account that revoked the attestation.
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (m *EventRevokeAttestation) Reset()         { *m = EventRevokeAttestation{} }
+func (m *EventRevokeAttestation) String() string { return proto.CompactTextString(m) }
+func (*EventRevokeAttestation) ProtoMessage()    {}
+func (*EventRevokeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e110e0930a307df, []int{2}
+}
+func (m *EventRevokeAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokeAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokeAttestation.Merge(m, src)
+}
+func (m *EventRevokeAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokeAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokeAttestation proto.InternalMessageInfo
+
+func (m *EventRevokeAttestation) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
+}
+
+func (m *EventRevokeAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
 // EventDefineResolver is an event emitted when a resolved is defined on chain.
 type EventDefineResolver struct {
 	// id is the ID of the defined resolver.
@@ -134,7 +189,7 @@ func (m *EventDefineResolver) Reset()         { *m = EventDefineResolver{} }
 func (m *EventDefineResolver) String() string { return proto.CompactTextString(m) }
 func (*EventDefineResolver) ProtoMessage()    {}
 func (*EventDefineResolver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e110e0930a307df, []int{2}
+	return fileDescriptor_3e110e0930a307df, []int{3}
 }
 func (m *EventDefineResolver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +238,7 @@ func (m *EventRegisterResolver) Reset()         { *m = EventRegisterResolver{} }
 func (m *EventRegisterResolver) String() string { return proto.CompactTextString(m) }
 func (*EventRegisterResolver) ProtoMessage()    {}
 func (*EventRegisterResolver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e110e0930a307df, []int{3}
+	return fileDescriptor_3e110e0930a307df, []int{4}
 }
 func (m *EventRegisterResolver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,6 +284,7 @@ func (m *EventRegisterResolver) GetIri() string {
 func init() {
 	proto.RegisterType((*EventAnchor)(nil), "regen.data.v1.EventAnchor")
 	proto.RegisterType((*EventAttest)(nil), "regen.data.v1.EventAttest")
+	proto.RegisterType((*EventRevokeAttestation)(nil), "regen.data.v1.EventRevokeAttestation")
 	proto.RegisterType((*EventDefineResolver)(nil), "regen.data.v1.EventDefineResolver")
 	proto.RegisterType((*EventRegisterResolver)(nil), "regen.data.v1.EventRegisterResolver")
 }
@@ -236,22 +292,24 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1/events.proto", fileDescriptor_3e110e0930a307df) }
 
 var fileDescriptor_3e110e0930a307df = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
+	// 258 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x4a, 0x4d, 0x4f,
 	0xcd, 0xd3, 0x4f, 0x49, 0x2c, 0x49, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0xcb, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
 	0x95, 0xe4, 0xb9, 0xb8, 0x5d, 0x41, 0xd2, 0x8e, 0x79, 0xc9, 0x19, 0xf9, 0x45, 0x42, 0x02, 0x5c,
 	0xcc, 0x99, 0x45, 0x99, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20, 0xa6, 0x92, 0x35, 0x4c,
 	0x41, 0x49, 0x49, 0x6a, 0x71, 0x09, 0xa6, 0x02, 0x21, 0x29, 0x2e, 0x8e, 0x44, 0xb0, 0x5c, 0x7e,
-	0x91, 0x04, 0x13, 0x58, 0x18, 0xce, 0x57, 0x52, 0xe5, 0x12, 0x06, 0x6b, 0x76, 0x49, 0x4d, 0xcb,
-	0xcc, 0x4b, 0x0d, 0x4a, 0x2d, 0xce, 0xcf, 0x29, 0x4b, 0x2d, 0x12, 0xe2, 0xe3, 0x62, 0xca, 0x4c,
-	0x01, 0x9b, 0xc1, 0x12, 0xc4, 0x94, 0x99, 0xa2, 0x64, 0xc9, 0x25, 0x0a, 0x56, 0x16, 0x94, 0x9a,
-	0x9e, 0x59, 0x5c, 0x92, 0x5a, 0x84, 0x4b, 0x21, 0xcc, 0x76, 0x26, 0xb8, 0xed, 0x4e, 0x6e, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf6, 0xb3, 0x6e, 0x5e, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36,
-	0x94, 0x97, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0xa4, 0x5f, 0x01, 0x0e, 0xa6, 0x24, 0x36, 0x70, 0xe8,
-	0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69, 0x64, 0x71, 0x3b, 0x01, 0x00, 0x00,
+	0x91, 0x04, 0x13, 0x58, 0x18, 0xce, 0x57, 0x72, 0xe3, 0x12, 0x03, 0x6b, 0x0e, 0x4a, 0x2d, 0xcb,
+	0xcf, 0x4e, 0x85, 0x18, 0x91, 0x58, 0x92, 0x99, 0x9f, 0x47, 0xa2, 0x39, 0xaa, 0x5c, 0xc2, 0x60,
+	0x73, 0x5c, 0x52, 0xd3, 0x32, 0xf3, 0x52, 0x83, 0x52, 0x8b, 0xf3, 0x73, 0xca, 0x52, 0x8b, 0x84,
+	0xf8, 0xb8, 0x98, 0x32, 0x53, 0xc0, 0x66, 0xb0, 0x04, 0x31, 0x65, 0xa6, 0x28, 0x59, 0x72, 0x89,
+	0x42, 0xad, 0x4b, 0xcf, 0x2c, 0x2e, 0x49, 0x2d, 0xc2, 0xa5, 0x10, 0x66, 0x3b, 0x13, 0xdc, 0x76,
+	0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
+	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c,
+	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x87, 0x9d, 0x6e, 0x5e, 0x6a, 0x49, 0x79,
+	0x7e, 0x51, 0x36, 0x94, 0x97, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0xa4, 0x5f, 0x01, 0x0e, 0xee, 0x24,
+	0x36, 0x70, 0x28, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0x97, 0xbb, 0xf6, 0x83, 0x01,
+	0x00, 0x00,
 }
 
 func (m *EventAnchor) Marshal() (dAtA []byte, err error) {
@@ -321,6 +379,43 @@ func (m *EventAttest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRevokeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventDefineResolver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +520,23 @@ func (m *EventAttest) Size() (n int) {
 	return n
 }
 
+func (m *EventRevokeAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventDefineResolver) Size() (n int) {
 	if m == nil {
 		return 0
@@ -655,6 +767,120 @@ func (m *EventAttest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRevokeAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokeAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventDefineResolver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,4 +45,26 @@ Feature: MsgAttest
     When the message is validated
     Then expect the error "content hashes cannot be empty: invalid request"
 
+  Scenario: an error is returned if content hashes includes duplicates
+    Given the message
+    """
+    {
+      "attestor": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "content_hashes": [
+        {
+          "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+          "digest_algorithm": 1,
+          "canonicalization_algorithm": 1
+        },
+        {
+          "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+          "digest_algorithm": 1,
+          "canonicalization_algorithm": 1
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "duplicate content hash regen:13toVfvC2YxrrfSXWB5h2BGHiXZURsKxWUz72uDRDSPMCrYPguGUXSC.rdf: invalid request"
+
   # Note: see ./types_content_hash.feature for content hash validation
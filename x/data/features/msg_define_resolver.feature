@@ -49,3 +49,25 @@ Feature: MsgDefineResolver
     """
     When the message is validated
     Then expect the error "invalid resolver url: invalid request"
+
+  Scenario: an error is returned if resolver url does not use http or https
+    Given the message
+    """
+    {
+      "manager": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "resolver_url": "ftp://foo.bar"
+    }
+    """
+    When the message is validated
+    Then expect the error "resolver url must use http or https: invalid request"
+
+  Scenario: an error is returned if resolver url is missing a host
+    Given the message
+    """
+    {
+      "manager": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "resolver_url": "https:///foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "resolver url must include a host: invalid request"
@@ -14,6 +14,20 @@ Feature: Types
     When the content hash is validated
     Then expect no error
 
+  Scenario: a valid raw content hash with sha256 digest algorithm
+    Given the content hash
+    """
+    {
+      "raw": {
+        "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+        "digest_algorithm": 2,
+        "media_type": 1
+      }
+    }
+    """
+    When the content hash is validated
+    Then expect no error
+
   Scenario: a valid graph content hash
     Given the content hash
     """
@@ -76,12 +90,12 @@ Feature: Types
     {
       "raw": {
         "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
-        "digest_algorithm": 2
+        "digest_algorithm": 3
       }
     }
     """
     When the content hash is validated
-    Then expect the error "unknown data.DigestAlgorithm 2: invalid request"
+    Then expect the error "unknown data.DigestAlgorithm 3: invalid request"
 
   Scenario: an error is returned if raw content hash length does not match blake2b digest algorithm
     Given the content hash
@@ -96,6 +110,19 @@ Feature: Types
     When the content hash is validated
     Then expect the error "expected 32 bytes for DIGEST_ALGORITHM_BLAKE2B_256, got 1: invalid request"
 
+  Scenario: an error is returned if raw content hash length does not match sha256 digest algorithm
+    Given the content hash
+    """
+    {
+      "raw": {
+        "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+        "digest_algorithm": 2
+      }
+    }
+    """
+    When the content hash is validated
+    Then expect the error "expected 32 bytes for DIGEST_ALGORITHM_SHA256, got 31: invalid request"
+
   Scenario: no error is returned if raw content hash media type is unspecified
     Given the content hash
     """
@@ -137,12 +164,12 @@ Feature: Types
     {
       "graph": {
         "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
-        "digest_algorithm": 2
+        "digest_algorithm": 3
       }
     }
     """
     When the content hash is validated
-    Then expect the error "unknown data.DigestAlgorithm 2: invalid request"
+    Then expect the error "unknown data.DigestAlgorithm 3: invalid request"
 
   Scenario: an error is returned if graph content hash length does not match blake2b digest algorithm
     Given the content hash
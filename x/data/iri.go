@@ -143,7 +143,7 @@ func ParseIRI(iri string) (*ContentHash, error) {
 	// read first byte
 	typ, err := rdr.ReadByte()
 	if err != nil {
-		return nil, err
+		return nil, ErrInvalidIRI.Wrapf("failed to parse IRI %s: missing type prefix", iri)
 	}
 
 	// switch on first byte which represents the type prefix
@@ -152,7 +152,7 @@ func ParseIRI(iri string) (*ContentHash, error) {
 		// read next byte
 		b0, err := rdr.ReadByte()
 		if err != nil {
-			return nil, err
+			return nil, ErrInvalidIRI.Wrapf("failed to parse IRI %s: unexpected end of data", iri)
 		}
 
 		// look up extension as media type
@@ -184,7 +184,7 @@ func ParseIRI(iri string) (*ContentHash, error) {
 		// read next byte
 		b0, err := rdr.ReadByte()
 		if err != nil {
-			return nil, err
+			return nil, ErrInvalidIRI.Wrapf("failed to parse IRI %s: unexpected end of data", iri)
 		}
 
 		// interpret next byte as canonicalization algorithm
@@ -197,7 +197,7 @@ func ParseIRI(iri string) (*ContentHash, error) {
 		// read next byte
 		b0, err = rdr.ReadByte()
 		if err != nil {
-			return nil, err
+			return nil, ErrInvalidIRI.Wrapf("failed to parse IRI %s: unexpected end of data", iri)
 		}
 
 		// interpret next byte as merklization algorithm
@@ -210,7 +210,7 @@ func ParseIRI(iri string) (*ContentHash, error) {
 		// read next byte
 		b0, err = rdr.ReadByte()
 		if err != nil {
-			return nil, err
+			return nil, ErrInvalidIRI.Wrapf("failed to parse IRI %s: unexpected end of data", iri)
 		}
 
 		// interpret next byte as digest algorithm
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"fmt"
 	"testing"
 
 	"gotest.tools/v3/assert"
@@ -206,6 +207,15 @@ func TestContentHash_Raw_ToIRI(t *testing.T) {
 			},
 			"regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.ogg",
 		},
+		{
+			"valid sha256 media bin",
+			ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA256,
+				MediaType:       RawMediaType_RAW_MEDIA_TYPE_UNSPECIFIED,
+			},
+			"regen:115dNuUmeLdZEBP9opwjTqvH8GCx56SGfgmDHbMeYtwe8neW4iXb.bin",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -261,6 +271,16 @@ func TestParseIRI(t *testing.T) {
 			iri:     "regen:114DDL1RtVwKpfqgaPfAG153ckiKfuPEgTT7tEGs1Hic5sC9dCta.abc",
 			wantErr: "failed to resolve media type for extension abc, expected bin: invalid media extension",
 		},
+		{
+			name:    "missing type prefix",
+			iri:     "regen:1Wh4bh.rdf",
+			wantErr: "failed to parse IRI regen:1Wh4bh.rdf: missing type prefix: invalid IRI",
+		},
+		{
+			name:    "truncated graph",
+			iri:     "regen:1W42LZrL.rdf",
+			wantErr: "failed to parse IRI regen:1W42LZrL.rdf: unexpected end of data: invalid IRI",
+		},
 
 		{
 			name: "valid media bin",
@@ -271,6 +291,15 @@ func TestParseIRI(t *testing.T) {
 				MediaType:       RawMediaType_RAW_MEDIA_TYPE_UNSPECIFIED,
 			}},
 		},
+		{
+			name: "valid sha256 media bin",
+			iri:  "regen:115dNuUmeLdZEBP9opwjTqvH8GCx56SGfgmDHbMeYtwe8neW4iXb.bin",
+			wantHash: &ContentHash{Raw: &ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA256,
+				MediaType:       RawMediaType_RAW_MEDIA_TYPE_UNSPECIFIED,
+			}},
+		},
 		{
 			name: "valid media txt",
 			iri:  "regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.txt",
@@ -445,3 +474,45 @@ func TestParseIRI(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIRI_RoundTrip(t *testing.T) {
+	hash := []byte("abcdefghijklmnopqrstuvwxyz123456")
+
+	for da := range DigestAlgorithmLength {
+		for mt := range mediaExtensionTypeToString {
+			chr := ContentHash_Raw{Hash: hash, DigestAlgorithm: da, MediaType: mt}
+			t.Run(fmt.Sprintf("raw %s %s", da, mt), func(t *testing.T) {
+				iri, err := chr.ToIRI()
+				require.NoError(t, err)
+
+				ch, err := ParseIRI(iri)
+				require.NoError(t, err)
+				require.NotNil(t, ch.Raw)
+				assert.DeepEqual(t, *ch.Raw, chr)
+
+				parsed, err := ch.ToIRI()
+				require.NoError(t, err)
+				require.Equal(t, iri, parsed)
+			})
+		}
+
+		chg := ContentHash_Graph{
+			Hash:                      hash,
+			DigestAlgorithm:           da,
+			CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+		}
+		t.Run(fmt.Sprintf("graph %s", da), func(t *testing.T) {
+			iri, err := chg.ToIRI()
+			require.NoError(t, err)
+
+			ch, err := ParseIRI(iri)
+			require.NoError(t, err)
+			require.NotNil(t, ch.Graph)
+			assert.DeepEqual(t, *ch.Graph, chg)
+
+			parsed, err := ch.ToIRI()
+			require.NoError(t, err)
+			require.Equal(t, iri, parsed)
+		})
+	}
+}
@@ -18,6 +18,7 @@ func (m *MsgAttest) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("content hashes cannot be empty")
 	}
 
+	seen := make(map[string]bool)
 	for _, hash := range m.ContentHashes {
 		if hash == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("content hash cannot be empty")
@@ -26,6 +27,15 @@ func (m *MsgAttest) ValidateBasic() error {
 		if err != nil {
 			return err
 		}
+
+		iri, err := hash.ToIRI()
+		if err != nil {
+			return err
+		}
+		if seen[iri] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate content hash %s", iri)
+		}
+		seen[iri] = true
 	}
 	return nil
 }
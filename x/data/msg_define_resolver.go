@@ -16,10 +16,19 @@ func (m *MsgDefineResolver) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
 	}
 
-	if _, err := url.ParseRequestURI(m.ResolverUrl); err != nil {
+	u, err := url.ParseRequestURI(m.ResolverUrl)
+	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("invalid resolver url")
 	}
 
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return sdkerrors.ErrInvalidRequest.Wrap("resolver url must use http or https")
+	}
+
+	if u.Host == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("resolver url must include a host")
+	}
+
 	return nil
 }
 
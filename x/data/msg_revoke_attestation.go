@@ -0,0 +1,39 @@
+package data
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgRevokeAttestation{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRevokeAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Attestor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if m.ContentHash == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("content hash cannot be empty")
+	}
+
+	return m.ContentHash.Validate()
+}
+
+// GetSigners returns the expected signers for MsgRevokeAttestation.
+func (m *MsgRevokeAttestation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Attestor)
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRevokeAttestation) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRevokeAttestation) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevokeAttestation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
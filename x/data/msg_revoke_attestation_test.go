@@ -0,0 +1,43 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRevokeAttestationSuite struct {
+	t   gocuke.TestingT
+	msg *MsgRevokeAttestation
+	err error
+}
+
+func TestMsgRevokeAttestation(t *testing.T) {
+	runner := gocuke.NewRunner(t, &msgRevokeAttestationSuite{}).Path("./features/msg_revoke_attestation.feature")
+	runner.Step(`^the\s+message\s+"((?:[^\"]|\")*)"`, (*msgRevokeAttestationSuite).TheMessage)
+	runner.Run()
+}
+
+func (s *msgRevokeAttestationSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRevokeAttestationSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRevokeAttestation{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRevokeAttestationSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRevokeAttestationSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRevokeAttestationSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -1161,6 +1161,8 @@ type AnchorInfo struct {
 	ContentHash *ContentHash `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
 	// timestamp is the time at which the data was anchored.
 	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// height is the block height at which the data was anchored.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *AnchorInfo) Reset()         { *m = AnchorInfo{} }
@@ -1217,6 +1219,13 @@ func (m *AnchorInfo) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+func (m *AnchorInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // AttestationInfo is the information for an attestation.
 type AttestationInfo struct {
 	// iri is the IRI of the anchored data.
@@ -1376,81 +1385,82 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1/query.proto", fileDescriptor_38d540b97ef3e368) }
 
 var fileDescriptor_38d540b97ef3e368 = []byte{
-	// 1174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
-	0x18, 0xc6, 0x33, 0x0e, 0x94, 0xe4, 0x4d, 0x68, 0xca, 0x08, 0xa8, 0xe3, 0x24, 0x4e, 0x98, 0xb4,
-	0x49, 0x48, 0xe2, 0x5d, 0x6c, 0x0e, 0x40, 0x25, 0x84, 0x48, 0xa1, 0xad, 0xab, 0xa6, 0x2d, 0xdb,
-	0x44, 0x22, 0xbe, 0xa0, 0xb5, 0x3d, 0xb5, 0x57, 0xd8, 0x3b, 0xee, 0xee, 0xda, 0x60, 0x45, 0xb9,
-	0x70, 0xe2, 0x88, 0x40, 0x88, 0x0b, 0x17, 0x10, 0x12, 0xa7, 0xf6, 0xc0, 0x81, 0x0b, 0x7c, 0x00,
-	0x04, 0x97, 0x4a, 0x5c, 0x38, 0xa2, 0x84, 0x33, 0x47, 0xce, 0x68, 0x67, 0x67, 0xbd, 0xff, 0xd7,
-	0x6e, 0x1b, 0x90, 0x6f, 0x1e, 0xef, 0x33, 0x33, 0xbf, 0xf7, 0x9d, 0x67, 0x76, 0x1f, 0x98, 0x37,
-	0x68, 0x83, 0xea, 0x72, 0x5d, 0xb5, 0x54, 0xb9, 0x57, 0x94, 0xef, 0x75, 0xa9, 0xd1, 0x97, 0x3a,
-	0x06, 0xb3, 0x18, 0x7e, 0x96, 0x3f, 0x92, 0xec, 0x47, 0x52, 0xaf, 0x98, 0x5b, 0x6c, 0x30, 0xd6,
-	0x68, 0x51, 0x59, 0xed, 0x68, 0xb2, 0xaa, 0xeb, 0xcc, 0x52, 0x2d, 0x8d, 0xe9, 0xa6, 0x23, 0xce,
-	0x2d, 0x8b, 0xa7, 0x7c, 0x54, 0xed, 0xde, 0x95, 0x2d, 0xad, 0x4d, 0x4d, 0x4b, 0x6d, 0x77, 0x84,
-	0x60, 0xb3, 0xc6, 0xcc, 0x36, 0x33, 0xe5, 0xaa, 0x6a, 0x52, 0x67, 0x1b, 0xb9, 0x57, 0xac, 0x52,
-	0x4b, 0x2d, 0xca, 0x1d, 0xb5, 0xa1, 0xe9, 0x7c, 0x35, 0xa1, 0x0d, 0x41, 0x59, 0xfd, 0x0e, 0x15,
-	0xfb, 0x90, 0x2d, 0x38, 0xff, 0x9e, 0x3d, 0xf9, 0x6d, 0xbd, 0xd6, 0x64, 0xc6, 0x4e, 0xbf, 0xac,
-	0x94, 0x15, 0x7a, 0xaf, 0x4b, 0x4d, 0x0b, 0x9f, 0x83, 0x49, 0xcd, 0xd0, 0xb2, 0x68, 0x05, 0x6d,
-	0x4c, 0x2b, 0xf6, 0x4f, 0xb2, 0x0b, 0xd9, 0xa8, 0xd8, 0xec, 0x30, 0xdd, 0xa4, 0xb8, 0x08, 0x67,
-	0x54, 0xfe, 0x37, 0x9f, 0x30, 0x53, 0x9a, 0x97, 0x02, 0xe5, 0x4a, 0xce, 0x9c, 0xb2, 0x7e, 0x97,
-	0x29, 0x42, 0x48, 0x0e, 0x42, 0xcb, 0x5d, 0x53, 0xcd, 0xa6, 0xbb, 0xf9, 0x9b, 0x30, 0x5b, 0x63,
-	0xba, 0x45, 0x75, 0xeb, 0x83, 0xa6, 0x6a, 0x36, 0xc5, 0xa2, 0xb9, 0xd0, 0xa2, 0x97, 0x1d, 0x09,
-	0x9f, 0x38, 0x53, 0xf3, 0x06, 0xe4, 0x26, 0xcc, 0xc7, 0x2c, 0xfd, 0xf8, 0xa8, 0x9f, 0x22, 0x20,
-	0xce, 0x82, 0x96, 0x65, 0x1f, 0x03, 0x3f, 0xaa, 0x1d, 0x31, 0x62, 0x86, 0x4b, 0x9d, 0x83, 0x29,
-	0x55, 0xfc, 0x25, 0xfa, 0x36, 0x18, 0xe3, 0x2b, 0x00, 0xde, 0xc1, 0x64, 0x33, 0x7c, 0xe7, 0x35,
-	0xc9, 0x39, 0x45, 0xc9, 0x3e, 0x45, 0xc9, 0x31, 0x8b, 0x38, 0x45, 0xe9, 0xb6, 0xda, 0xa0, 0x62,
-	0x5d, 0xc5, 0x37, 0x93, 0xfc, 0x80, 0x60, 0x35, 0x15, 0x45, 0x54, 0xb9, 0x03, 0xb3, 0xaa, 0x4f,
-	0x91, 0x45, 0x2b, 0x93, 0x1b, 0x33, 0xa5, 0x7c, 0xb8, 0x56, 0x4f, 0xc2, 0x0b, 0x0e, 0xcc, 0xc1,
-	0x57, 0x63, 0x98, 0xd7, 0x87, 0x32, 0x3b, 0x00, 0x01, 0xe8, 0x3e, 0x2c, 0xc5, 0x30, 0xa7, 0x99,
-	0xed, 0xd4, 0xfa, 0x75, 0x1f, 0x41, 0x3e, 0x69, 0xef, 0x71, 0x6c, 0xd5, 0xf7, 0xf1, 0xbc, 0xa7,
-	0x77, 0x39, 0x4e, 0xad, 0xb3, 0x0f, 0x10, 0x2c, 0x27, 0x92, 0x8e, 0x63, 0x6b, 0xd7, 0xe0, 0x79,
-	0xce, 0xab, 0x50, 0x93, 0xb5, 0x7a, 0x74, 0x70, 0x6d, 0xcf, 0x42, 0x46, 0xab, 0xf3, 0x2e, 0x3e,
-	0xa5, 0x64, 0xb4, 0x3a, 0xb9, 0x0d, 0x2f, 0x84, 0x74, 0xa2, 0x9a, 0xd7, 0x60, 0xca, 0x10, 0xff,
-	0x89, 0xa6, 0x2f, 0x84, 0x2a, 0x71, 0xa7, 0xf0, 0x32, 0x06, 0x62, 0xd2, 0x83, 0x5c, 0x60, 0xc5,
-	0xff, 0xcb, 0xfc, 0xdf, 0x20, 0x58, 0x88, 0xdd, 0x58, 0x14, 0xf4, 0x06, 0x4c, 0xbb, 0x8c, 0xee,
-	0xd9, 0xa4, 0x56, 0xe4, 0xa9, 0x4f, 0xef, 0x54, 0xbe, 0x8b, 0x61, 0x1c, 0x43, 0xb7, 0x7f, 0x8b,
-	0x60, 0x31, 0x1e, 0x73, 0x8c, 0x7a, 0x19, 0xe3, 0xb3, 0x7d, 0xe5, 0x86, 0xcf, 0x67, 0x5d, 0xa3,
-	0xe5, 0xfa, 0xac, 0x6b, 0xb4, 0xfe, 0x53, 0x9f, 0xf1, 0x8d, 0xc7, 0xa8, 0x37, 0x5b, 0x70, 0xfe,
-	0x32, 0xd3, 0x7b, 0xd4, 0xb0, 0xca, 0x4a, 0x79, 0x8f, 0xf9, 0x2d, 0x16, 0x8d, 0x3a, 0x07, 0x90,
-	0x8d, 0x8a, 0x45, 0x31, 0x4f, 0x98, 0x4d, 0xde, 0x1f, 0x70, 0xd8, 0xc3, 0x3d, 0xe6, 0x7b, 0x11,
-	0x3c, 0xe1, 0xca, 0xdb, 0x03, 0x68, 0xdf, 0xca, 0x02, 0x3a, 0x5a, 0xe2, 0x57, 0x08, 0xc0, 0x8b,
-	0x3a, 0x31, 0x2f, 0xa1, 0x30, 0x4d, 0xe6, 0xd1, 0x2e, 0xde, 0xeb, 0x30, 0x3d, 0x08, 0xad, 0xd9,
-	0x49, 0x31, 0xd7, 0x89, 0xb5, 0x92, 0x1b, 0x6b, 0xa5, 0x3d, 0x57, 0xa1, 0x78, 0x62, 0xd2, 0x87,
-	0xb9, 0xd0, 0x17, 0x21, 0x86, 0xce, 0x9f, 0xb5, 0x32, 0xa1, 0xac, 0xf5, 0xf8, 0x5b, 0x5f, 0x87,
-	0x59, 0xbf, 0x11, 0xc3, 0x9f, 0x06, 0xf7, 0x0a, 0x65, 0xbc, 0x2b, 0x94, 0x85, 0x67, 0xda, 0xaa,
-	0xae, 0x36, 0xa8, 0xc1, 0x77, 0x9a, 0x56, 0xdc, 0x61, 0xe9, 0x9f, 0x39, 0x78, 0x9a, 0x5f, 0x0a,
-	0xfc, 0x00, 0xc1, 0x8c, 0x2f, 0x34, 0xe3, 0xb5, 0x50, 0x0f, 0x13, 0x22, 0x78, 0x6e, 0x7d, 0xa8,
-	0xce, 0x39, 0x5d, 0x72, 0xf3, 0x93, 0xdf, 0xff, 0xfa, 0x22, 0x73, 0x0d, 0x13, 0x39, 0x18, 0xf5,
-	0x9d, 0xf8, 0x5a, 0xa8, 0xf6, 0x0b, 0x9a, 0xa1, 0xc9, 0x87, 0x9a, 0xa1, 0x1d, 0x55, 0x08, 0x5e,
-	0x89, 0x55, 0x99, 0xf2, 0x40, 0x83, 0xef, 0x23, 0x98, 0xf5, 0x67, 0x67, 0x9c, 0x4a, 0xe2, 0xbb,
-	0x4a, 0xb9, 0x8d, 0xe1, 0x42, 0xc1, 0x7c, 0x9d, 0x33, 0xbf, 0x43, 0x96, 0x12, 0x99, 0x6d, 0xd7,
-	0x5d, 0x42, 0x9b, 0x95, 0x15, 0xb2, 0x90, 0x40, 0x2c, 0x14, 0xf8, 0x6f, 0x04, 0x2f, 0xc6, 0xe7,
-	0x61, 0x5c, 0x8c, 0x05, 0x4a, 0x8b, 0xf1, 0xb9, 0xd2, 0xa3, 0x4c, 0x11, 0xd5, 0xb4, 0x79, 0x35,
-	0x0d, 0x5c, 0x0a, 0x93, 0xfa, 0xa6, 0xd9, 0x35, 0xb9, 0x1e, 0x95, 0x0f, 0xdd, 0x5f, 0x47, 0x95,
-	0x22, 0x96, 0x53, 0x66, 0xc9, 0x31, 0x53, 0xf0, 0x6f, 0x08, 0x9e, 0x8b, 0x04, 0x5a, 0xbc, 0x3d,
-	0x1c, 0xdc, 0xe7, 0xae, 0xc2, 0x88, 0x6a, 0x51, 0xe1, 0x01, 0xaf, 0xf0, 0x0e, 0xde, 0x18, 0x52,
-	0xa1, 0xe7, 0xb4, 0x75, 0x7c, 0x31, 0xad, 0x2e, 0xcf, 0x6e, 0xbf, 0x22, 0xc0, 0xd1, 0x10, 0x89,
-	0x47, 0x00, 0xf4, 0x5b, 0x4f, 0x1a, 0x55, 0x2e, 0x0a, 0xda, 0xe7, 0x05, 0xdd, 0x22, 0xab, 0x43,
-	0x0a, 0x72, 0x6d, 0x78, 0x91, 0xac, 0xa4, 0x95, 0xe3, 0x7a, 0xf1, 0x6b, 0x04, 0x53, 0xee, 0x3b,
-	0x04, 0xaf, 0xc6, 0x31, 0x85, 0xf2, 0x67, 0xee, 0x42, 0xba, 0x48, 0xe0, 0xbe, 0xcb, 0x71, 0xdf,
-	0xc2, 0x8b, 0x21, 0x08, 0xf7, 0x53, 0x29, 0x1f, 0x6a, 0xf5, 0xa3, 0xca, 0x32, 0x5e, 0x4a, 0x78,
-	0x6e, 0x72, 0x01, 0xfe, 0x19, 0xc1, 0xd9, 0x60, 0x1a, 0xc4, 0x2f, 0xa7, 0xed, 0x1f, 0xf4, 0xcc,
-	0xe6, 0x28, 0x52, 0x01, 0x7c, 0x87, 0x03, 0xef, 0x46, 0x4c, 0x30, 0x00, 0x0a, 0xba, 0xe5, 0x42,
-	0xe4, 0xed, 0xe5, 0x91, 0x7b, 0x56, 0xf9, 0x09, 0xc1, 0x5c, 0x28, 0x81, 0xe1, 0x61, 0x50, 0x7e,
-	0x93, 0x6c, 0x8d, 0xa4, 0x15, 0x15, 0xdc, 0xe2, 0x15, 0x94, 0x23, 0xe7, 0x1e, 0xa8, 0xc0, 0xb5,
-	0x07, 0x21, 0xc9, 0x9d, 0x77, 0xbd, 0xf1, 0x63, 0xb0, 0xf9, 0xfb, 0xca, 0x8d, 0xa1, 0xcd, 0xf7,
-	0xf2, 0xdb, 0xd0, 0xe6, 0xfb, 0x12, 0x17, 0xd9, 0xe5, 0xe8, 0x57, 0xc9, 0x72, 0x1a, 0x7a, 0xd7,
-	0x68, 0xd9, 0xe4, 0x2f, 0x91, 0xc5, 0x44, 0x72, 0x47, 0x82, 0xbf, 0x44, 0x70, 0x2e, 0x1c, 0x88,
-	0x22, 0x9f, 0xb1, 0x84, 0x78, 0x15, 0xf9, 0x8c, 0x25, 0x25, 0x2b, 0xf2, 0x0a, 0x87, 0xde, 0x8c,
-	0xbc, 0x62, 0x6a, 0xce, 0x04, 0xdb, 0x2c, 0x05, 0x8b, 0xf1, 0x8e, 0x0b, 0x3b, 0x7c, 0xee, 0x71,
-	0x0d, 0x32, 0x4f, 0x12, 0x57, 0x38, 0x6e, 0x25, 0x71, 0x45, 0xc2, 0x13, 0x29, 0x70, 0xae, 0x75,
-	0x42, 0x12, 0xb8, 0x6c, 0x20, 0x1b, 0x4c, 0x33, 0xb4, 0x4b, 0x68, 0x73, 0xe7, 0xca, 0x2f, 0xc7,
-	0x79, 0xf4, 0xf0, 0x38, 0x8f, 0xfe, 0x3c, 0xce, 0xa3, 0xcf, 0x4e, 0xf2, 0x13, 0x0f, 0x4f, 0xf2,
-	0x13, 0x7f, 0x9c, 0xe4, 0x27, 0x2a, 0xdb, 0x0d, 0xcd, 0x6a, 0x76, 0xab, 0x52, 0x8d, 0xb5, 0x9d,
-	0xa5, 0x0a, 0x3a, 0xb5, 0x3e, 0x62, 0xc6, 0x87, 0x62, 0xd4, 0xa2, 0xf5, 0x06, 0x35, 0xe4, 0x8f,
-	0xf9, 0x0e, 0xd5, 0x33, 0x3c, 0xab, 0xbc, 0xfa, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x10,
-	0xd9, 0x40, 0x55, 0x14, 0x00, 0x00,
+	// 1191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x33, 0x4e, 0x09, 0xc9, 0x9b, 0xd0, 0x94, 0x11, 0xb4, 0x8e, 0x93, 0x38, 0x66, 0xd2,
+	0x26, 0x21, 0x89, 0x77, 0x71, 0x38, 0x00, 0x95, 0x10, 0x22, 0x85, 0xb6, 0xae, 0x9a, 0xb6, 0x6c,
+	0x13, 0x89, 0xe4, 0x82, 0xd6, 0xce, 0x74, 0xbd, 0xc2, 0xde, 0x71, 0x77, 0xd7, 0x06, 0x2b, 0xca,
+	0x85, 0x13, 0x47, 0x04, 0xe2, 0xc6, 0x05, 0x84, 0xc4, 0xa9, 0x45, 0xe2, 0xc0, 0x05, 0x3e, 0x00,
+	0x82, 0x4b, 0x25, 0x2e, 0x1c, 0x51, 0xc2, 0x99, 0x23, 0x67, 0xb4, 0xb3, 0xb3, 0xde, 0xff, 0xeb,
+	0xb4, 0x0d, 0xc8, 0xb7, 0x8c, 0xf7, 0x99, 0x99, 0xdf, 0xfb, 0xce, 0x33, 0xbb, 0x4f, 0x60, 0xc6,
+	0xa4, 0x1a, 0x35, 0xe4, 0x7d, 0xd5, 0x56, 0xe5, 0x6e, 0x45, 0xbe, 0xdf, 0xa1, 0x66, 0x4f, 0x6a,
+	0x9b, 0xcc, 0x66, 0xf8, 0x39, 0xfe, 0x48, 0x72, 0x1e, 0x49, 0xdd, 0x4a, 0x61, 0x4e, 0x63, 0x4c,
+	0x6b, 0x52, 0x59, 0x6d, 0xeb, 0xb2, 0x6a, 0x18, 0xcc, 0x56, 0x6d, 0x9d, 0x19, 0x96, 0x2b, 0x2e,
+	0x2c, 0x88, 0xa7, 0x7c, 0x54, 0xeb, 0xdc, 0x93, 0x6d, 0xbd, 0x45, 0x2d, 0x5b, 0x6d, 0xb5, 0x85,
+	0x60, 0xb5, 0xce, 0xac, 0x16, 0xb3, 0xe4, 0x9a, 0x6a, 0x51, 0x77, 0x1b, 0xb9, 0x5b, 0xa9, 0x51,
+	0x5b, 0xad, 0xc8, 0x6d, 0x55, 0xd3, 0x0d, 0xbe, 0x9a, 0xd0, 0x46, 0xa0, 0xec, 0x5e, 0x9b, 0x8a,
+	0x7d, 0xc8, 0x1a, 0x5c, 0x78, 0xcf, 0x99, 0xfc, 0xb6, 0x51, 0x6f, 0x30, 0x73, 0xb3, 0x57, 0x55,
+	0xaa, 0x0a, 0xbd, 0xdf, 0xa1, 0x96, 0x8d, 0xcf, 0xc1, 0xa8, 0x6e, 0xea, 0x79, 0x54, 0x42, 0x2b,
+	0x13, 0x8a, 0xf3, 0x27, 0xd9, 0x82, 0x7c, 0x5c, 0x6c, 0xb5, 0x99, 0x61, 0x51, 0x5c, 0x81, 0x31,
+	0x95, 0xff, 0xcc, 0x27, 0x4c, 0x6e, 0xcc, 0x48, 0xa1, 0x72, 0x25, 0x77, 0x4e, 0xd5, 0xb8, 0xc7,
+	0x14, 0x21, 0x24, 0xbb, 0x91, 0xe5, 0xae, 0xab, 0x56, 0xc3, 0xdb, 0xfc, 0x4d, 0x98, 0xaa, 0x33,
+	0xc3, 0xa6, 0x86, 0xfd, 0x41, 0x43, 0xb5, 0x1a, 0x62, 0xd1, 0x42, 0x64, 0xd1, 0x2b, 0xae, 0x84,
+	0x4f, 0x9c, 0xac, 0xfb, 0x03, 0x72, 0x0b, 0x66, 0x12, 0x96, 0x7e, 0x72, 0xd4, 0x4f, 0x11, 0x10,
+	0x77, 0x41, 0xdb, 0x76, 0x8e, 0x81, 0x1f, 0xd5, 0xa6, 0x18, 0x31, 0xd3, 0xa3, 0x2e, 0xc0, 0xb8,
+	0x2a, 0x7e, 0x12, 0x7d, 0xeb, 0x8f, 0xf1, 0x55, 0x00, 0xff, 0x60, 0xf2, 0x39, 0xbe, 0xf3, 0x92,
+	0xe4, 0x9e, 0xa2, 0xe4, 0x9c, 0xa2, 0xe4, 0x9a, 0x45, 0x9c, 0xa2, 0x74, 0x47, 0xd5, 0xa8, 0x58,
+	0x57, 0x09, 0xcc, 0x24, 0x3f, 0x20, 0x58, 0xcc, 0x44, 0x11, 0x55, 0x6e, 0xc2, 0x94, 0x1a, 0x50,
+	0xe4, 0x51, 0x69, 0x74, 0x65, 0x72, 0xa3, 0x18, 0xad, 0xd5, 0x97, 0xf0, 0x82, 0x43, 0x73, 0xf0,
+	0xb5, 0x04, 0xe6, 0xe5, 0x81, 0xcc, 0x2e, 0x40, 0x08, 0xba, 0x07, 0xf3, 0x09, 0xcc, 0x59, 0x66,
+	0x3b, 0xb5, 0x7e, 0x3d, 0x40, 0x50, 0x4c, 0xdb, 0x7b, 0x18, 0x5b, 0xf5, 0x5d, 0x32, 0xef, 0xe9,
+	0x5d, 0x8e, 0x53, 0xeb, 0xec, 0x43, 0x04, 0x0b, 0xa9, 0xa4, 0xc3, 0xd8, 0xda, 0x25, 0x78, 0x81,
+	0xf3, 0x2a, 0xd4, 0x62, 0xcd, 0x2e, 0xed, 0x5f, 0xdb, 0xb3, 0x90, 0xd3, 0xf7, 0x79, 0x17, 0xcf,
+	0x28, 0x39, 0x7d, 0x9f, 0xdc, 0x81, 0x17, 0x23, 0x3a, 0x51, 0xcd, 0x6b, 0x30, 0x6e, 0x8a, 0xdf,
+	0x44, 0xd3, 0x67, 0x23, 0x95, 0x78, 0x53, 0x78, 0x19, 0x7d, 0x31, 0xe9, 0x42, 0x21, 0xb4, 0xe2,
+	0xff, 0x65, 0xfe, 0xaf, 0x11, 0xcc, 0x26, 0x6e, 0x2c, 0x0a, 0x7a, 0x03, 0x26, 0x3c, 0x46, 0xef,
+	0x6c, 0x32, 0x2b, 0xf2, 0xd5, 0xa7, 0x77, 0x2a, 0xdf, 0x26, 0x30, 0x0e, 0xa1, 0xdb, 0xbf, 0x41,
+	0x30, 0x97, 0x8c, 0x39, 0x44, 0xbd, 0x4c, 0xf0, 0xd9, 0x8e, 0x72, 0x33, 0xe0, 0xb3, 0x8e, 0xd9,
+	0xf4, 0x7c, 0xd6, 0x31, 0x9b, 0xff, 0xa9, 0xcf, 0xf8, 0xc6, 0x43, 0xd4, 0x9b, 0x35, 0xb8, 0x70,
+	0x85, 0x19, 0x5d, 0x6a, 0xda, 0x55, 0xa5, 0xba, 0xcd, 0x82, 0x16, 0x8b, 0x47, 0x9d, 0x5d, 0xc8,
+	0xc7, 0xc5, 0xa2, 0x98, 0xa7, 0xcc, 0x26, 0xef, 0xf7, 0x39, 0x9c, 0xe1, 0x36, 0x0b, 0xbc, 0x08,
+	0x9e, 0x72, 0xe5, 0xf5, 0x3e, 0x74, 0x60, 0x65, 0x01, 0x1d, 0x2f, 0xf1, 0x7b, 0x04, 0xe0, 0x47,
+	0x9d, 0x84, 0x97, 0x50, 0x94, 0x26, 0xf7, 0x78, 0x17, 0xef, 0x75, 0x98, 0xe8, 0x87, 0xd6, 0xfc,
+	0xa8, 0x98, 0xeb, 0xc6, 0x5a, 0xc9, 0x8b, 0xb5, 0xd2, 0xb6, 0xa7, 0x50, 0x7c, 0x31, 0x3e, 0x0f,
+	0x63, 0x0d, 0xaa, 0x6b, 0x0d, 0x3b, 0x7f, 0xa6, 0x84, 0x56, 0x46, 0x15, 0x31, 0x22, 0x3d, 0x98,
+	0x8e, 0x7c, 0x29, 0x12, 0xa8, 0x83, 0x19, 0x2c, 0x17, 0xc9, 0x60, 0x4f, 0x8c, 0x44, 0x6e, 0xc0,
+	0x54, 0xd0, 0xa0, 0xd1, 0x4f, 0x86, 0x77, 0xb5, 0x72, 0xfe, 0xd5, 0xca, 0xc3, 0xb3, 0x2d, 0xd5,
+	0x50, 0x35, 0x6a, 0xf2, 0x9d, 0x26, 0x14, 0x6f, 0xb8, 0xf1, 0xcf, 0x34, 0x3c, 0xc3, 0x2f, 0x0b,
+	0x7e, 0x88, 0x60, 0x32, 0x10, 0xa6, 0xf1, 0x52, 0xa4, 0xb7, 0x29, 0xd1, 0xbc, 0xb0, 0x3c, 0x50,
+	0xe7, 0x9e, 0x3a, 0xb9, 0xf5, 0xc9, 0xef, 0x7f, 0x7d, 0x91, 0xbb, 0x8e, 0x89, 0x1c, 0xfe, 0x17,
+	0xc0, 0x8d, 0xb5, 0xe5, 0x5a, 0xaf, 0xac, 0x9b, 0xba, 0x7c, 0xa0, 0x9b, 0xfa, 0xe1, 0x1e, 0xc1,
+	0xa5, 0x44, 0x95, 0x25, 0xf7, 0x35, 0xf8, 0x01, 0x82, 0xa9, 0x60, 0xa6, 0xc6, 0x99, 0x24, 0x81,
+	0x2b, 0x56, 0x58, 0x19, 0x2c, 0x14, 0xcc, 0x37, 0x38, 0xf3, 0x3b, 0x64, 0x3e, 0x95, 0xd9, 0x71,
+	0xe3, 0x65, 0xb4, 0xba, 0x57, 0x22, 0xb3, 0x29, 0xc4, 0x42, 0x81, 0xff, 0x46, 0x70, 0x3e, 0x39,
+	0x27, 0xe3, 0x4a, 0x22, 0x50, 0x56, 0xbc, 0x2f, 0x6c, 0x3c, 0xce, 0x14, 0x51, 0x4d, 0x8b, 0x57,
+	0xa3, 0xe1, 0x8d, 0x28, 0x69, 0x60, 0x9a, 0x53, 0x93, 0xe7, 0x51, 0xf9, 0xc0, 0xfb, 0xeb, 0x70,
+	0xaf, 0x82, 0xe5, 0x8c, 0x59, 0x72, 0xc2, 0x14, 0xfc, 0x1b, 0x82, 0xe7, 0x63, 0x41, 0x17, 0xaf,
+	0x0f, 0x06, 0x0f, 0xb8, 0xab, 0x7c, 0x42, 0xb5, 0xa8, 0x70, 0x97, 0x57, 0x78, 0x17, 0xaf, 0x0c,
+	0xa8, 0xd0, 0x77, 0xda, 0x32, 0xbe, 0x94, 0x55, 0x97, 0x6f, 0xb7, 0x5f, 0x11, 0xe0, 0x78, 0xb8,
+	0xc4, 0x27, 0x00, 0x0c, 0x5a, 0x4f, 0x3a, 0xa9, 0x5c, 0x14, 0xb4, 0xc3, 0x0b, 0xba, 0x4d, 0x16,
+	0x07, 0x14, 0xe4, 0xd9, 0xf0, 0x12, 0x29, 0x65, 0x95, 0xe3, 0x79, 0xf1, 0x2b, 0x04, 0xe3, 0xde,
+	0x3b, 0x04, 0x2f, 0x26, 0x31, 0x45, 0x72, 0x69, 0xe1, 0x62, 0xb6, 0x48, 0xe0, 0xbe, 0xcb, 0x71,
+	0xdf, 0xc2, 0x73, 0x11, 0x08, 0xef, 0x13, 0x2a, 0x1f, 0xe8, 0xfb, 0x87, 0x7b, 0x0b, 0x78, 0x3e,
+	0xe5, 0xb9, 0xc5, 0x05, 0xf8, 0x67, 0x04, 0x67, 0xc3, 0x29, 0x11, 0xbf, 0x9c, 0xb5, 0x7f, 0xd8,
+	0x33, 0xab, 0x27, 0x91, 0x0a, 0xe0, 0xbb, 0x1c, 0x78, 0x2b, 0x66, 0x82, 0x3e, 0x50, 0xd8, 0x2d,
+	0x17, 0x63, 0x6f, 0x2f, 0x9f, 0xdc, 0xb7, 0xca, 0x4f, 0x08, 0xa6, 0x23, 0xc9, 0x0c, 0x0f, 0x82,
+	0x0a, 0x9a, 0x64, 0xed, 0x44, 0x5a, 0x51, 0xc1, 0x6d, 0x5e, 0x41, 0x35, 0x76, 0xee, 0xa1, 0x0a,
+	0x3c, 0x7b, 0x10, 0x92, 0xde, 0x79, 0xcf, 0x1b, 0x3f, 0x86, 0x9b, 0xbf, 0xa3, 0xdc, 0x1c, 0xd8,
+	0x7c, 0x3f, 0xd7, 0x0d, 0x6c, 0x7e, 0x20, 0x89, 0x91, 0x2d, 0x8e, 0x7e, 0x8d, 0x2c, 0x64, 0xa1,
+	0x77, 0xcc, 0xa6, 0x43, 0xfe, 0x12, 0x99, 0x4b, 0x25, 0x77, 0x25, 0xf8, 0x4b, 0x04, 0xe7, 0xa2,
+	0x41, 0x29, 0xf6, 0x19, 0x4b, 0x89, 0x5d, 0xb1, 0xcf, 0x58, 0x5a, 0xe2, 0x22, 0xaf, 0x70, 0xe8,
+	0xd5, 0xd8, 0x2b, 0xa6, 0xee, 0x4e, 0x70, 0xcc, 0x52, 0xb6, 0x19, 0xef, 0xb8, 0xb0, 0xc3, 0xe7,
+	0x3e, 0x57, 0x3f, 0x0b, 0xa5, 0x71, 0x45, 0x63, 0x58, 0x1a, 0x57, 0x2c, 0x54, 0x91, 0x32, 0xe7,
+	0x5a, 0x26, 0x24, 0x85, 0xcb, 0x01, 0x72, 0xc0, 0x74, 0x53, 0xbf, 0x8c, 0x56, 0x37, 0xaf, 0xfe,
+	0x72, 0x54, 0x44, 0x8f, 0x8e, 0x8a, 0xe8, 0xcf, 0xa3, 0x22, 0xfa, 0xec, 0xb8, 0x38, 0xf2, 0xe8,
+	0xb8, 0x38, 0xf2, 0xc7, 0x71, 0x71, 0x64, 0x6f, 0x5d, 0xd3, 0xed, 0x46, 0xa7, 0x26, 0xd5, 0x59,
+	0xcb, 0x5d, 0xaa, 0x6c, 0x50, 0xfb, 0x23, 0x66, 0x7e, 0x28, 0x46, 0x4d, 0xba, 0xaf, 0x51, 0x53,
+	0xfe, 0x98, 0xef, 0x50, 0x1b, 0xe3, 0x59, 0xe5, 0xd5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0f,
+	0x4a, 0xd9, 0xf0, 0x6d, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2825,6 +2835,11 @@ func (m *AnchorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -3324,6 +3339,9 @@ func (m *AnchorInfo) Size() (n int) {
 		l = m.Timestamp.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -5774,6 +5792,25 @@ func (m *AnchorInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
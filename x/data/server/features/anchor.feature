@@ -26,3 +26,14 @@ Feature: Anchor
       Given alice has anchored the data at block time "2020-01-01"
       When bob attempts to anchor the data at block time "2020-01-02"
       Then the anchor entry exists with timestamp "2020-01-01"
+
+  Rule: the block height is recorded when the data is first anchored
+
+    Scenario: the data has not been anchored
+      When alice attempts to anchor the data at block height "10"
+      Then the anchor entry exists with height "10"
+
+    Scenario: the data has already been anchored
+      Given alice has anchored the data at block height "10"
+      When alice attempts to anchor the data at block height "11"
+      Then the anchor entry exists with height "10"
@@ -38,3 +38,22 @@ Feature: Attest
       Given alice has attested to the data at block time "2020-01-01"
       When bob attempts to attest to the data at block time "2020-01-02"
       Then the attestor entry for bob exists with timestamp "2020-01-02"
+
+  Rule: the response includes the result of attesting to each content hash
+
+    Scenario: the data is attested to alongside data already attested to
+      Given alice has attested to the data at block time "2020-01-01"
+      When alice attempts to attest to the data and data with hash "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=" at block time "2020-01-02"
+      Then expect the attest results
+      """
+      [
+        {
+          "iri": "regen:13toVfvC2YxrrfSXWB5h2BGHiXZURsKxWUz72uDRDSPMCrYPguGUXSC.rdf",
+          "attested": false
+        },
+        {
+          "iri": "regen:13toVfvdftodu8c1Jc4TXxCnq7XLRAe4p9MgDKF2VeFKMx9eZXMgGnB.rdf",
+          "attested": true
+        }
+      ]
+      """
@@ -0,0 +1,47 @@
+Feature: RevokeAttestation
+
+  Background:
+    Given the content hash
+    """
+    {
+      "graph": {
+        "hash": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+        "digest_algorithm": 1,
+        "canonicalization_algorithm": 1
+      }
+    }
+    """
+
+  Rule: the attestation is removed if the attestor has attested to the data
+
+    Scenario: the data has been attested to by the attestor
+      Given alice has attested to the data
+      When alice attempts to revoke the attestation
+      Then expect no error
+      And the attestor entry for alice does not exist
+
+    Scenario: the data has been attested to by the attestor and another address
+      Given alice has attested to the data
+      And bob has attested to the data
+      When alice attempts to revoke the attestation
+      Then expect no error
+      And the attestor entry for alice does not exist
+      And the attestor entry for bob exists
+
+  Rule: an error is returned if the attestor has not attested to the data
+
+    Scenario: the data has not been anchored
+      When alice attempts to revoke the attestation
+      Then expect the error contains "data record with IRI regen:13toVfvC2YxrrfSXWB5h2BGHiXZURsKxWUz72uDRDSPMCrYPguGUXSC.rdf: not found"
+
+    Scenario: the data has only been attested to by another address
+      Given bob has attested to the data
+      When alice attempts to revoke the attestation
+      Then expect the error contains "has not attested to regen:13toVfvC2YxrrfSXWB5h2BGHiXZURsKxWUz72uDRDSPMCrYPguGUXSC.rdf: not found"
+
+  Rule: an event is emitted with the IRI of the data
+
+    Scenario: the attestation is revoked
+      Given alice has attested to the data
+      When alice attempts to revoke the attestation
+      Then expect the revoke attestation event with iri "regen:13toVfvC2YxrrfSXWB5h2BGHiXZURsKxWUz72uDRDSPMCrYPguGUXSC.rdf"
@@ -95,6 +95,7 @@ func (s serverImpl) anchorAndGetTimestamp(ctx context.Context, id []byte, iri st
 			err = s.stateStore.DataAnchorTable().Insert(ctx, &api.DataAnchor{
 				Id:        id,
 				Timestamp: types.GogoToProtobufTimestamp(timestamp),
+				Height:    sdkCtx.BlockHeight(),
 			})
 			if err != nil {
 				return nil, err
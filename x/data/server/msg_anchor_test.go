@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -75,6 +76,30 @@ func (s *anchorSuite) BobAttemptsToAnchorTheDataAtBlockTime(a string) {
 	})
 }
 
+func (s *anchorSuite) AliceHasAnchoredTheDataAtBlockHeight(a string) {
+	blockHeight, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx.WithBlockHeight(blockHeight))
+
+	_, s.err = s.server.Anchor(s.ctx, &data.MsgAnchor{
+		Sender:      s.alice.String(),
+		ContentHash: s.ch,
+	})
+}
+
+func (s *anchorSuite) AliceAttemptsToAnchorTheDataAtBlockHeight(a string) {
+	blockHeight, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx.WithBlockHeight(blockHeight))
+
+	_, s.err = s.server.Anchor(s.ctx, &data.MsgAnchor{
+		Sender:      s.alice.String(),
+		ContentHash: s.ch,
+	})
+}
+
 func (s *anchorSuite) TheAnchorEntryExistsWithTimestamp(a string) {
 	anchorTime, err := types.ParseDate("anchor timestamp", a)
 	require.NoError(s.t, err)
@@ -92,3 +117,18 @@ func (s *anchorSuite) TheAnchorEntryExistsWithTimestamp(a string) {
 	require.NotNil(s.t, dataAnchor)
 	require.Equal(s.t, anchorTime, dataAnchor.Timestamp.AsTime())
 }
+
+func (s *anchorSuite) TheAnchorEntryExistsWithHeight(a string) {
+	anchorHeight, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	iri, err := s.ch.ToIRI()
+	require.NoError(s.t, err)
+
+	dataId, err := s.server.stateStore.DataIDTable().GetByIri(s.ctx, iri)
+	require.NoError(s.t, err)
+
+	dataAnchor, err := s.server.stateStore.DataAnchorTable().Get(s.ctx, dataId.Id)
+	require.NoError(s.t, err)
+	require.Equal(s.t, anchorHeight, dataAnchor.Height)
+}
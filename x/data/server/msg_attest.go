@@ -18,6 +18,7 @@ func (s serverImpl) Attest(ctx context.Context, request *data.MsgAttest) (*data.
 	timestamp := timestamppb.New(sdkCtx.BlockTime())
 
 	var iris []string // only the IRIs for new attestations
+	results := make([]*data.AttestResult, 0, len(request.ContentHashes))
 
 	for _, ch := range request.ContentHashes {
 		iri, id, _, err := s.anchorAndGetIRI(ctx, ch)
@@ -35,6 +36,7 @@ func (s serverImpl) Attest(ctx context.Context, request *data.MsgAttest) (*data.
 			return nil, err
 		} else if found {
 			// an attestor attesting to the same piece of date is a no-op
+			results = append(results, &data.AttestResult{Iri: iri})
 			continue
 		}
 
@@ -56,6 +58,7 @@ func (s serverImpl) Attest(ctx context.Context, request *data.MsgAttest) (*data.
 		}
 
 		iris = append(iris, iri)
+		results = append(results, &data.AttestResult{Iri: iri, Attested: true})
 
 		sdkCtx.GasMeter().ConsumeGas(data.GasCostPerIteration, "data/Attest content hash iteration")
 	}
@@ -63,5 +66,6 @@ func (s serverImpl) Attest(ctx context.Context, request *data.MsgAttest) (*data.
 	return &data.MsgAttestResponse{
 		Iris:      iris,
 		Timestamp: types.ProtobufToGogoTimestamp(timestamp),
+		Results:   results,
 	}, nil
 }
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -18,6 +20,7 @@ type attestSuite struct {
 	alice sdk.AccAddress
 	bob   sdk.AccAddress
 	ch    *data.ContentHash
+	res   *data.MsgAttestResponse
 	err   error
 }
 
@@ -87,6 +90,33 @@ func (s *attestSuite) BobAttemptsToAttestToTheDataAtBlockTime(a string) {
 	})
 }
 
+func (s *attestSuite) AliceAttemptsToAttestToTheDataAndDataWithHashAtBlockTime(a string, b string) {
+	hash, err := base64.StdEncoding.DecodeString(a)
+	require.NoError(s.t, err)
+
+	blockTime, err := types.ParseDate("block time", b)
+	require.NoError(s.t, err)
+
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx.WithBlockTime(blockTime))
+
+	other := *s.ch.Graph
+	other.Hash = hash
+
+	s.res, s.err = s.server.Attest(s.ctx, &data.MsgAttest{
+		Attestor:      s.alice.String(),
+		ContentHashes: []*data.ContentHash_Graph{s.ch.Graph, &other},
+	})
+}
+
+func (s *attestSuite) ExpectTheAttestResults(a gocuke.DocString) {
+	var results []*data.AttestResult
+	err := json.Unmarshal([]byte(a.Content), &results)
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.err)
+	require.Equal(s.t, results, s.res.Results)
+}
+
 func (s *attestSuite) TheAnchorEntryExistsWithTimestamp(a string) {
 	anchorTime, err := types.ParseDate("anchor timestamp", a)
 	require.NoError(s.t, err)
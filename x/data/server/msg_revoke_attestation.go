@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// RevokeAttestation removes an attestation previously made by the attestor.
+func (s serverImpl) RevokeAttestation(ctx context.Context, request *data.MsgRevokeAttestation) (*data.MsgRevokeAttestationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	iri, err := request.ContentHash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Attestor)
+	if err != nil {
+		return nil, err
+	}
+
+	dataId, err := s.stateStore.DataIDTable().GetByIri(ctx, iri)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("data record with IRI %s", iri)
+	}
+
+	dataAttestor, err := s.stateStore.DataAttestorTable().Get(ctx, dataId.Id, addr)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("attestor %s has not attested to %s", request.Attestor, iri)
+	}
+
+	err = s.stateStore.DataAttestorTable().Delete(ctx, dataAttestor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sdkCtx.EventManager().EmitTypedEvent(&data.EventRevokeAttestation{
+		Iri:      iri,
+		Attestor: request.Attestor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgRevokeAttestationResponse{Iri: iri}, nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+type revokeAttestationSuite struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	ch    *data.ContentHash
+	err   error
+}
+
+func TestRevokeAttestation(t *testing.T) {
+	runner := gocuke.NewRunner(t, &revokeAttestationSuite{}).Path("./features/revoke_attestation.feature")
+	runner.Step(`^the\s+content\s+hash\s+"((?:[^\"]|\")*)"`, (*revokeAttestationSuite).TheContentHash)
+	runner.Run()
+}
+
+func (s *revokeAttestationSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+}
+
+func (s *revokeAttestationSuite) TheContentHash(a gocuke.DocString) {
+	s.ch = &data.ContentHash{}
+	err := jsonpb.UnmarshalString(a.Content, s.ch)
+	require.NoError(s.t, err)
+}
+
+func (s *revokeAttestationSuite) AliceHasAttestedToTheData() {
+	_, err := s.server.Attest(s.ctx, &data.MsgAttest{
+		Attestor:      s.alice.String(),
+		ContentHashes: []*data.ContentHash_Graph{s.ch.Graph},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeAttestationSuite) BobHasAttestedToTheData() {
+	_, err := s.server.Attest(s.ctx, &data.MsgAttest{
+		Attestor:      s.bob.String(),
+		ContentHashes: []*data.ContentHash_Graph{s.ch.Graph},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeAttestationSuite) AliceAttemptsToRevokeTheAttestation() {
+	_, s.err = s.server.RevokeAttestation(s.ctx, &data.MsgRevokeAttestation{
+		Attestor:    s.alice.String(),
+		ContentHash: s.ch.Graph,
+	})
+}
+
+func (s *revokeAttestationSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *revokeAttestationSuite) ExpectTheErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *revokeAttestationSuite) TheAttestorEntryForAliceDoesNotExist() {
+	found, err := s.server.stateStore.DataAttestorTable().Has(s.ctx, s.getDataId(), s.alice)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *revokeAttestationSuite) TheAttestorEntryForBobExists() {
+	found, err := s.server.stateStore.DataAttestorTable().Has(s.ctx, s.getDataId(), s.bob)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *revokeAttestationSuite) ExpectTheRevokeAttestationEventWithIri(a string) {
+	require.NoError(s.t, s.err)
+
+	var found bool
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != proto.MessageName(&data.EventRevokeAttestation{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(s.t, err)
+
+		e := msg.(*data.EventRevokeAttestation)
+		require.Equal(s.t, a, e.Iri)
+		require.Equal(s.t, s.alice.String(), e.Attestor)
+		found = true
+	}
+	require.True(s.t, found)
+}
+
+func (s *revokeAttestationSuite) getDataId() []byte {
+	iri, err := s.ch.ToIRI()
+	require.NoError(s.t, err)
+
+	dataId, err := s.server.stateStore.DataIDTable().GetByIri(s.ctx, iri)
+	require.NoError(s.t, err)
+
+	return dataId.Id
+}
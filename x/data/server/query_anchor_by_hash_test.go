@@ -38,6 +38,7 @@ func TestQuery_AnchorByHash(t *testing.T) {
 	err = s.server.stateStore.DataAnchorTable().Insert(s.ctx, &api.DataAnchor{
 		Id:        id,
 		Timestamp: timestamp,
+		Height:    10,
 	})
 	require.NoError(t, err)
 
@@ -50,6 +51,7 @@ func TestQuery_AnchorByHash(t *testing.T) {
 	require.Equal(t, ch, res.Anchor.ContentHash)
 	require.Equal(t, timestamp.Seconds, res.Anchor.Timestamp.Seconds)
 	require.Equal(t, timestamp.Nanos, res.Anchor.Timestamp.Nanos)
+	require.Equal(t, int64(10), res.Anchor.Height)
 
 	// query data anchor with empty content hash
 	_, err = s.server.AnchorByHash(s.ctx, &data.QueryAnchorByHashRequest{})
@@ -70,4 +72,25 @@ func TestQuery_AnchorByHash(t *testing.T) {
 		}},
 	})
 	require.EqualError(t, err, "data record with content hash: not found")
+
+	// insert data id without a data anchor
+	unanchored := &data.ContentHash{Graph: &data.ContentHash_Graph{
+		Hash:                      bytes.Repeat([]byte{2}, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+	}}
+	unanchoredIri, err := unanchored.ToIRI()
+	require.NoError(t, err)
+
+	err = s.server.stateStore.DataIDTable().Insert(s.ctx, &api.DataID{
+		Id:  []byte{2},
+		Iri: unanchoredIri,
+	})
+	require.NoError(t, err)
+
+	// query data anchor with content hash that has a data id but no anchor
+	_, err = s.server.AnchorByHash(s.ctx, &data.QueryAnchorByHashRequest{
+		ContentHash: unanchored,
+	})
+	require.EqualError(t, err, "data anchor with content hash: not found")
 }
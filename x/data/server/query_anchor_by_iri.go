@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/regen-network/regen-ledger/types"
@@ -27,6 +28,9 @@ func (s serverImpl) AnchorByIRI(ctx context.Context, request *data.QueryAnchorBy
 
 	anchor, err := s.stateStore.DataAnchorTable().Get(ctx, dataId.Id)
 	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrap("data anchor with IRI")
+		}
 		return nil, err
 	}
 
@@ -35,6 +39,7 @@ func (s serverImpl) AnchorByIRI(ctx context.Context, request *data.QueryAnchorBy
 			Iri:         request.Iri,
 			ContentHash: contentHash,
 			Timestamp:   types.ProtobufToGogoTimestamp(anchor.Timestamp),
+			Height:      anchor.Height,
 		},
 	}, nil
 }
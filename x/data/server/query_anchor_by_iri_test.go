@@ -39,6 +39,7 @@ func TestQuery_AnchorByIRI(t *testing.T) {
 	err = s.server.stateStore.DataAnchorTable().Insert(s.ctx, &api.DataAnchor{
 		Id:        id,
 		Timestamp: timestamp,
+		Height:    10,
 	})
 	require.NoError(t, err)
 
@@ -51,6 +52,7 @@ func TestQuery_AnchorByIRI(t *testing.T) {
 	require.Equal(t, ch, res.Anchor.ContentHash)
 	require.Equal(t, timestamp.Seconds, res.Anchor.Timestamp.Seconds)
 	require.Equal(t, timestamp.Nanos, res.Anchor.Timestamp.Nanos)
+	require.Equal(t, int64(10), res.Anchor.Height)
 
 	// query data anchor with empty iri
 	_, err = s.server.AnchorByIRI(s.ctx, &data.QueryAnchorByIRIRequest{})
@@ -79,13 +79,16 @@ func (m *DataID) GetIri() string {
 	return ""
 }
 
-// DataAnchor stores the anchor timestamp for a data object.
+// DataAnchor stores the anchor timestamp and height for a data object.
 type DataAnchor struct {
 	// id is the compact data ID.
 	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// timestamp is the anchor timestamp for this object - the time at which
 	// it was first known to the blockchain.
 	Timestamp *types.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// height is the block height at which this object was first known to the
+	// blockchain.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *DataAnchor) Reset()         { *m = DataAnchor{} }
@@ -135,6 +138,13 @@ func (m *DataAnchor) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+func (m *DataAnchor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // DataAttestor is a join table for associating data IDs and attestors.
 type DataAttestor struct {
 	// id is the compact data ID.
@@ -331,34 +341,35 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1/state.proto", fileDescriptor_29cc90d94a9e9542) }
 
 var fileDescriptor_29cc90d94a9e9542 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
+	// 450 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x69, 0xed, 0xb6, 0xaf, 0xd9, 0x12, 0xa2, 0x87, 0xd9, 0x80, 0x69, 0x09, 0x28,
-	0x7b, 0xa8, 0x19, 0xaa, 0x17, 0xe9, 0x4d, 0x59, 0x85, 0xbd, 0x06, 0x4f, 0x7a, 0x90, 0x69, 0x33,
-	0x9b, 0x0e, 0x26, 0x99, 0x32, 0x99, 0x44, 0x3f, 0x85, 0x78, 0x17, 0xfc, 0x3c, 0x1e, 0x17, 0xbc,
-	0x78, 0x94, 0xf6, 0x1b, 0xf8, 0x09, 0x24, 0x2f, 0x4d, 0x76, 0xc5, 0xbd, 0x78, 0xcb, 0xcb, 0xfb,
-	0xcf, 0xff, 0xf7, 0xe7, 0xcf, 0x83, 0x33, 0x2d, 0x12, 0x91, 0xb3, 0x98, 0x1b, 0xce, 0xaa, 0x25,
-	0x2b, 0x0c, 0x37, 0x22, 0xdc, 0x69, 0x65, 0x94, 0x7b, 0x8a, 0xab, 0xb0, 0x5e, 0x85, 0xd5, 0xd2,
-	0x7b, 0xb8, 0x51, 0x45, 0xa6, 0x0a, 0xa6, 0x74, 0xc6, 0xaa, 0x25, 0x4f, 0x77, 0x5b, 0xbe, 0xac,
-	0x87, 0x46, 0xed, 0xcd, 0x12, 0xa5, 0x92, 0x54, 0x30, 0x9c, 0xd6, 0xe5, 0x15, 0x33, 0x32, 0x13,
-	0x85, 0xe1, 0xd9, 0xae, 0x11, 0x04, 0xaf, 0x60, 0x78, 0xc1, 0x0d, 0xbf, 0xbc, 0x70, 0xa7, 0x60,
-	0xc9, 0x98, 0x92, 0x39, 0x39, 0xb7, 0x23, 0x4b, 0xc6, 0xae, 0x03, 0x7d, 0xa9, 0x25, 0xb5, 0xe6,
-	0xe4, 0x7c, 0x1c, 0xd5, 0x9f, 0xab, 0xb3, 0xdf, 0xdf, 0x7e, 0x7c, 0xee, 0xdf, 0x87, 0x41, 0xad,
-	0x74, 0xc7, 0xb8, 0x77, 0x08, 0x25, 0x94, 0x04, 0x57, 0x00, 0xb5, 0xcd, 0x8b, 0x7c, 0xb3, 0x55,
-	0xfa, 0x1f, 0xab, 0xe7, 0x30, 0xee, 0xb8, 0x68, 0x38, 0x79, 0xea, 0x85, 0x4d, 0xb2, 0xb0, 0x4d,
-	0x16, 0xbe, 0x69, 0x15, 0xd1, 0x8d, 0x78, 0x35, 0x45, 0xe4, 0xa8, 0x41, 0x52, 0x2b, 0xf8, 0x4a,
-	0xc0, 0x46, 0x90, 0x31, 0xa2, 0x30, 0x77, 0xa0, 0x3c, 0x18, 0xf1, 0xe3, 0x0e, 0x49, 0x76, 0xd4,
-	0xcd, 0x7f, 0xc7, 0xe8, 0xff, 0x4f, 0x8c, 0x47, 0x18, 0x63, 0x06, 0xa7, 0x30, 0x91, 0xf1, 0xa2,
-	0x33, 0xb4, 0x6f, 0x60, 0x0e, 0xa1, 0xfd, 0x20, 0x87, 0x51, 0x24, 0x0a, 0x95, 0x56, 0xe2, 0x76,
-	0xb0, 0x41, 0x5b, 0x67, 0xa9, 0xd3, 0xb6, 0xce, 0x52, 0xa7, 0x2e, 0x85, 0x93, 0x8c, 0xe7, 0x3c,
-	0x11, 0x1a, 0xc3, 0xd8, 0x51, 0x3b, 0xae, 0x1e, 0x23, 0x6e, 0x0e, 0xc3, 0xda, 0xc3, 0x21, 0xee,
-	0x09, 0xbe, 0x75, 0x88, 0x3b, 0xe9, 0x9e, 0x38, 0x16, 0x1d, 0x04, 0xef, 0x9a, 0x32, 0xee, 0x60,
-	0x36, 0x65, 0xcc, 0x60, 0xa2, 0x8f, 0xbb, 0xf7, 0x75, 0x7d, 0x18, 0x06, 0xda, 0x5f, 0x97, 0xf1,
-	0xca, 0x43, 0xd0, 0x03, 0x70, 0x60, 0x2a, 0xe3, 0xc5, 0x6d, 0xed, 0xbd, 0x97, 0xaf, 0xbf, 0xef,
-	0x7d, 0x72, 0xbd, 0xf7, 0xc9, 0xaf, 0xbd, 0x4f, 0xbe, 0x1c, 0xfc, 0xde, 0xf5, 0xc1, 0xef, 0xfd,
-	0x3c, 0xf8, 0xbd, 0xb7, 0x8b, 0x44, 0x9a, 0x6d, 0xb9, 0x0e, 0x37, 0x2a, 0x63, 0x78, 0x8d, 0x4f,
-	0x72, 0x61, 0x3e, 0x2a, 0xfd, 0xe1, 0x38, 0xa5, 0x22, 0x4e, 0x84, 0x66, 0x9f, 0xf0, 0x7e, 0xd7,
-	0x43, 0xac, 0xf6, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x6b, 0xfb, 0x2b, 0xd4, 0x02,
+	0x18, 0x86, 0x3b, 0x49, 0xed, 0xb6, 0x5f, 0xb3, 0x25, 0x8c, 0x22, 0xb3, 0x01, 0xd3, 0x12, 0x50,
+	0x7a, 0xa8, 0x19, 0xaa, 0x17, 0xc9, 0x4d, 0x59, 0x85, 0xbd, 0x06, 0x4f, 0x7a, 0x90, 0x69, 0x33,
+	0x26, 0x83, 0x49, 0xa6, 0x4c, 0xa6, 0xd5, 0x93, 0x3f, 0x41, 0xbc, 0x0b, 0xfe, 0x1e, 0x8f, 0x0b,
+	0x5e, 0x3c, 0x4a, 0xfb, 0x0f, 0xfc, 0x05, 0x92, 0x49, 0x93, 0x5d, 0x71, 0x2f, 0x7b, 0xcb, 0x9b,
+	0xef, 0x9d, 0xef, 0x7d, 0x78, 0xf9, 0xe0, 0x4c, 0xf1, 0x94, 0x97, 0x34, 0x61, 0x9a, 0xd1, 0xdd,
+	0x92, 0x56, 0x9a, 0x69, 0x1e, 0x6e, 0x94, 0xd4, 0x12, 0x9f, 0x9a, 0x51, 0x58, 0x8f, 0xc2, 0xdd,
+	0xd2, 0x7b, 0xb0, 0x96, 0x55, 0x21, 0x2b, 0x2a, 0x55, 0x41, 0x77, 0x4b, 0x96, 0x6f, 0x32, 0xb6,
+	0xac, 0x45, 0xe3, 0xf6, 0xa6, 0xa9, 0x94, 0x69, 0xce, 0xa9, 0x51, 0xab, 0xed, 0x7b, 0xaa, 0x45,
+	0xc1, 0x2b, 0xcd, 0x8a, 0x4d, 0x63, 0x08, 0x5e, 0xc2, 0xe0, 0x9c, 0x69, 0x76, 0x71, 0x8e, 0x27,
+	0x60, 0x89, 0x84, 0xa0, 0x19, 0x9a, 0x3b, 0xb1, 0x25, 0x12, 0xec, 0x82, 0x2d, 0x94, 0x20, 0xd6,
+	0x0c, 0xcd, 0x47, 0x71, 0xfd, 0x19, 0x9d, 0xfd, 0xf9, 0xfe, 0xf3, 0x8b, 0x7d, 0x17, 0xfa, 0xb5,
+	0x13, 0x8f, 0xcc, 0xdc, 0x45, 0x04, 0x11, 0x14, 0x7c, 0x06, 0xa8, 0xd7, 0x3c, 0x2f, 0xd7, 0x99,
+	0x54, 0xff, 0xad, 0x7a, 0x06, 0xa3, 0x2e, 0xd7, 0x2c, 0x1c, 0x3f, 0xf1, 0xc2, 0x86, 0x2c, 0x6c,
+	0xc9, 0xc2, 0xd7, 0xad, 0x23, 0xbe, 0x32, 0xe3, 0xfb, 0x30, 0xc8, 0xb8, 0x48, 0x33, 0x4d, 0xec,
+	0x19, 0x9a, 0xdb, 0xf1, 0x51, 0x45, 0x13, 0x83, 0x32, 0x6c, 0x50, 0x88, 0x15, 0x7c, 0x43, 0xe0,
+	0x18, 0x00, 0xad, 0x79, 0xa5, 0x6f, 0x40, 0xf0, 0x60, 0xc8, 0x8e, 0x33, 0x43, 0xe0, 0xc4, 0x9d,
+	0xfe, 0x17, 0xcf, 0xbe, 0x05, 0x5e, 0xf4, 0xd0, 0x60, 0x4c, 0xe1, 0x14, 0xc6, 0x22, 0x59, 0x74,
+	0x0b, 0x9d, 0xab, 0x30, 0x17, 0x11, 0x3b, 0x28, 0x61, 0x18, 0xf3, 0x4a, 0xe6, 0x3b, 0x7e, 0x1d,
+	0xac, 0xdf, 0xd6, 0xbc, 0x55, 0x79, 0x5b, 0xf3, 0x56, 0xe5, 0x98, 0xc0, 0x49, 0xc1, 0x4a, 0x96,
+	0x72, 0x65, 0x60, 0x9c, 0xb8, 0x95, 0xd1, 0x23, 0x13, 0x37, 0x83, 0x41, 0xbd, 0xc3, 0x45, 0xf8,
+	0xc4, 0xbc, 0x75, 0x11, 0x1e, 0x77, 0x4f, 0x5c, 0x8b, 0xf4, 0x83, 0xb7, 0x4d, 0x19, 0x37, 0x64,
+	0x36, 0x65, 0x4c, 0x61, 0xac, 0x8e, 0xb3, 0x77, 0x75, 0x7d, 0x06, 0x06, 0xda, 0x5f, 0x17, 0x49,
+	0xe4, 0x99, 0xa0, 0x7b, 0xe0, 0xc2, 0x44, 0x24, 0x8b, 0xeb, 0xde, 0x3b, 0x2f, 0x5e, 0xfd, 0xd8,
+	0xfb, 0xe8, 0x72, 0xef, 0xa3, 0xdf, 0x7b, 0x1f, 0x7d, 0x3d, 0xf8, 0xbd, 0xcb, 0x83, 0xdf, 0xfb,
+	0x75, 0xf0, 0x7b, 0x6f, 0x16, 0xa9, 0xd0, 0xd9, 0x76, 0x15, 0xae, 0x65, 0x41, 0xcd, 0x95, 0x3e,
+	0x2e, 0xb9, 0xfe, 0x28, 0xd5, 0x87, 0xa3, 0xca, 0x79, 0x92, 0x72, 0x45, 0x3f, 0x99, 0xbb, 0x5e,
+	0x0d, 0x4c, 0xb5, 0x4f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x02, 0x9c, 0x7d, 0xc0, 0xec, 0x02,
 	0x00, 0x00,
 }
 
@@ -419,6 +430,11 @@ func (m *DataAnchor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -609,6 +625,9 @@ func (m *DataAnchor) Size() (n int) {
 		l = m.Timestamp.Size()
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovState(uint64(m.Height))
+	}
 	return n
 }
 
@@ -890,6 +909,25 @@ func (m *DataAnchor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
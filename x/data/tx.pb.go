@@ -208,6 +208,9 @@ type MsgAttestResponse struct {
 	Iris []string `protobuf:"bytes,1,rep,name=iris,proto3" json:"iris,omitempty"`
 	// timestamp is the time at which any new attestations were made.
 	Timestamp *types.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// results are the results of attesting to each content hash, listed in the
+	// same order as the content hashes in the request.
+	Results []*AttestResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (m *MsgAttestResponse) Reset()         { *m = MsgAttestResponse{} }
@@ -257,6 +260,171 @@ func (m *MsgAttestResponse) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+func (m *MsgAttestResponse) GetResults() []*AttestResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// AttestResult is the result of attesting to a single content hash.
+type AttestResult struct {
+	// iri is the IRI of the data.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
+	// attested is true if a new attestation was made and false if the attestor
+	// had already attested to the data, in which case the previous attestation
+	// was not updated.
+	Attested bool `protobuf:"varint,2,opt,name=attested,proto3" json:"attested,omitempty"`
+}
+
+func (m *AttestResult) Reset()         { *m = AttestResult{} }
+func (m *AttestResult) String() string { return proto.CompactTextString(m) }
+func (*AttestResult) ProtoMessage()    {}
+func (*AttestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c87f072557099c45, []int{4}
+}
+func (m *AttestResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestResult.Merge(m, src)
+}
+func (m *AttestResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestResult proto.InternalMessageInfo
+
+func (m *AttestResult) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
+}
+
+func (m *AttestResult) GetAttested() bool {
+	if m != nil {
+		return m.Attested
+	}
+	return false
+}
+
+// MsgRevokeAttestation is the Msg/RevokeAttestation request type.
+type MsgRevokeAttestation struct {
+	// attestor is the address of the account revoking the attestation.
+	Attestor string `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// content_hash is the content hash of the data attested to.
+	ContentHash *ContentHash_Graph `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (m *MsgRevokeAttestation) Reset()         { *m = MsgRevokeAttestation{} }
+func (m *MsgRevokeAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttestation) ProtoMessage()    {}
+func (*MsgRevokeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c87f072557099c45, []int{5}
+}
+func (m *MsgRevokeAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttestation.Merge(m, src)
+}
+func (m *MsgRevokeAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttestation proto.InternalMessageInfo
+
+func (m *MsgRevokeAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttestation) GetContentHash() *ContentHash_Graph {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+// MsgRevokeAttestationResponse is the Msg/RevokeAttestation response type.
+type MsgRevokeAttestationResponse struct {
+	// iri is the IRI of the data for which the attestation was revoked.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
+}
+
+func (m *MsgRevokeAttestationResponse) Reset()         { *m = MsgRevokeAttestationResponse{} }
+func (m *MsgRevokeAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttestationResponse) ProtoMessage()    {}
+func (*MsgRevokeAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c87f072557099c45, []int{6}
+}
+func (m *MsgRevokeAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttestationResponse.Merge(m, src)
+}
+func (m *MsgRevokeAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttestationResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeAttestationResponse) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
+}
+
 // MsgDefineResolver is the Msg/DefineResolver request type.
 type MsgDefineResolver struct {
 	// manager is the address of the resolver manager. The manager is able
@@ -276,7 +444,7 @@ func (m *MsgDefineResolver) Reset()         { *m = MsgDefineResolver{} }
 func (m *MsgDefineResolver) String() string { return proto.CompactTextString(m) }
 func (*MsgDefineResolver) ProtoMessage()    {}
 func (*MsgDefineResolver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c87f072557099c45, []int{4}
+	return fileDescriptor_c87f072557099c45, []int{7}
 }
 func (m *MsgDefineResolver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +498,7 @@ func (m *MsgDefineResolverResponse) Reset()         { *m = MsgDefineResolverResp
 func (m *MsgDefineResolverResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDefineResolverResponse) ProtoMessage()    {}
 func (*MsgDefineResolverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c87f072557099c45, []int{5}
+	return fileDescriptor_c87f072557099c45, []int{8}
 }
 func (m *MsgDefineResolverResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +550,7 @@ func (m *MsgRegisterResolver) Reset()         { *m = MsgRegisterResolver{} }
 func (m *MsgRegisterResolver) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterResolver) ProtoMessage()    {}
 func (*MsgRegisterResolver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c87f072557099c45, []int{6}
+	return fileDescriptor_c87f072557099c45, []int{9}
 }
 func (m *MsgRegisterResolver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +608,7 @@ func (m *MsgRegisterResolverResponse) Reset()         { *m = MsgRegisterResolver
 func (m *MsgRegisterResolverResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterResolverResponse) ProtoMessage()    {}
 func (*MsgRegisterResolverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c87f072557099c45, []int{7}
+	return fileDescriptor_c87f072557099c45, []int{10}
 }
 func (m *MsgRegisterResolverResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,6 +642,9 @@ func init() {
 	proto.RegisterType((*MsgAnchorResponse)(nil), "regen.data.v1.MsgAnchorResponse")
 	proto.RegisterType((*MsgAttest)(nil), "regen.data.v1.MsgAttest")
 	proto.RegisterType((*MsgAttestResponse)(nil), "regen.data.v1.MsgAttestResponse")
+	proto.RegisterType((*AttestResult)(nil), "regen.data.v1.AttestResult")
+	proto.RegisterType((*MsgRevokeAttestation)(nil), "regen.data.v1.MsgRevokeAttestation")
+	proto.RegisterType((*MsgRevokeAttestationResponse)(nil), "regen.data.v1.MsgRevokeAttestationResponse")
 	proto.RegisterType((*MsgDefineResolver)(nil), "regen.data.v1.MsgDefineResolver")
 	proto.RegisterType((*MsgDefineResolverResponse)(nil), "regen.data.v1.MsgDefineResolverResponse")
 	proto.RegisterType((*MsgRegisterResolver)(nil), "regen.data.v1.MsgRegisterResolver")
@@ -483,40 +654,46 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1/tx.proto", fileDescriptor_c87f072557099c45) }
 
 var fileDescriptor_c87f072557099c45 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0x2a, 0x90, 0x49, 0x5b, 0x95, 0x45, 0xaa, 0x5c, 0x23, 0x5c, 0xe3, 0x53, 0x84,
-	0xc0, 0x56, 0xc3, 0x85, 0x03, 0x1c, 0x0a, 0x15, 0x85, 0x43, 0x24, 0x64, 0xc1, 0x05, 0x21, 0x45,
-	0x9b, 0x64, 0xba, 0xb6, 0x48, 0xbc, 0xd6, 0xee, 0x26, 0x94, 0xbf, 0x40, 0xe2, 0xa7, 0x38, 0x56,
-	0xe2, 0xc2, 0x11, 0x25, 0x3f, 0x82, 0xb2, 0xb6, 0xb7, 0x8d, 0x93, 0x92, 0x03, 0xb7, 0x9d, 0x99,
-	0x37, 0x6f, 0xe6, 0xe5, 0x4d, 0x0c, 0x87, 0x02, 0x19, 0xa6, 0xe1, 0x88, 0x2a, 0x1a, 0xce, 0x4e,
-	0x42, 0x75, 0x19, 0x64, 0x82, 0x2b, 0x4e, 0xf6, 0x74, 0x3e, 0x58, 0xe6, 0x83, 0xd9, 0x89, 0x73,
-	0xcc, 0x38, 0x67, 0x63, 0x0c, 0x75, 0x71, 0x30, 0xbd, 0x08, 0x55, 0x32, 0x41, 0xa9, 0xe8, 0x24,
-	0xcb, 0xf1, 0xce, 0x51, 0x85, 0xe7, 0x5b, 0x86, 0x32, 0x2f, 0xf9, 0x03, 0x68, 0xf5, 0x24, 0x3b,
-	0x4d, 0x87, 0x31, 0x17, 0xe4, 0x10, 0x9a, 0x12, 0xd3, 0x11, 0x0a, 0xdb, 0xf2, 0xac, 0x4e, 0x2b,
-	0x2a, 0x22, 0xf2, 0x12, 0x76, 0x87, 0x3c, 0x55, 0x98, 0xaa, 0x7e, 0x4c, 0x65, 0x6c, 0xd7, 0x3d,
-	0xab, 0xd3, 0xee, 0x3a, 0xc1, 0xca, 0x1a, 0xc1, 0xeb, 0x1c, 0xf2, 0x96, 0xca, 0x38, 0x6a, 0x0f,
-	0xaf, 0x03, 0xbf, 0x0f, 0xf7, 0xcc, 0x8c, 0x08, 0x65, 0xc6, 0x53, 0x89, 0xe4, 0x00, 0x1a, 0x89,
-	0x48, 0x8a, 0x41, 0xcb, 0x27, 0x79, 0x0e, 0x2d, 0xb3, 0xb8, 0x19, 0x91, 0x4b, 0x0b, 0x4a, 0x69,
-	0xc1, 0x87, 0x12, 0x11, 0x5d, 0x83, 0xfd, 0x2c, 0x17, 0xa1, 0x14, 0x4a, 0x45, 0x1c, 0xb8, 0x4b,
-	0xf5, 0x8b, 0x97, 0x32, 0x4c, 0x4c, 0xce, 0x61, 0xff, 0xa6, 0x10, 0x94, 0x76, 0xdd, 0x6b, 0x74,
-	0xda, 0x5d, 0xef, 0x76, 0x29, 0xc1, 0xb9, 0xa0, 0x59, 0x1c, 0xed, 0xdd, 0x10, 0x84, 0xd2, 0xa7,
-	0xb9, 0x24, 0xcd, 0x6b, 0x24, 0x11, 0xd8, 0x49, 0x44, 0x22, 0x6d, 0xcb, 0x6b, 0x74, 0x5a, 0x91,
-	0x7e, 0xff, 0x87, 0xa8, 0xf7, 0x7a, 0xc4, 0x19, 0x5e, 0x24, 0x29, 0x46, 0x28, 0xf9, 0x78, 0x86,
-	0x82, 0xd8, 0x70, 0x67, 0x42, 0x53, 0xca, 0x8c, 0x45, 0x65, 0x48, 0x1e, 0xc1, 0xae, 0x28, 0x50,
-	0xfd, 0xa9, 0x18, 0xeb, 0x59, 0xad, 0xa8, 0x5d, 0xe6, 0x3e, 0x8a, 0xb1, 0xff, 0x02, 0x8e, 0xd6,
-	0x18, 0xcd, 0xf2, 0xc7, 0x60, 0xb0, 0xfd, 0x64, 0xa4, 0xd9, 0x77, 0x22, 0x28, 0x53, 0xef, 0x46,
-	0xfe, 0x0f, 0x0b, 0xee, 0xf7, 0x24, 0x8b, 0x90, 0x25, 0x52, 0xe9, 0xc6, 0x6d, 0x2b, 0x55, 0x28,
-	0xeb, 0x55, 0x4a, 0x72, 0xba, 0x66, 0x47, 0x43, 0xdb, 0xf1, 0xaf, 0xcb, 0xaa, 0x18, 0xf1, 0x10,
-	0x1e, 0x6c, 0x58, 0xaa, 0x54, 0xd5, 0xfd, 0x55, 0x87, 0x46, 0x4f, 0x32, 0x72, 0x06, 0xcd, 0xe2,
-	0xc6, 0xed, 0x0a, 0xb7, 0xb9, 0x4c, 0xc7, 0xbb, 0xad, 0x62, 0x7e, 0xa3, 0x25, 0x4b, 0x7e, 0x64,
-	0x9b, 0x58, 0x74, 0x65, 0x23, 0xcb, 0xea, 0x99, 0x7c, 0x86, 0xfd, 0x8a, 0xab, 0x1b, 0x7a, 0x56,
-	0x11, 0x4e, 0x67, 0x1b, 0xc2, 0xb0, 0x0f, 0xe0, 0x60, 0xcd, 0x22, 0x7f, 0xbd, 0xbb, 0x8a, 0x71,
-	0x1e, 0x6f, 0xc7, 0x94, 0x33, 0x5e, 0xbd, 0xf9, 0x39, 0x77, 0xad, 0xab, 0xb9, 0x6b, 0xfd, 0x99,
-	0xbb, 0xd6, 0xf7, 0x85, 0x5b, 0xbb, 0x5a, 0xb8, 0xb5, 0xdf, 0x0b, 0xb7, 0xf6, 0xe9, 0x09, 0x4b,
-	0x54, 0x3c, 0x1d, 0x04, 0x43, 0x3e, 0x09, 0x35, 0xdf, 0xd3, 0x14, 0xd5, 0x57, 0x2e, 0xbe, 0x14,
-	0xd1, 0x18, 0x47, 0x0c, 0x45, 0x78, 0xa9, 0xbf, 0x45, 0x83, 0xa6, 0xfe, 0x07, 0x3c, 0xfb, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xd4, 0xd5, 0xa1, 0x07, 0xe8, 0x04, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc5, 0x04, 0x01, 0xb9, 0x01, 0x04, 0xf3, 0x7d, 0x42, 0xc6, 0xb4, 0xc1, 0x75, 0x37, 0x51,
+	0x7f, 0xec, 0x42, 0x55, 0xa9, 0x0b, 0xba, 0xa0, 0xa0, 0xd2, 0x2e, 0x90, 0xaa, 0x51, 0xbb, 0xa9,
+	0x2a, 0x45, 0x4e, 0x7c, 0xb1, 0x2d, 0x1c, 0x8f, 0x35, 0x33, 0x09, 0xf4, 0x2d, 0x2a, 0x75, 0xd3,
+	0x67, 0xe9, 0x13, 0x74, 0xc9, 0xb2, 0xcb, 0x0a, 0x5e, 0xa4, 0x62, 0x1c, 0x0f, 0xc4, 0x36, 0xa4,
+	0xea, 0xce, 0x77, 0xee, 0xf1, 0xb9, 0xe7, 0xdc, 0x9c, 0x71, 0x60, 0x9d, 0x63, 0x88, 0xa9, 0x17,
+	0xf8, 0xd2, 0xf7, 0x46, 0xdb, 0x9e, 0x3c, 0x73, 0x33, 0xce, 0x24, 0x23, 0xcb, 0xea, 0xdc, 0xbd,
+	0x3a, 0x77, 0x47, 0xdb, 0xd6, 0x56, 0xc8, 0x58, 0x98, 0xa0, 0xa7, 0x9a, 0xbd, 0xe1, 0xb1, 0x27,
+	0xe3, 0x01, 0x0a, 0xe9, 0x0f, 0xb2, 0x1c, 0x6f, 0x6d, 0x94, 0x78, 0xbe, 0x64, 0x28, 0xf2, 0x96,
+	0xd3, 0x83, 0xe6, 0x91, 0x08, 0xf7, 0xd2, 0x7e, 0xc4, 0x38, 0x59, 0x87, 0x79, 0x81, 0x69, 0x80,
+	0xdc, 0x34, 0x6c, 0xa3, 0xd3, 0xa4, 0xe3, 0x8a, 0xbc, 0x82, 0xa5, 0x3e, 0x4b, 0x25, 0xa6, 0xb2,
+	0x1b, 0xf9, 0x22, 0x32, 0x67, 0x6d, 0xa3, 0xd3, 0xda, 0xb1, 0xdc, 0x09, 0x19, 0xee, 0x7e, 0x0e,
+	0x79, 0xeb, 0x8b, 0x88, 0xb6, 0xfa, 0xd7, 0x85, 0xd3, 0x85, 0x35, 0x3d, 0x83, 0xa2, 0xc8, 0x58,
+	0x2a, 0x90, 0xac, 0x42, 0x23, 0xe6, 0xf1, 0x78, 0xd0, 0xd5, 0x23, 0x79, 0x09, 0x4d, 0x2d, 0x5c,
+	0x8f, 0xc8, 0xad, 0xb9, 0x85, 0x35, 0xf7, 0x43, 0x81, 0xa0, 0xd7, 0x60, 0x27, 0xcb, 0x4d, 0x48,
+	0x89, 0x42, 0x12, 0x0b, 0x16, 0x7d, 0xf5, 0xc4, 0x0a, 0x1b, 0xba, 0x26, 0x87, 0xb0, 0x72, 0xd3,
+	0x08, 0x0a, 0x73, 0xd6, 0x6e, 0x74, 0x5a, 0x3b, 0xf6, 0xed, 0x56, 0xdc, 0x43, 0xee, 0x67, 0x11,
+	0x5d, 0xbe, 0x61, 0x08, 0x85, 0xf3, 0xdd, 0xc8, 0x3d, 0x29, 0x62, 0xed, 0x89, 0xc0, 0x5c, 0xcc,
+	0x63, 0x61, 0x1a, 0x76, 0xa3, 0xd3, 0xa4, 0xea, 0xf9, 0xdf, 0x5d, 0x91, 0x17, 0xb0, 0xc0, 0x51,
+	0x0c, 0x13, 0x29, 0xcc, 0x86, 0x52, 0xb9, 0x59, 0x52, 0xa9, 0xa7, 0x0f, 0x13, 0x49, 0x0b, 0xac,
+	0xb3, 0x0b, 0x4b, 0x37, 0x1b, 0x35, 0x8b, 0xd6, 0x1b, 0xc2, 0x40, 0x29, 0x5a, 0xa4, 0xba, 0x76,
+	0x4e, 0xe1, 0xff, 0x23, 0x11, 0x52, 0x1c, 0xb1, 0x13, 0xcc, 0x69, 0x7c, 0x19, 0xb3, 0xf4, 0xce,
+	0xad, 0xee, 0xd7, 0xc6, 0x63, 0xfa, 0x4e, 0x27, 0x42, 0xf2, 0x0c, 0xee, 0xd5, 0x0d, 0xbe, 0x3d,
+	0x2f, 0xce, 0x7b, 0xf5, 0x13, 0x1c, 0xe0, 0x71, 0x9c, 0x22, 0x45, 0xc1, 0x92, 0x11, 0x72, 0x62,
+	0xc2, 0xc2, 0xc0, 0x4f, 0xfd, 0x50, 0x67, 0xb8, 0x28, 0xc9, 0x03, 0x58, 0xe2, 0x63, 0x54, 0x77,
+	0xc8, 0x13, 0xa5, 0xb2, 0x49, 0x5b, 0xc5, 0xd9, 0x47, 0x9e, 0x38, 0xbb, 0xb0, 0x51, 0x61, 0xd4,
+	0x02, 0xb6, 0x40, 0x63, 0xbb, 0x71, 0xa0, 0xd8, 0xe7, 0x28, 0x14, 0x47, 0xef, 0x02, 0xe7, 0x9b,
+	0x01, 0xff, 0x29, 0x0b, 0x61, 0x2c, 0xa4, 0x7a, 0x71, 0x9a, 0xa4, 0x12, 0xe5, 0x6c, 0x99, 0x92,
+	0xec, 0x55, 0xf2, 0x9a, 0x27, 0xe1, 0xae, 0xab, 0x57, 0x4a, 0xea, 0x7d, 0xd8, 0xac, 0x11, 0x55,
+	0xb8, 0xda, 0xf9, 0xd1, 0x80, 0xc6, 0x91, 0x08, 0xc9, 0x01, 0xcc, 0x8f, 0x3f, 0x02, 0x66, 0x89,
+	0x5b, 0x5f, 0x5d, 0xcb, 0xbe, 0xad, 0xa3, 0x77, 0x74, 0xc5, 0x92, 0xdf, 0xc2, 0x3a, 0x16, 0xd5,
+	0xa9, 0x65, 0x99, 0xbc, 0x46, 0x08, 0x6b, 0xd5, 0x00, 0x3e, 0xac, 0xbe, 0x56, 0x01, 0x59, 0x8f,
+	0xff, 0x02, 0xa4, 0xc7, 0x7c, 0x86, 0x95, 0x52, 0x78, 0x6a, 0xa4, 0x4d, 0x22, 0xac, 0xce, 0x34,
+	0x84, 0x66, 0xef, 0xc1, 0x6a, 0x25, 0x09, 0x4e, 0x9d, 0xbc, 0x49, 0x8c, 0xf5, 0x68, 0x3a, 0xa6,
+	0x98, 0xf1, 0xfa, 0xcd, 0xcf, 0x8b, 0xb6, 0x71, 0x7e, 0xd1, 0x36, 0x7e, 0x5f, 0xb4, 0x8d, 0xaf,
+	0x97, 0xed, 0x99, 0xf3, 0xcb, 0xf6, 0xcc, 0xaf, 0xcb, 0xf6, 0xcc, 0xa7, 0x27, 0x61, 0x2c, 0xa3,
+	0x61, 0xcf, 0xed, 0xb3, 0x81, 0xa7, 0xf8, 0x9e, 0xa6, 0x28, 0x4f, 0x19, 0x3f, 0x19, 0x57, 0x09,
+	0x06, 0x21, 0x72, 0xef, 0x4c, 0xfd, 0x27, 0xf4, 0xe6, 0xd5, 0x87, 0xe8, 0xf9, 0x9f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x9b, 0xf4, 0xfe, 0xb1, 0x70, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -547,12 +724,12 @@ type MsgClient interface {
 	// veracity of the data, they are simply communicating that it exists.
 	//
 	// On-chain signatures have the following benefits:
-	// - on-chain identities can be managed using different cryptographic keys
-	//   that change over time through key rotation practices
-	// - an on-chain identity may represent an organization and through delegation
-	//   individual members may sign on behalf of the group
-	// - the blockchain transaction envelope provides built-in replay protection
-	//   and timestamping
+	//   - on-chain identities can be managed using different cryptographic keys
+	//     that change over time through key rotation practices
+	//   - an on-chain identity may represent an organization and through delegation
+	//     individual members may sign on behalf of the group
+	//   - the blockchain transaction envelope provides built-in replay protection
+	//     and timestamping
 	//
 	// Attest implicitly calls Anchor if the data was not already anchored.
 	//
@@ -562,6 +739,10 @@ type MsgClient interface {
 	// data, the attestor will be ignored and a new attestation with a new
 	// timestamp will not be added.
 	Attest(ctx context.Context, in *MsgAttest, opts ...grpc.CallOption) (*MsgAttestResponse, error)
+	// RevokeAttestation allows an attestor to retract an attestation, for
+	// example when the attestor discovers the underlying data was wrong. The
+	// data remains anchored and other attestations of the data are not affected.
+	RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error)
 	// DefineResolver defines a resolver URL and assigns it a new integer ID
 	// that can be used in calls to RegisterResolver.
 	DefineResolver(ctx context.Context, in *MsgDefineResolver, opts ...grpc.CallOption) (*MsgDefineResolverResponse, error)
@@ -595,6 +776,15 @@ func (c *msgClient) Attest(ctx context.Context, in *MsgAttest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error) {
+	out := new(MsgRevokeAttestationResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1.Msg/RevokeAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DefineResolver(ctx context.Context, in *MsgDefineResolver, opts ...grpc.CallOption) (*MsgDefineResolverResponse, error) {
 	out := new(MsgDefineResolverResponse)
 	err := c.cc.Invoke(ctx, "/regen.data.v1.Msg/DefineResolver", in, out, opts...)
@@ -631,12 +821,12 @@ type MsgServer interface {
 	// veracity of the data, they are simply communicating that it exists.
 	//
 	// On-chain signatures have the following benefits:
-	// - on-chain identities can be managed using different cryptographic keys
-	//   that change over time through key rotation practices
-	// - an on-chain identity may represent an organization and through delegation
-	//   individual members may sign on behalf of the group
-	// - the blockchain transaction envelope provides built-in replay protection
-	//   and timestamping
+	//   - on-chain identities can be managed using different cryptographic keys
+	//     that change over time through key rotation practices
+	//   - an on-chain identity may represent an organization and through delegation
+	//     individual members may sign on behalf of the group
+	//   - the blockchain transaction envelope provides built-in replay protection
+	//     and timestamping
 	//
 	// Attest implicitly calls Anchor if the data was not already anchored.
 	//
@@ -646,6 +836,10 @@ type MsgServer interface {
 	// data, the attestor will be ignored and a new attestation with a new
 	// timestamp will not be added.
 	Attest(context.Context, *MsgAttest) (*MsgAttestResponse, error)
+	// RevokeAttestation allows an attestor to retract an attestation, for
+	// example when the attestor discovers the underlying data was wrong. The
+	// data remains anchored and other attestations of the data are not affected.
+	RevokeAttestation(context.Context, *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error)
 	// DefineResolver defines a resolver URL and assigns it a new integer ID
 	// that can be used in calls to RegisterResolver.
 	DefineResolver(context.Context, *MsgDefineResolver) (*MsgDefineResolverResponse, error)
@@ -663,6 +857,9 @@ func (*UnimplementedMsgServer) Anchor(ctx context.Context, req *MsgAnchor) (*Msg
 func (*UnimplementedMsgServer) Attest(ctx context.Context, req *MsgAttest) (*MsgAttestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAttestation(ctx context.Context, req *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttestation not implemented")
+}
 func (*UnimplementedMsgServer) DefineResolver(ctx context.Context, req *MsgDefineResolver) (*MsgDefineResolverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DefineResolver not implemented")
 }
@@ -710,6 +907,24 @@ func _Msg_Attest_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1.Msg/RevokeAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAttestation(ctx, req.(*MsgRevokeAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DefineResolver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDefineResolver)
 	if err := dec(in); err != nil {
@@ -758,6 +973,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Attest",
 			Handler:    _Msg_Attest_Handler,
 		},
+		{
+			MethodName: "RevokeAttestation",
+			Handler:    _Msg_RevokeAttestation_Handler,
+		},
 		{
 			MethodName: "DefineResolver",
 			Handler:    _Msg_DefineResolver_Handler,
@@ -919,6 +1138,20 @@ func (m *MsgAttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -943,6 +1176,118 @@ func (m *MsgAttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentHash != nil {
+		{
+			size, err := m.ContentHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDefineResolver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1160,37 +1505,89 @@ func (m *MsgAttestResponse) Size() (n int) {
 		l = m.Timestamp.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgDefineResolver) Size() (n int) {
+func (m *AttestResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Manager)
+	l = len(m.Iri)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ResolverUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Attested {
+		n += 2
 	}
 	return n
 }
 
-func (m *MsgDefineResolverResponse) Size() (n int) {
+func (m *MsgRevokeAttestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ResolverId != 0 {
-		n += 1 + sovTx(uint64(m.ResolverId))
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if m.ContentHash != nil {
+		l = m.ContentHash.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDefineResolver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResolverUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDefineResolverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResolverId != 0 {
+		n += 1 + sovTx(uint64(m.ResolverId))
+	}
+	return n
+}
 
 func (m *MsgRegisterResolver) Size() (n int) {
 	if m == nil {
@@ -1678,6 +2075,342 @@ func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &AttestResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attested = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContentHash == nil {
+				m.ContentHash = &ContentHash_Graph{}
+			}
+			if err := m.ContentHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
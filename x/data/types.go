@@ -8,6 +8,7 @@ import (
 
 var DigestAlgorithmLength = map[DigestAlgorithm]int{
 	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256: 256,
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA256:      256,
 }
 
 func (ch ContentHash) Validate() error {
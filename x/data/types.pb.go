@@ -30,16 +30,20 @@ const (
 	DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED DigestAlgorithm = 0
 	// BLAKE2b-256
 	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256 DigestAlgorithm = 1
+	// SHA-256
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA256 DigestAlgorithm = 2
 )
 
 var DigestAlgorithm_name = map[int32]string{
 	0: "DIGEST_ALGORITHM_UNSPECIFIED",
 	1: "DIGEST_ALGORITHM_BLAKE2B_256",
+	2: "DIGEST_ALGORITHM_SHA256",
 }
 
 var DigestAlgorithm_value = map[string]int32{
 	"DIGEST_ALGORITHM_UNSPECIFIED": 0,
 	"DIGEST_ALGORITHM_BLAKE2B_256": 1,
+	"DIGEST_ALGORITHM_SHA256":      2,
 }
 
 func (x DigestAlgorithm) String() string {
@@ -457,49 +461,50 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1/types.proto", fileDescriptor_a49a7c2bdb2b2846) }
 
 var fileDescriptor_a49a7c2bdb2b2846 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x72, 0xd2, 0x40,
-	0x1c, 0x27, 0x85, 0x3a, 0xe3, 0x9f, 0x7e, 0xac, 0x5b, 0x6d, 0x29, 0x6a, 0x06, 0x71, 0xc6, 0xe9,
-	0x30, 0x6d, 0x68, 0xd1, 0x76, 0x46, 0x2f, 0x4e, 0x80, 0x90, 0xa6, 0x25, 0x21, 0xb3, 0xa4, 0x1f,
-	0xf6, 0x92, 0xd9, 0xc2, 0x0e, 0x30, 0x85, 0x84, 0x09, 0xb1, 0x58, 0x8f, 0x3e, 0x81, 0x17, 0xef,
-	0x3e, 0x83, 0x4f, 0xe1, 0xb1, 0x07, 0x0f, 0x1e, 0x9d, 0xf6, 0x45, 0x1c, 0x16, 0xab, 0x34, 0x6e,
-	0xeb, 0xc9, 0xdb, 0xe6, 0xf7, 0xf5, 0xff, 0x4d, 0xf6, 0x03, 0x96, 0x03, 0xd6, 0x62, 0x5e, 0xbe,
-	0x49, 0x43, 0x9a, 0x3f, 0xdd, 0xc8, 0x87, 0x67, 0x7d, 0x36, 0x50, 0xfa, 0x81, 0x1f, 0xfa, 0x78,
-	0x96, 0x53, 0xca, 0x88, 0x52, 0x4e, 0x37, 0xb2, 0x5f, 0x12, 0x90, 0x2c, 0xf9, 0x5e, 0xc8, 0xbc,
-	0x70, 0x9b, 0x0e, 0xda, 0x78, 0x1d, 0xe2, 0x01, 0x1d, 0xa6, 0xa4, 0x8c, 0xb4, 0x92, 0x2c, 0xc8,
-	0xca, 0x35, 0xb1, 0x32, 0x21, 0x54, 0x08, 0x1d, 0x92, 0x91, 0x14, 0x6f, 0xc1, 0x74, 0x2b, 0xa0,
-	0xfd, 0x76, 0x6a, 0x8a, 0x7b, 0x32, 0xb7, 0x78, 0xf4, 0x91, 0x8e, 0x8c, 0xe5, 0xe9, 0xcf, 0x12,
-	0xc4, 0x09, 0x1d, 0x62, 0x0c, 0x89, 0x36, 0x1d, 0xb4, 0xf9, 0xc8, 0x19, 0xc2, 0xd7, 0xd8, 0x00,
-	0xd4, 0xec, 0xb4, 0xd8, 0x20, 0x74, 0x69, 0xb7, 0xe5, 0x07, 0x9d, 0xb0, 0xdd, 0xe3, 0xf1, 0x73,
-	0x7f, 0x55, 0x2a, 0x73, 0x99, 0x7a, 0xa5, 0x22, 0xf3, 0xcd, 0xeb, 0x00, 0x7e, 0x05, 0xd0, 0x63,
-	0xcd, 0x0e, 0x75, 0x47, 0x3f, 0x21, 0x15, 0xe7, 0x21, 0x0f, 0x23, 0x21, 0x84, 0x0e, 0xcd, 0x91,
-	0xc6, 0x39, 0xeb, 0x33, 0x72, 0xb7, 0x77, 0xb5, 0x4c, 0x7f, 0x9a, 0x82, 0x69, 0xde, 0xf9, 0x7f,
-	0x97, 0xec, 0x42, 0xba, 0x41, 0x3d, 0xdf, 0xeb, 0x34, 0x68, 0xb7, 0xf3, 0x9e, 0x86, 0x1d, 0xdf,
-	0x9b, 0x08, 0x1d, 0x97, 0x5e, 0x8b, 0x84, 0xf2, 0x62, 0xa5, 0x88, 0xeb, 0xcf, 0x8c, 0xe5, 0xc6,
-	0x4d, 0x14, 0x7e, 0x0d, 0xc9, 0x1e, 0x0b, 0x4e, 0xba, 0xcc, 0x0d, 0x03, 0xc6, 0x52, 0x09, 0x61,
-	0x67, 0x1e, 0x6f, 0x72, 0x99, 0x13, 0x30, 0x46, 0xa0, 0xf7, 0x7b, 0x9d, 0x25, 0x30, 0x3b, 0xb1,
-	0xad, 0x6c, 0x80, 0x55, 0x98, 0x6b, 0x8c, 0x01, 0xb7, 0xcd, 0x91, 0x94, 0x94, 0x89, 0xaf, 0x24,
-	0x0b, 0xe9, 0x9b, 0x0f, 0x03, 0x99, 0x6d, 0x4c, 0x46, 0xe4, 0xf6, 0x60, 0x3e, 0xf2, 0x9b, 0x70,
-	0x06, 0x1e, 0x95, 0x0d, 0x5d, 0xab, 0x3b, 0xae, 0x5a, 0xd5, 0x6b, 0xc4, 0x70, 0xb6, 0x4d, 0x77,
-	0xcf, 0xaa, 0xdb, 0x5a, 0xc9, 0xa8, 0x18, 0x5a, 0x19, 0xc5, 0x84, 0x8a, 0x62, 0x55, 0xdd, 0xd5,
-	0x0a, 0x45, 0xb7, 0xb0, 0xb9, 0x85, 0xa4, 0xdc, 0xb7, 0x38, 0xcc, 0x4c, 0x6e, 0x2f, 0x96, 0x21,
-	0x4d, 0xd4, 0x03, 0xd7, 0xd4, 0xca, 0x86, 0xea, 0x3a, 0x6f, 0x6c, 0x2d, 0x12, 0xf9, 0x18, 0x96,
-	0x23, 0xbc, 0xa3, 0x1d, 0x3a, 0xae, 0x5d, 0x55, 0x0d, 0x0b, 0x49, 0x78, 0x09, 0x16, 0x22, 0xf4,
-	0x4e, 0xbd, 0x66, 0xa1, 0x29, 0xbc, 0x08, 0x38, 0x42, 0x94, 0xea, 0xfb, 0x28, 0x2e, 0xc0, 0x0f,
-	0xcd, 0x2a, 0x4a, 0x08, 0x70, 0xbb, 0x5c, 0x41, 0xd3, 0x82, 0x01, 0x8e, 0x51, 0xa9, 0x20, 0x24,
-	0x30, 0xec, 0xd8, 0x3a, 0xba, 0x27, 0x0a, 0xb2, 0x74, 0x84, 0x05, 0x78, 0x7d, 0x5f, 0x47, 0x0b,
-	0x82, 0x01, 0x07, 0x5a, 0xd1, 0x46, 0xf7, 0x05, 0x84, 0xba, 0x6f, 0x54, 0xd0, 0x03, 0x41, 0x92,
-	0x6e, 0x54, 0xd0, 0xa2, 0xc8, 0x30, 0x1a, 0xbd, 0x24, 0x20, 0x4c, 0x5b, 0xd3, 0x51, 0x46, 0x90,
-	0x64, 0xda, 0x2f, 0xd0, 0x13, 0x71, 0x27, 0x13, 0x65, 0x05, 0x86, 0x9a, 0xae, 0xa3, 0xa7, 0xb9,
-	0x0f, 0x12, 0xc8, 0xb7, 0x5f, 0x00, 0xbc, 0x0e, 0xab, 0x3a, 0x51, 0xed, 0x6d, 0xb7, 0xa4, 0x5a,
-	0x35, 0xcb, 0x28, 0xa9, 0x55, 0xe3, 0x48, 0x75, 0x8c, 0x9a, 0x75, 0xe3, 0x69, 0x52, 0x20, 0xf7,
-	0x6f, 0x07, 0x29, 0x5b, 0x6a, 0x61, 0x7d, 0x63, 0x13, 0x49, 0xb9, 0x97, 0x30, 0x1f, 0xb9, 0x25,
-	0xf8, 0x19, 0x64, 0xc7, 0x11, 0xa6, 0x46, 0x76, 0xab, 0x9a, 0xeb, 0x10, 0x4d, 0x73, 0xad, 0x9a,
-	0x15, 0x39, 0x65, 0xc5, 0xca, 0xd7, 0x0b, 0x59, 0x3a, 0xbf, 0x90, 0xa5, 0x1f, 0x17, 0xb2, 0xf4,
-	0xf1, 0x52, 0x8e, 0x9d, 0x5f, 0xca, 0xb1, 0xef, 0x97, 0x72, 0xec, 0x68, 0xb5, 0xd5, 0x09, 0xdb,
-	0x6f, 0x8f, 0x95, 0x86, 0xdf, 0xcb, 0xf3, 0xcb, 0xb3, 0xe6, 0xb1, 0x70, 0xe8, 0x07, 0x27, 0xbf,
-	0xbe, 0xba, 0xac, 0xd9, 0x62, 0x41, 0xfe, 0x1d, 0x7f, 0xdc, 0x8f, 0xef, 0xf0, 0x47, 0xfd, 0xf9,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x18, 0xcf, 0xc5, 0xf1, 0x05, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0x12, 0x4d,
+	0x1c, 0x67, 0x81, 0x3e, 0xc9, 0xf3, 0xa7, 0x2f, 0xe3, 0x54, 0x5b, 0x4a, 0x75, 0x83, 0x98, 0x98,
+	0x86, 0xb4, 0x4b, 0x8b, 0xb6, 0x89, 0x5e, 0xcc, 0x02, 0xcb, 0xb2, 0x2d, 0xbb, 0x6c, 0x86, 0xb5,
+	0xad, 0xbd, 0x6c, 0xa6, 0x30, 0x01, 0x52, 0x60, 0xc9, 0xb2, 0x16, 0xeb, 0xd1, 0x4f, 0xe0, 0xc5,
+	0xbb, 0x9f, 0xc1, 0x4f, 0xe1, 0xb1, 0x07, 0x0f, 0x1e, 0x4d, 0xfb, 0x45, 0x0c, 0x83, 0x55, 0xba,
+	0x9d, 0xd6, 0x93, 0xb7, 0xd9, 0xff, 0xef, 0x35, 0x3b, 0x2f, 0xb0, 0xe2, 0xb3, 0x16, 0xeb, 0xe7,
+	0x9a, 0x34, 0xa0, 0xb9, 0xd3, 0xad, 0x5c, 0x70, 0x36, 0x60, 0x43, 0x65, 0xe0, 0x7b, 0x81, 0x87,
+	0xe7, 0x38, 0xa4, 0x8c, 0x21, 0xe5, 0x74, 0x2b, 0xf3, 0x25, 0x0e, 0x89, 0xa2, 0xd7, 0x0f, 0x58,
+	0x3f, 0xa8, 0xd0, 0x61, 0x1b, 0x6f, 0x42, 0xcc, 0xa7, 0xa3, 0xa4, 0x94, 0x96, 0xd6, 0x12, 0x79,
+	0x59, 0xb9, 0x46, 0x56, 0xa6, 0x88, 0x0a, 0xa1, 0x23, 0x32, 0xa6, 0xe2, 0x1d, 0x98, 0x69, 0xf9,
+	0x74, 0xd0, 0x4e, 0x46, 0xb9, 0x26, 0x7d, 0x87, 0x46, 0x1f, 0xf3, 0xc8, 0x84, 0x9e, 0xfa, 0x2c,
+	0x41, 0x8c, 0xd0, 0x11, 0xc6, 0x10, 0x6f, 0xd3, 0x61, 0x9b, 0x47, 0xce, 0x12, 0xbe, 0xc6, 0x06,
+	0xa0, 0x66, 0xa7, 0xc5, 0x86, 0x81, 0x4b, 0xbb, 0x2d, 0xcf, 0xef, 0x04, 0xed, 0x1e, 0xb7, 0x9f,
+	0xbf, 0x51, 0xa9, 0xc4, 0x69, 0xea, 0x15, 0x8b, 0x2c, 0x34, 0xaf, 0x0f, 0xf0, 0x4b, 0x80, 0x1e,
+	0x6b, 0x76, 0xa8, 0x3b, 0xfe, 0x09, 0xc9, 0x18, 0x37, 0x59, 0x0d, 0x99, 0x10, 0x3a, 0x32, 0xc7,
+	0x1c, 0xe7, 0x6c, 0xc0, 0xc8, 0xff, 0xbd, 0xab, 0x65, 0xea, 0x53, 0x14, 0x66, 0x78, 0xe7, 0x7f,
+	0x5d, 0xb2, 0x0b, 0xa9, 0x06, 0xed, 0x7b, 0xfd, 0x4e, 0x83, 0x76, 0x3b, 0xef, 0x69, 0xd0, 0xf1,
+	0xfa, 0x53, 0xa6, 0x93, 0xd2, 0x1b, 0x21, 0x53, 0x5e, 0xac, 0x18, 0x52, 0xfd, 0xc9, 0x58, 0x69,
+	0xdc, 0x06, 0xe1, 0x57, 0x90, 0xe8, 0x31, 0xff, 0xa4, 0xcb, 0xdc, 0xc0, 0x67, 0x2c, 0x19, 0x17,
+	0x76, 0xe6, 0xf6, 0x26, 0xa7, 0x39, 0x3e, 0x63, 0x04, 0x7a, 0xbf, 0xd7, 0x19, 0x02, 0x73, 0x53,
+	0xdb, 0xca, 0x86, 0x58, 0x85, 0xf9, 0xc6, 0x64, 0xe0, 0xb6, 0xf9, 0x24, 0x29, 0xa5, 0x63, 0x6b,
+	0x89, 0x7c, 0xea, 0xf6, 0xc3, 0x40, 0xe6, 0x1a, 0xd3, 0x16, 0x59, 0x1f, 0x16, 0x42, 0xbf, 0x09,
+	0xa7, 0xe1, 0x61, 0xc9, 0xd0, 0xb5, 0xba, 0xe3, 0xaa, 0x55, 0xbd, 0x46, 0x0c, 0xa7, 0x62, 0xba,
+	0xaf, 0xad, 0xba, 0xad, 0x15, 0x8d, 0xb2, 0xa1, 0x95, 0x50, 0x44, 0xc8, 0x28, 0x54, 0xd5, 0x3d,
+	0x2d, 0x5f, 0x70, 0xf3, 0xdb, 0x3b, 0x48, 0xc2, 0xab, 0xb0, 0x7c, 0x83, 0x51, 0xaf, 0xa8, 0x63,
+	0x30, 0x9a, 0xfd, 0x16, 0x83, 0xd9, 0xe9, 0xbd, 0xc7, 0x32, 0xa4, 0x88, 0x7a, 0xe0, 0x9a, 0x5a,
+	0xc9, 0x50, 0x5d, 0xe7, 0x8d, 0xad, 0x85, 0xf2, 0x1e, 0xc1, 0x4a, 0x08, 0x77, 0xb4, 0x43, 0xc7,
+	0xb5, 0xab, 0xaa, 0x61, 0x21, 0x09, 0x2f, 0xc3, 0x62, 0x08, 0xde, 0xad, 0xd7, 0x2c, 0x14, 0xc5,
+	0x4b, 0x80, 0x43, 0x40, 0xb1, 0xbe, 0x8f, 0x62, 0x82, 0xf9, 0xa1, 0x59, 0x45, 0x71, 0xc1, 0xdc,
+	0x2e, 0x95, 0xd1, 0x8c, 0x20, 0xc0, 0x31, 0xca, 0x65, 0x84, 0x04, 0x82, 0x5d, 0x5b, 0x47, 0xf7,
+	0x44, 0x46, 0x96, 0x8e, 0xb0, 0x60, 0x5e, 0xdf, 0xd7, 0xd1, 0xa2, 0x20, 0xe0, 0x40, 0x2b, 0xd8,
+	0xe8, 0xbe, 0x00, 0x50, 0xf7, 0x8d, 0x32, 0x7a, 0x20, 0x70, 0xd2, 0x8d, 0x32, 0x5a, 0x12, 0x09,
+	0xc6, 0xd1, 0xcb, 0x02, 0xc0, 0xb4, 0x35, 0x1d, 0xa5, 0x05, 0x4e, 0xa6, 0xfd, 0x1c, 0x3d, 0x16,
+	0x77, 0x32, 0x51, 0x46, 0x20, 0xa8, 0xe9, 0x3a, 0x7a, 0x92, 0xfd, 0x20, 0x81, 0x7c, 0xf7, 0xed,
+	0xc0, 0x9b, 0xb0, 0xae, 0x13, 0xd5, 0xae, 0xb8, 0x45, 0xd5, 0xaa, 0x59, 0x46, 0x51, 0xad, 0x1a,
+	0x47, 0xaa, 0x63, 0xd4, 0xac, 0x5b, 0x8f, 0x9a, 0x02, 0xd9, 0xbf, 0x2b, 0x48, 0xc9, 0x52, 0xf3,
+	0x9b, 0x5b, 0xdb, 0x48, 0xca, 0xbe, 0x80, 0x85, 0xd0, 0x15, 0xc2, 0x4f, 0x21, 0x33, 0xb1, 0x30,
+	0x35, 0xb2, 0x57, 0xd5, 0x5c, 0x87, 0x68, 0x9a, 0x6b, 0xd5, 0xac, 0xd0, 0x29, 0x2b, 0x94, 0xbf,
+	0x5e, 0xc8, 0xd2, 0xf9, 0x85, 0x2c, 0xfd, 0xb8, 0x90, 0xa5, 0x8f, 0x97, 0x72, 0xe4, 0xfc, 0x52,
+	0x8e, 0x7c, 0xbf, 0x94, 0x23, 0x47, 0xeb, 0xad, 0x4e, 0xd0, 0x7e, 0x7b, 0xac, 0x34, 0xbc, 0x5e,
+	0x8e, 0xdf, 0xac, 0x8d, 0x3e, 0x0b, 0x46, 0x9e, 0x7f, 0xf2, 0xeb, 0xab, 0xcb, 0x9a, 0x2d, 0xe6,
+	0xe7, 0xde, 0xf1, 0x97, 0xff, 0xf8, 0x3f, 0xfe, 0xe2, 0x3f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff,
+	0x0c, 0x8f, 0x2b, 0xf0, 0x0e, 0x06, 0x00, 0x00,
 }
 
 func (m *ContentHash) Marshal() (dAtA []byte, err error) {
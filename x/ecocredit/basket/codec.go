@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreate{}, "regen.basket/MsgCreate", nil)
 	cdc.RegisterConcrete(&MsgPut{}, "regen.basket/MsgPut", nil)
 	cdc.RegisterConcrete(&MsgTake{}, "regen.basket/MsgTake", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketAllowedClasses{}, "regen.basket/MsgUpdateBasketAllowedClasses", nil)
+	cdc.RegisterConcrete(&MsgCloseBasket{}, "regen.basket/MsgCloseBasket", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketMinTake{}, "regen.basket/MsgUpdateBasketMinTake", nil)
 }
 
 var (
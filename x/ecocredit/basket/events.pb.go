@@ -246,10 +246,205 @@ func (m *EventTake) GetAmount() string {
 	return ""
 }
 
+// EventUpdateBasketAllowedClasses is an event emitted when the credit classes
+// allowed to be put into a basket are updated.
+//
+// Since Revision 2
+type EventUpdateBasketAllowedClasses struct {
+	// basket_denom is the basket bank denom of the basket that was updated.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// add_classes are the credit classes that were added to the basket.
+	AddClasses []string `protobuf:"bytes,2,rep,name=add_classes,json=addClasses,proto3" json:"add_classes,omitempty"`
+	// remove_classes are the credit classes that were removed from the basket.
+	RemoveClasses []string `protobuf:"bytes,3,rep,name=remove_classes,json=removeClasses,proto3" json:"remove_classes,omitempty"`
+}
+
+func (m *EventUpdateBasketAllowedClasses) Reset()         { *m = EventUpdateBasketAllowedClasses{} }
+func (m *EventUpdateBasketAllowedClasses) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketAllowedClasses) ProtoMessage()    {}
+func (*EventUpdateBasketAllowedClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{3}
+}
+func (m *EventUpdateBasketAllowedClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketAllowedClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketAllowedClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketAllowedClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketAllowedClasses.Merge(m, src)
+}
+func (m *EventUpdateBasketAllowedClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketAllowedClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketAllowedClasses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketAllowedClasses proto.InternalMessageInfo
+
+func (m *EventUpdateBasketAllowedClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketAllowedClasses) GetAddClasses() []string {
+	if m != nil {
+		return m.AddClasses
+	}
+	return nil
+}
+
+func (m *EventUpdateBasketAllowedClasses) GetRemoveClasses() []string {
+	if m != nil {
+		return m.RemoveClasses
+	}
+	return nil
+}
+
+// EventCloseBasket is an event emitted when a basket is closed.
+//
+// Since Revision 2
+type EventCloseBasket struct {
+	// curator is the address of the basket curator that closed the basket.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket that was closed.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// credits are the credits swept from the basket to the basket curator.
+	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *EventCloseBasket) Reset()         { *m = EventCloseBasket{} }
+func (m *EventCloseBasket) String() string { return proto.CompactTextString(m) }
+func (*EventCloseBasket) ProtoMessage()    {}
+func (*EventCloseBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{4}
+}
+func (m *EventCloseBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCloseBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCloseBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCloseBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCloseBasket.Merge(m, src)
+}
+func (m *EventCloseBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCloseBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCloseBasket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCloseBasket proto.InternalMessageInfo
+
+func (m *EventCloseBasket) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *EventCloseBasket) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventCloseBasket) GetCredits() []*BasketCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+// EventUpdateBasketMinTake is an event emitted when the minimum take amount
+// of a basket is updated.
+//
+// Since Revision 2
+type EventUpdateBasketMinTake struct {
+	// basket_denom is the basket bank denom of the basket that was updated.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// min_take is the new minimum take amount of the basket. An empty value
+	// indicates the minimum take amount was removed.
+	MinTake string `protobuf:"bytes,2,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
+}
+
+func (m *EventUpdateBasketMinTake) Reset()         { *m = EventUpdateBasketMinTake{} }
+func (m *EventUpdateBasketMinTake) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketMinTake) ProtoMessage()    {}
+func (*EventUpdateBasketMinTake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{5}
+}
+func (m *EventUpdateBasketMinTake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketMinTake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketMinTake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketMinTake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketMinTake.Merge(m, src)
+}
+func (m *EventUpdateBasketMinTake) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketMinTake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketMinTake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketMinTake proto.InternalMessageInfo
+
+func (m *EventUpdateBasketMinTake) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketMinTake) GetMinTake() string {
+	if m != nil {
+		return m.MinTake
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreate)(nil), "regen.ecocredit.basket.v1.EventCreate")
 	proto.RegisterType((*EventPut)(nil), "regen.ecocredit.basket.v1.EventPut")
 	proto.RegisterType((*EventTake)(nil), "regen.ecocredit.basket.v1.EventTake")
+	proto.RegisterType((*EventUpdateBasketAllowedClasses)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketAllowedClasses")
+	proto.RegisterType((*EventCloseBasket)(nil), "regen.ecocredit.basket.v1.EventCloseBasket")
+	proto.RegisterType((*EventUpdateBasketMinTake)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketMinTake")
 }
 
 func init() {
@@ -257,26 +452,34 @@ func init() {
 }
 
 var fileDescriptor_bc7fc2fbcbd93cbc = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x3f, 0x4e, 0xc3, 0x30,
-	0x18, 0xc5, 0xeb, 0x16, 0x5a, 0xea, 0x32, 0x45, 0x0c, 0xa1, 0x42, 0x56, 0x89, 0x04, 0x74, 0xc1,
-	0x56, 0x61, 0x61, 0x4e, 0xe9, 0x8a, 0x50, 0xc4, 0xc4, 0x82, 0xf2, 0xe7, 0x53, 0xa8, 0x42, 0xec,
-	0xca, 0x71, 0x52, 0xb8, 0x05, 0xa7, 0x80, 0xab, 0x30, 0x76, 0x64, 0x44, 0xc9, 0x45, 0x50, 0xec,
-	0xd0, 0x25, 0xea, 0xce, 0xe6, 0xef, 0xf9, 0xf9, 0xbd, 0x9f, 0x65, 0xe3, 0x73, 0x09, 0x31, 0x70,
-	0x06, 0xa1, 0x08, 0x25, 0x44, 0x4b, 0xc5, 0x02, 0x3f, 0x4b, 0x40, 0xb1, 0x62, 0xc6, 0xa0, 0x00,
-	0xae, 0x32, 0xba, 0x92, 0x42, 0x09, 0xeb, 0x58, 0xfb, 0xe8, 0xd6, 0x47, 0x8d, 0x8f, 0x16, 0xb3,
-	0xf1, 0xd9, 0xee, 0x08, 0xf5, 0xb6, 0x82, 0x26, 0xc1, 0xb9, 0xc3, 0xa3, 0x45, 0x9d, 0x38, 0x97,
-	0xe0, 0x2b, 0xb0, 0x4e, 0xf1, 0xa1, 0xf1, 0x3d, 0x45, 0xc0, 0x45, 0x6a, 0xa3, 0x09, 0x9a, 0x0e,
-	0xbd, 0x91, 0xd1, 0x6e, 0x6b, 0xc9, 0x3a, 0xc1, 0x83, 0x30, 0x97, 0xbe, 0x12, 0xd2, 0xee, 0xd6,
-	0xbb, 0x6e, 0xd7, 0x46, 0xde, 0x9f, 0xe4, 0x7c, 0x20, 0x7c, 0xa0, 0x03, 0xef, 0x73, 0x65, 0x1d,
-	0xe1, 0x7d, 0xb1, 0xe6, 0x20, 0x9b, 0x18, 0x33, 0xb4, 0x3a, 0xba, 0xed, 0x8e, 0x05, 0x1e, 0x18,
-	0xea, 0xcc, 0xee, 0x4d, 0x7a, 0xd3, 0xd1, 0xd5, 0x05, 0xdd, 0x79, 0x53, 0xea, 0xea, 0xd5, 0x5c,
-	0xcb, 0x0d, 0x8c, 0x39, 0x6b, 0x8d, 0x71, 0xdf, 0x4f, 0x45, 0xce, 0x95, 0xbd, 0xb7, 0x25, 0x6d,
-	0x14, 0xe7, 0x13, 0xe1, 0xa1, 0x06, 0x7d, 0xf0, 0x13, 0xf8, 0xcf, 0xa4, 0xae, 0xf7, 0x55, 0x12,
-	0xb4, 0x29, 0x09, 0xfa, 0x29, 0x09, 0x7a, 0xaf, 0x48, 0x67, 0x53, 0x91, 0xce, 0x77, 0x45, 0x3a,
-	0x8f, 0x37, 0xf1, 0x52, 0x3d, 0xe7, 0x01, 0x0d, 0x45, 0xca, 0x74, 0xeb, 0x25, 0x07, 0xb5, 0x16,
-	0x32, 0x69, 0xa6, 0x17, 0x88, 0x62, 0x90, 0xec, 0xb5, 0xf5, 0x0b, 0x82, 0xbe, 0x7e, 0xfd, 0xeb,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x4f, 0x26, 0x0d, 0x69, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xad, 0x5b, 0xd8, 0x6e, 0x27, 0x80, 0x50, 0xc4, 0xc1, 0xbb, 0x42, 0xd9, 0x12, 0x69, 0xa1,
+	0x17, 0x12, 0x2d, 0x5c, 0xb8, 0x6e, 0xcb, 0x1e, 0x41, 0x28, 0x02, 0x09, 0x71, 0xa9, 0xdc, 0x78,
+	0x54, 0xa2, 0x24, 0x76, 0xe5, 0x38, 0x29, 0xfc, 0x03, 0x07, 0xc4, 0x47, 0xc0, 0xaf, 0x70, 0xdc,
+	0x23, 0x47, 0xd4, 0xfe, 0x08, 0xaa, 0xed, 0x0d, 0x87, 0xa8, 0x52, 0xc5, 0x89, 0x5b, 0xe6, 0xe5,
+	0xcd, 0x9b, 0xe7, 0x67, 0x0f, 0x3c, 0x56, 0xb8, 0x44, 0x11, 0x63, 0x2a, 0x53, 0x85, 0x3c, 0xd3,
+	0xf1, 0x82, 0x55, 0x39, 0xea, 0xb8, 0xb9, 0x88, 0xb1, 0x41, 0xa1, 0xab, 0x68, 0xa5, 0xa4, 0x96,
+	0xfe, 0x89, 0xe1, 0x45, 0x2d, 0x2f, 0xb2, 0xbc, 0xa8, 0xb9, 0x38, 0x3d, 0xdf, 0x2f, 0xa1, 0x3f,
+	0xaf, 0xd0, 0x29, 0x84, 0xaf, 0xc1, 0xbb, 0xda, 0x29, 0xce, 0x14, 0x32, 0x8d, 0xfe, 0x23, 0xb8,
+	0x63, 0x79, 0x73, 0x8e, 0x42, 0x96, 0x94, 0x8c, 0xc9, 0x64, 0x94, 0x78, 0x16, 0x7b, 0xb9, 0x83,
+	0xfc, 0x87, 0x30, 0x4c, 0x6b, 0xc5, 0xb4, 0x54, 0xb4, 0xbf, 0xfb, 0x3b, 0xed, 0x53, 0x92, 0xdc,
+	0x40, 0xe1, 0x77, 0x02, 0xc7, 0x46, 0xf0, 0x4d, 0xad, 0xfd, 0x07, 0x70, 0x5b, 0xae, 0x05, 0x2a,
+	0x27, 0x63, 0x8b, 0xce, 0x8c, 0x7e, 0x77, 0xc6, 0x15, 0x0c, 0xad, 0xeb, 0x8a, 0x0e, 0xc6, 0x83,
+	0x89, 0xf7, 0xec, 0x49, 0xb4, 0xf7, 0xa4, 0xd1, 0xd4, 0x7c, 0xcd, 0x0c, 0xec, 0xcc, 0xd8, 0x5e,
+	0xff, 0x14, 0x8e, 0x58, 0x29, 0x6b, 0xa1, 0xe9, 0xad, 0xd6, 0xa9, 0x43, 0xc2, 0x1f, 0x04, 0x46,
+	0xc6, 0xe8, 0x5b, 0x96, 0xe3, 0x7f, 0xed, 0xf4, 0x0b, 0x81, 0x33, 0xe3, 0xf4, 0xdd, 0x8a, 0x33,
+	0x8d, 0x56, 0xe4, 0xb2, 0x28, 0xe4, 0x1a, 0xf9, 0xac, 0x60, 0x55, 0x85, 0xd5, 0x21, 0xf7, 0x76,
+	0x06, 0x1e, 0xe3, 0x7c, 0x9e, 0xda, 0x0e, 0xda, 0x1f, 0x0f, 0x26, 0xa3, 0x04, 0x18, 0x6f, 0x35,
+	0xce, 0xe1, 0x9e, 0xc2, 0x52, 0x36, 0xd8, 0x72, 0x06, 0x86, 0x73, 0xd7, 0xa2, 0x8e, 0x16, 0x7e,
+	0x23, 0x70, 0xdf, 0x3e, 0x99, 0x42, 0x56, 0xce, 0x8d, 0x4f, 0xff, 0x3e, 0x0a, 0x3b, 0xfa, 0xa6,
+	0x3c, 0x24, 0xc3, 0xcb, 0x7f, 0xcd, 0xb0, 0xcd, 0x2f, 0x7c, 0x0f, 0xb4, 0x13, 0xd1, 0xab, 0x4c,
+	0x98, 0xbb, 0x3d, 0x20, 0x9b, 0x13, 0x38, 0x2e, 0x33, 0x31, 0xd7, 0x2c, 0x47, 0x67, 0x70, 0x58,
+	0xda, 0xee, 0x69, 0xf2, 0x73, 0x13, 0x90, 0xeb, 0x4d, 0x40, 0x7e, 0x6f, 0x02, 0xf2, 0x75, 0x1b,
+	0xf4, 0xae, 0xb7, 0x41, 0xef, 0xd7, 0x36, 0xe8, 0x7d, 0x78, 0xb1, 0xcc, 0xf4, 0xc7, 0x7a, 0x11,
+	0xa5, 0xb2, 0x8c, 0x8d, 0xdf, 0xa7, 0x02, 0xf5, 0x5a, 0xaa, 0xdc, 0x55, 0x05, 0xf2, 0x25, 0xaa,
+	0xf8, 0x53, 0x67, 0x07, 0x17, 0x47, 0x66, 0xf7, 0x9e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x06,
+	0x59, 0x5b, 0x1b, 0xe7, 0x03, 0x00, 0x00,
 }
 
 func (m *EventCreate) Marshal() (dAtA []byte, err error) {
@@ -432,6 +635,142 @@ func (m *EventTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateBasketAllowedClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketAllowedClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketAllowedClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveClasses) > 0 {
+		for iNdEx := len(m.RemoveClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveClasses[iNdEx])
+			copy(dAtA[i:], m.RemoveClasses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemoveClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddClasses) > 0 {
+		for iNdEx := len(m.AddClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddClasses[iNdEx])
+			copy(dAtA[i:], m.AddClasses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCloseBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBasketMinTake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketMinTake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketMinTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinTake) > 0 {
+		i -= len(m.MinTake)
+		copy(dAtA[i:], m.MinTake)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MinTake)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -514,6 +853,71 @@ func (m *EventTake) Size() (n int) {
 	return n
 }
 
+func (m *EventUpdateBasketAllowedClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddClasses) > 0 {
+		for _, s := range m.AddClasses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemoveClasses) > 0 {
+		for _, s := range m.RemoveClasses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCloseBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateBasketMinTake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MinTake)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -553,7 +957,185 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -563,27 +1145,29 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -611,7 +1195,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Curator = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -634,7 +1218,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPut) Unmarshal(dAtA []byte) error {
+func (m *EventTake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -657,10 +1241,10 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -814,7 +1398,7 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTake) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateBasketAllowedClasses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,15 +1421,15 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTake: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateBasketAllowedClasses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateBasketAllowedClasses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -873,7 +1457,153 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddClasses = append(m.AddClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveClasses = append(m.RemoveClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCloseBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -941,9 +1671,59 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBasketMinTake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBasketMinTake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBasketMinTake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -971,7 +1751,39 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTake = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
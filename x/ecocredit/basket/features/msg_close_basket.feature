@@ -0,0 +1,53 @@
+Feature: MsgCloseBasket
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with force
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "force": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "malformed curator address: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
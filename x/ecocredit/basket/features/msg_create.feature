@@ -31,6 +31,22 @@ Feature: MsgCreate
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with min take
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_type_abbrev": "C",
+      "allowed_classes": [
+        "C01"
+      ],
+      "min_take": "1000000"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario Outline: a valid message with disable auto-retire
     Given the message
     """
@@ -202,6 +218,114 @@ Feature: MsgCreate
     When the message is validated
     Then expect the error "credit type abbreviation must be 1-3 uppercase latin letters: got foobar: invalid request"
 
+  Scenario: a valid message with credit types
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "0.6"},
+        {"credit_type_abbrev": "BIO", "weight": "0.4"}
+      ],
+      "allowed_classes": [
+        "C01"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if credit type abbreviation and credit types are both set
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_type_abbrev": "C",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "0.5"},
+        {"credit_type_abbrev": "BIO", "weight": "0.5"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation and credit types cannot both be set: invalid request"
+
+  Scenario: an error is returned if credit types includes a single credit type
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "1"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credit types must include at least two credit types: invalid request"
+
+  Scenario: an error is returned if a credit type in credit types is not formatted
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "0.5"},
+        {"credit_type_abbrev": "foobar", "weight": "0.5"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation must be 1-3 uppercase latin letters: got foobar: invalid request"
+
+  Scenario: an error is returned if credit types includes a duplicate credit type
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "0.5"},
+        {"credit_type_abbrev": "C", "weight": "0.5"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "duplicate credit type C: invalid request"
+
+  Scenario: an error is returned if a credit type weight is not positive
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "1"},
+        {"credit_type_abbrev": "BIO", "weight": "0"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credit_types[1] weight: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if credit type weights do not sum to one
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_types": [
+        {"credit_type_abbrev": "C", "weight": "0.5"},
+        {"credit_type_abbrev": "BIO", "weight": "0.4"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type weights must sum to 1, got 0.9: invalid request"
+
   Scenario: an error is returned if allowed credit classes is empty
     Given the message
     """
@@ -302,6 +426,38 @@ Feature: MsgCreate
     When the message is validated
     Then expect the error "invalid date criteria: start_date_window must be at least 1 day: invalid request"
 
+  Scenario: an error is returned if min take is not an integer
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_type_abbrev": "C",
+      "allowed_classes": [
+        "C01"
+      ],
+      "min_take": "1.5"
+    }
+    """
+    When the message is validated
+    Then expect the error "min take 1.5 is not a valid integer: invalid request"
+
+  Scenario: an error is returned if min take is not positive
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "name": "NCT",
+      "credit_type_abbrev": "C",
+      "allowed_classes": [
+        "C01"
+      ],
+      "min_take": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "min take must be positive, got 0: invalid request"
+
   Scenario: an error is returned if fee denom is empty
     Given the message
     """
@@ -146,3 +146,39 @@ Feature: MsgPut
     """
     When the message is validated
     Then expect the error "expected a positive decimal, got -100: invalid decimal string: invalid request"
+
+  Scenario: a valid message with retirement jurisdiction
+    Given the message
+    """
+    {
+      "owner": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if retirement jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "owner": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "retirement_jurisdiction": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
@@ -0,0 +1,103 @@
+Feature: MsgUpdateBasketAllowedClasses
+
+  Scenario: a valid message with classes to add and remove
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "add_classes": [
+        "C02"
+      ],
+      "remove_classes": [
+        "C01"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with classes to add
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "add_classes": [
+        "C02"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "malformed curator address: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if no classes are provided
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect the error "must specify at least one of add_classes or remove_classes: invalid request"
+
+  Scenario: an error is returned if a class is not formatted
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "remove_classes": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "remove_classes[0] is not a valid class ID: class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: an error is returned if a class is both added and removed
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "add_classes": [
+        "C01"
+      ],
+      "remove_classes": [
+        "C01"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "duplicate class C01: invalid request"
@@ -0,0 +1,77 @@
+Feature: MsgUpdateBasketMinTake
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "min_take": "1000000"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing the min take
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "malformed curator address: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if min take is not an integer
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "min_take": "1.5"
+    }
+    """
+    When the message is validated
+    Then expect the error "min take 1.5 is not a valid integer: invalid request"
+
+  Scenario: an error is returned if min take is not positive
+    Given the message
+    """
+    {
+      "curator": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4afc3d27",
+      "basket_denom": "eco.uC.NCT",
+      "min_take": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "min take must be positive, got 0: invalid request"
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCloseBasketSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCloseBasket
+	err error
+}
+
+func TestMsgCloseBasket(t *testing.T) {
+	gocuke.NewRunner(t, &msgCloseBasketSuite{}).Path("./features/msg_close_basket.feature").Run()
+}
+
+func (s *msgCloseBasketSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCloseBasketSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCloseBasket{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCloseBasketSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCloseBasketSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCloseBasketSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
@@ -42,12 +43,22 @@ func (m MsgCreate) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("description length cannot be greater than %d characters", descrMaxLen)
 	}
 
-	if len(m.CreditTypeAbbrev) == 0 {
-		return sdkerrors.ErrInvalidRequest.Wrapf("credit type abbreviation cannot be empty")
-	}
+	if len(m.CreditTypes) > 0 {
+		if len(m.CreditTypeAbbrev) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("credit type abbreviation and credit types cannot both be set")
+		}
+
+		if err := validateCreditTypeWeights(m.CreditTypes); err != nil {
+			return err
+		}
+	} else {
+		if len(m.CreditTypeAbbrev) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit type abbreviation cannot be empty")
+		}
 
-	if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
-		return err
+		if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+			return err
+		}
 	}
 
 	if len(m.AllowedClasses) == 0 {
@@ -67,6 +78,12 @@ func (m MsgCreate) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("invalid date criteria: %s", err)
 	}
 
+	if len(m.MinTake) != 0 {
+		if err := validateMinTake(m.MinTake); err != nil {
+			return err
+		}
+	}
+
 	// In the next version of the basket package, this field will be updated to
 	// a single Coin rather than a list of Coins. In the meantime, the message
 	// will fail basic validation if more than one Coin is provided.
@@ -77,6 +94,59 @@ func (m MsgCreate) ValidateBasic() error {
 	return m.Fee.Validate()
 }
 
+// validateCreditTypeWeights checks that a blended basket has at least two
+// unique and valid credit types with positive weights that sum to 1.
+func validateCreditTypeWeights(creditTypes []*CreditTypeWeight) error {
+	if len(creditTypes) < 2 {
+		return sdkerrors.ErrInvalidRequest.Wrap("credit types must include at least two credit types")
+	}
+
+	seen := make(map[string]bool, len(creditTypes))
+	sum := math.NewDecFromInt64(0)
+	for i, ct := range creditTypes {
+		if ct == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit_types[%d] cannot be empty", i)
+		}
+
+		if err := core.ValidateCreditTypeAbbreviation(ct.CreditTypeAbbrev); err != nil {
+			return err
+		}
+
+		if seen[ct.CreditTypeAbbrev] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit type %s", ct.CreditTypeAbbrev)
+		}
+		seen[ct.CreditTypeAbbrev] = true
+
+		weight, err := math.NewPositiveDecFromString(ct.Weight)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit_types[%d] weight: %s", i, err)
+		}
+
+		sum, err = sum.Add(weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !sum.Equal(math.NewDecFromInt64(1)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit type weights must sum to 1, got %s", sum)
+	}
+
+	return nil
+}
+
+// validateMinTake checks that the minimum take amount is a positive integer.
+func validateMinTake(minTake string) error {
+	amount, ok := sdk.NewIntFromString(minTake)
+	if !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("min take %s is not a valid integer", minTake)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("min take must be positive, got %s", minTake)
+	}
+	return nil
+}
+
 // GetSigners returns the expected signers for MsgCreate.
 func (m MsgCreate) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Curator)
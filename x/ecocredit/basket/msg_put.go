@@ -59,6 +59,14 @@ func (m MsgPut) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
 	}
 
+	// retirement jurisdiction is only required if the basket retires credits
+	// on put, which is checked against state when the message is handled
+	if len(m.RetirementJurisdiction) != 0 {
+		if err := core.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,68 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBasketAllowedClasses{}
+
+// Route implements LegacyMsg.
+func (m MsgUpdateBasketAllowedClasses) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgUpdateBasketAllowedClasses) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgUpdateBasketAllowedClasses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgUpdateBasketAllowedClasses) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrap("malformed curator address: " + err.Error())
+	}
+
+	if len(m.BasketDenom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("basket denom cannot be empty")
+	}
+
+	if err := ValidateBasketDenom(m.BasketDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.AddClasses) == 0 && len(m.RemoveClasses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("must specify at least one of add_classes or remove_classes")
+	}
+
+	seen := make(map[string]bool)
+	validateClasses := func(field string, classes []string) error {
+		for i, class := range classes {
+			if err := core.ValidateClassId(class); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s[%d] is not a valid class ID: %s", field, i, err)
+			}
+			if seen[class] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class %s", class)
+			}
+			seen[class] = true
+		}
+		return nil
+	}
+
+	if err := validateClasses("add_classes", m.AddClasses); err != nil {
+		return err
+	}
+
+	return validateClasses("remove_classes", m.RemoveClasses)
+}
+
+// GetSigners returns the expected signers for MsgUpdateBasketAllowedClasses.
+func (m MsgUpdateBasketAllowedClasses) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketAllowedClassesSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketAllowedClasses
+	err error
+}
+
+func TestMsgUpdateBasketAllowedClasses(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketAllowedClassesSuite{}).Path("./features/msg_update_basket_allowed_classes.feature").Run()
+}
+
+func (s *msgUpdateBasketAllowedClassesSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketAllowedClassesSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketAllowedClasses{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketAllowedClassesSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketAllowedClassesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketAllowedClassesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketMinTakeSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketMinTake
+	err error
+}
+
+func TestMsgUpdateBasketMinTake(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketMinTakeSuite{}).Path("./features/msg_update_basket_min_take.feature").Run()
+}
+
+func (s *msgUpdateBasketMinTakeSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketMinTakeSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketMinTake{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketMinTakeSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketMinTakeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketMinTakeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
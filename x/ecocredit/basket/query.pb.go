@@ -6,9 +6,11 @@ package basket
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -332,6 +334,11 @@ type QueryBasketBalancesResponse struct {
 	//
 	// Since Revision 1
 	BalancesInfo []*BasketBalanceInfo `protobuf:"bytes,3,rep,name=balances_info,json=balancesInfo,proto3" json:"balances_info,omitempty"`
+	// basket_token_supply is the total supply of basket tokens, which can be
+	// compared with the balances to compute the backing ratio of the basket.
+	//
+	// Since Revision 2
+	BasketTokenSupply *types.Coin `protobuf:"bytes,4,opt,name=basket_token_supply,json=basketTokenSupply,proto3" json:"basket_token_supply,omitempty"`
 }
 
 func (m *QueryBasketBalancesResponse) Reset()         { *m = QueryBasketBalancesResponse{} }
@@ -389,6 +396,13 @@ func (m *QueryBasketBalancesResponse) GetBalancesInfo() []*BasketBalanceInfo {
 	return nil
 }
 
+func (m *QueryBasketBalancesResponse) GetBasketTokenSupply() *types.Coin {
+	if m != nil {
+		return m.BasketTokenSupply
+	}
+	return nil
+}
+
 // QueryBasketBalanceRequest is the Query/BasketBalance request type.
 type QueryBasketBalanceRequest struct {
 	// basket_denom is the denom of the basket.
@@ -490,6 +504,224 @@ func (m *QueryBasketBalanceResponse) GetBalance() string {
 	return ""
 }
 
+// QueryBasketCriteriaRequest is the Query/BasketCriteria request type.
+type QueryBasketCriteriaRequest struct {
+	// basket_denom is the denom of the basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+}
+
+func (m *QueryBasketCriteriaRequest) Reset()         { *m = QueryBasketCriteriaRequest{} }
+func (m *QueryBasketCriteriaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketCriteriaRequest) ProtoMessage()    {}
+func (*QueryBasketCriteriaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{8}
+}
+func (m *QueryBasketCriteriaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketCriteriaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketCriteriaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketCriteriaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketCriteriaRequest.Merge(m, src)
+}
+func (m *QueryBasketCriteriaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketCriteriaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketCriteriaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketCriteriaRequest proto.InternalMessageInfo
+
+func (m *QueryBasketCriteriaRequest) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+// QueryBasketCriteriaResponse is the Query/BasketCriteria response type.
+type QueryBasketCriteriaResponse struct {
+	// min_start_date is the earliest start date, formatted as RFC 3339, of
+	// batches allowed in the basket. It is empty if the basket doesn't have a
+	// minimum start date.
+	MinStartDate string `protobuf:"bytes,1,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// start_date_window is the maximum age of the start date of batches allowed
+	// in the basket, formatted as a duration string (e.g. "8760h0m0s"). It is
+	// empty if the basket doesn't have a start date window.
+	StartDateWindow string `protobuf:"bytes,2,opt,name=start_date_window,json=startDateWindow,proto3" json:"start_date_window,omitempty"`
+	// years_in_the_past is the number of years in the past, relative to the
+	// current year, from which batch start dates are allowed in the basket. It
+	// is zero if the basket doesn't use this criteria.
+	YearsInThePast uint32 `protobuf:"varint,3,opt,name=years_in_the_past,json=yearsInThePast,proto3" json:"years_in_the_past,omitempty"`
+}
+
+func (m *QueryBasketCriteriaResponse) Reset()         { *m = QueryBasketCriteriaResponse{} }
+func (m *QueryBasketCriteriaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketCriteriaResponse) ProtoMessage()    {}
+func (*QueryBasketCriteriaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{9}
+}
+func (m *QueryBasketCriteriaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketCriteriaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketCriteriaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketCriteriaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketCriteriaResponse.Merge(m, src)
+}
+func (m *QueryBasketCriteriaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketCriteriaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketCriteriaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketCriteriaResponse proto.InternalMessageInfo
+
+func (m *QueryBasketCriteriaResponse) GetMinStartDate() string {
+	if m != nil {
+		return m.MinStartDate
+	}
+	return ""
+}
+
+func (m *QueryBasketCriteriaResponse) GetStartDateWindow() string {
+	if m != nil {
+		return m.StartDateWindow
+	}
+	return ""
+}
+
+func (m *QueryBasketCriteriaResponse) GetYearsInThePast() uint32 {
+	if m != nil {
+		return m.YearsInThePast
+	}
+	return 0
+}
+
+// QueryEligibleCreditsRequest is the Query/EligibleCredits request type.
+type QueryEligibleCreditsRequest struct {
+	// owner is the address of the account holding the credits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// basket_denom is the denom of the basket.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+}
+
+func (m *QueryEligibleCreditsRequest) Reset()         { *m = QueryEligibleCreditsRequest{} }
+func (m *QueryEligibleCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEligibleCreditsRequest) ProtoMessage()    {}
+func (*QueryEligibleCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{10}
+}
+func (m *QueryEligibleCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEligibleCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEligibleCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEligibleCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEligibleCreditsRequest.Merge(m, src)
+}
+func (m *QueryEligibleCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEligibleCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEligibleCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEligibleCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryEligibleCreditsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryEligibleCreditsRequest) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+// QueryEligibleCreditsResponse is the Query/EligibleCredits response type.
+type QueryEligibleCreditsResponse struct {
+	// credits are the tradable credit balances of the owner that the basket
+	// accepts. Batches with no tradable balance are omitted.
+	Credits []*BasketCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *QueryEligibleCreditsResponse) Reset()         { *m = QueryEligibleCreditsResponse{} }
+func (m *QueryEligibleCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEligibleCreditsResponse) ProtoMessage()    {}
+func (*QueryEligibleCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{11}
+}
+func (m *QueryEligibleCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEligibleCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEligibleCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEligibleCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEligibleCreditsResponse.Merge(m, src)
+}
+func (m *QueryEligibleCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEligibleCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEligibleCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEligibleCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryEligibleCreditsResponse) GetCredits() []*BasketCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
 // BasketInfo is the human-readable basket information.
 type BasketInfo struct {
 	// basket_denom is the basket bank denom.
@@ -511,13 +743,32 @@ type BasketInfo struct {
 	// curator is the address of the basket curator who is able to change certain
 	// basket settings.
 	Curator string `protobuf:"bytes,7,opt,name=curator,proto3" json:"curator,omitempty"`
+	// credit_types are the credit types and weights of a basket holding more
+	// than one credit type.
+	//
+	// Since Revision 2
+	CreditTypes []*CreditTypeWeight `protobuf:"bytes,8,rep,name=credit_types,json=creditTypes,proto3" json:"credit_types,omitempty"`
+	// retire_on_put dictates whether credits put into the basket are retired
+	// immediately instead of being held by the basket.
+	//
+	// Since Revision 2
+	RetireOnPut bool `protobuf:"varint,9,opt,name=retire_on_put,json=retireOnPut,proto3" json:"retire_on_put,omitempty"`
+	// min_take is the minimum integer amount of basket tokens that can be taken
+	// from the basket in a single MsgTake.
+	//
+	// Since Revision 2
+	MinTake string `protobuf:"bytes,10,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
+	// closed indicates whether the basket has been closed by the curator.
+	//
+	// Since Revision 2
+	Closed bool `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
 func (m *BasketInfo) Reset()         { *m = BasketInfo{} }
 func (m *BasketInfo) String() string { return proto.CompactTextString(m) }
 func (*BasketInfo) ProtoMessage()    {}
 func (*BasketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a83a50529e6be723, []int{8}
+	return fileDescriptor_a83a50529e6be723, []int{12}
 }
 func (m *BasketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,19 +846,51 @@ func (m *BasketInfo) GetCurator() string {
 	return ""
 }
 
+func (m *BasketInfo) GetCreditTypes() []*CreditTypeWeight {
+	if m != nil {
+		return m.CreditTypes
+	}
+	return nil
+}
+
+func (m *BasketInfo) GetRetireOnPut() bool {
+	if m != nil {
+		return m.RetireOnPut
+	}
+	return false
+}
+
+func (m *BasketInfo) GetMinTake() string {
+	if m != nil {
+		return m.MinTake
+	}
+	return ""
+}
+
+func (m *BasketInfo) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 // BasketBalanceInfo is the human-readable basket balance information.
 type BasketBalanceInfo struct {
 	// batch_denom is the denom of the credit batch
 	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
 	// balance is the amount of ecocredits held in the basket
 	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// batch_start_date is the start date of the credit batch.
+	//
+	// Since Revision 2
+	BatchStartDate *types1.Timestamp `protobuf:"bytes,3,opt,name=batch_start_date,json=batchStartDate,proto3" json:"batch_start_date,omitempty"`
 }
 
 func (m *BasketBalanceInfo) Reset()         { *m = BasketBalanceInfo{} }
 func (m *BasketBalanceInfo) String() string { return proto.CompactTextString(m) }
 func (*BasketBalanceInfo) ProtoMessage()    {}
 func (*BasketBalanceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a83a50529e6be723, []int{9}
+	return fileDescriptor_a83a50529e6be723, []int{13}
 }
 func (m *BasketBalanceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,6 +933,13 @@ func (m *BasketBalanceInfo) GetBalance() string {
 	return ""
 }
 
+func (m *BasketBalanceInfo) GetBatchStartDate() *types1.Timestamp {
+	if m != nil {
+		return m.BatchStartDate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBasketRequest)(nil), "regen.ecocredit.basket.v1.QueryBasketRequest")
 	proto.RegisterType((*QueryBasketResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketResponse")
@@ -659,6 +949,10 @@ func init() {
 	proto.RegisterType((*QueryBasketBalancesResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketBalancesResponse")
 	proto.RegisterType((*QueryBasketBalanceRequest)(nil), "regen.ecocredit.basket.v1.QueryBasketBalanceRequest")
 	proto.RegisterType((*QueryBasketBalanceResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketBalanceResponse")
+	proto.RegisterType((*QueryBasketCriteriaRequest)(nil), "regen.ecocredit.basket.v1.QueryBasketCriteriaRequest")
+	proto.RegisterType((*QueryBasketCriteriaResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketCriteriaResponse")
+	proto.RegisterType((*QueryEligibleCreditsRequest)(nil), "regen.ecocredit.basket.v1.QueryEligibleCreditsRequest")
+	proto.RegisterType((*QueryEligibleCreditsResponse)(nil), "regen.ecocredit.basket.v1.QueryEligibleCreditsResponse")
 	proto.RegisterType((*BasketInfo)(nil), "regen.ecocredit.basket.v1.BasketInfo")
 	proto.RegisterType((*BasketBalanceInfo)(nil), "regen.ecocredit.basket.v1.BasketBalanceInfo")
 }
@@ -668,61 +962,84 @@ func init() {
 }
 
 var fileDescriptor_a83a50529e6be723 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xed, 0x38, 0xbb, 0x49, 0x77, 0xb6, 0x41, 0xec, 0x2c, 0x07, 0x6f, 0x40, 0x21, 0x6b, 0xb1,
-	0x10, 0xad, 0xb6, 0x36, 0xd9, 0x65, 0xcb, 0x0f, 0x81, 0x50, 0x43, 0x55, 0x0a, 0x42, 0x88, 0x5a,
-	0x9c, 0x2a, 0x21, 0x6b, 0xec, 0x7c, 0x75, 0xad, 0x26, 0x9e, 0xd4, 0x33, 0x09, 0x8d, 0xaa, 0x0a,
-	0x04, 0x07, 0xae, 0x48, 0x20, 0x24, 0xe0, 0xcc, 0xbf, 0xc0, 0xdf, 0xc0, 0xb1, 0x12, 0x12, 0xe2,
-	0x88, 0x5a, 0x4e, 0xfc, 0x15, 0x28, 0x33, 0xe3, 0xd4, 0x4e, 0xda, 0xc6, 0xa9, 0xf6, 0xe6, 0x19,
-	0x7f, 0xef, 0xcd, 0xfb, 0xde, 0x1b, 0x7f, 0x09, 0x7e, 0x90, 0x40, 0x08, 0xb1, 0x03, 0x01, 0x0b,
-	0x12, 0xe8, 0x44, 0xc2, 0xf1, 0x29, 0xdf, 0x07, 0xe1, 0x0c, 0x5b, 0xce, 0xc1, 0x00, 0x92, 0x91,
-	0xdd, 0x4f, 0x98, 0x60, 0xe4, 0x9e, 0x2c, 0xb3, 0x27, 0x65, 0xb6, 0x2a, 0xb3, 0x87, 0xad, 0xda,
-	0x4b, 0x21, 0x63, 0x61, 0x17, 0x1c, 0xda, 0x8f, 0x1c, 0x1a, 0xc7, 0x4c, 0x50, 0x11, 0xb1, 0x98,
-	0x2b, 0x60, 0xed, 0x0a, 0x7e, 0x2e, 0xa8, 0x00, 0x5d, 0xf6, 0x30, 0x60, 0xbc, 0xc7, 0xf8, 0xf8,
-	0x2d, 0xa8, 0x83, 0x9d, 0x61, 0xcb, 0x07, 0x41, 0x5b, 0x4e, 0x9f, 0x86, 0x51, 0x2c, 0x39, 0xe7,
-	0x53, 0x8a, 0x51, 0x1f, 0xf4, 0xc9, 0xd6, 0x9b, 0x98, 0x6c, 0x8f, 0x89, 0xda, 0xf2, 0xad, 0x0b,
-	0x07, 0x03, 0xe0, 0x82, 0xdc, 0xc7, 0x2b, 0xaa, 0xdc, 0xeb, 0x40, 0xcc, 0x7a, 0x26, 0x6a, 0xa0,
-	0xe6, 0x2d, 0xf7, 0xb6, 0xda, 0xdb, 0x18, 0x6f, 0x59, 0xbf, 0x23, 0x7c, 0x37, 0x87, 0xe4, 0x7d,
-	0x16, 0x73, 0x20, 0xef, 0xe1, 0xb2, 0x2a, 0x93, 0xa0, 0xdb, 0x8f, 0xef, 0xdb, 0x97, 0x9a, 0x62,
-	0x2b, 0x68, 0xdb, 0x30, 0x91, 0xab, 0x41, 0xc4, 0xc4, 0x95, 0xa0, 0x4b, 0x39, 0x07, 0x6e, 0x1a,
-	0x8d, 0x52, 0xf3, 0x96, 0x9b, 0x2e, 0xc9, 0x26, 0xd6, 0xe7, 0x7b, 0x51, 0xbc, 0xcb, 0xcc, 0x92,
-	0x64, 0x7f, 0x30, 0x97, 0xfd, 0xa3, 0x78, 0x97, 0xb9, 0xd8, 0x9f, 0x3c, 0x5b, 0x5f, 0xe4, 0x74,
-	0xf3, 0xb4, 0xe5, 0x4d, 0x8c, 0xcf, 0x3d, 0xd4, 0xda, 0x5f, 0xb5, 0x95, 0xe1, 0x63, 0x52, 0xb0,
-	0x55, 0xd2, 0xda, 0x70, 0xfb, 0x33, 0x1a, 0x82, 0xc6, 0xba, 0x19, 0xa4, 0xf5, 0x1f, 0xc2, 0x2f,
-	0xe4, 0xf9, 0xb5, 0x31, 0xef, 0xe3, 0x8a, 0x52, 0xc1, 0x4d, 0xd4, 0x28, 0x15, 0x77, 0x26, 0x45,
-	0x91, 0x0f, 0x73, 0x0a, 0x0d, 0xa9, 0xf0, 0xb5, 0xb9, 0x0a, 0xd5, 0xe9, 0x59, 0x89, 0x64, 0x2b,
-	0x4d, 0x97, 0xa7, 0x56, 0x96, 0x8a, 0x5b, 0xa9, 0x43, 0xe0, 0xd2, 0xcb, 0xef, 0x10, 0xae, 0x65,
-	0x9a, 0x6d, 0xd3, 0x2e, 0x8d, 0x03, 0xe0, 0xc5, 0xaf, 0xd1, 0x94, 0xed, 0xc6, 0xb5, 0x6d, 0xff,
-	0xd6, 0xc0, 0x2f, 0x5e, 0xa8, 0x44, 0xbb, 0xbf, 0x85, 0x97, 0x7d, 0xbd, 0xa7, 0xed, 0x6f, 0xce,
-	0xb7, 0x5f, 0x01, 0x64, 0x0a, 0x13, 0xf4, 0xb3, 0x8b, 0x61, 0x1b, 0x57, 0x53, 0xd2, 0x6c, 0x0e,
-	0x8f, 0x8a, 0xea, 0x92, 0x71, 0xac, 0xa4, 0x14, 0x32, 0x0f, 0x0f, 0xdf, 0x9b, 0x35, 0x61, 0x81,
-	0x34, 0x5e, 0x1e, 0x7f, 0x63, 0x22, 0xd8, 0xd3, 0x15, 0x86, 0xac, 0xc0, 0x72, 0x4b, 0x7d, 0xf5,
-	0x6b, 0x17, 0xe5, 0x3d, 0x31, 0xd9, 0x1c, 0x5f, 0x71, 0xb9, 0xa5, 0xc9, 0xd3, 0xa5, 0xf5, 0x9b,
-	0x81, 0xf1, 0xf9, 0x25, 0x2a, 0x22, 0x85, 0xe0, 0x1b, 0x31, 0xed, 0x81, 0xd6, 0x20, 0x9f, 0x89,
-	0x8d, 0xef, 0x76, 0x22, 0x4e, 0xfd, 0x2e, 0x78, 0x74, 0x20, 0x98, 0x97, 0x80, 0x88, 0x12, 0x90,
-	0xa3, 0x60, 0xd9, 0xbd, 0xa3, 0x5f, 0xad, 0x0f, 0x04, 0x73, 0xe5, 0x0b, 0xf2, 0x08, 0x13, 0x65,
-	0xa1, 0x37, 0x1e, 0x79, 0x1e, 0xf5, 0xfd, 0x04, 0x86, 0xe6, 0x0d, 0xc9, 0xf8, 0xbc, 0x7a, 0xf3,
-	0xf9, 0xa8, 0x0f, 0xeb, 0x72, 0x9f, 0x7c, 0x82, 0xab, 0x1d, 0x2a, 0xc0, 0x0b, 0x92, 0x48, 0x40,
-	0x12, 0x51, 0xf3, 0xa6, 0xce, 0xf6, 0xf2, 0x3c, 0x36, 0xa8, 0x80, 0x0f, 0x74, 0xb9, 0xbb, 0xd2,
-	0xc9, 0xac, 0x48, 0x0d, 0x2f, 0xc3, 0x61, 0x9f, 0xc5, 0x10, 0x0b, 0xb3, 0xdc, 0x40, 0xcd, 0xaa,
-	0x3b, 0x59, 0xcb, 0x21, 0x37, 0x48, 0xa8, 0x60, 0x89, 0x59, 0x51, 0x3e, 0xe9, 0xa5, 0xf5, 0x29,
-	0xbe, 0x33, 0x93, 0xf1, 0x74, 0x2a, 0x68, 0x3a, 0x95, 0xac, 0xef, 0x46, 0xce, 0xf7, 0xc7, 0x3f,
-	0x57, 0xf0, 0x4d, 0x19, 0x18, 0xf9, 0x0b, 0xe1, 0xb2, 0xa2, 0x26, 0xab, 0x57, 0x74, 0x34, 0xfb,
-	0x63, 0x50, 0xb3, 0x8b, 0x96, 0xab, 0x5b, 0x60, 0xf5, 0xbe, 0xf9, 0xf3, 0xdf, 0x1f, 0x8c, 0x90,
-	0xbc, 0xee, 0x5c, 0xfe, 0x13, 0xa4, 0x9f, 0x8e, 0xb2, 0xb7, 0xe0, 0x78, 0xe7, 0x09, 0x69, 0xcd,
-	0xc5, 0xf0, 0x29, 0x10, 0xf9, 0x09, 0xe1, 0x8a, 0x9e, 0xb5, 0xa4, 0xa0, 0xd4, 0x74, 0x40, 0xd5,
-	0x9c, 0xc2, 0xf5, 0xba, 0xb7, 0x87, 0xb2, 0xb7, 0x57, 0x88, 0x35, 0x5f, 0x27, 0xf9, 0xda, 0xc0,
-	0xcf, 0xe5, 0xa7, 0x11, 0x79, 0x5a, 0xec, 0xbc, 0xa9, 0x39, 0x5a, 0x5b, 0x5b, 0x14, 0xa6, 0xd5,
-	0x7e, 0x25, 0xd5, 0x8e, 0xc8, 0xdb, 0x73, 0xd5, 0xae, 0xa6, 0x63, 0x64, 0x3a, 0x92, 0x77, 0xc9,
-	0x3b, 0x0b, 0x47, 0xe2, 0x4c, 0x66, 0xe5, 0x2f, 0x06, 0xae, 0xe6, 0xb4, 0x91, 0x37, 0x16, 0x6a,
-	0x25, 0x35, 0xe0, 0xe9, 0x82, 0x28, 0xdd, 0xff, 0xaf, 0x48, 0x1a, 0xf0, 0x23, 0x22, 0x9b, 0x85,
-	0x1d, 0x98, 0xee, 0xe5, 0x28, 0xf3, 0xe9, 0x1d, 0xef, 0x7c, 0x4c, 0xb6, 0xae, 0x6f, 0x47, 0x9e,
-	0xab, 0xed, 0xfe, 0x71, 0x5a, 0x47, 0x27, 0xa7, 0x75, 0xf4, 0xcf, 0x69, 0x1d, 0x7d, 0x7f, 0x56,
-	0x5f, 0x3a, 0x39, 0xab, 0x2f, 0xfd, 0x7d, 0x56, 0x5f, 0xda, 0x79, 0x2b, 0x8c, 0xc4, 0xde, 0xc0,
-	0xb7, 0x03, 0xd6, 0x53, 0xa7, 0xad, 0xc6, 0x20, 0xbe, 0x64, 0xc9, 0xbe, 0x5e, 0x75, 0xa1, 0x13,
-	0x42, 0xe2, 0x1c, 0xce, 0x88, 0xf0, 0xcb, 0xf2, 0x5f, 0xdd, 0x93, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x7e, 0xe6, 0x7b, 0xac, 0xb1, 0x0a, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xaf, 0x37, 0xcd, 0xd7, 0xcb, 0x47, 0x9b, 0x49, 0x85, 0x9c, 0xa5, 0xda, 0xa6, 0x56, 0x4b,
+	0x43, 0x68, 0x6c, 0xd2, 0xd2, 0x16, 0x10, 0xa8, 0xca, 0x07, 0x21, 0xa9, 0x2a, 0x48, 0xdd, 0x88,
+	0x4a, 0x91, 0x90, 0x35, 0xbb, 0x3b, 0xd9, 0x58, 0xd9, 0x9d, 0x71, 0x3d, 0xb3, 0x49, 0x57, 0x51,
+	0x04, 0xe2, 0xc2, 0x15, 0x89, 0x8a, 0x43, 0xf9, 0x3f, 0x10, 0x77, 0x2e, 0x1c, 0x10, 0xaa, 0x84,
+	0x84, 0x38, 0xa2, 0x84, 0x13, 0x7f, 0x05, 0xf2, 0xcc, 0xd8, 0xb1, 0x77, 0x93, 0xac, 0x37, 0xe2,
+	0xe6, 0x99, 0x79, 0xbf, 0xe7, 0xdf, 0xfb, 0xbd, 0xf7, 0xe6, 0x0d, 0xdc, 0x0c, 0x49, 0x8d, 0x50,
+	0x87, 0x54, 0x58, 0x25, 0x24, 0x55, 0x5f, 0x38, 0x65, 0xcc, 0x77, 0x88, 0x70, 0x76, 0xe7, 0x9d,
+	0xe7, 0x4d, 0x12, 0xb6, 0xec, 0x20, 0x64, 0x82, 0xa1, 0x29, 0x69, 0x66, 0x27, 0x66, 0xb6, 0x32,
+	0xb3, 0x77, 0xe7, 0x8b, 0x57, 0x6b, 0x8c, 0xd5, 0xea, 0xc4, 0xc1, 0x81, 0xef, 0x60, 0x4a, 0x99,
+	0xc0, 0xc2, 0x67, 0x94, 0x2b, 0x60, 0xf1, 0x9a, 0x3e, 0x95, 0xab, 0x72, 0x73, 0xcb, 0x11, 0x7e,
+	0x83, 0x70, 0x81, 0x1b, 0x81, 0x36, 0x28, 0x55, 0x18, 0x6f, 0x30, 0x1e, 0xfd, 0x97, 0x38, 0xbb,
+	0xf3, 0x65, 0x22, 0xf0, 0xbc, 0x53, 0x61, 0x3e, 0xd5, 0xe7, 0x67, 0x10, 0xe4, 0x02, 0x0b, 0xa2,
+	0xcd, 0x66, 0xd3, 0x6e, 0x24, 0xf3, 0xc4, 0x59, 0x80, 0x6b, 0x3e, 0x95, 0xa4, 0xba, 0xbb, 0x14,
+	0xad, 0x80, 0x68, 0xea, 0xd6, 0x03, 0x40, 0x4f, 0x22, 0x47, 0x8b, 0xf2, 0xd4, 0x25, 0xcf, 0x9b,
+	0x84, 0x0b, 0x74, 0x1d, 0x46, 0x95, 0xb9, 0x57, 0x25, 0x94, 0x35, 0x4c, 0x63, 0xda, 0x98, 0x19,
+	0x76, 0x47, 0xd4, 0xde, 0x72, 0xb4, 0x65, 0xfd, 0x64, 0xc0, 0x64, 0x06, 0xc9, 0x03, 0x46, 0x39,
+	0x41, 0x1f, 0xc3, 0x80, 0x32, 0x93, 0xa0, 0x91, 0x3b, 0xd7, 0xed, 0x53, 0x55, 0xb5, 0x15, 0x74,
+	0xb1, 0x60, 0x1a, 0xae, 0x06, 0x21, 0x13, 0x06, 0x2b, 0x75, 0xcc, 0x39, 0xe1, 0x66, 0x61, 0xba,
+	0x6f, 0x66, 0xd8, 0x8d, 0x97, 0x68, 0x05, 0xf4, 0xff, 0x3d, 0x9f, 0x6e, 0x31, 0xb3, 0x4f, 0x7a,
+	0xbf, 0xd9, 0xd5, 0xfb, 0x1a, 0xdd, 0x62, 0x2e, 0x94, 0x93, 0x6f, 0xeb, 0xcb, 0x0c, 0x6f, 0x1e,
+	0x87, 0xbc, 0x02, 0x70, 0xac, 0xa1, 0xe6, 0xfe, 0x96, 0xad, 0x04, 0x8f, 0x9c, 0x12, 0x5b, 0x95,
+	0x8a, 0x16, 0xdc, 0x5e, 0xc7, 0x35, 0xa2, 0xb1, 0x6e, 0x0a, 0x69, 0xfd, 0x6b, 0xc0, 0x95, 0xac,
+	0x7f, 0x2d, 0xcc, 0x43, 0x18, 0x54, 0x2c, 0xb8, 0x69, 0x4c, 0xf7, 0xe5, 0x57, 0x26, 0x46, 0xa1,
+	0x4f, 0x33, 0x0c, 0x0b, 0x92, 0xe1, 0xad, 0xae, 0x0c, 0xd5, 0xdf, 0xd3, 0x14, 0xd1, 0x6a, 0x9c,
+	0x5d, 0x1e, 0x4b, 0xd9, 0x97, 0x5f, 0x4a, 0x9d, 0x04, 0x2e, 0xb5, 0xfc, 0xd6, 0x80, 0x62, 0x2a,
+	0xd8, 0x45, 0x5c, 0xc7, 0xb4, 0x42, 0x78, 0xfe, 0x32, 0x6a, 0x93, 0xbd, 0x70, 0x6e, 0xd9, 0x7f,
+	0x2f, 0xc0, 0x9b, 0x27, 0x32, 0xd1, 0xea, 0xaf, 0xc2, 0x50, 0x59, 0xef, 0x69, 0xf9, 0x67, 0xba,
+	0xcb, 0xaf, 0x00, 0x32, 0x0b, 0x09, 0xfa, 0xff, 0x4b, 0xc3, 0x13, 0x18, 0x8b, 0x9d, 0xa6, 0xf3,
+	0x70, 0x3b, 0x2f, 0x2f, 0x99, 0x8e, 0xd1, 0xd8, 0x45, 0xb4, 0x42, 0x6b, 0x30, 0xa9, 0x05, 0x17,
+	0x6c, 0x87, 0x50, 0x8f, 0x37, 0x83, 0xa0, 0xde, 0x32, 0x2f, 0x4a, 0x92, 0x53, 0x19, 0x92, 0x31,
+	0xbd, 0x25, 0xe6, 0x53, 0x77, 0x42, 0xa1, 0x36, 0x22, 0xd0, 0x53, 0x89, 0xb1, 0x3c, 0x98, 0xea,
+	0xd4, 0xb3, 0x87, 0xc4, 0x5e, 0x8b, 0xda, 0x55, 0x54, 0xb6, 0xb5, 0x45, 0x41, 0x5a, 0x80, 0xdc,
+	0x52, 0x17, 0xc8, 0xfd, 0x93, 0x4a, 0x27, 0xc9, 0x97, 0x19, 0x75, 0x8b, 0xdc, 0xd2, 0xce, 0xe3,
+	0xa5, 0xf5, 0x30, 0x83, 0x5b, 0x0a, 0x7d, 0x41, 0x42, 0x1f, 0xf7, 0x70, 0x73, 0xbd, 0x32, 0x32,
+	0xa5, 0x72, 0xec, 0x41, 0xff, 0xfa, 0x06, 0x8c, 0x37, 0x7c, 0xea, 0x71, 0x81, 0x43, 0xe1, 0x55,
+	0xb1, 0x88, 0x19, 0x8c, 0x36, 0x7c, 0xfa, 0x34, 0xda, 0x5c, 0xc6, 0x82, 0xa0, 0x59, 0x98, 0x38,
+	0xb6, 0xf0, 0xf6, 0x7c, 0x5a, 0x65, 0x7b, 0x3a, 0xca, 0x4b, 0x3c, 0xb6, 0x7a, 0x26, 0xb7, 0xd1,
+	0xdb, 0x30, 0xd1, 0x22, 0x38, 0x8c, 0xd2, 0xec, 0x89, 0x6d, 0xe2, 0x05, 0x98, 0x0b, 0x79, 0x81,
+	0x8d, 0xb9, 0xe3, 0xf2, 0x60, 0x8d, 0x6e, 0x6c, 0x93, 0x75, 0xcc, 0x85, 0xf5, 0x85, 0xe6, 0xf6,
+	0x49, 0xdd, 0xaf, 0xf9, 0xe5, 0x3a, 0x59, 0x92, 0x25, 0x90, 0x74, 0xd4, 0x15, 0xe8, 0x67, 0x7b,
+	0x94, 0x84, 0x9a, 0x92, 0x5a, 0x74, 0x04, 0x5d, 0xe8, 0x0c, 0x1a, 0xc3, 0xd5, 0x93, 0xfd, 0xea,
+	0xa0, 0x17, 0x60, 0x50, 0x55, 0x5b, 0xdc, 0x1e, 0xb7, 0xba, 0x96, 0xa1, 0x72, 0xe1, 0xc6, 0x38,
+	0xeb, 0xb7, 0x3e, 0x80, 0xe3, 0x8b, 0x22, 0x4f, 0x8d, 0x20, 0xb8, 0x48, 0x71, 0x83, 0x68, 0xbe,
+	0xf2, 0x1b, 0xd9, 0x30, 0x59, 0xf5, 0x39, 0x2e, 0xd7, 0x89, 0x87, 0x9b, 0x82, 0x79, 0x21, 0x11,
+	0x7e, 0x48, 0xa4, 0x5a, 0x43, 0xee, 0x84, 0x3e, 0x5a, 0x68, 0x0a, 0xe6, 0xca, 0x03, 0x74, 0x1b,
+	0x90, 0x22, 0xe0, 0x45, 0x63, 0xcd, 0xc3, 0xe5, 0x72, 0x48, 0x76, 0x65, 0xc5, 0x0f, 0xbb, 0x97,
+	0xd5, 0xc9, 0x46, 0x2b, 0x20, 0x0b, 0x72, 0x1f, 0x3d, 0x86, 0x31, 0x99, 0xaf, 0x8a, 0x4e, 0xba,
+	0xd9, 0xaf, 0xfb, 0xf7, 0xf4, 0x60, 0xa3, 0x3c, 0x26, 0x35, 0x32, 0x5a, 0x4d, 0xad, 0x50, 0x11,
+	0x86, 0xc8, 0x8b, 0x80, 0x51, 0x42, 0x85, 0x39, 0x20, 0xd3, 0x99, 0xac, 0xe5, 0x20, 0x6b, 0x86,
+	0x58, 0xb0, 0xd0, 0x1c, 0x54, 0x05, 0xac, 0x97, 0xe8, 0x33, 0x18, 0x4d, 0x31, 0xe6, 0xe6, 0x90,
+	0xd4, 0xfb, 0x9d, 0x33, 0x28, 0x2c, 0x25, 0x61, 0x3c, 0x23, 0x7e, 0x6d, 0x5b, 0xb8, 0x23, 0xc7,
+	0x81, 0x71, 0x64, 0xc1, 0x98, 0x12, 0xc9, 0x63, 0xd4, 0x0b, 0x9a, 0xc2, 0x1c, 0x96, 0x5a, 0x8d,
+	0xa8, 0xcd, 0xcf, 0xe9, 0x7a, 0x53, 0xa0, 0x29, 0x18, 0x8a, 0x6a, 0x5a, 0xe0, 0x1d, 0x62, 0x82,
+	0xa2, 0xd3, 0xf0, 0xe9, 0x06, 0xde, 0x21, 0xe8, 0x0d, 0x18, 0xa8, 0xd4, 0x19, 0x27, 0x55, 0x73,
+	0x44, 0xe2, 0xf4, 0xca, 0x7a, 0x69, 0xc0, 0x44, 0xc7, 0x7d, 0xd3, 0xde, 0xd6, 0x46, 0x7b, 0x5b,
+	0xa7, 0x1b, 0xb7, 0x90, 0x69, 0x5c, 0xb4, 0x0c, 0x97, 0x15, 0x34, 0xd5, 0x59, 0x6a, 0x8a, 0x17,
+	0x6d, 0xf5, 0x80, 0xb2, 0xe3, 0x07, 0x94, 0xbd, 0x11, 0x3f, 0xa0, 0xdc, 0x71, 0x89, 0x49, 0xfa,
+	0xee, 0xce, 0xcf, 0x00, 0xfd, 0xb2, 0x92, 0xd1, 0x9f, 0x06, 0x0c, 0x28, 0x82, 0x68, 0xee, 0x0c,
+	0xf1, 0x3a, 0x9f, 0x37, 0x45, 0x3b, 0xaf, 0xb9, 0x6a, 0x0e, 0xab, 0xf1, 0xcd, 0x1f, 0xff, 0x7c,
+	0x5f, 0xa8, 0xa1, 0x77, 0x9d, 0xd3, 0x1f, 0x55, 0xfa, 0x6b, 0x3f, 0x5d, 0xf3, 0x07, 0x9b, 0x77,
+	0xd1, 0x7c, 0x57, 0x0c, 0x6f, 0x03, 0xa1, 0x1f, 0x0c, 0x18, 0xd4, 0xaf, 0x07, 0x94, 0x93, 0x6a,
+	0x7c, 0x41, 0x14, 0x9d, 0xdc, 0xf6, 0x3a, 0xb6, 0x59, 0x19, 0xdb, 0x0d, 0x64, 0x75, 0xe7, 0x89,
+	0xbe, 0x2e, 0xc0, 0x78, 0x76, 0xbe, 0xa2, 0x7b, 0xf9, 0xfe, 0xd7, 0xf6, 0x32, 0x28, 0xde, 0xef,
+	0x15, 0xa6, 0xd9, 0x7e, 0x25, 0xd9, 0xb6, 0xd0, 0x07, 0x5d, 0xd9, 0xce, 0xc5, 0x83, 0xb1, 0x3d,
+	0x25, 0x1f, 0xa1, 0x0f, 0x7b, 0x4e, 0x89, 0x93, 0x4c, 0xff, 0x57, 0x05, 0x18, 0xcb, 0x70, 0x43,
+	0xef, 0xf5, 0x14, 0x4a, 0x2c, 0xc0, 0xbd, 0x1e, 0x51, 0x3a, 0xfe, 0x1f, 0x0d, 0x29, 0xc0, 0x4b,
+	0x03, 0xad, 0xe4, 0x56, 0xa0, 0x3d, 0x96, 0xfd, 0x54, 0x03, 0x1f, 0x6c, 0x3e, 0x42, 0xab, 0xe7,
+	0x97, 0x23, 0xeb, 0x0b, 0xfd, 0x62, 0xc4, 0xf5, 0x91, 0x5c, 0x91, 0x39, 0xe3, 0x6c, 0x1b, 0xe3,
+	0x79, 0xeb, 0xa3, 0x7d, 0x76, 0x5b, 0x8b, 0x52, 0x9e, 0x73, 0xa5, 0x38, 0x1e, 0x09, 0xd1, 0xbd,
+	0x72, 0xa9, 0x6d, 0x4c, 0xa2, 0xae, 0x7c, 0x4e, 0x9e, 0xd7, 0xc5, 0x07, 0x3d, 0xe3, 0x74, 0x20,
+	0xae, 0x0c, 0xe4, 0x31, 0x7a, 0xd4, 0x7b, 0x20, 0x44, 0xbb, 0x9c, 0xd3, 0x83, 0xd9, 0xd9, 0x97,
+	0xaf, 0x84, 0x83, 0x45, 0xf7, 0xd7, 0xc3, 0x92, 0xf1, 0xfa, 0xb0, 0x64, 0xfc, 0x7d, 0x58, 0x32,
+	0xbe, 0x3b, 0x2a, 0x5d, 0x78, 0x7d, 0x54, 0xba, 0xf0, 0xd7, 0x51, 0xe9, 0xc2, 0xe6, 0xfb, 0x35,
+	0x5f, 0x6c, 0x37, 0xcb, 0x76, 0x85, 0x35, 0xd4, 0xff, 0xe6, 0x28, 0x11, 0x7b, 0x2c, 0xdc, 0xd1,
+	0xab, 0x3a, 0xa9, 0xd6, 0x48, 0xe8, 0xbc, 0xe8, 0xa0, 0x51, 0x1e, 0x90, 0x57, 0xf6, 0xdd, 0xff,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x98, 0xa5, 0xb9, 0x63, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -741,10 +1058,23 @@ type QueryClient interface {
 	Basket(ctx context.Context, in *QueryBasketRequest, opts ...grpc.CallOption) (*QueryBasketResponse, error)
 	// Baskets lists all baskets in the ecocredit module.
 	Baskets(ctx context.Context, in *QueryBasketsRequest, opts ...grpc.CallOption) (*QueryBasketsResponse, error)
-	// BasketBalances lists the balance of each credit batch in the basket.
+	// BasketBalances lists the balance of each credit batch in the basket. The
+	// balances are sorted by batch start date, oldest first, which is the order
+	// in which credits are taken from the basket.
 	BasketBalances(ctx context.Context, in *QueryBasketBalancesRequest, opts ...grpc.CallOption) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(ctx context.Context, in *QueryBasketBalanceRequest, opts ...grpc.CallOption) (*QueryBasketBalanceResponse, error)
+	// BasketCriteria queries the date criteria of a basket in human-readable
+	// form.
+	//
+	// Since Revision 2
+	BasketCriteria(ctx context.Context, in *QueryBasketCriteriaRequest, opts ...grpc.CallOption) (*QueryBasketCriteriaResponse, error)
+	// EligibleCredits queries the tradable credit balances of an account that
+	// can be put into a basket, applying the same credit type, credit class and
+	// date criteria checks as Msg/Put.
+	//
+	// Since Revision 2
+	EligibleCredits(ctx context.Context, in *QueryEligibleCreditsRequest, opts ...grpc.CallOption) (*QueryEligibleCreditsResponse, error)
 }
 
 type queryClient struct {
@@ -791,16 +1121,47 @@ func (c *queryClient) BasketBalance(ctx context.Context, in *QueryBasketBalanceR
 	return out, nil
 }
 
+func (c *queryClient) BasketCriteria(ctx context.Context, in *QueryBasketCriteriaRequest, opts ...grpc.CallOption) (*QueryBasketCriteriaResponse, error) {
+	out := new(QueryBasketCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/BasketCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EligibleCredits(ctx context.Context, in *QueryEligibleCreditsRequest, opts ...grpc.CallOption) (*QueryEligibleCreditsResponse, error) {
+	out := new(QueryEligibleCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/EligibleCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Basket queries one basket by denom.
 	Basket(context.Context, *QueryBasketRequest) (*QueryBasketResponse, error)
 	// Baskets lists all baskets in the ecocredit module.
 	Baskets(context.Context, *QueryBasketsRequest) (*QueryBasketsResponse, error)
-	// BasketBalances lists the balance of each credit batch in the basket.
+	// BasketBalances lists the balance of each credit batch in the basket. The
+	// balances are sorted by batch start date, oldest first, which is the order
+	// in which credits are taken from the basket.
 	BasketBalances(context.Context, *QueryBasketBalancesRequest) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error)
+	// BasketCriteria queries the date criteria of a basket in human-readable
+	// form.
+	//
+	// Since Revision 2
+	BasketCriteria(context.Context, *QueryBasketCriteriaRequest) (*QueryBasketCriteriaResponse, error)
+	// EligibleCredits queries the tradable credit balances of an account that
+	// can be put into a basket, applying the same credit type, credit class and
+	// date criteria checks as Msg/Put.
+	//
+	// Since Revision 2
+	EligibleCredits(context.Context, *QueryEligibleCreditsRequest) (*QueryEligibleCreditsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -819,6 +1180,12 @@ func (*UnimplementedQueryServer) BasketBalances(ctx context.Context, req *QueryB
 func (*UnimplementedQueryServer) BasketBalance(ctx context.Context, req *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BasketBalance not implemented")
 }
+func (*UnimplementedQueryServer) BasketCriteria(ctx context.Context, req *QueryBasketCriteriaRequest) (*QueryBasketCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketCriteria not implemented")
+}
+func (*UnimplementedQueryServer) EligibleCredits(ctx context.Context, req *QueryEligibleCreditsRequest) (*QueryEligibleCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EligibleCredits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -896,6 +1263,42 @@ func _Query_BasketBalance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BasketCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasketCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/BasketCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasketCriteria(ctx, req.(*QueryBasketCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EligibleCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEligibleCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EligibleCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/EligibleCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EligibleCredits(ctx, req.(*QueryEligibleCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "regen.ecocredit.basket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -916,6 +1319,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BasketBalance",
 			Handler:    _Query_BasketBalance_Handler,
 		},
+		{
+			MethodName: "BasketCriteria",
+			Handler:    _Query_BasketCriteria_Handler,
+		},
+		{
+			MethodName: "EligibleCredits",
+			Handler:    _Query_EligibleCredits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/query.proto",
@@ -1167,6 +1578,18 @@ func (m *QueryBasketBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.BasketTokenSupply != nil {
+		{
+			size, err := m.BasketTokenSupply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.BalancesInfo) > 0 {
 		for iNdEx := len(m.BalancesInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1277,7 +1700,7 @@ func (m *QueryBasketBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *BasketInfo) Marshal() (dAtA []byte, err error) {
+func (m *QueryBasketCriteriaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1287,39 +1710,226 @@ func (m *BasketInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BasketInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBasketCriteriaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BasketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBasketCriteriaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Curator) > 0 {
-		i -= len(m.Curator)
-		copy(dAtA[i:], m.Curator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Curator)))
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BasketDenom)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0xa
 	}
-	if m.Exponent != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketCriteriaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DateCriteria != nil {
-		{
-			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketCriteriaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketCriteriaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.YearsInThePast != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.YearsInThePast))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StartDateWindow) > 0 {
+		i -= len(m.StartDateWindow)
+		copy(dAtA[i:], m.StartDateWindow)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartDateWindow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinStartDate) > 0 {
+		i -= len(m.MinStartDate)
+		copy(dAtA[i:], m.MinStartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinStartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEligibleCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEligibleCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEligibleCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEligibleCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEligibleCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEligibleCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MinTake) > 0 {
+		i -= len(m.MinTake)
+		copy(dAtA[i:], m.MinTake)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinTake)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RetireOnPut {
+		i--
+		if m.RetireOnPut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CreditTypes) > 0 {
+		for iNdEx := len(m.CreditTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DateCriteria != nil {
+		{
+			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.CreditTypeAbbrev) > 0 {
 		i -= len(m.CreditTypeAbbrev)
@@ -1375,6 +1985,18 @@ func (m *BasketBalanceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchStartDate != nil {
+		{
+			size, err := m.BatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Balance) > 0 {
 		i -= len(m.Balance)
 		copy(dAtA[i:], m.Balance)
@@ -1516,6 +2138,10 @@ func (m *QueryBasketBalancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.BasketTokenSupply != nil {
+		l = m.BasketTokenSupply.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1549,6 +2175,71 @@ func (m *QueryBasketBalanceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBasketCriteriaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBasketCriteriaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinStartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StartDateWindow)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.YearsInThePast != 0 {
+		n += 1 + sovQuery(uint64(m.YearsInThePast))
+	}
+	return n
+}
+
+func (m *QueryEligibleCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEligibleCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *BasketInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1581,6 +2272,22 @@ func (m *BasketInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.CreditTypes) > 0 {
+		for _, e := range m.CreditTypes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RetireOnPut {
+		n += 2
+	}
+	l = len(m.MinTake)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Closed {
+		n += 2
+	}
 	return n
 }
 
@@ -1598,6 +2305,10 @@ func (m *BasketBalanceInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.BatchStartDate != nil {
+		l = m.BatchStartDate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2334,13 +3045,460 @@ func (m *QueryBasketBalancesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketTokenSupply", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasketTokenSupply == nil {
+				m.BasketTokenSupply = &types.Coin{}
+			}
+			if err := m.BasketTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketCriteriaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketCriteriaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketCriteriaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketCriteriaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketCriteriaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketCriteriaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinStartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDateWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDateWindow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearsInThePast", wireType)
+			}
+			m.YearsInThePast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearsInThePast |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
@@ -2355,7 +3513,7 @@ func (m *QueryBasketBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEligibleCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,15 +3536,15 @@ func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBasketBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEligibleCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBasketBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEligibleCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2414,11 +3572,11 @@ func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2446,7 +3604,7 @@ func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2469,7 +3627,7 @@ func (m *QueryBasketBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBasketBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEligibleCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2492,17 +3650,17 @@ func (m *QueryBasketBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBasketBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEligibleCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBasketBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEligibleCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2512,23 +3670,25 @@ func (m *QueryBasketBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2783,6 +3943,112 @@ func (m *BasketInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypes = append(m.CreditTypes, &CreditTypeWeight{})
+			if err := m.CreditTypes[len(m.CreditTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnPut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetireOnPut = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2897,6 +4163,42 @@ func (m *BasketBalanceInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchStartDate == nil {
+				m.BatchStartDate = &types1.Timestamp{}
+			}
+			if err := m.BatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
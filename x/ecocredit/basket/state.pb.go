@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: regen/ecocredit/basket/v1/state.proto
 
-// Revision 1
+// Revision 2
 
 package basket
 
@@ -55,7 +55,9 @@ type Basket struct {
 	// upon withdraw from the basket.
 	DisableAutoRetire bool `protobuf:"varint,4,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
 	// credit_type_abbrev is the abbreviation of the credit type this basket is
-	// able to hold.
+	// able to hold. For a basket holding more than one credit type, this is the
+	// first credit type provided in MsgCreate and the remaining credit types are
+	// stored in the BasketCreditType table.
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
 	// date_criteria is the date criteria for batches admitted to the basket.
 	DateCriteria *DateCriteria `protobuf:"bytes,6,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
@@ -69,6 +71,22 @@ type Basket struct {
 	//
 	// Since Revision 1
 	Curator []byte `protobuf:"bytes,8,opt,name=curator,proto3" json:"curator,omitempty"`
+	// retire_on_put dictates whether credits put into the basket are retired
+	// immediately instead of being held by the basket.
+	//
+	// Since Revision 2
+	RetireOnPut bool `protobuf:"varint,9,opt,name=retire_on_put,json=retireOnPut,proto3" json:"retire_on_put,omitempty"`
+	// min_take is the minimum integer amount of basket tokens that can be taken
+	// from the basket in a single MsgTake. A minimum is not enforced if empty.
+	//
+	// Since Revision 2
+	MinTake string `protobuf:"bytes,10,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
+	// closed indicates whether the basket has been closed by the curator.
+	// Credits cannot be put into a closed basket, but holders of outstanding
+	// basket tokens can still take credits from it.
+	//
+	// Since Revision 2
+	Closed bool `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
 func (m *Basket) Reset()         { *m = Basket{} }
@@ -161,6 +179,27 @@ func (m *Basket) GetCurator() []byte {
 	return nil
 }
 
+func (m *Basket) GetRetireOnPut() bool {
+	if m != nil {
+		return m.RetireOnPut
+	}
+	return false
+}
+
+func (m *Basket) GetMinTake() string {
+	if m != nil {
+		return m.MinTake
+	}
+	return ""
+}
+
+func (m *Basket) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 // BasketClass describes a credit class that can be deposited in a basket.
 type BasketClass struct {
 	// basket_id is the ID of the basket
@@ -291,10 +330,80 @@ func (m *BasketBalance) GetBatchStartDate() *types.Timestamp {
 	return nil
 }
 
+// BasketCreditType describes a credit type and its weight in a basket holding
+// more than one credit type. Baskets holding a single credit type do not have
+// any entries in this table.
+//
+// Since Revision 2
+type BasketCreditType struct {
+	// basket_id is the ID of the basket
+	BasketId uint64 `protobuf:"varint,1,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// weight is the decimal ratio at which credits of this credit type are
+	// taken from the basket
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *BasketCreditType) Reset()         { *m = BasketCreditType{} }
+func (m *BasketCreditType) String() string { return proto.CompactTextString(m) }
+func (*BasketCreditType) ProtoMessage()    {}
+func (*BasketCreditType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c416a19075224f85, []int{3}
+}
+func (m *BasketCreditType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketCreditType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketCreditType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketCreditType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketCreditType.Merge(m, src)
+}
+func (m *BasketCreditType) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketCreditType) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketCreditType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketCreditType proto.InternalMessageInfo
+
+func (m *BasketCreditType) GetBasketId() uint64 {
+	if m != nil {
+		return m.BasketId
+	}
+	return 0
+}
+
+func (m *BasketCreditType) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *BasketCreditType) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Basket)(nil), "regen.ecocredit.basket.v1.Basket")
 	proto.RegisterType((*BasketClass)(nil), "regen.ecocredit.basket.v1.BasketClass")
 	proto.RegisterType((*BasketBalance)(nil), "regen.ecocredit.basket.v1.BasketBalance")
+	proto.RegisterType((*BasketCreditType)(nil), "regen.ecocredit.basket.v1.BasketCreditType")
 }
 
 func init() {
@@ -302,43 +411,49 @@ func init() {
 }
 
 var fileDescriptor_c416a19075224f85 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xed, 0xba, 0x25, 0x49, 0x27, 0x6d, 0x65, 0x16, 0x10, 0xdb, 0x20, 0x5c, 0x53, 0x09, 0x61,
-	0xa1, 0x62, 0x93, 0x72, 0x41, 0xe5, 0xd4, 0xb4, 0x97, 0x4a, 0x9c, 0x4c, 0x4f, 0x5c, 0xac, 0xb5,
-	0x3d, 0xa4, 0x56, 0x6d, 0xaf, 0xb5, 0xde, 0x84, 0xf6, 0x27, 0x10, 0x5f, 0xc0, 0xf7, 0x70, 0xac,
-	0xc4, 0x85, 0x23, 0x6a, 0x0f, 0x5c, 0x11, 0x5f, 0x80, 0xbc, 0xeb, 0x24, 0x2d, 0x88, 0xde, 0xf2,
-	0xe6, 0xbd, 0xe7, 0x99, 0x7d, 0x33, 0x81, 0xa7, 0x12, 0xc7, 0x58, 0x06, 0x98, 0x88, 0x44, 0x62,
-	0x9a, 0xa9, 0x20, 0xe6, 0xf5, 0x29, 0xaa, 0x60, 0x3a, 0x0c, 0x6a, 0xc5, 0x15, 0xfa, 0x95, 0x14,
-	0x4a, 0xd0, 0x4d, 0x2d, 0xf3, 0xe7, 0x32, 0xdf, 0xc8, 0xfc, 0xe9, 0x70, 0xf0, 0x38, 0x11, 0x75,
-	0x21, 0xea, 0x40, 0xc8, 0x22, 0x98, 0x0e, 0x79, 0x5e, 0x9d, 0xf0, 0x61, 0x03, 0x8c, 0x73, 0xb0,
-	0x35, 0x16, 0x62, 0x9c, 0x63, 0xa0, 0x51, 0x3c, 0xf9, 0x10, 0xa8, 0xac, 0xc0, 0x5a, 0xf1, 0xa2,
-	0x6a, 0x05, 0xb7, 0x4c, 0xa0, 0xce, 0x2b, 0xac, 0x8d, 0x6c, 0xfb, 0xa7, 0x05, 0x9d, 0x91, 0x66,
-	0xe8, 0x06, 0x58, 0x59, 0xca, 0x88, 0x4b, 0xbc, 0x95, 0xd0, 0xca, 0x52, 0xfa, 0x04, 0xd6, 0x8c,
-	0x27, 0x4a, 0xb1, 0x14, 0x05, 0xb3, 0x5c, 0xe2, 0xad, 0x86, 0x7d, 0x53, 0x3b, 0x6c, 0x4a, 0x94,
-	0xc2, 0x4a, 0xc9, 0x0b, 0x64, 0xcb, 0x9a, 0xd2, 0xbf, 0xa9, 0x0f, 0xf7, 0xd2, 0xac, 0xe6, 0x71,
-	0x8e, 0x11, 0x9f, 0x28, 0x11, 0x49, 0x54, 0x99, 0x44, 0xb6, 0xe2, 0x12, 0xaf, 0x17, 0xde, 0x6d,
-	0xa9, 0xfd, 0x89, 0x12, 0xa1, 0x26, 0xe8, 0x0e, 0x50, 0x33, 0x61, 0xd4, 0xcc, 0x15, 0xf1, 0x38,
-	0x96, 0x38, 0x65, 0x77, 0xf4, 0x17, 0x6d, 0xc3, 0x1c, 0x9f, 0x57, 0xb8, 0xaf, 0xeb, 0xf4, 0x2d,
-	0xac, 0xa7, 0x5c, 0x61, 0x94, 0xc8, 0x4c, 0xa1, 0xcc, 0x38, 0xeb, 0xb8, 0xc4, 0xeb, 0xef, 0x3e,
-	0xf3, 0xff, 0x9b, 0xa4, 0x7f, 0xc8, 0x15, 0x1e, 0xb4, 0xf2, 0x70, 0x2d, 0xbd, 0x86, 0xa8, 0x03,
-	0x3d, 0x3c, 0xab, 0x44, 0x89, 0xa5, 0x62, 0x5d, 0x97, 0x78, 0xeb, 0x23, 0x8b, 0x91, 0x70, 0x5e,
-	0xa3, 0x0c, 0xba, 0xc9, 0x44, 0x72, 0x25, 0x24, 0xeb, 0xb9, 0xc4, 0x5b, 0x0b, 0x67, 0x70, 0xef,
-	0xe5, 0xef, 0x2f, 0xdf, 0x3e, 0x2d, 0x3f, 0x87, 0x4e, 0x13, 0x9a, 0x4d, 0x28, 0xbd, 0x19, 0x96,
-	0x4d, 0x18, 0xa1, 0x60, 0xd2, 0xb1, 0x2d, 0x46, 0x18, 0xd9, 0x46, 0xe8, 0x9b, 0xa0, 0x0f, 0x72,
-	0x5e, 0xd7, 0xf4, 0x11, 0xac, 0xb6, 0x86, 0x79, 0xe8, 0x3d, 0x53, 0x38, 0x4a, 0xe9, 0x26, 0xf4,
-	0x92, 0x46, 0xd5, 0x70, 0x26, 0xf6, 0xae, 0xc6, 0x47, 0xe9, 0x9e, 0xa3, 0x1b, 0x33, 0xb8, 0x0f,
-	0x74, 0xee, 0xdf, 0x59, 0x88, 0xb7, 0x7f, 0x11, 0x58, 0x37, 0x7d, 0x46, 0x3c, 0xe7, 0x65, 0x82,
-	0xb7, 0x77, 0xda, 0x82, 0x7e, 0xcc, 0x55, 0x72, 0x72, 0x63, 0xc7, 0xa0, 0x4b, 0x66, 0xc5, 0x0c,
-	0xba, 0xb1, 0xf9, 0x50, 0xbb, 0xe5, 0x19, 0xa4, 0x87, 0x60, 0x1b, 0x6b, 0xad, 0xb8, 0x54, 0x51,
-	0x13, 0xac, 0xde, 0x72, 0x7f, 0x77, 0xe0, 0x9b, 0xeb, 0xf4, 0x67, 0xd7, 0xe9, 0x1f, 0xcf, 0xae,
-	0x33, 0xdc, 0xd0, 0x9e, 0x77, 0x8d, 0xa5, 0x59, 0xcc, 0xde, 0xbe, 0x7e, 0xcf, 0x1b, 0x78, 0x08,
-	0x0f, 0x16, 0xef, 0xb9, 0x36, 0x12, 0x75, 0x60, 0xf0, 0x37, 0xb1, 0x68, 0x68, 0x13, 0xb6, 0x3c,
-	0x0a, 0xbf, 0x5e, 0x3a, 0xe4, 0xe2, 0xd2, 0x21, 0x3f, 0x2e, 0x1d, 0xf2, 0xf9, 0xca, 0x59, 0xba,
-	0xb8, 0x72, 0x96, 0xbe, 0x5f, 0x39, 0x4b, 0xef, 0x5f, 0x8f, 0x33, 0x75, 0x32, 0x89, 0xfd, 0x44,
-	0x14, 0x81, 0x3e, 0x90, 0x17, 0x25, 0xaa, 0x8f, 0x42, 0x9e, 0xb6, 0x28, 0xc7, 0x74, 0x8c, 0x32,
-	0x38, 0xfb, 0xe7, 0x6f, 0x12, 0x77, 0xf4, 0xe8, 0xaf, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x51,
-	0xb2, 0x58, 0xb2, 0xc9, 0x03, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xee, 0x24, 0xb9, 0x49, 0x7a, 0xd2, 0x54, 0xb9, 0x73, 0x2f, 0x65, 0x1a, 0xc0, 0x0d, 0x91,
+	0x10, 0x11, 0x2a, 0x36, 0x29, 0x1b, 0x14, 0x56, 0x4d, 0xbb, 0xa9, 0x84, 0x04, 0x32, 0x5d, 0xb1,
+	0xb1, 0xc6, 0xf6, 0x21, 0xb1, 0x12, 0x7b, 0xa2, 0xf1, 0x38, 0x6d, 0x5f, 0x02, 0xf1, 0x04, 0xf0,
+	0x26, 0xac, 0x59, 0x56, 0x62, 0xc3, 0x12, 0xb5, 0x2f, 0x80, 0x78, 0x02, 0xe4, 0x19, 0x27, 0x6d,
+	0x29, 0xed, 0x2e, 0xdf, 0x39, 0xe7, 0x9b, 0xf3, 0xf3, 0x7d, 0x31, 0x3c, 0x92, 0x38, 0xc2, 0xc4,
+	0xc1, 0x40, 0x04, 0x12, 0xc3, 0x48, 0x39, 0x3e, 0x4f, 0x27, 0xa8, 0x9c, 0x79, 0xdf, 0x49, 0x15,
+	0x57, 0x68, 0xcf, 0xa4, 0x50, 0x82, 0x6e, 0xea, 0x32, 0x7b, 0x59, 0x66, 0x9b, 0x32, 0x7b, 0xde,
+	0x6f, 0x3f, 0x08, 0x44, 0x1a, 0x8b, 0xd4, 0x11, 0x32, 0x76, 0xe6, 0x7d, 0x3e, 0x9d, 0x8d, 0x79,
+	0x3f, 0x07, 0x86, 0xd9, 0xde, 0x1a, 0x09, 0x31, 0x9a, 0xa2, 0xa3, 0x91, 0x9f, 0xbd, 0x77, 0x54,
+	0x14, 0x63, 0xaa, 0x78, 0x3c, 0x2b, 0x0a, 0x6e, 0x99, 0x40, 0x9d, 0xcc, 0x30, 0x35, 0x65, 0xdd,
+	0x2f, 0x65, 0xa8, 0x0e, 0x75, 0x86, 0xae, 0x43, 0x29, 0x0a, 0x19, 0xe9, 0x90, 0x5e, 0xc5, 0x2d,
+	0x45, 0x21, 0x7d, 0x08, 0x6b, 0x86, 0xe3, 0x85, 0x98, 0x88, 0x98, 0x95, 0x3a, 0xa4, 0xb7, 0xea,
+	0x36, 0x4c, 0x6c, 0x3f, 0x0f, 0x51, 0x0a, 0x95, 0x84, 0xc7, 0xc8, 0xca, 0x3a, 0xa5, 0x7f, 0x53,
+	0x1b, 0xfe, 0x0b, 0xa3, 0x94, 0xfb, 0x53, 0xf4, 0x78, 0xa6, 0x84, 0x27, 0x51, 0x45, 0x12, 0x59,
+	0xa5, 0x43, 0x7a, 0x75, 0xf7, 0xdf, 0x22, 0xb5, 0x9b, 0x29, 0xe1, 0xea, 0x04, 0xdd, 0x06, 0x6a,
+	0x26, 0xf4, 0xf2, 0xb9, 0x3c, 0xee, 0xfb, 0x12, 0xe7, 0xec, 0x1f, 0xfd, 0x62, 0xcb, 0x64, 0x0e,
+	0x4f, 0x66, 0xb8, 0xab, 0xe3, 0xf4, 0x15, 0x34, 0x43, 0xae, 0xd0, 0x0b, 0x64, 0xa4, 0x50, 0x46,
+	0x9c, 0x55, 0x3b, 0xa4, 0xd7, 0xd8, 0x79, 0x6c, 0xdf, 0x78, 0x49, 0x7b, 0x9f, 0x2b, 0xdc, 0x2b,
+	0xca, 0xdd, 0xb5, 0xf0, 0x12, 0xa2, 0x16, 0xd4, 0xf1, 0x78, 0x26, 0x12, 0x4c, 0x14, 0xab, 0x75,
+	0x48, 0xaf, 0x39, 0x2c, 0x31, 0xe2, 0x2e, 0x63, 0x94, 0x41, 0x2d, 0xc8, 0x24, 0x57, 0x42, 0xb2,
+	0x7a, 0x87, 0xf4, 0xd6, 0xdc, 0x05, 0xa4, 0x5d, 0x68, 0x9a, 0xc5, 0x3c, 0x91, 0x78, 0xb3, 0x4c,
+	0xb1, 0x55, 0xbd, 0x5f, 0xc3, 0x04, 0x5f, 0x27, 0x6f, 0x32, 0x45, 0x37, 0xa1, 0x1e, 0x47, 0x89,
+	0xa7, 0xf8, 0x04, 0x19, 0xe8, 0x7d, 0x6a, 0x71, 0x94, 0x1c, 0xf2, 0x09, 0xd2, 0x0d, 0xa8, 0x06,
+	0x53, 0x91, 0x62, 0xc8, 0x1a, 0x9a, 0x57, 0xa0, 0xc1, 0xb3, 0x5f, 0x9f, 0xbe, 0x7d, 0x28, 0x3f,
+	0x81, 0x6a, 0xae, 0x45, 0x8b, 0x50, 0x7a, 0x55, 0x83, 0x16, 0x61, 0x84, 0x82, 0x39, 0x7a, 0xab,
+	0xc4, 0x08, 0x23, 0x5d, 0x84, 0x86, 0xd1, 0x6f, 0x6f, 0xca, 0xd3, 0x94, 0xde, 0x83, 0xd5, 0x82,
+	0xb0, 0xd4, 0xb2, 0x6e, 0x02, 0x07, 0x61, 0x3e, 0x50, 0x90, 0x57, 0xe5, 0x39, 0xa3, 0x66, 0x4d,
+	0xe3, 0x83, 0x70, 0x60, 0xe9, 0xc6, 0x0c, 0xfe, 0x07, 0xba, 0xe4, 0x6f, 0x5f, 0x14, 0x77, 0x7f,
+	0x12, 0x68, 0x9a, 0x3e, 0x43, 0x3e, 0xe5, 0x49, 0x80, 0xb7, 0x77, 0xda, 0x82, 0x86, 0xcf, 0x55,
+	0x30, 0xbe, 0x62, 0x1d, 0xd0, 0x21, 0xe3, 0x1c, 0x06, 0x35, 0xdf, 0x3c, 0x54, 0x98, 0x67, 0x01,
+	0xe9, 0x3e, 0xb4, 0x0c, 0x35, 0x55, 0x5c, 0x2a, 0x2f, 0xd7, 0x4b, 0x9b, 0xa7, 0xb1, 0xd3, 0xb6,
+	0x8d, 0xe9, 0xed, 0x85, 0xe9, 0xed, 0xc3, 0x85, 0xe9, 0xdd, 0x75, 0xcd, 0x79, 0x9b, 0x53, 0x72,
+	0xbd, 0x07, 0xbb, 0x7a, 0x9f, 0x97, 0x70, 0x17, 0xee, 0x5c, 0xec, 0x73, 0x69, 0x24, 0x6a, 0x41,
+	0xfb, 0xcf, 0xc4, 0x45, 0xc3, 0x16, 0x61, 0xe5, 0xee, 0x67, 0x02, 0xad, 0xe2, 0xb4, 0x4b, 0x17,
+	0xde, 0xbe, 0xf5, 0xdf, 0xad, 0x5c, 0xba, 0xc1, 0xca, 0x1b, 0x50, 0x3d, 0xc2, 0x68, 0x34, 0x56,
+	0xc5, 0x05, 0x0a, 0x34, 0xe8, 0xe9, 0xd1, 0xbb, 0x60, 0xc1, 0xfd, 0x4b, 0x52, 0x5c, 0x7f, 0xb7,
+	0x32, 0x74, 0xbf, 0x9e, 0x59, 0xe4, 0xf4, 0xcc, 0x22, 0x3f, 0xce, 0x2c, 0xf2, 0xf1, 0xdc, 0x5a,
+	0x39, 0x3d, 0xb7, 0x56, 0xbe, 0x9f, 0x5b, 0x2b, 0xef, 0x5e, 0x8c, 0x22, 0x35, 0xce, 0x7c, 0x3b,
+	0x10, 0xb1, 0xa3, 0xff, 0x19, 0x4f, 0x13, 0x54, 0x47, 0x42, 0x4e, 0x0a, 0x34, 0xc5, 0x70, 0x84,
+	0xd2, 0x39, 0xbe, 0xf6, 0x7d, 0xf0, 0xab, 0xfa, 0xb8, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
+	0xcb, 0x45, 0x7a, 0xd4, 0xc2, 0x04, 0x00, 0x00,
 }
 
 func (m *Basket) Marshal() (dAtA []byte, err error) {
@@ -361,6 +476,33 @@ func (m *Basket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MinTake) > 0 {
+		i -= len(m.MinTake)
+		copy(dAtA[i:], m.MinTake)
+		i = encodeVarintState(dAtA, i, uint64(len(m.MinTake)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RetireOnPut {
+		i--
+		if m.RetireOnPut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Curator) > 0 {
 		i -= len(m.Curator)
 		copy(dAtA[i:], m.Curator)
@@ -513,6 +655,48 @@ func (m *BasketBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BasketCreditType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketCreditType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketCreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BasketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BasketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -559,6 +743,16 @@ func (m *Basket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.RetireOnPut {
+		n += 2
+	}
+	l = len(m.MinTake)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Closed {
+		n += 2
+	}
 	return n
 }
 
@@ -602,6 +796,26 @@ func (m *BasketBalance) Size() (n int) {
 	return n
 }
 
+func (m *BasketCreditType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasketId != 0 {
+		n += 1 + sovState(uint64(m.BasketId))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -861,6 +1075,78 @@ func (m *Basket) Unmarshal(dAtA []byte) error {
 				m.Curator = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnPut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetireOnPut = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1152,6 +1438,139 @@ func (m *BasketBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BasketCreditType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketCreditType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketId", wireType)
+			}
+			m.BasketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
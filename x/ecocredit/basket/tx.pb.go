@@ -58,7 +58,7 @@ type MsgCreate struct {
 	// tradable.
 	DisableAutoRetire bool `protobuf:"varint,5,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
 	// credit_type_abbrev is the abbreviation of the credit type this basket is
-	// able to hold.
+	// able to hold. It must be empty if credit_types is set.
 	CreditTypeAbbrev string `protobuf:"bytes,6,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
 	// allowed_classes are the credit classes allowed to be put in the basket
 	AllowedClasses []string `protobuf:"bytes,7,rep,name=allowed_classes,json=allowedClasses,proto3" json:"allowed_classes,omitempty"`
@@ -77,6 +77,28 @@ type MsgCreate struct {
 	// This field will be updated to a single fee rather than a list of fees in
 	// the next version to reflect these requirements.
 	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// credit_types (optional) are the credit types a blended basket is able to
+	// hold and the weight at which each credit type contributes to the credits
+	// taken from the basket. At least two credit types sharing the same
+	// precision must be provided and the weights must sum to 1. The first credit
+	// type is used to form the basket denom.
+	//
+	// Since Revision 2
+	CreditTypes []*CreditTypeWeight `protobuf:"bytes,10,rep,name=credit_types,json=creditTypes,proto3" json:"credit_types,omitempty"`
+	// retire_on_put dictates whether credits put into the basket are retired
+	// immediately, in which case the basket tokens received in exchange only
+	// represent the retirement of the credits and credits cannot be taken from
+	// the basket.
+	//
+	// Since Revision 2
+	RetireOnPut bool `protobuf:"varint,11,opt,name=retire_on_put,json=retireOnPut,proto3" json:"retire_on_put,omitempty"`
+	// min_take (optional) is the minimum integer amount of basket tokens that
+	// can be taken from the basket in a single MsgTake. Setting a minimum take
+	// amount prevents takes so small that drawing from multiple batches leaves
+	// behind dust balances.
+	//
+	// Since Revision 2
+	MinTake string `protobuf:"bytes,12,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
 }
 
 func (m *MsgCreate) Reset()         { *m = MsgCreate{} }
@@ -176,6 +198,27 @@ func (m *MsgCreate) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgCreate) GetCreditTypes() []*CreditTypeWeight {
+	if m != nil {
+		return m.CreditTypes
+	}
+	return nil
+}
+
+func (m *MsgCreate) GetRetireOnPut() bool {
+	if m != nil {
+		return m.RetireOnPut
+	}
+	return false
+}
+
+func (m *MsgCreate) GetMinTake() string {
+	if m != nil {
+		return m.MinTake
+	}
+	return ""
+}
+
 // MsgCreateBasketResponse is the Msg/CreateBasket response type.
 type MsgCreateResponse struct {
 	// basket_denom is the unique denomination ID of the newly created basket.
@@ -233,6 +276,12 @@ type MsgPut struct {
 	// left over when converting credits to basket tokens, these credits will
 	// not be converted to basket tokens and instead remain with the owner.
 	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+	// retirement_jurisdiction is the jurisdiction under which the credits are
+	// retired when the basket retires credits on put. It is required if the
+	// basket retires credits on put and ignored otherwise.
+	//
+	// Since Revision 2
+	RetirementJurisdiction string `protobuf:"bytes,4,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
 }
 
 func (m *MsgPut) Reset()         { *m = MsgPut{} }
@@ -289,6 +338,13 @@ func (m *MsgPut) GetCredits() []*BasketCredit {
 	return nil
 }
 
+func (m *MsgPut) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
 // MsgAddToBasketResponse is the Msg/AddToBasket response type.
 type MsgPutResponse struct {
 	// amount_received is the integer amount of basket tokens received.
@@ -341,7 +397,9 @@ type MsgTake struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	// basket_denom is the basket bank denom to take credits from.
 	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// amount is the integer number of basket tokens to convert into credits.
+	// amount is the integer number of basket tokens to convert into credits. If
+	// the basket has a minimum take amount, amount must be greater than or equal
+	// to the minimum take amount.
 	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	// retirement_location is the optional retirement jurisdiction for the
 	// credits which will be used only if retire_on_take is true for this basket.
@@ -483,6 +541,349 @@ func (m *MsgTakeResponse) GetCredits() []*BasketCredit {
 	return nil
 }
 
+// MsgUpdateBasketAllowedClasses is the Msg/UpdateBasketAllowedClasses request
+// type.
+//
+// Since Revision 2
+type MsgUpdateBasketAllowedClasses struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// add_classes are the credit classes that will be added to the list of
+	// credit classes allowed to be put into the basket.
+	AddClasses []string `protobuf:"bytes,3,rep,name=add_classes,json=addClasses,proto3" json:"add_classes,omitempty"`
+	// remove_classes are the credit classes that will be removed from the list
+	// of credit classes allowed to be put into the basket.
+	RemoveClasses []string `protobuf:"bytes,4,rep,name=remove_classes,json=removeClasses,proto3" json:"remove_classes,omitempty"`
+}
+
+func (m *MsgUpdateBasketAllowedClasses) Reset()         { *m = MsgUpdateBasketAllowedClasses{} }
+func (m *MsgUpdateBasketAllowedClasses) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketAllowedClasses) ProtoMessage()    {}
+func (*MsgUpdateBasketAllowedClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{6}
+}
+func (m *MsgUpdateBasketAllowedClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketAllowedClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketAllowedClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBasketAllowedClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketAllowedClasses.Merge(m, src)
+}
+func (m *MsgUpdateBasketAllowedClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketAllowedClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketAllowedClasses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketAllowedClasses proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketAllowedClasses) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *MsgUpdateBasketAllowedClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *MsgUpdateBasketAllowedClasses) GetAddClasses() []string {
+	if m != nil {
+		return m.AddClasses
+	}
+	return nil
+}
+
+func (m *MsgUpdateBasketAllowedClasses) GetRemoveClasses() []string {
+	if m != nil {
+		return m.RemoveClasses
+	}
+	return nil
+}
+
+// MsgUpdateBasketAllowedClassesResponse is the Msg/UpdateBasketAllowedClasses
+// response type.
+//
+// Since Revision 2
+type MsgUpdateBasketAllowedClassesResponse struct {
+}
+
+func (m *MsgUpdateBasketAllowedClassesResponse) Reset()         { *m = MsgUpdateBasketAllowedClassesResponse{} }
+func (m *MsgUpdateBasketAllowedClassesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketAllowedClassesResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketAllowedClassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{7}
+}
+func (m *MsgUpdateBasketAllowedClassesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketAllowedClassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketAllowedClassesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBasketAllowedClassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketAllowedClassesResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketAllowedClassesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketAllowedClassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketAllowedClassesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketAllowedClassesResponse proto.InternalMessageInfo
+
+// MsgCloseBasket is the Msg/CloseBasket request type.
+//
+// Since Revision 2
+type MsgCloseBasket struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to close.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// force allows the basket to be closed while basket tokens are still in
+	// circulation. The credits backing the outstanding basket tokens remain in
+	// the basket and can still be taken by the token holders.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (m *MsgCloseBasket) Reset()         { *m = MsgCloseBasket{} }
+func (m *MsgCloseBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseBasket) ProtoMessage()    {}
+func (*MsgCloseBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{8}
+}
+func (m *MsgCloseBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseBasket.Merge(m, src)
+}
+func (m *MsgCloseBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseBasket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseBasket proto.InternalMessageInfo
+
+func (m *MsgCloseBasket) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *MsgCloseBasket) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *MsgCloseBasket) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+// MsgCloseBasketResponse is the Msg/CloseBasket response type.
+//
+// Since Revision 2
+type MsgCloseBasketResponse struct {
+	// credits are the credits swept from the basket to the basket curator.
+	// Credits are only swept if no basket tokens are outstanding.
+	Credits []*BasketCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *MsgCloseBasketResponse) Reset()         { *m = MsgCloseBasketResponse{} }
+func (m *MsgCloseBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseBasketResponse) ProtoMessage()    {}
+func (*MsgCloseBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{9}
+}
+func (m *MsgCloseBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseBasketResponse.Merge(m, src)
+}
+func (m *MsgCloseBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseBasketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseBasketResponse proto.InternalMessageInfo
+
+func (m *MsgCloseBasketResponse) GetCredits() []*BasketCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+// MsgUpdateBasketMinTake is the Msg/UpdateBasketMinTake request type.
+//
+// Since Revision 2
+type MsgUpdateBasketMinTake struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// min_take is the new minimum integer amount of basket tokens that can be
+	// taken from the basket in a single MsgTake. An empty value removes the
+	// minimum take amount.
+	MinTake string `protobuf:"bytes,3,opt,name=min_take,json=minTake,proto3" json:"min_take,omitempty"`
+}
+
+func (m *MsgUpdateBasketMinTake) Reset()         { *m = MsgUpdateBasketMinTake{} }
+func (m *MsgUpdateBasketMinTake) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketMinTake) ProtoMessage()    {}
+func (*MsgUpdateBasketMinTake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{10}
+}
+func (m *MsgUpdateBasketMinTake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketMinTake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketMinTake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBasketMinTake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketMinTake.Merge(m, src)
+}
+func (m *MsgUpdateBasketMinTake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketMinTake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketMinTake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketMinTake proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketMinTake) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+func (m *MsgUpdateBasketMinTake) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *MsgUpdateBasketMinTake) GetMinTake() string {
+	if m != nil {
+		return m.MinTake
+	}
+	return ""
+}
+
+// MsgUpdateBasketMinTakeResponse is the Msg/UpdateBasketMinTake response
+// type.
+//
+// Since Revision 2
+type MsgUpdateBasketMinTakeResponse struct {
+}
+
+func (m *MsgUpdateBasketMinTakeResponse) Reset()         { *m = MsgUpdateBasketMinTakeResponse{} }
+func (m *MsgUpdateBasketMinTakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketMinTakeResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketMinTakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{11}
+}
+func (m *MsgUpdateBasketMinTakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketMinTakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketMinTakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBasketMinTakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketMinTakeResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketMinTakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketMinTakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketMinTakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketMinTakeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreate)(nil), "regen.ecocredit.basket.v1.MsgCreate")
 	proto.RegisterType((*MsgCreateResponse)(nil), "regen.ecocredit.basket.v1.MsgCreateResponse")
@@ -490,6 +891,12 @@ func init() {
 	proto.RegisterType((*MsgPutResponse)(nil), "regen.ecocredit.basket.v1.MsgPutResponse")
 	proto.RegisterType((*MsgTake)(nil), "regen.ecocredit.basket.v1.MsgTake")
 	proto.RegisterType((*MsgTakeResponse)(nil), "regen.ecocredit.basket.v1.MsgTakeResponse")
+	proto.RegisterType((*MsgUpdateBasketAllowedClasses)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketAllowedClasses")
+	proto.RegisterType((*MsgUpdateBasketAllowedClassesResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketAllowedClassesResponse")
+	proto.RegisterType((*MsgCloseBasket)(nil), "regen.ecocredit.basket.v1.MsgCloseBasket")
+	proto.RegisterType((*MsgCloseBasketResponse)(nil), "regen.ecocredit.basket.v1.MsgCloseBasketResponse")
+	proto.RegisterType((*MsgUpdateBasketMinTake)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketMinTake")
+	proto.RegisterType((*MsgUpdateBasketMinTakeResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketMinTakeResponse")
 }
 
 func init() {
@@ -497,52 +904,67 @@ func init() {
 }
 
 var fileDescriptor_a60f962a3c61f018 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xeb, 0x46,
-	0x14, 0x8d, 0x63, 0x48, 0xc8, 0x04, 0x42, 0x19, 0x10, 0x35, 0x59, 0x98, 0x60, 0x51, 0x91, 0x56,
-	0x60, 0x37, 0x20, 0xf5, 0x63, 0x49, 0xc2, 0xaa, 0x22, 0x2a, 0x72, 0x51, 0x17, 0x55, 0x2b, 0x6b,
-	0x62, 0xdf, 0xba, 0x6e, 0x12, 0x4f, 0x34, 0x33, 0x0e, 0xb0, 0xef, 0x0f, 0xe0, 0x77, 0x74, 0xf3,
-	0xfe, 0x06, 0x4b, 0x96, 0x6f, 0xf5, 0xde, 0x13, 0xec, 0xdf, 0x6f, 0x78, 0xf2, 0xcc, 0xc4, 0x44,
-	0x42, 0x2f, 0x3c, 0xb1, 0x8a, 0xe7, 0x9c, 0x73, 0x4f, 0xee, 0x9d, 0x73, 0x6d, 0xe4, 0x30, 0x88,
-	0x21, 0xf5, 0x20, 0xa4, 0x21, 0x83, 0x28, 0x11, 0xde, 0x80, 0xf0, 0x21, 0x08, 0x6f, 0xda, 0xf1,
-	0xc4, 0xb5, 0x3b, 0x61, 0x54, 0x50, 0xbc, 0x23, 0x35, 0x6e, 0xa1, 0x71, 0x95, 0xc6, 0x9d, 0x76,
-	0x9a, 0x5b, 0x31, 0x8d, 0xa9, 0x54, 0x79, 0xf9, 0x93, 0x2a, 0x68, 0x7e, 0xb3, 0xc0, 0xf4, 0x66,
-	0x02, 0x5c, 0xcb, 0xec, 0x90, 0xf2, 0x31, 0xe5, 0x39, 0x0b, 0xde, 0xb4, 0x33, 0x00, 0x41, 0x3a,
-	0x5e, 0x48, 0x93, 0x54, 0xf1, 0xce, 0x1b, 0x13, 0xd5, 0xfa, 0x3c, 0xee, 0x31, 0x20, 0x02, 0xb0,
-	0x85, 0xaa, 0x61, 0xc6, 0x88, 0xa0, 0xcc, 0x32, 0x5a, 0x46, 0xbb, 0xe6, 0xcf, 0x8e, 0x18, 0xa3,
-	0xa5, 0x94, 0x8c, 0xc1, 0x2a, 0x4b, 0x58, 0x3e, 0xe3, 0x16, 0xaa, 0x47, 0xc0, 0x43, 0x96, 0x4c,
-	0x44, 0x42, 0x53, 0xcb, 0x94, 0xd4, 0x3c, 0x84, 0x6d, 0xb4, 0x02, 0xd7, 0x13, 0x9a, 0x42, 0x2a,
-	0xac, 0xa5, 0x96, 0xd1, 0x5e, 0xeb, 0x96, 0x2d, 0xc3, 0x2f, 0x30, 0xec, 0xa2, 0xcd, 0x28, 0xe1,
-	0x64, 0x30, 0x82, 0x80, 0x64, 0x82, 0x06, 0x0c, 0x44, 0xc2, 0xc0, 0x5a, 0x6e, 0x19, 0xed, 0x15,
-	0x7f, 0x43, 0x53, 0xa7, 0x99, 0xa0, 0xbe, 0x24, 0xf0, 0x21, 0xc2, 0x6a, 0xda, 0x20, 0x9f, 0x31,
-	0x20, 0x83, 0x01, 0x83, 0xa9, 0x55, 0x91, 0x7f, 0xfc, 0x95, 0x62, 0x2e, 0x6f, 0x26, 0x70, 0x2a,
-	0x71, 0x7c, 0x80, 0xd6, 0xc9, 0x68, 0x44, 0xaf, 0x20, 0x0a, 0xc2, 0x11, 0xe1, 0x1c, 0xb8, 0x55,
-	0x6d, 0x99, 0xed, 0x9a, 0xdf, 0xd0, 0x70, 0x4f, 0xa1, 0xf8, 0x1c, 0xad, 0x45, 0x44, 0x40, 0x10,
-	0xb2, 0x44, 0x00, 0x4b, 0x88, 0xb5, 0xd2, 0x32, 0xda, 0xf5, 0xe3, 0x03, 0xf7, 0xb3, 0xa1, 0xb8,
-	0x67, 0x44, 0x40, 0x4f, 0xcb, 0xfd, 0xd5, 0x68, 0xee, 0x84, 0xff, 0x42, 0xe6, 0xdf, 0x00, 0x56,
-	0xad, 0x65, 0xb6, 0xeb, 0xc7, 0x3b, 0xae, 0x0a, 0x20, 0x2f, 0x05, 0x57, 0x07, 0xe0, 0xf6, 0x68,
-	0x92, 0x76, 0xbf, 0xbf, 0x7b, 0xb7, 0x5b, 0xfa, 0xff, 0xfd, 0x6e, 0x3b, 0x4e, 0xc4, 0x3f, 0xd9,
-	0xc0, 0x0d, 0xe9, 0xd8, 0xd3, 0x69, 0xa9, 0x9f, 0x23, 0x1e, 0x0d, 0x75, 0x98, 0x79, 0x01, 0xf7,
-	0x73, 0x5f, 0xe7, 0x07, 0xb4, 0x51, 0x04, 0xe6, 0x03, 0x9f, 0xd0, 0x94, 0x03, 0xde, 0x43, 0xab,
-	0xaa, 0xb7, 0x20, 0x82, 0x94, 0x8e, 0x75, 0x7a, 0x75, 0x85, 0x9d, 0xe5, 0x90, 0xf3, 0x9f, 0x81,
-	0x2a, 0x7d, 0x1e, 0x5f, 0x64, 0x02, 0x6f, 0xa1, 0x65, 0x7a, 0x95, 0xc2, 0x2c, 0x64, 0x75, 0x78,
-	0xe6, 0x51, 0x7e, 0xe6, 0x81, 0x4f, 0x51, 0x55, 0xdd, 0x04, 0xb7, 0x4c, 0x39, 0xde, 0xa2, 0x2b,
-	0xea, 0xca, 0xa7, 0x9e, 0x84, 0xfd, 0x59, 0x9d, 0xf3, 0x33, 0x6a, 0xa8, 0x2e, 0x8a, 0xde, 0xf3,
-	0x98, 0xc6, 0x34, 0x4b, 0x45, 0xc0, 0x20, 0x84, 0x64, 0x0a, 0x91, 0xee, 0xab, 0xa1, 0x60, 0x5f,
-	0xa3, 0xce, 0x47, 0x03, 0x55, 0xfb, 0x3c, 0xbe, 0x24, 0x43, 0x78, 0xfd, 0x08, 0xdb, 0xa8, 0xa2,
-	0x6c, 0xf5, 0xbe, 0xea, 0x13, 0x3e, 0x41, 0x9b, 0x6a, 0xfb, 0xc6, 0x90, 0x8a, 0x60, 0x44, 0x43,
-	0x22, 0x97, 0x3a, 0xdf, 0xda, 0x9a, 0xdc, 0x5a, 0xfc, 0x44, 0x9f, 0x6b, 0x16, 0xef, 0xa3, 0x86,
-	0x42, 0x03, 0x9a, 0x06, 0x82, 0x0c, 0x67, 0xab, 0xbb, 0xaa, 0xd0, 0x5f, 0x53, 0xd9, 0xeb, 0x8f,
-	0xe8, 0xeb, 0x39, 0xeb, 0x7f, 0x33, 0x96, 0xf0, 0x28, 0x09, 0xa5, 0xbd, 0x5a, 0xdd, 0xed, 0x27,
-	0xfa, 0x97, 0x39, 0xd6, 0xb9, 0x44, 0xeb, 0x7a, 0xde, 0xe2, 0xb2, 0xe6, 0x12, 0x30, 0x5e, 0x97,
-	0xc0, 0xf1, 0x6d, 0x19, 0x99, 0x7d, 0x1e, 0xe3, 0x3f, 0x51, 0x45, 0xbf, 0xf6, 0xfb, 0x0b, 0x3c,
-	0x8a, 0x5d, 0x6b, 0x1e, 0x7e, 0x89, 0xaa, 0x68, 0xf4, 0x37, 0x64, 0xe6, 0xab, 0xb6, 0xb7, 0xb8,
-	0xe8, 0x22, 0x13, 0xcd, 0x6f, 0x5f, 0x94, 0x14, 0xa6, 0xbf, 0xa3, 0x25, 0x79, 0xa3, 0xce, 0xe2,
-	0x92, 0x5c, 0xd3, 0xfc, 0xee, 0x65, 0xcd, 0xcc, 0xb7, 0xeb, 0xdf, 0x3d, 0xd8, 0xc6, 0xfd, 0x83,
-	0x6d, 0x7c, 0x78, 0xb0, 0x8d, 0xdb, 0x47, 0xbb, 0x74, 0xff, 0x68, 0x97, 0xde, 0x3e, 0xda, 0xa5,
-	0x3f, 0x7e, 0x9a, 0x7b, 0x39, 0xa5, 0xdf, 0x51, 0x0a, 0xe2, 0x8a, 0xb2, 0xa1, 0x3e, 0x8d, 0x20,
-	0x8a, 0x81, 0x79, 0xd7, 0xcf, 0x3e, 0xc4, 0x83, 0x8a, 0xfc, 0xc0, 0x9e, 0x7c, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x1d, 0xb1, 0x16, 0xfe, 0x05, 0x00, 0x00,
+	// 954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x62, 0xc7, 0x49, 0x9e, 0x9d, 0x94, 0x6e, 0x32, 0x41, 0xf1, 0x0c, 0x8e, 0xab, 0x69,
+	0x26, 0x06, 0x5a, 0x19, 0xa7, 0x33, 0xd0, 0xde, 0x48, 0xdc, 0x13, 0x53, 0x43, 0x47, 0x04, 0x98,
+	0xe1, 0xcf, 0x68, 0xd6, 0xd2, 0xab, 0x2a, 0x6c, 0x6b, 0x35, 0xda, 0x95, 0x93, 0x9e, 0xf9, 0x02,
+	0x1c, 0x39, 0x71, 0xe2, 0xc4, 0x77, 0x80, 0x73, 0x8f, 0x3d, 0x72, 0x02, 0x26, 0xb9, 0xf3, 0x19,
+	0x18, 0xed, 0xae, 0x65, 0x65, 0xd2, 0x28, 0x21, 0xe5, 0x64, 0xed, 0x7b, 0xbf, 0xf7, 0x76, 0x7f,
+	0xbf, 0xf7, 0xf6, 0x79, 0xc1, 0x4a, 0x30, 0xc0, 0xa8, 0x8b, 0x1e, 0xf3, 0x12, 0xf4, 0x43, 0xd1,
+	0x1d, 0x52, 0x3e, 0x42, 0xd1, 0x9d, 0xf6, 0xba, 0xe2, 0xc4, 0x8e, 0x13, 0x26, 0x18, 0xd9, 0x96,
+	0x18, 0x3b, 0xc7, 0xd8, 0x0a, 0x63, 0x4f, 0x7b, 0xcd, 0xcd, 0x80, 0x05, 0x4c, 0xa2, 0xba, 0xd9,
+	0x97, 0x0a, 0x68, 0xee, 0x96, 0x24, 0x7d, 0x11, 0x23, 0xd7, 0xb0, 0x96, 0xc7, 0xf8, 0x84, 0xf1,
+	0xcc, 0x8b, 0xdd, 0x69, 0x6f, 0x88, 0x82, 0xf6, 0xba, 0x1e, 0x0b, 0x23, 0xe5, 0xb7, 0x7e, 0xaf,
+	0xc2, 0xea, 0x80, 0x07, 0xfd, 0x04, 0xa9, 0x40, 0x62, 0xc2, 0xb2, 0x97, 0x26, 0x54, 0xb0, 0xc4,
+	0x34, 0xda, 0x46, 0x67, 0xd5, 0x99, 0x2d, 0x09, 0x81, 0x6a, 0x44, 0x27, 0x68, 0x2e, 0x4a, 0xb3,
+	0xfc, 0x26, 0x6d, 0xa8, 0xfb, 0xc8, 0xbd, 0x24, 0x8c, 0x45, 0xc8, 0x22, 0xb3, 0x22, 0x5d, 0x45,
+	0x13, 0x69, 0xc1, 0x0a, 0x9e, 0xc4, 0x2c, 0xc2, 0x48, 0x98, 0xd5, 0xb6, 0xd1, 0x59, 0x3b, 0x5c,
+	0x34, 0x0d, 0x27, 0xb7, 0x11, 0x1b, 0x36, 0xfc, 0x90, 0xd3, 0xe1, 0x18, 0x5d, 0x9a, 0x0a, 0xe6,
+	0x26, 0x28, 0xc2, 0x04, 0xcd, 0xa5, 0xb6, 0xd1, 0x59, 0x71, 0x6e, 0x6b, 0xd7, 0x41, 0x2a, 0x98,
+	0x23, 0x1d, 0xe4, 0x1e, 0x10, 0xc5, 0xd6, 0xcd, 0x38, 0xba, 0x74, 0x38, 0x4c, 0x70, 0x6a, 0xd6,
+	0xe4, 0xc6, 0x6f, 0x29, 0xcf, 0xd1, 0x8b, 0x18, 0x0f, 0xa4, 0x9d, 0xec, 0xc1, 0x2d, 0x3a, 0x1e,
+	0xb3, 0x63, 0xf4, 0x5d, 0x6f, 0x4c, 0x39, 0x47, 0x6e, 0x2e, 0xb7, 0x2b, 0x9d, 0x55, 0x67, 0x5d,
+	0x9b, 0xfb, 0xca, 0x4a, 0x9e, 0xc0, 0x9a, 0x4f, 0x05, 0xba, 0x5e, 0x12, 0x0a, 0x4c, 0x42, 0x6a,
+	0xae, 0xb4, 0x8d, 0x4e, 0x7d, 0x7f, 0xcf, 0xbe, 0xb4, 0x28, 0xf6, 0x63, 0x2a, 0xb0, 0xaf, 0xe1,
+	0x4e, 0xc3, 0x2f, 0xac, 0xc8, 0x77, 0x50, 0x79, 0x86, 0x68, 0xae, 0xb6, 0x2b, 0x9d, 0xfa, 0xfe,
+	0xb6, 0xad, 0x0a, 0x90, 0x85, 0xa2, 0xad, 0x0b, 0x60, 0xf7, 0x59, 0x18, 0x1d, 0x7e, 0xf0, 0xf2,
+	0xcf, 0x9d, 0x85, 0x5f, 0xff, 0xda, 0xe9, 0x04, 0xa1, 0x78, 0x9e, 0x0e, 0x6d, 0x8f, 0x4d, 0xba,
+	0xba, 0x5a, 0xea, 0xe7, 0x3e, 0xf7, 0x47, 0xba, 0x98, 0x59, 0x00, 0x77, 0xb2, 0xbc, 0xe4, 0x53,
+	0x68, 0x14, 0x34, 0xe0, 0x26, 0xc8, 0x7d, 0xde, 0x2f, 0x39, 0x6b, 0x3f, 0x17, 0xe6, 0x2b, 0x0c,
+	0x83, 0xe7, 0xc2, 0xa9, 0xcf, 0xa5, 0xe2, 0xc4, 0x82, 0x35, 0x25, 0xbb, 0xcb, 0x22, 0x37, 0x4e,
+	0x85, 0x59, 0x97, 0xea, 0xd7, 0x95, 0xf1, 0xb3, 0xe8, 0x69, 0x2a, 0xc8, 0x36, 0xac, 0x4c, 0xc2,
+	0xc8, 0x15, 0x74, 0x84, 0x66, 0x43, 0x35, 0xc6, 0x24, 0x8c, 0x8e, 0xe8, 0x08, 0xad, 0x0f, 0xe1,
+	0x76, 0xde, 0x3f, 0x0e, 0xf2, 0x98, 0x45, 0x1c, 0xc9, 0x1d, 0x68, 0xa8, 0xed, 0x5d, 0x1f, 0x23,
+	0x36, 0xd1, 0xcd, 0x54, 0x57, 0xb6, 0xc7, 0x99, 0xc9, 0xfa, 0xcd, 0x80, 0xda, 0x80, 0x07, 0x59,
+	0xf6, 0x4d, 0x58, 0x62, 0xc7, 0x11, 0xce, 0x7a, 0x4e, 0x2d, 0x2e, 0xe4, 0x58, 0xbc, 0x90, 0x83,
+	0x1c, 0xc0, 0xb2, 0x62, 0xc2, 0xcd, 0x8a, 0x54, 0xa1, 0xac, 0x62, 0x87, 0xf2, 0x4b, 0x69, 0xe1,
+	0xcc, 0xe2, 0xc8, 0x47, 0xf0, 0xb6, 0x22, 0x3a, 0xc1, 0x48, 0xb8, 0xdf, 0xa7, 0x49, 0xc8, 0xfd,
+	0xd0, 0x93, 0xfd, 0x5c, 0x95, 0x1b, 0x6e, 0xcd, 0xdd, 0x9f, 0x14, 0xbc, 0xd6, 0x23, 0x58, 0x57,
+	0xc7, 0xcf, 0x49, 0x67, 0xed, 0x36, 0x61, 0x69, 0x24, 0xdc, 0x04, 0x3d, 0x0c, 0xa7, 0xe8, 0x6b,
+	0x42, 0xeb, 0xca, 0xec, 0x68, 0xab, 0xf5, 0x8f, 0x01, 0xcb, 0x03, 0x1e, 0x64, 0xf2, 0xdd, 0x9c,
+	0xfb, 0x16, 0xd4, 0x54, 0x5a, 0x7d, 0xef, 0xf4, 0x8a, 0x3c, 0x80, 0x8d, 0x02, 0xa1, 0x31, 0xf3,
+	0xe8, 0x9c, 0x8c, 0xbc, 0x7d, 0x64, 0xee, 0x7e, 0xa2, 0xbd, 0xe4, 0x2e, 0xac, 0xcf, 0x7b, 0x40,
+	0x56, 0x59, 0x5d, 0xc1, 0xc6, 0xac, 0x09, 0xe4, 0x59, 0x4b, 0xb4, 0xaa, 0x95, 0x6a, 0x75, 0x04,
+	0xb7, 0x34, 0xdf, 0x5c, 0xac, 0x42, 0xe9, 0x8c, 0x9b, 0x95, 0xce, 0xfa, 0xc5, 0x80, 0x77, 0x06,
+	0x3c, 0xf8, 0x22, 0xce, 0x6e, 0x9f, 0x82, 0x1c, 0x9c, 0xbf, 0xd7, 0x97, 0x8f, 0xb3, 0x6b, 0x08,
+	0xbc, 0x03, 0x75, 0xea, 0xcf, 0x27, 0x47, 0x45, 0x4e, 0x0e, 0xa0, 0x7e, 0x9e, 0x7d, 0x37, 0x13,
+	0x6d, 0xc2, 0xa6, 0x98, 0x63, 0xaa, 0x12, 0xb3, 0xa6, 0xac, 0x1a, 0x66, 0xed, 0xc1, 0x6e, 0xe9,
+	0x29, 0x67, 0x92, 0x58, 0x9e, 0xec, 0xa8, 0xfe, 0x98, 0x71, 0x8d, 0x7b, 0xb3, 0xf3, 0x6f, 0xc2,
+	0xd2, 0x33, 0x96, 0x78, 0x28, 0xfb, 0x63, 0xc5, 0x51, 0x0b, 0xeb, 0x1b, 0xd8, 0x3a, 0xbf, 0xc9,
+	0xff, 0x59, 0x91, 0x58, 0x26, 0x2f, 0x52, 0x1d, 0xa8, 0x29, 0xf1, 0x66, 0x4c, 0x8a, 0xd3, 0xa7,
+	0x72, 0x7e, 0xfa, 0xb4, 0xa1, 0xf5, 0xfa, 0x1d, 0x67, 0xb4, 0xf6, 0x7f, 0x5e, 0x82, 0xca, 0x80,
+	0x07, 0xe4, 0x5b, 0xa8, 0xe9, 0x3f, 0xb9, 0xbb, 0x25, 0xbc, 0xf2, 0x51, 0xd6, 0xbc, 0x77, 0x1d,
+	0x54, 0x2e, 0xde, 0xe7, 0x50, 0xc9, 0x26, 0xd9, 0x9d, 0xf2, 0xa0, 0xa7, 0xa9, 0x68, 0xbe, 0x7b,
+	0x25, 0x24, 0x4f, 0xfa, 0x25, 0x54, 0xa5, 0x78, 0x56, 0x79, 0x48, 0x86, 0x69, 0xbe, 0x77, 0x35,
+	0x26, 0xcf, 0xfb, 0x93, 0x01, 0xcd, 0x92, 0x5b, 0xf3, 0xb0, 0x3c, 0xd5, 0xe5, 0x91, 0xcd, 0x8f,
+	0x6f, 0x1a, 0x99, 0x1f, 0x6d, 0x04, 0xf5, 0xe2, 0x05, 0xb8, 0x42, 0xac, 0x02, 0xb4, 0xd9, 0xbb,
+	0x36, 0x34, 0xdf, 0xec, 0x07, 0x03, 0x36, 0x5e, 0xd7, 0xac, 0xbd, 0xeb, 0xd3, 0xd0, 0x21, 0xcd,
+	0x47, 0xff, 0x39, 0x64, 0x76, 0x8a, 0x43, 0xe7, 0xe5, 0x69, 0xcb, 0x78, 0x75, 0xda, 0x32, 0xfe,
+	0x3e, 0x6d, 0x19, 0x3f, 0x9e, 0xb5, 0x16, 0x5e, 0x9d, 0xb5, 0x16, 0xfe, 0x38, 0x6b, 0x2d, 0x7c,
+	0xfd, 0xb0, 0xf0, 0x30, 0x90, 0xe9, 0xef, 0x47, 0x28, 0x8e, 0x59, 0x32, 0xd2, 0xab, 0x31, 0xfa,
+	0x01, 0x26, 0xdd, 0x93, 0x0b, 0x8f, 0xc0, 0x61, 0x4d, 0x3e, 0xee, 0x1e, 0xfc, 0x1b, 0x00, 0x00,
+	0xff, 0xff, 0x3b, 0x61, 0x9b, 0xc5, 0x7a, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +986,26 @@ type MsgClient interface {
 	// Take takes credits from a basket starting from the oldest
 	// credits first.
 	Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error)
+	// UpdateBasketAllowedClasses updates the credit classes allowed to be put
+	// into a basket. Only the basket curator can update the allowed classes.
+	// Classes that still have credits held in the basket cannot be removed.
+	//
+	// Since Revision 2
+	UpdateBasketAllowedClasses(ctx context.Context, in *MsgUpdateBasketAllowedClasses, opts ...grpc.CallOption) (*MsgUpdateBasketAllowedClassesResponse, error)
+	// CloseBasket closes a basket, preventing any further credits from being
+	// put into the basket. If no basket tokens are outstanding, the credits
+	// remaining in the basket are swept to the basket curator. Otherwise the
+	// credits remain in the basket so that token holders can still take them.
+	// Only the basket curator can close a basket.
+	//
+	// Since Revision 2
+	CloseBasket(ctx context.Context, in *MsgCloseBasket, opts ...grpc.CallOption) (*MsgCloseBasketResponse, error)
+	// UpdateBasketMinTake updates the minimum amount of basket tokens that can
+	// be taken from a basket in a single MsgTake. Only the basket curator can
+	// update the minimum take amount.
+	//
+	// Since Revision 2
+	UpdateBasketMinTake(ctx context.Context, in *MsgUpdateBasketMinTake, opts ...grpc.CallOption) (*MsgUpdateBasketMinTakeResponse, error)
 }
 
 type msgClient struct {
@@ -601,6 +1043,33 @@ func (c *msgClient) Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateBasketAllowedClasses(ctx context.Context, in *MsgUpdateBasketAllowedClasses, opts ...grpc.CallOption) (*MsgUpdateBasketAllowedClassesResponse, error) {
+	out := new(MsgUpdateBasketAllowedClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketAllowedClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseBasket(ctx context.Context, in *MsgCloseBasket, opts ...grpc.CallOption) (*MsgCloseBasketResponse, error) {
+	out := new(MsgCloseBasketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/CloseBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketMinTake(ctx context.Context, in *MsgUpdateBasketMinTake, opts ...grpc.CallOption) (*MsgUpdateBasketMinTakeResponse, error) {
+	out := new(MsgUpdateBasketMinTakeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketMinTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Create creates a bank denom which wraps credits.
@@ -610,6 +1079,26 @@ type MsgServer interface {
 	// Take takes credits from a basket starting from the oldest
 	// credits first.
 	Take(context.Context, *MsgTake) (*MsgTakeResponse, error)
+	// UpdateBasketAllowedClasses updates the credit classes allowed to be put
+	// into a basket. Only the basket curator can update the allowed classes.
+	// Classes that still have credits held in the basket cannot be removed.
+	//
+	// Since Revision 2
+	UpdateBasketAllowedClasses(context.Context, *MsgUpdateBasketAllowedClasses) (*MsgUpdateBasketAllowedClassesResponse, error)
+	// CloseBasket closes a basket, preventing any further credits from being
+	// put into the basket. If no basket tokens are outstanding, the credits
+	// remaining in the basket are swept to the basket curator. Otherwise the
+	// credits remain in the basket so that token holders can still take them.
+	// Only the basket curator can close a basket.
+	//
+	// Since Revision 2
+	CloseBasket(context.Context, *MsgCloseBasket) (*MsgCloseBasketResponse, error)
+	// UpdateBasketMinTake updates the minimum amount of basket tokens that can
+	// be taken from a basket in a single MsgTake. Only the basket curator can
+	// update the minimum take amount.
+	//
+	// Since Revision 2
+	UpdateBasketMinTake(context.Context, *MsgUpdateBasketMinTake) (*MsgUpdateBasketMinTakeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -625,6 +1114,15 @@ func (*UnimplementedMsgServer) Put(ctx context.Context, req *MsgPut) (*MsgPutRes
 func (*UnimplementedMsgServer) Take(ctx context.Context, req *MsgTake) (*MsgTakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Take not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBasketAllowedClasses(ctx context.Context, req *MsgUpdateBasketAllowedClasses) (*MsgUpdateBasketAllowedClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketAllowedClasses not implemented")
+}
+func (*UnimplementedMsgServer) CloseBasket(ctx context.Context, req *MsgCloseBasket) (*MsgCloseBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBasket not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketMinTake(ctx context.Context, req *MsgUpdateBasketMinTake) (*MsgUpdateBasketMinTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketMinTake not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -684,9 +1182,63 @@ func _Msg_Take_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.ecocredit.basket.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
+func _Msg_UpdateBasketAllowedClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketAllowedClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketAllowedClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketAllowedClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketAllowedClasses(ctx, req.(*MsgUpdateBasketAllowedClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/CloseBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseBasket(ctx, req.(*MsgCloseBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketMinTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketMinTake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketMinTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketMinTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketMinTake(ctx, req.(*MsgUpdateBasketMinTake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.ecocredit.basket.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
@@ -700,6 +1252,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Take",
 			Handler:    _Msg_Take_Handler,
 		},
+		{
+			MethodName: "UpdateBasketAllowedClasses",
+			Handler:    _Msg_UpdateBasketAllowedClasses_Handler,
+		},
+		{
+			MethodName: "CloseBasket",
+			Handler:    _Msg_CloseBasket_Handler,
+		},
+		{
+			MethodName: "UpdateBasketMinTake",
+			Handler:    _Msg_UpdateBasketMinTake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/tx.proto",
@@ -725,6 +1289,37 @@ func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinTake) > 0 {
+		i -= len(m.MinTake)
+		copy(dAtA[i:], m.MinTake)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinTake)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RetireOnPut {
+		i--
+		if m.RetireOnPut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CreditTypes) > 0 {
+		for iNdEx := len(m.CreditTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Fee) > 0 {
 		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -856,6 +1451,13 @@ func (m *MsgPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Credits) > 0 {
 		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1022,127 +1624,373 @@ func (m *MsgTakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateBasketAllowedClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateBasketAllowedClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketAllowedClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Curator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Exponent != 0 {
-		n += 1 + sovTx(uint64(m.Exponent))
-	}
-	if m.DisableAutoRetire {
-		n += 2
-	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RemoveClasses) > 0 {
+		for iNdEx := len(m.RemoveClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveClasses[iNdEx])
+			copy(dAtA[i:], m.RemoveClasses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.AllowedClasses) > 0 {
-		for _, s := range m.AllowedClasses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AddClasses) > 0 {
+		for iNdEx := len(m.AddClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddClasses[iNdEx])
+			copy(dAtA[i:], m.AddClasses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.DateCriteria != nil {
-		l = m.DateCriteria.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBasketAllowedClassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketAllowedClassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketAllowedClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPut) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCloseBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Credits) > 0 {
-		for _, e := range m.Credits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AmountReceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCloseBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCloseBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketMinTake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketMinTake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketMinTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinTake) > 0 {
+		i -= len(m.MinTake)
+		copy(dAtA[i:], m.MinTake)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinTake)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketMinTakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketMinTakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketMinTakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedClasses) > 0 {
+		for _, s := range m.AllowedClasses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DateCriteria != nil {
+		l = m.DateCriteria.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreditTypes) > 0 {
+		for _, e := range m.CreditTypes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RetireOnPut {
+		n += 2
+	}
+	l = len(m.MinTake)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AmountReceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Amount)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1173,16 +2021,868 @@ func (m *MsgTakeResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateBasketAllowedClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddClasses) > 0 {
+		for _, s := range m.AddClasses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveClasses) > 0 {
+		for _, s := range m.RemoveClasses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketAllowedClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCloseBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketMinTake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinTake)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketMinTakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClasses = append(m.AllowedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DateCriteria == nil {
+				m.DateCriteria = &DateCriteria{}
+			}
+			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypes = append(m.CreditTypes, &CreditTypeWeight{})
+			if err := m.CreditTypes[len(m.CreditTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnPut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetireOnPut = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountReceived = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+func (m *MsgTake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,15 +2905,15 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1241,11 +2941,11 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Curator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1273,11 +2973,11 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +3005,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementLocation", wireType)
 			}
-			m.Exponent = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1321,14 +3021,27 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnTake", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1345,10 +3058,176 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableAutoRetire = bool(v != 0)
+			m.RetireOnTake = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBasketAllowedClasses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBasketAllowedClasses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBasketAllowedClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1376,11 +3255,11 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClasses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1408,13 +3287,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedClasses = append(m.AllowedClasses, string(dAtA[iNdEx:postIndex]))
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddClasses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1424,33 +3303,29 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DateCriteria == nil {
-				m.DateCriteria = &DateCriteria{}
-			}
-			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddClasses = append(m.AddClasses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClasses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1460,25 +3335,23 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemoveClasses = append(m.RemoveClasses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1501,7 +3374,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketAllowedClassesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1524,44 +3397,12 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketAllowedClassesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketAllowedClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BasketDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1583,7 +3424,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPut) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseBasket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,15 +3447,15 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseBasket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseBasket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1642,7 +3483,7 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1677,10 +3518,10 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1690,26 +3531,12 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1731,7 +3558,7 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseBasketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,17 +3581,17 @@ func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseBasketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountReceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1774,23 +3601,25 @@ func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountReceived = string(dAtA[iNdEx:postIndex])
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1813,7 +3642,7 @@ func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketMinTake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1836,15 +3665,15 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketMinTake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketMinTake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1872,7 +3701,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1908,91 +3737,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetirementLocation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RetirementLocation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnTake", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RetireOnTake = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,7 +3765,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			m.MinTake = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2043,7 +3788,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketMinTakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2066,46 +3811,12 @@ func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketMinTakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketMinTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
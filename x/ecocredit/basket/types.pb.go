@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: regen/ecocredit/basket/v1/types.proto
 
-// Revision 1
+// Revision 2
 
 package basket
 
@@ -82,6 +82,67 @@ func (m *BasketCredit) GetAmount() string {
 	return ""
 }
 
+// CreditTypeWeight represents a credit type accepted by a basket holding more
+// than one credit type and the ratio at which the credit type contributes to
+// the credits taken from the basket.
+//
+// Since Revision 2
+type CreditTypeWeight struct {
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,1,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// weight is the decimal ratio at which credits of this credit type are
+	// taken from the basket. The weights of all credit types in a basket must
+	// sum to 1.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *CreditTypeWeight) Reset()         { *m = CreditTypeWeight{} }
+func (m *CreditTypeWeight) String() string { return proto.CompactTextString(m) }
+func (*CreditTypeWeight) ProtoMessage()    {}
+func (*CreditTypeWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c256e957c69c4d, []int{1}
+}
+func (m *CreditTypeWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditTypeWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditTypeWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditTypeWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditTypeWeight.Merge(m, src)
+}
+func (m *CreditTypeWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditTypeWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditTypeWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditTypeWeight proto.InternalMessageInfo
+
+func (m *CreditTypeWeight) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *CreditTypeWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 // DateCriteria represents the information for credit acceptance in a basket.
 // At most, only one of the values should be set.
 type DateCriteria struct {
@@ -111,7 +172,7 @@ func (m *DateCriteria) Reset()         { *m = DateCriteria{} }
 func (m *DateCriteria) String() string { return proto.CompactTextString(m) }
 func (*DateCriteria) ProtoMessage()    {}
 func (*DateCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6c256e957c69c4d, []int{1}
+	return fileDescriptor_e6c256e957c69c4d, []int{2}
 }
 func (m *DateCriteria) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,6 +224,7 @@ func (m *DateCriteria) GetYearsInThePast() uint32 {
 
 func init() {
 	proto.RegisterType((*BasketCredit)(nil), "regen.ecocredit.basket.v1.BasketCredit")
+	proto.RegisterType((*CreditTypeWeight)(nil), "regen.ecocredit.basket.v1.CreditTypeWeight")
 	proto.RegisterType((*DateCriteria)(nil), "regen.ecocredit.basket.v1.DateCriteria")
 }
 
@@ -171,30 +233,32 @@ func init() {
 }
 
 var fileDescriptor_e6c256e957c69c4d = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x6b, 0xe2, 0x40,
-	0x14, 0xc6, 0xcd, 0x2e, 0x08, 0x3b, 0xba, 0x2e, 0xe6, 0xb0, 0xa8, 0x87, 0x28, 0xc2, 0x82, 0x7b,
-	0xe8, 0x0c, 0xda, 0x4b, 0x8f, 0x45, 0x2d, 0xa5, 0xb7, 0x92, 0x0a, 0x85, 0x5e, 0xc2, 0x24, 0x79,
-	0x4d, 0x06, 0xcd, 0x4c, 0x98, 0x79, 0xd1, 0xfa, 0x5f, 0xf4, 0x6f, 0xea, 0xa9, 0x47, 0x8f, 0x3d,
-	0x16, 0xfd, 0x47, 0x8a, 0x13, 0x6d, 0xa1, 0x1e, 0xdf, 0xfb, 0xbe, 0xef, 0x37, 0xdf, 0xf0, 0xc8,
-	0x3f, 0x0d, 0x09, 0x48, 0x06, 0x91, 0x8a, 0x34, 0xc4, 0x02, 0x59, 0xc8, 0xcd, 0x1c, 0x90, 0x2d,
-	0x87, 0x0c, 0xd7, 0x39, 0x18, 0x9a, 0x6b, 0x85, 0xca, 0x6d, 0x5b, 0x1b, 0xfd, 0xb4, 0xd1, 0xd2,
-	0x46, 0x97, 0xc3, 0x4e, 0x37, 0x51, 0x2a, 0x59, 0x00, 0xb3, 0xc6, 0xb0, 0x78, 0x64, 0x28, 0x32,
-	0x30, 0xc8, 0xb3, 0xbc, 0xcc, 0x76, 0xbc, 0xef, 0x86, 0xb8, 0xd0, 0x1c, 0x85, 0x92, 0xa5, 0xde,
-	0xbf, 0x26, 0xf5, 0xb1, 0xa5, 0x4d, 0x2c, 0xda, 0xed, 0x92, 0x5a, 0xc8, 0x31, 0x4a, 0x83, 0x18,
-	0xa4, 0xca, 0x5a, 0x4e, 0xcf, 0x19, 0xfc, 0xf2, 0x89, 0x5d, 0x4d, 0xf7, 0x1b, 0xf7, 0x2f, 0xa9,
-	0xf2, 0x4c, 0x15, 0x12, 0x5b, 0x3f, 0xac, 0x76, 0x98, 0xfa, 0x2f, 0x0e, 0xa9, 0x4f, 0x39, 0xc2,
-	0x44, 0x0b, 0x04, 0x2d, 0xb8, 0x7b, 0x49, 0x1a, 0x99, 0x90, 0x81, 0x41, 0xae, 0x31, 0x88, 0x39,
-	0x82, 0x85, 0xd5, 0x46, 0x1d, 0x5a, 0x56, 0xa2, 0xc7, 0x4a, 0x74, 0x76, 0xec, 0xec, 0xd7, 0x33,
-	0x21, 0xef, 0xf6, 0x81, 0x3d, 0xc9, 0xbd, 0x22, 0xcd, 0xaf, 0x74, 0xb0, 0x12, 0x32, 0x56, 0x2b,
-	0xfb, 0x6a, 0x6d, 0xd4, 0x3e, 0x81, 0x4c, 0x0f, 0xff, 0xf2, 0xff, 0x98, 0x23, 0xe0, 0xde, 0x26,
-	0xdc, 0xff, 0xa4, 0xb9, 0x06, 0xae, 0x4d, 0x20, 0x64, 0x80, 0x29, 0x04, 0x39, 0x37, 0xd8, 0xfa,
-	0xd9, 0x73, 0x06, 0xbf, 0xfd, 0x86, 0x15, 0x6e, 0xe4, 0x2c, 0x85, 0x5b, 0x6e, 0x70, 0xec, 0xbf,
-	0x6e, 0x3d, 0x67, 0xb3, 0xf5, 0x9c, 0xf7, 0xad, 0xe7, 0x3c, 0xef, 0xbc, 0xca, 0x66, 0xe7, 0x55,
-	0xde, 0x76, 0x5e, 0xe5, 0xe1, 0x22, 0x11, 0x98, 0x16, 0x21, 0x8d, 0x54, 0xc6, 0xec, 0x39, 0xce,
-	0x24, 0xe0, 0x4a, 0xe9, 0xf9, 0x61, 0x5a, 0x40, 0x9c, 0x80, 0x66, 0x4f, 0x27, 0xc7, 0x0c, 0xab,
-	0xb6, 0xe2, 0xf9, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xf9, 0xb4, 0xee, 0xed, 0x01, 0x00,
-	0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x90, 0x2a, 0xb1, 0x09, 0xa5, 0xdd, 0x03, 0x4a, 0x73, 0x70, 0xab, 0x48, 0x48,
+	0x45, 0x82, 0x5d, 0xb5, 0x5c, 0x38, 0x42, 0x1b, 0x84, 0xb8, 0x21, 0x13, 0x29, 0x88, 0xcb, 0x6a,
+	0x6d, 0x0f, 0xf6, 0x2a, 0xf1, 0xae, 0xb5, 0x3b, 0x8e, 0xc9, 0x5b, 0xf0, 0x4c, 0x9c, 0x38, 0xe6,
+	0xc8, 0x11, 0x25, 0x2f, 0x82, 0xbc, 0xb6, 0x83, 0xd4, 0x1c, 0x67, 0xe6, 0xff, 0x3f, 0xcf, 0x3f,
+	0x5e, 0xf2, 0xc2, 0x42, 0x06, 0x9a, 0x43, 0x62, 0x12, 0x0b, 0xa9, 0x42, 0x1e, 0x4b, 0xb7, 0x04,
+	0xe4, 0xeb, 0x1b, 0x8e, 0x9b, 0x12, 0x1c, 0x2b, 0xad, 0x41, 0x43, 0x2f, 0xbc, 0x8c, 0x1d, 0x64,
+	0xac, 0x95, 0xb1, 0xf5, 0xcd, 0xe4, 0x32, 0x33, 0x26, 0x5b, 0x01, 0xf7, 0xc2, 0xb8, 0xfa, 0xce,
+	0x51, 0x15, 0xe0, 0x50, 0x16, 0x65, 0xeb, 0x9d, 0x84, 0x0f, 0x05, 0x69, 0x65, 0x25, 0x2a, 0xa3,
+	0xdb, 0xf9, 0xf4, 0x23, 0x19, 0xdd, 0x79, 0xda, 0xbd, 0x47, 0xd3, 0x4b, 0x32, 0x8c, 0x25, 0x26,
+	0xb9, 0x48, 0x41, 0x9b, 0x62, 0x1c, 0x5c, 0x05, 0xd7, 0x4f, 0x22, 0xe2, 0x5b, 0xb3, 0xa6, 0x43,
+	0x9f, 0x93, 0x13, 0x59, 0x98, 0x4a, 0xe3, 0xf8, 0x91, 0x9f, 0x75, 0xd5, 0xf4, 0x2b, 0x39, 0x6b,
+	0x11, 0xf3, 0x4d, 0x09, 0x0b, 0x50, 0x59, 0x8e, 0xf4, 0x15, 0xa1, 0xed, 0xc6, 0xa2, 0x89, 0x23,
+	0x64, 0x1c, 0x5b, 0x58, 0x77, 0xcc, 0xb3, 0xe4, 0xa0, 0x7e, 0xef, 0xfb, 0x0d, 0xb9, 0xf6, 0xbe,
+	0x9e, 0xdc, 0x56, 0xd3, 0x5f, 0x01, 0x19, 0xcd, 0x24, 0xc2, 0xbd, 0x55, 0x08, 0x56, 0x49, 0xfa,
+	0x8e, 0x9c, 0x16, 0x4a, 0x0b, 0x87, 0xd2, 0xa2, 0x48, 0x25, 0x82, 0x47, 0x0e, 0x6f, 0x27, 0xac,
+	0x0d, 0xcb, 0xfa, 0xb0, 0x6c, 0xde, 0x5f, 0x23, 0x1a, 0x15, 0x4a, 0x7f, 0x69, 0x0c, 0x0d, 0x89,
+	0x7e, 0x20, 0xe7, 0xff, 0xdd, 0xa2, 0x56, 0x3a, 0x35, 0xb5, 0xff, 0xea, 0xf0, 0xf6, 0xe2, 0x08,
+	0x32, 0xeb, 0x2e, 0x16, 0x3d, 0x73, 0x3d, 0x60, 0xe1, 0x1d, 0xf4, 0x25, 0x39, 0xdf, 0x80, 0xb4,
+	0x4e, 0x28, 0x2d, 0x30, 0x07, 0x51, 0x4a, 0x87, 0xe3, 0xc7, 0x57, 0xc1, 0xf5, 0xd3, 0xe8, 0xd4,
+	0x0f, 0x3e, 0xe9, 0x79, 0x0e, 0x9f, 0xa5, 0xc3, 0xbb, 0xe8, 0xf7, 0x2e, 0x0c, 0xb6, 0xbb, 0x30,
+	0xf8, 0xbb, 0x0b, 0x83, 0x9f, 0xfb, 0x70, 0xb0, 0xdd, 0x87, 0x83, 0x3f, 0xfb, 0x70, 0xf0, 0xed,
+	0x6d, 0xa6, 0x30, 0xaf, 0x62, 0x96, 0x98, 0x82, 0xfb, 0x1f, 0xfd, 0x5a, 0x03, 0xd6, 0xc6, 0x2e,
+	0xbb, 0x6a, 0x05, 0x69, 0x06, 0x96, 0xff, 0x38, 0x7a, 0x26, 0xf1, 0x89, 0x5f, 0xf1, 0xcd, 0xbf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x43, 0xf7, 0x7c, 0xaa, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *BasketCredit) Marshal() (dAtA []byte, err error) {
@@ -234,6 +298,43 @@ func (m *BasketCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreditTypeWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditTypeWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditTypeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DateCriteria) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -314,6 +415,23 @@ func (m *BasketCredit) Size() (n int) {
 	return n
 }
 
+func (m *CreditTypeWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DateCriteria) Size() (n int) {
 	if m == nil {
 		return 0
@@ -454,6 +572,120 @@ func (m *BasketCredit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreditTypeWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditTypeWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditTypeWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DateCriteria) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package basket
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,10 +29,11 @@ func TestFormatBasketDenom(t *testing.T) {
 		{"exponent-6",
 			"X", 6, "eco.uX.foo", "eco.X.foo", false},
 	}
-	require := require.New(t)
 	for _, tc := range tcs {
+		tc := tc
 		t.Run(tc.tname, func(t *testing.T) {
 			t.Parallel()
+			require := require.New(t)
 			d, displayD, err := FormatBasketDenom("foo", tc.abbrev, tc.exponent)
 			if tc.err {
 				require.Error(err, tc.tname)
@@ -43,3 +45,44 @@ func TestFormatBasketDenom(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasketName(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		tname string
+		name  string
+		err   bool
+	}{
+		{"valid min length", "NCT", false},
+		{"valid max length", "CARBONAB", false},
+		{"valid alphanumeric", "C02x", false},
+		{"empty", "", true},
+		{"too short", "CT", true},
+		{"too long", "CARBONTOKEN", true},
+		{"starts with a digit", "1CT", true},
+		{"includes a space", "C T", true},
+		{"includes a period", "N.CT", true},
+		{"includes a slash", "N/CT", true},
+		{"includes a dash", "N-CT", true},
+		{"includes a non-ascii character", "NCTé", true},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.tname, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateBasketName(tc.name)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// a valid name always produces a valid bank denom
+			denom, displayDenom, err := FormatBasketDenom(tc.name, "BIO", 6)
+			require.NoError(t, err)
+			require.NoError(t, sdk.ValidateDenom(denom))
+			require.NoError(t, sdk.ValidateDenom(displayDenom))
+		})
+	}
+}
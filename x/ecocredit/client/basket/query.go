@@ -114,7 +114,10 @@ func QueryBasketBalancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "basket-balances [basket-denom]",
 		Short: "Retrieves the balance of each credit batch for the given basket denom",
-		Long:  "Retrieves the balance of each credit batch for the given basket denom",
+		Long: `Retrieves the balance of each credit batch for the given basket denom with pagination flags.
+
+The balances are sorted by batch start date, oldest first, which is the order in which credits are taken from
+the basket. The total supply of basket tokens is also returned.`,
 		Example: `
 regen q ecocredit basket-balances BASKET1
 regen q ecocredit basket-balances BASKET1 --offset 1 --limit 10
@@ -149,3 +152,64 @@ regen q ecocredit basket-balances BASKET1 --offset 1 --limit 10
 
 	return cmd
 }
+
+// QueryBasketCriteriaCmd returns a query command that retrieves the date criteria of a basket.
+func QueryBasketCriteriaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "basket-criteria [basket-denom]",
+		Short:   "Retrieves the date criteria of a basket",
+		Long:    "Retrieves the date criteria of a basket, with the minimum start date as an RFC 3339 timestamp and the start date window as a duration string",
+		Example: "regen q ecocredit basket-criteria eco.uC.NCT",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			client := basket.NewQueryClient(ctx)
+
+			res, err := client.BasketCriteria(cmd.Context(), &basket.QueryBasketCriteriaRequest{BasketDenom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryEligibleCreditsCmd returns a query command that retrieves the credits of an account that can be put into a basket.
+func QueryEligibleCreditsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "eligible-credits [owner] [basket-denom]",
+		Short:   "Retrieves the credits of an account that can be put into a basket",
+		Long:    "Retrieves the tradable credit balances of an account that pass the credit type, credit class and date criteria of a basket",
+		Example: "regen q ecocredit eligible-credits regen1fv85... eco.uC.NCT",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			client := basket.NewQueryClient(ctx)
+
+			res, err := client.EligibleCredits(cmd.Context(), &basket.QueryEligibleCreditsRequest{
+				Owner:       args[0],
+				BasketDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,11 +15,13 @@ import (
 
 	regentypes "github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
 const (
 	FlagDisableAutoRetire      = "disable-auto-retire"
 	FlagCreditTypeAbbreviation = "credit-type-abbreviation"
+	FlagCreditTypes            = "credit-types"
 	FlagAllowedClasses         = "allowed-classes"
 	FlagMinimumStartDate       = "minimum-start-date"
 	FlagStartDateWindow        = "start-date-window"
@@ -27,6 +29,11 @@ const (
 	FlagDenomDescription       = "description"
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
 	FlagRetireOnTake           = "retire-on-take"
+	FlagAddClasses             = "add-classes"
+	FlagRemoveClasses          = "remove-classes"
+	FlagRetireOnPut            = "retire-on-put"
+	FlagMinTake                = "min-take"
+	FlagForce                  = "force"
 )
 
 func TxCreateBasketCmd() *cobra.Command {
@@ -44,6 +51,9 @@ Flags:
 			false unless the credits were previously put into the basket by the address
 			picking them from the basket, in which case they will remain tradable.
 		credit-type-abbreviation: filters against credits from this credit type abbreviation (e.g. "BIO").
+		credit-types: comma separated (no spaces) list of credit types and weights for a basket
+			holding more than one credit type (e.g. "C:0.6,BIO:0.4"). The weights must sum to 1
+			and credit-type-abbreviation must not be set.
 		allowed_classes: comma separated (no spaces) list of credit classes allowed to be put in
 			the basket (e.g. "C01,C02").
 		min-start-date: the earliest start date for batches of credits allowed into the basket.
@@ -53,7 +63,11 @@ Flags:
 			required Params.basket_creation_fee. We include the fee explicitly here so that the
 			curator explicitly acknowledges paying this fee and is not surprised to learn that the
 			paid a big fee and didn't know beforehand.
-		description: the description to be used in the basket coin's bank denom metadata.`),
+		description: the description to be used in the basket coin's bank denom metadata.
+		retire-on-put: retires credits when they are put into the basket. Credits cannot be
+			taken from a basket that retires credits on put.
+		min-take: the minimum amount of basket tokens that can be taken from the basket in a
+			single transaction.`),
 		Example: `
 		$regen tx ecocredit create-basket HEAED
 			--from regen...
@@ -79,6 +93,18 @@ Flags:
 				return err
 			}
 
+			var creditTypes []*basket.CreditTypeWeight
+			creditTypesString, err := cmd.Flags().GetString(FlagCreditTypes)
+			if err != nil {
+				return err
+			}
+			if creditTypesString != "" {
+				creditTypes, err = parseCreditTypeWeights(creditTypesString)
+				if err != nil {
+					return err
+				}
+			}
+
 			allowedClasses, err := cmd.Flags().GetStringSlice(FlagAllowedClasses)
 			if err != nil {
 				return err
@@ -137,15 +163,28 @@ Flags:
 				}
 			}
 
+			retireOnPut, err := cmd.Flags().GetBool(FlagRetireOnPut)
+			if err != nil {
+				return err
+			}
+
+			minTake, err := cmd.Flags().GetString(FlagMinTake)
+			if err != nil {
+				return err
+			}
+
 			msg := basket.MsgCreate{
 				Curator:           clientCtx.FromAddress.String(),
 				Name:              args[0],
 				Description:       denomDescription,
 				DisableAutoRetire: disableAutoRetire,
 				CreditTypeAbbrev:  creditTypeName,
+				CreditTypes:       creditTypes,
 				AllowedClasses:    allowedClasses,
 				DateCriteria:      dateCriteria,
 				Fee:               fee,
+				RetireOnPut:       retireOnPut,
+				MinTake:           minTake,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -159,14 +198,16 @@ Flags:
 	// command flags
 	cmd.Flags().Bool(FlagDisableAutoRetire, false, "dictates whether credits will be auto-retired upon taking")
 	cmd.Flags().String(FlagCreditTypeAbbreviation, "", "filters against credits from this credit type abbreviation (e.g. \"C\")")
+	cmd.Flags().String(FlagCreditTypes, "", "comma separated (no spaces) list of credit types and weights for a blended basket (e.g. \"C:0.6,BIO:0.4\")")
 	cmd.Flags().StringSlice(FlagAllowedClasses, []string{}, "comma separated (no spaces) list of credit classes allowed to be put in the basket (e.g. \"C01,C02\")")
 	cmd.Flags().String(FlagMinimumStartDate, "", "the earliest start date for batches of credits allowed into the basket (e.g. \"2012-01-01\")")
 	cmd.Flags().Uint64(FlagStartDateWindow, 0, "sets a cutoff for batch start dates when adding new credits to the basket (e.g. 1325404800)")
 	cmd.Flags().String(FlagBasketFee, "", "the fee that the curator will pay to create the basket (e.g. \"20regen\")")
 	cmd.Flags().String(FlagDenomDescription, "", "the description to be used in the bank denom metadata.")
+	cmd.Flags().Bool(FlagRetireOnPut, false, "dictates whether credits will be retired upon putting")
+	cmd.Flags().String(FlagMinTake, "", "the minimum amount of basket tokens that can be taken from the basket at once (e.g. \"1000000\")")
 
 	// required flags
-	cmd.MarkFlagRequired(FlagCreditTypeAbbreviation)
 	cmd.MarkFlagRequired(FlagAllowedClasses)
 
 	return txFlags(cmd)
@@ -179,12 +220,21 @@ func TxPutInBasketCmd() *cobra.Command {
 		Long: strings.TrimSpace(`add credits to the basket.
 Parameters:
 		basket_denom: basket identifier
-		credits: path to JSON file containing credits to put in the basket
+		credits: path to JSON file containing credits to put in the basket, or "-" to read the
+			JSON from stdin
 Flags:
 		from: account address of the owner
+		retire-on-put: acknowledges that the basket retires credits on put. Credits put into such
+			a basket are retired in the name of the owner instead of being held by the basket, and
+			the owner still receives basket tokens. Required if the basket retires credits on put
+			and rejected if it doesn't (not checked when offline). Requires retirement-jurisdiction.
+		retirement-jurisdiction: jurisdiction for the credits, required if the basket retires
+			credits on put. It is ignored if the basket doesn't retire credits on put.
 		`),
 		Example: `
 regen tx ecocredit put-in-basket eco.uC.NCT credits.json
+regen tx ecocredit put-in-basket eco.uC.NCT credits.json --retire-on-put --retirement-jurisdiction US-WA
+cat credits.json | regen tx ecocredit put-in-basket eco.uC.NCT -
 
 Where the credits.json file contains:
 
@@ -206,15 +256,43 @@ Where the credits.json file contains:
 				return err
 			}
 
-			credits, err := parseBasketCredits(args[1])
+			credits, err := parseBasketCredits(cmd.InOrStdin(), args[1])
 			if err != nil {
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			retireOnPut, err := cmd.Flags().GetBool(FlagRetireOnPut)
+			if err != nil {
+				return err
+			}
+
+			retirementJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			if retireOnPut && retirementJurisdiction == "" {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s is required when %s is set", FlagRetirementJurisdiction, FlagRetireOnPut)
+			}
+
+			if retirementJurisdiction != "" {
+				if err := core.ValidateJurisdiction(retirementJurisdiction); err != nil {
+					return sdkerrors.ErrInvalidRequest.Wrapf("invalid %s: %s", FlagRetirementJurisdiction, err)
+				}
+			}
+
+			// the basket cannot be queried in offline mode
+			if !clientCtx.Offline {
+				if err := checkRetireOnPut(cmd, clientCtx, args[0], retireOnPut); err != nil {
+					return err
+				}
+			}
+
 			msg := basket.MsgPut{
-				Owner:       clientCtx.FromAddress.String(),
-				BasketDenom: args[0],
-				Credits:     credits,
+				Owner:                  clientCtx.FromAddress.String(),
+				BasketDenom:            args[0],
+				Credits:                credits,
+				RetirementJurisdiction: retirementJurisdiction,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -225,9 +303,30 @@ Where the credits.json file contains:
 		},
 	}
 
+	cmd.Flags().Bool(FlagRetireOnPut, false, "acknowledges that the basket retires credits on put, requires a retirement jurisdiction")
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "jurisdiction for the credits which will be used only if the basket retires credits on put")
+
 	return txFlags(cmd)
 }
 
+// checkRetireOnPut queries the basket and returns an error if the retire-on-put
+// flag does not match whether the basket retires credits on put.
+func checkRetireOnPut(cmd *cobra.Command, clientCtx client.Context, basketDenom string, retireOnPut bool) error {
+	res, err := basket.NewQueryClient(clientCtx).Basket(cmd.Context(), &basket.QueryBasketRequest{BasketDenom: basketDenom})
+	if err != nil {
+		return err
+	}
+
+	if res.BasketInfo.RetireOnPut && !retireOnPut {
+		return sdkerrors.ErrInvalidRequest.Wrapf("basket %s retires credits on put, %s is required", basketDenom, FlagRetireOnPut)
+	}
+	if !res.BasketInfo.RetireOnPut && retireOnPut {
+		return sdkerrors.ErrInvalidRequest.Wrapf("basket %s does not retire credits on put", basketDenom)
+	}
+
+	return nil
+}
+
 func TxTakeFromBasketCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "take-from-basket [basket_denom] [amount]",
@@ -287,3 +386,146 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take true --reti
 
 	return txFlags(cmd)
 }
+
+func TxUpdateBasketAllowedClassesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-allowed-classes [basket_denom]",
+		Short: "Updates the credit classes allowed in a basket",
+		Long: strings.TrimSpace(`updates the credit classes allowed in a basket. Only the curator of the basket can update the allowed credit classes.
+Parameters:
+		basket_denom: denom identifying the basket to update.
+Flags:
+		from: account address of the curator of the basket.
+		add-classes: comma separated (no spaces) list of credit classes to allow in the basket.
+		remove-classes: comma separated (no spaces) list of credit classes to no longer allow in the basket.
+		`),
+		Example: `
+regen tx ecocredit update-basket-allowed-classes eco.uC.NCT --add-classes C02,C03
+regen tx ecocredit update-basket-allowed-classes eco.uC.NCT --add-classes C03 --remove-classes C01
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addClasses, err := cmd.Flags().GetStringSlice(FlagAddClasses)
+			if err != nil {
+				return err
+			}
+
+			removeClasses, err := cmd.Flags().GetStringSlice(FlagRemoveClasses)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketAllowedClasses{
+				Curator:       clientCtx.FromAddress.String(),
+				BasketDenom:   args[0],
+				AddClasses:    addClasses,
+				RemoveClasses: removeClasses,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddClasses, []string{}, "comma separated (no spaces) list of credit classes to allow in the basket (e.g. \"C02,C03\")")
+	cmd.Flags().StringSlice(FlagRemoveClasses, []string{}, "comma separated (no spaces) list of credit classes to no longer allow in the basket (e.g. \"C01\")")
+
+	return txFlags(cmd)
+}
+
+func TxCloseBasketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-basket [basket_denom]",
+		Short: "Closes a basket to further puts",
+		Long: strings.TrimSpace(`closes a basket, preventing any further credits from being put into the basket. If no basket tokens
+are in circulation, the credits remaining in the basket are sent to the curator. Otherwise the credits remain in the basket
+so that token holders can still take them. Only the curator of the basket can close the basket.
+Parameters:
+		basket_denom: denom identifying the basket to close.
+Flags:
+		from: account address of the curator of the basket.
+		force: close the basket even if basket tokens are still in circulation.
+		`),
+		Example: `
+regen tx ecocredit close-basket eco.uC.NCT
+regen tx ecocredit close-basket eco.uC.NCT --force
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			force, err := cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgCloseBasket{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				Force:       force,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagForce, false, "close the basket even if basket tokens are still in circulation")
+
+	return txFlags(cmd)
+}
+
+func TxUpdateBasketMinTakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-min-take [basket_denom] [min_take]",
+		Short: "Updates the minimum amount of basket tokens that can be taken from a basket",
+		Long: strings.TrimSpace(`updates the minimum amount of basket tokens that can be taken from a basket in a single transaction.
+Only the curator of the basket can update the minimum take amount.
+Parameters:
+		basket_denom: denom identifying the basket to update.
+		min_take: the new minimum integer amount of basket tokens that can be taken from the basket at once.
+			An empty value ("") removes the minimum take amount.
+Flags:
+		from: account address of the curator of the basket.
+		`),
+		Example: `
+regen tx ecocredit update-basket-min-take eco.uC.NCT 1000000
+regen tx ecocredit update-basket-min-take eco.uC.NCT ""
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketMinTake{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				MinTake:     args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
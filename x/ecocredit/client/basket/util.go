@@ -3,7 +3,10 @@ package basketclient
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -18,8 +21,16 @@ func txFlags(cmd *cobra.Command) *cobra.Command {
 	return cmd
 }
 
-func parseBasketCredits(creditsFile string) ([]*basket.BasketCredit, error) {
-	bz, err := ioutil.ReadFile(creditsFile)
+// parseBasketCredits parses the basket credits from the JSON file, or from stdin
+// if the file is "-".
+func parseBasketCredits(stdin io.Reader, creditsFile string) ([]*basket.BasketCredit, error) {
+	var bz []byte
+	var err error
+	if creditsFile == "-" {
+		bz, err = ioutil.ReadAll(stdin)
+	} else {
+		bz, err = ioutil.ReadFile(creditsFile)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -37,3 +48,22 @@ func parseBasketCredits(creditsFile string) ([]*basket.BasketCredit, error) {
 
 	return credits, nil
 }
+
+// parseCreditTypeWeights parses a comma separated list of credit type weights
+// in the format "<credit type abbreviation>:<weight>" (e.g. "C:0.6,BIO:0.4").
+func parseCreditTypeWeights(creditTypes string) ([]*basket.CreditTypeWeight, error) {
+	var weights []*basket.CreditTypeWeight
+	for _, ct := range strings.Split(creditTypes, ",") {
+		parts := strings.Split(strings.TrimSpace(ct), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid credit type weight %s: expected format <abbreviation>:<weight>", ct)
+		}
+
+		weights = append(weights, &basket.CreditTypeWeight{
+			CreditTypeAbbrev: parts[0],
+			Weight:           parts[1],
+		})
+	}
+
+	return weights, nil
+}
@@ -1,6 +1,7 @@
 package basketclient
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,9 +26,12 @@ func TestParseBasketCredits(t *testing.T) {
 		}
 	]`).Name()
 
+	validStdin := `[{"batch_denom": "C01-001-20210101-20210101-001", "amount": "10"}]`
+
 	testCases := []struct {
 		name      string
 		file      string
+		stdin     string
 		expErr    bool
 		expErrMsg string
 		expRes    []*basket.BasketCredit
@@ -70,11 +74,80 @@ func TestParseBasketCredits(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "invalid json from stdin",
+			file:      "-",
+			stdin:     `{foo:bar}`,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys from stdin",
+			file:      "-",
+			stdin:     `{"foo":"bar","foo":"baz"}`,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name:  "valid from stdin",
+			file:  "-",
+			stdin: validStdin,
+			expRes: []*basket.BasketCredit{
+				{
+					BatchDenom: "C01-001-20210101-20210101-001",
+					Amount:     "10",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseBasketCredits(strings.NewReader(tc.stdin), tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseCreditTypeWeights(t *testing.T) {
+	testCases := []struct {
+		name        string
+		creditTypes string
+		expErr      bool
+		expErrMsg   string
+		expRes      []*basket.CreditTypeWeight
+	}{
+		{
+			name:        "missing weight",
+			creditTypes: "C,BIO:0.4",
+			expErr:      true,
+			expErrMsg:   "invalid credit type weight C",
+		},
+		{
+			name:        "valid test",
+			creditTypes: "C:0.6, BIO:0.4",
+			expRes: []*basket.CreditTypeWeight{
+				{
+					CreditTypeAbbrev: "C",
+					Weight:           "0.6",
+				},
+				{
+					CreditTypeAbbrev: "BIO",
+					Weight:           "0.4",
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := parseBasketCredits(tc.file)
+			res, err := parseCreditTypeWeights(tc.creditTypes)
 			if tc.expErr {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.expErrMsg)
@@ -1,11 +1,14 @@
 package client
 
 import (
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/regen-network/regen-ledger/types"
 	basketcli "github.com/regen-network/regen-ledger/x/ecocredit/client/basket"
 	marketplacecli "github.com/regen-network/regen-ledger/x/ecocredit/client/marketplace"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -31,7 +34,19 @@ func QueryCmd(name string) *cobra.Command {
 		QueryBatchesByProjectCmd(),
 		QueryBatchCmd(),
 		QueryBalanceCmd(),
+		QueryCreditTypesByHolderCmd(),
 		QuerySupplyCmd(),
+		QueryBatchSupplyCmd(),
+		QueryClassSupplyCmd(),
+		QueryClassSupplyTotalsCmd(),
+		QuerySupplyReconciliationCmd(),
+		QuerySimulateSendCmd(),
+		QueryExpiringCreditsCmd(),
+		QueryBatchesByIssuanceDateCmd(),
+		QueryCreditsByOriginTxCmd(),
+		QueryBatchAnnotationsCmd(),
+		QueryBeneficiaryRetirementsCmd(),
+		QueryBufferPoolCmd(),
 		QueryCreditTypesCmd(),
 		QueryProjectsCmd(),
 		QueryProjectsByClassCmd(),
@@ -43,6 +58,8 @@ func QueryCmd(name string) *cobra.Command {
 		basketcli.QueryBasketsCmd(),
 		basketcli.QueryBasketBalanceCmd(),
 		basketcli.QueryBasketBalancesCmd(),
+		basketcli.QueryBasketCriteriaCmd(),
+		basketcli.QueryEligibleCreditsCmd(),
 		marketplacecli.QuerySellOrderCmd(),
 		marketplacecli.QuerySellOrdersCmd(),
 		marketplacecli.QuerySellOrdersBySellerCmd(),
@@ -60,6 +77,7 @@ func QueryClassesCmd() *cobra.Command {
 		Example: `
 regen q ecocredit classes
 regen q ecocredit classes --limit 10
+regen q ecocredit classes --count-only
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,13 +91,20 @@ regen q ecocredit classes --limit 10
 				return err
 			}
 
+			countOnly, err := cmd.Flags().GetBool(FlagCountOnly)
+			if err != nil {
+				return err
+			}
+
 			res, err := c.Classes(cmd.Context(), &core.QueryClassesRequest{
 				Pagination: pagination,
+				CountOnly:  countOnly,
 			})
 			return printQueryResponse(ctx, res, err)
 		},
 	}
 	flags.AddPaginationFlagsToCmd(cmd, "classes")
+	cmd.Flags().Bool(FlagCountOnly, false, "only query the total count of classes")
 	return qflags(cmd)
 }
 
@@ -158,6 +183,7 @@ func QueryProjectsCmd() *cobra.Command {
 		Example: `
 regen q ecocredit projects
 regen q ecocredit projects --limit 10 --count-total
+regen q ecocredit projects --count-only
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -171,8 +197,14 @@ regen q ecocredit projects --limit 10 --count-total
 				return err
 			}
 
+			countOnly, err := cmd.Flags().GetBool(FlagCountOnly)
+			if err != nil {
+				return err
+			}
+
 			res, err := c.Projects(cmd.Context(), &core.QueryProjectsRequest{
 				Pagination: pagination,
+				CountOnly:  countOnly,
 			})
 
 			return printQueryResponse(ctx, res, err)
@@ -180,6 +212,7 @@ regen q ecocredit projects --limit 10 --count-total
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "projects")
+	cmd.Flags().Bool(FlagCountOnly, false, "only query the total count of projects")
 
 	return qflags(cmd)
 }
@@ -252,6 +285,7 @@ func QueryBatchesCmd() *cobra.Command {
 		Example: `
 regen q ecocredit batches
 regen q ecocredit batches --limit 10
+regen q ecocredit batches --count-only
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -265,8 +299,14 @@ regen q ecocredit batches --limit 10
 				return err
 			}
 
+			countOnly, err := cmd.Flags().GetBool(FlagCountOnly)
+			if err != nil {
+				return err
+			}
+
 			res, err := c.Batches(cmd.Context(), &core.QueryBatchesRequest{
 				Pagination: pagination,
+				CountOnly:  countOnly,
 			})
 
 			return printQueryResponse(ctx, res, err)
@@ -274,6 +314,7 @@ regen q ecocredit batches --limit 10
 	}
 
 	flags.AddPaginationFlagsToCmd(cmd, "batches")
+	cmd.Flags().Bool(FlagCountOnly, false, "only query the total count of batches")
 
 	return qflags(cmd)
 }
@@ -438,6 +479,30 @@ regen q ecocredit balance C01-001-20200101-20210101-001 regen1r9pl9gvr56kmclgkpj
 	})
 }
 
+// QueryCreditTypesByHolderCmd returns a query command that retrieves the
+// credit types of all credit batches an account holds credits in.
+func QueryCreditTypesByHolderCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "credit-types-by-holder [account]",
+		Short: "Retrieve the credit types an account holds credits in",
+		Long:  "Retrieve the credit types of all credit batches a given account address holds credits in",
+		Example: `
+regen q ecocredit credit-types-by-holder regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.CreditTypesByHolder(cmd.Context(), &core.QueryCreditTypesByHolderRequest{
+				Address: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
 // QuerySupplyCmd returns a query command that retrieves the tradable and
 // retired supply of credits for a given credit batch.
 func QuerySupplyCmd() *cobra.Command {
@@ -462,16 +527,453 @@ regen q ecocredit supply C01-001-20200101-20210101-001
 	})
 }
 
+// QueryBatchSupplyCmd returns a query command that retrieves the full supply
+// breakdown of a given credit batch.
+func QueryBatchSupplyCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "batch-supply [batch_denom]",
+		Short: "Retrieve the full supply breakdown of the credit batch",
+		Long:  "Retrieve the tradable, retired, and cancelled supply of the credit batch along with the credit type precision",
+		Example: `
+regen q ecocredit batch-supply C01-001-20200101-20210101-001
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.BatchSupply(cmd.Context(), &core.QueryBatchSupplyRequest{
+				BatchDenom: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryCreditsByOriginTxCmd returns a query command that retrieves the credit
+// batch and the amount of credits issued from an originating transaction.
+func QueryCreditsByOriginTxCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "credits-by-origin-tx [source] [id]",
+		Short: "Retrieve the credits issued from an originating transaction",
+		Long:  "Retrieve the credit batch and the tradable and retired amount of credits issued from an originating transaction",
+		Example: `
+regen q ecocredit credits-by-origin-tx polygon 0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.CreditsByOriginTx(cmd.Context(), &core.QueryCreditsByOriginTxRequest{
+				Source: args[0],
+				Id:     args[1],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryBatchAnnotationsCmd returns a query command that retrieves the
+// annotations of a given credit batch.
+func QueryBatchAnnotationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-annotations [batch_denom]",
+		Short: "Retrieve the annotations of the credit batch",
+		Long:  "Retrieve the annotations of the credit batch in the order they were appended with pagination flags",
+		Example: `
+regen q ecocredit batch-annotations C01-001-20200101-20210101-001
+regen q ecocredit batch-annotations C01-001-20200101-20210101-001 --limit 10
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchAnnotations(cmd.Context(), &core.QueryBatchAnnotationsRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "batch-annotations")
+
+	return qflags(cmd)
+}
+
+// QueryBeneficiaryRetirementsCmd returns a query command that retrieves the
+// retirements attributed to a given beneficiary.
+func QueryBeneficiaryRetirementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-beneficiary [beneficiary]",
+		Short: "Retrieve the retirements attributed to a beneficiary",
+		Long: `Retrieve the retirements attributed to a beneficiary across all credit batches, regardless of which
+account retired the credits, with pagination flags. The total amount of credits retired to the beneficiary is
+also returned.`,
+		Example: `
+regen q ecocredit retirements-by-beneficiary "Acme Corp"
+regen q ecocredit retirements-by-beneficiary "Acme Corp" --limit 10
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BeneficiaryRetirements(cmd.Context(), &core.QueryBeneficiaryRetirementsRequest{
+				Beneficiary: args[0],
+				Pagination:  pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-beneficiary")
+
+	return qflags(cmd)
+}
+
+// QueryClassSupplyCmd returns a query command that retrieves the total supply
+// of credits within a credit class.
+func QueryClassSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-supply [class_id]",
+		Short: "Query the total supply of credits within a credit class",
+		Long: `Query the tradable, retired, cancelled, and total issued supply of credits summed across all credit batches
+within a credit class with pagination flags. Pagination applies to the credit batches that are summed, so the totals
+of each page must be added for a credit class with more credit batches than the page limit.`,
+		Example: `
+regen q ecocredit class-supply C01
+regen q ecocredit class-supply C01 --limit 1000
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassSupply(cmd.Context(), &core.QueryClassSupplyRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "class-supply")
+
+	return qflags(cmd)
+}
+
+// QuerySimulateSendCmd returns a query command that simulates sending credits
+// and retrieves the resulting balances and supply without committing state.
+func QuerySimulateSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-send [sender] [recipient] [credits]",
+		Short: "Simulate sending credits and query the resulting balances and supply",
+		Long: `Simulate sending credits from the sender to the recipient and query the resulting balances of the sender
+and recipient and the supply of each credit batch. No state is committed.
+
+Parameters:
+  sender: sender address
+  recipient: recipient address
+  credits: path to JSON file containing credits to send (see "regen tx ecocredit send --help")`,
+		Example: "regen q ecocredit simulate-send regen1... regen1... credits.json",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			credits, err := parseSendCredits(args[2])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			res, err := c.SimulateSend(cmd.Context(), &core.QuerySimulateSendRequest{
+				Sender:    args[0],
+				Recipient: args[1],
+				Credits:   credits,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	return qflags(cmd)
+}
+
+// QueryClassSupplyTotalsCmd returns a query command that retrieves the credit
+// classes with the total supply of credits within each credit class.
+func QueryClassSupplyTotalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-supply-totals",
+		Short: "Query the total supply of credits within each credit class",
+		Long: `Query the tradable, retired, cancelled, and total issued supply of credits summed across all credit batches
+within each credit class with pagination flags. Pagination applies to the credit batches that are summed, so a credit
+class whose credit batches span more than one page is included in each page with the totals within that page.`,
+		Example: `
+regen q ecocredit class-supply-totals
+regen q ecocredit class-supply-totals --limit 10
+		`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassSupplyTotals(cmd.Context(), &core.QueryClassSupplyTotalsRequest{
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "class-supply-totals")
+
+	return qflags(cmd)
+}
+
+// QuerySupplyReconciliationCmd returns a query command that retrieves the
+// credit batches whose recorded supply doesn't match their balances.
+func QuerySupplyReconciliationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-reconciliation",
+		Short: "Query credit batches whose supply doesn't match their balances",
+		Long: `Query credit batches whose recorded tradable or retired supply doesn't match the supply calculated from the
+credit balances of all accounts and baskets with pagination flags. Pagination applies to the credit batches that are
+reconciled, so a page may contain fewer mismatches than the limit.`,
+		Example: `
+regen q ecocredit supply-reconciliation
+regen q ecocredit supply-reconciliation --limit 100
+		`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.SupplyReconciliation(cmd.Context(), &core.QuerySupplyReconciliationRequest{
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "supply-reconciliation")
+
+	return qflags(cmd)
+}
+
+// QueryExpiringCreditsCmd returns a query command that retrieves the credit
+// batches expiring within a given time window.
+func QueryExpiringCreditsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-credits [from] [to]",
+		Short: "Query all credit batches expiring within a time window",
+		Long: `Query all credit batches expiring within a time window with pagination flags.
+
+If an address is provided, the balances the address holds in the expiring credit batches are also returned.
+
+Parameters:
+  from: the beginning of the time window (inclusive) formatted as YYYY-MM-DD
+  to:   the end of the time window (inclusive) formatted as YYYY-MM-DD`,
+		Example: `
+regen q ecocredit expiring-credits 2022-01-01 2022-12-31
+regen q ecocredit expiring-credits 2022-01-01 2022-12-31 --address regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := types.ParseDate("from", args[0])
+			if err != nil {
+				return err
+			}
+
+			to, err := types.ParseDate("to", args[1])
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ExpiringCredits(cmd.Context(), &core.QueryExpiringCreditsRequest{
+				From:       &gogotypes.Timestamp{Seconds: from.Unix()},
+				To:         &gogotypes.Timestamp{Seconds: to.Unix()},
+				Address:    address,
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "account address to include balances for")
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-credits")
+
+	return qflags(cmd)
+}
+
+// QueryBatchesByIssuanceDateCmd returns a query command that retrieves all
+// credit batches issued within a time window.
+func QueryBatchesByIssuanceDateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batches-by-issuance-date [from] [to]",
+		Short: "Query all credit batches issued within a time window",
+		Long: `Query all credit batches issued within a time window with pagination flags.
+
+Parameters:
+  from: the beginning of the time window (inclusive) formatted as YYYY-MM-DD
+  to:   the end of the time window (inclusive) formatted as YYYY-MM-DD`,
+		Example: `
+regen q ecocredit batches-by-issuance-date 2022-01-01 2022-12-31
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := types.ParseDate("from", args[0])
+			if err != nil {
+				return err
+			}
+
+			to, err := types.ParseDate("to", args[1])
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchesByIssuanceDate(cmd.Context(), &core.QueryBatchesByIssuanceDateRequest{
+				From:       &gogotypes.Timestamp{Seconds: from.Unix()},
+				To:         &gogotypes.Timestamp{Seconds: to.Unix()},
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "batches-by-issuance-date")
+
+	return qflags(cmd)
+}
+
+// QueryBufferPoolCmd returns a query command that retrieves the credits
+// allocated to the buffer pool of a credit class.
+func QueryBufferPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buffer-pool [class_id]",
+		Short: "Query the credits allocated to the buffer pool of a credit class",
+		Long: `Query the credits allocated to the buffer pool of a credit class per credit batch with pagination flags.
+
+If a batch denom is provided, only the buffer pool credits allocated from the credit batch are returned.`,
+		Example: `
+regen q ecocredit buffer-pool C01
+regen q ecocredit buffer-pool C01 --batch-denom C01-001-20200101-20210101-001
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BufferPool(cmd.Context(), &core.QueryBufferPoolRequest{
+				ClassId:    args[0],
+				BatchDenom: batchDenom,
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	cmd.Flags().String(FlagBatchDenom, "", "credit batch denom to filter the buffer pool credits by")
+	flags.AddPaginationFlagsToCmd(cmd, "buffer-pool")
+
+	return qflags(cmd)
+}
+
 // QueryCreditTypesCmd returns a query command that retrieves the list of
 // approved credit types.
 func QueryCreditTypesCmd() *cobra.Command {
-	return qflags(&cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "types",
 		Short: "Retrieve the list of credit types",
-		Long:  "Retrieve the list of credit types that contains the type name, measurement unit and precision",
+		Long:  "Retrieve the list of credit types that contains the type name, measurement unit and precision, sorted by abbreviation",
 		Example: `
 regen q ecocredit types
-regen query ecocredit types
+regen query ecocredit types --limit 10
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -479,10 +981,20 @@ regen query ecocredit types
 			if err != nil {
 				return err
 			}
-			res, err := c.CreditTypes(cmd.Context(), &core.QueryCreditTypesRequest{})
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreditTypes(cmd.Context(), &core.QueryCreditTypesRequest{
+				Pagination: pagination,
+			})
 			return printQueryResponse(ctx, res, err)
 		},
-	})
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "types")
+	return qflags(cmd)
 }
 
 // QueryParamsCmd returns ecocredit module parameters.
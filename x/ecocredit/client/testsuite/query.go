@@ -131,6 +131,7 @@ func (s *IntegrationTestSuite) TestQueryClassCmd() {
 			expectedClass: &core.ClassInfo{
 				Id:               classId,
 				Admin:            val.Address.String(),
+				Issuers:          []string{val.Address.String()},
 				Metadata:         class.Metadata,
 				CreditTypeAbbrev: class.CreditTypeAbbrev,
 			},
@@ -205,6 +206,43 @@ func (s *IntegrationTestSuite) TestQueryBatchesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryCountOnlyCmds() {
+	ctx := s.val.ClientCtx
+	ctx.OutputFormat = "JSON"
+
+	args := []string{fmt.Sprintf("--%s", coreclient.FlagCountOnly)}
+
+	s.Run("classes", func() {
+		out, err := cli.ExecTestCLICmd(ctx, coreclient.QueryClassesCmd(), args)
+		s.Require().NoError(err, out.String())
+
+		var res core.QueryClassesResponse
+		s.Require().NoError(ctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Empty(res.Classes)
+		s.Require().True(res.Pagination.Total > 0)
+	})
+
+	s.Run("projects", func() {
+		out, err := cli.ExecTestCLICmd(ctx, coreclient.QueryProjectsCmd(), args)
+		s.Require().NoError(err, out.String())
+
+		var res core.QueryProjectsResponse
+		s.Require().NoError(ctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Empty(res.Projects)
+		s.Require().True(res.Pagination.Total > 0)
+	})
+
+	s.Run("batches", func() {
+		out, err := cli.ExecTestCLICmd(ctx, coreclient.QueryBatchesCmd(), args)
+		s.Require().NoError(err, out.String())
+
+		var res core.QueryBatchesResponse
+		s.Require().NoError(ctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Empty(res.Batches)
+		s.Require().True(res.Pagination.Total > 0)
+	})
+}
+
 func (s *IntegrationTestSuite) TestQueryBatchesByIssuerCmd() {
 	ctx := s.val.ClientCtx
 	ctx.OutputFormat = "JSON"
@@ -535,6 +573,7 @@ func (s *IntegrationTestSuite) TestQueryCreditTypesCmd() {
 		args           []string
 		expectErr      bool
 		expectedErrMsg string
+		expectedAmount int
 	}{
 		{
 			name:           "valid",
@@ -542,6 +581,13 @@ func (s *IntegrationTestSuite) TestQueryCreditTypesCmd() {
 			expectErr:      false,
 			expectedErrMsg: "",
 		},
+		{
+			name:           "valid with pagination",
+			args:           []string{fmt.Sprintf("--%s=1", flags.FlagLimit)},
+			expectErr:      false,
+			expectedErrMsg: "",
+			expectedAmount: 1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -557,6 +603,9 @@ func (s *IntegrationTestSuite) TestQueryCreditTypesCmd() {
 				var res core.QueryCreditTypesResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
 				s.Require().Greater(len(res.CreditTypes), 0)
+				if tc.expectedAmount > 0 {
+					s.Require().Len(res.CreditTypes, tc.expectedAmount)
+				}
 			}
 		})
 	}
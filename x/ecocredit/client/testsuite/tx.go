@@ -195,6 +195,110 @@ func (s *IntegrationTestSuite) TestTxCreateProjectCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestTxCreateProjectsFromFileCmd() {
+	require := s.Require()
+
+	admin := s.addr1.String()
+
+	validJson := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`[
+		{"class_id": "%[1]s", "jurisdiction": "US-WA", "metadata": "metadata"},
+		{"class_id": "%[1]s", "jurisdiction": "KE", "metadata": "metadata", "reference_id": "R01"}
+	]`, s.classId)).Name()
+	invalidJson := testutil.WriteToNewTempFile(s.T(), `{foo:bar}`).Name()
+	emptyJson := testutil.WriteToNewTempFile(s.T(), `[]`).Name()
+	invalidProjectsJson := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`[
+		{"class_id": "%s", "jurisdiction": "US-WA", "metadata": "metadata"},
+		{"class_id": "foo", "jurisdiction": "US-WA", "metadata": "metadata"},
+		{"class_id": "%s", "jurisdiction": "foo", "metadata": "metadata"}
+	]`, s.classId, s.classId)).Name()
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "missing args",
+			args:      []string{},
+			expErr:    true,
+			expErrMsg: "Error: accepts 1 arg(s), received 0",
+		},
+		{
+			name:      "too many args",
+			args:      []string{"foo", "bar"},
+			expErr:    true,
+			expErrMsg: "Error: accepts 1 arg(s), received 2",
+		},
+		{
+			name:      "missing from flag",
+			args:      []string{validJson},
+			expErr:    true,
+			expErrMsg: "Error: required flag(s) \"from\" not set",
+		},
+		{
+			name: "invalid json format",
+			args: []string{
+				invalidJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
+			},
+			expErr:    true,
+			expErrMsg: "failed to parse json: invalid character",
+		},
+		{
+			name: "empty projects",
+			args: []string{
+				emptyJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
+			},
+			expErr:    true,
+			expErrMsg: "projects cannot be empty",
+		},
+		{
+			name: "invalid projects",
+			args: []string{
+				invalidProjectsJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
+			},
+			expErr:    true,
+			expErrMsg: "project 1: class ID didn't match the format",
+		},
+		{
+			name: "valid",
+			args: []string{
+				validJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
+			},
+		},
+		{
+			name: "valid with amino-json",
+			args: []string{
+				validJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
+				fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := coreclient.TxCreateProjectsFromFileCmd()
+			args := append(tc.args, s.commonTxFlags()...)
+			out, err := cli.ExecTestCLICmd(s.val.ClientCtx, cmd, args)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(out.String(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+
+				var res sdk.TxResponse
+				require.NoError(s.val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				require.Zero(res.Code, res.RawLog)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestTxCreateBatchCmd() {
 	require := s.Require()
 
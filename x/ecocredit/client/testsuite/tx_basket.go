@@ -166,6 +166,41 @@ func (s *IntegrationTestSuite) TestTxPutInBasketCmd() {
 			expErr:    true,
 			expErrMsg: "failed to parse json: duplicate key",
 		},
+		{
+			name: "retire on put without retirement jurisdiction",
+			args: []string{
+				s.basketDenom,
+				validJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, owner),
+				fmt.Sprintf("--%s", basketclient.FlagRetireOnPut),
+			},
+			expErr:    true,
+			expErrMsg: "retirement-jurisdiction is required when retire-on-put is set",
+		},
+		{
+			name: "invalid retirement jurisdiction",
+			args: []string{
+				s.basketDenom,
+				validJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, owner),
+				fmt.Sprintf("--%s", basketclient.FlagRetireOnPut),
+				fmt.Sprintf("--%s=%s", basketclient.FlagRetirementJurisdiction, "foo"),
+			},
+			expErr:    true,
+			expErrMsg: "invalid retirement-jurisdiction",
+		},
+		{
+			name: "retire on put with basket that does not retire on put",
+			args: []string{
+				s.basketDenom,
+				validJson,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, owner),
+				fmt.Sprintf("--%s", basketclient.FlagRetireOnPut),
+				fmt.Sprintf("--%s=%s", basketclient.FlagRetirementJurisdiction, "US-WA"),
+			},
+			expErr:    true,
+			expErrMsg: fmt.Sprintf("basket %s does not retire credits on put", s.basketDenom),
+		},
 		{
 			name: "valid",
 			args: []string{
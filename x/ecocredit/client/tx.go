@@ -20,15 +20,24 @@ import (
 )
 
 const (
-	FlagProjectId     string = "project-id"
-	FlagIssuances     string = "issuances"
-	FlagStartDate     string = "start-date"
-	FlagEndDate       string = "end-date"
-	FlagMetadata      string = "metadata"
-	FlagAddIssuers    string = "add-issuers"
-	FlagRemoveIssuers string = "remove-issuers"
-	FlagReferenceId   string = "reference-id"
-	FlagIssuer        string = "issuer"
+	FlagProjectId            string = "project-id"
+	FlagIssuances            string = "issuances"
+	FlagStartDate            string = "start-date"
+	FlagEndDate              string = "end-date"
+	FlagMetadata             string = "metadata"
+	FlagAddIssuers           string = "add-issuers"
+	FlagRemoveIssuers        string = "remove-issuers"
+	FlagReferenceId          string = "reference-id"
+	FlagIssuer               string = "issuer"
+	FlagAddress              string = "address"
+	FlagExpectedMetadataHash string = "expected-metadata-hash"
+	FlagBatchDenom           string = "batch-denom"
+	FlagMinBatchStartDate    string = "min-batch-start-date"
+	FlagMaxBatchEndDate      string = "max-batch-end-date"
+	FlagMetadataSchema       string = "metadata-schema"
+	FlagBeneficiary          string = "beneficiary"
+	FlagTransferNote         string = "transfer-note"
+	FlagCountOnly            string = "count-only"
 )
 
 // TxCmd returns a root CLI command handler for all x/ecocredit transaction commands.
@@ -46,17 +55,25 @@ func TxCmd(name string) *cobra.Command {
 		TxGenBatchJSONCmd(),
 		TxCreateBatchCmd(),
 		TxSendCmd(),
+		TxMultiSendCmd(),
 		TxRetireCmd(),
 		TxCancelCmd(),
 		TxUpdateClassMetadataCmd(),
 		TxUpdateClassIssuersCmd(),
+		TxAddClassIssuerCmd(),
+		TxRemoveClassIssuerCmd(),
 		TxUpdateClassAdminCmd(),
 		TxCreateProjectCmd(),
+		TxCreateProjectsFromFileCmd(),
 		TxUpdateProjectAdminCmd(),
 		TxUpdateProjectMetadataCmd(),
+		TxAnnotateBatchCmd(),
 		basketcli.TxCreateBasketCmd(),
 		basketcli.TxPutInBasketCmd(),
 		basketcli.TxTakeFromBasketCmd(),
+		basketcli.TxUpdateBasketAllowedClassesCmd(),
+		basketcli.TxCloseBasketCmd(),
+		basketcli.TxUpdateBasketMinTakeCmd(),
 		marketplacecli.TxSellCmd(),
 		marketplacecli.TxUpdateSellOrdersCmd(),
 		marketplacecli.TxBuyDirectCmd(),
@@ -84,12 +101,18 @@ Parameters:
   issuer:    	               comma separated (no spaces) list of issuer account addresses. Example: "addr1,addr2"
   credit type abbreviation:    the abbreviation of a credit type (e.g. "C", "BIO")
   metadata:  	               arbitrary data attached to the credit class info
-  fee:                         fee to pay for the creation of the credit class (e.g. 10uatom, 10uregen)`,
+  fee:                         fee to pay for the creation of the credit class (e.g. 10uatom, 10uregen)
+
+Flags:
+  min-batch-start-date:        optional earliest start date allowed for credit batches (format: yyyy-mm-dd)
+  max-batch-end-date:          optional latest end date allowed for credit batches (format: yyyy-mm-dd)
+  metadata-schema:             optional URI of the schema that project and credit batch metadata must reference`,
 			core.KeyAllowedClassCreators,
 			core.KeyCreditClassFee,
 		),
 		Example: `
 regen tx ecocredit create-class regen1el...xmgqelsw,regen2tl...xsgqdlhy C "metadata" 10uregen
+regen tx ecocredit create-class regen1el...xmgqelsw C "metadata" 10uregen --min-batch-start-date 2020-01-01 --max-batch-end-date 2030-01-01
 		`,
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,10 +144,43 @@ regen tx ecocredit create-class regen1el...xmgqelsw,regen2tl...xsgqdlhy C "metad
 				Fee:              &fee,
 			}
 
+			minBatchStartDate, err := cmd.Flags().GetString(FlagMinBatchStartDate)
+			if err != nil {
+				return err
+			}
+			if minBatchStartDate != "" {
+				date, err := types.ParseDate("min batch start date", minBatchStartDate)
+				if err != nil {
+					return err
+				}
+				msg.MinBatchStartDate = &date
+			}
+
+			maxBatchEndDate, err := cmd.Flags().GetString(FlagMaxBatchEndDate)
+			if err != nil {
+				return err
+			}
+			if maxBatchEndDate != "" {
+				date, err := types.ParseDate("max batch end date", maxBatchEndDate)
+				if err != nil {
+					return err
+				}
+				msg.MaxBatchEndDate = &date
+			}
+
+			msg.MetadataSchema, err = cmd.Flags().GetString(FlagMetadataSchema)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMinBatchStartDate, "", "earliest start date allowed for credit batches (format: yyyy-mm-dd)")
+	cmd.Flags().String(FlagMaxBatchEndDate, "", "latest end date allowed for credit batches (format: yyyy-mm-dd)")
+	cmd.Flags().String(FlagMetadataSchema, "", "URI of the schema that project and credit batch metadata must reference")
+
 	return txFlags(cmd)
 }
 
@@ -316,11 +372,14 @@ Example JSON:
     "batch_denom": "C01-001-20210101-20210201-002",
     "tradable_amount": "50",
     "retired_amount": "100",
-    "retirement_jurisdiction": "YY-ZZ 12345"
+    "retirement_jurisdiction": "US-OR 12345"
   }
 ]
 
 Note: "retirement_jurisdiction" is only required when "retired_amount" is positive.
+
+Flags:
+  transfer-note: optional free-text note attached to the transfer (up to 512 characters).
 		`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -335,16 +394,97 @@ Note: "retirement_jurisdiction" is only required when "retired_amount" is positi
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			note, err := cmd.Flags().GetString(FlagTransferNote)
+			if err != nil {
+				return err
+			}
+
 			msg := core.MsgSend{
 				Sender:    clientCtx.GetFromAddress().String(),
 				Recipient: args[0],
 				Credits:   credits,
+				Note:      note,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagTransferNote, "", "an optional note attached to the transfer")
+
+	return txFlags(cmd)
+}
+
+// TxMultiSendCmd returns a transaction command that sends credits to multiple
+// recipients.
+func TxMultiSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [transfers]",
+		Short: "Sends credits from the transaction author (--from) to multiple recipients",
+		Long: `Sends credits from the transaction author (--from) to multiple recipients. All transfers are
+executed atomically: if any transfer fails, none of the transfers are applied.
+
+Parameters:
+  transfers: path to JSON file containing the recipients and credits to send
+
+Example JSON:
+[
+  {
+    "recipient": "regen1...",
+    "credits": [
+      {
+        "batch_denom": "C01-001-20210101-20210201-001",
+        "tradable_amount": "500"
+      }
+    ]
+  },
+  {
+    "recipient": "regen1...",
+    "credits": [
+      {
+        "batch_denom": "C01-001-20210101-20210201-001",
+        "tradable_amount": "50",
+        "retired_amount": "100",
+        "retirement_jurisdiction": "US-OR 12345"
+      }
+    ]
+  }
+]
+
+Note: "retirement_jurisdiction" is only required when "retired_amount" is positive.
+
+Flags:
+  transfer-note: optional free-text note attached to the transfers (up to 512 characters).
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			transfers, err := parseMultiSendTransfers(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			note, err := cmd.Flags().GetString(FlagTransferNote)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgMultiSend{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Transfers: transfers,
+				Note:      note,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTransferNote, "", "an optional note attached to the transfers")
+
 	return txFlags(cmd)
 }
 
@@ -364,6 +504,9 @@ Parameters:
                        postal-code being up to 64 alphanumeric characters.
                        eg: 'AA-BB 12345'
 
+Flags:
+  beneficiary: optional name of the entity the retirement is attributed to.
+
 Example JSON:
 [
   {
@@ -389,16 +532,24 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			beneficiary, err := cmd.Flags().GetString(FlagBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := core.MsgRetire{
 				Owner:        clientCtx.GetFromAddress().String(),
 				Credits:      credits,
 				Jurisdiction: args[1],
+				Beneficiary:  beneficiary,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagBeneficiary, "", "the name of the entity the retirement is attributed to")
+
 	return txFlags(cmd)
 }
 
@@ -577,6 +728,75 @@ regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2 --remove-i
 	return txFlags(cmd)
 }
 
+// TxAddClassIssuerCmd returns a transaction command that adds an issuer to a
+// credit class.
+func TxAddClassIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-class-issuer [class-id] [issuer]",
+		Short: "Adds an issuer to a specific credit class",
+		Long: `Adds an issuer to a specific credit class. the '--from' flag must equal the current credit class admin.
+
+Parameters:
+  class-id:  the class id that corresponds with the credit class you want to update
+  issuer:    the address of the issuer to add to the class issuers list`,
+		Example: `
+regen tx ecocredit add-class-issuer C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgAddClassIssuer{
+				Admin:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Issuer:  args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxRemoveClassIssuerCmd returns a transaction command that removes an issuer
+// from a credit class.
+func TxRemoveClassIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-class-issuer [class-id] [issuer]",
+		Short: "Removes an issuer from a specific credit class",
+		Long: `Removes an issuer from a specific credit class. the '--from' flag must equal the current credit class admin.
+The last issuer of a credit class cannot be removed.
+
+Parameters:
+  class-id:  the class id that corresponds with the credit class you want to update
+  issuer:    the address of the issuer to remove from the class issuers list`,
+		Example: `
+regen tx ecocredit remove-class-issuer C01 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgRemoveClassIssuer{
+				Admin:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Issuer:  args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
 // TxCreateProjectCmd returns a transaction command that creates a new project.
 func TxCreateProjectCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -625,6 +845,76 @@ regen tx ecocredit create-project C01 "AA-BB 12345" metadata --reference-id R01
 	return txFlags(cmd)
 }
 
+// TxCreateProjectsFromFileCmd returns a transaction command that creates multiple projects from a JSON file in a
+// single transaction.
+func TxCreateProjectsFromFileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-projects-from-file [projects]",
+		Short: "Create multiple projects from a JSON file",
+		Long: `Create multiple projects from a JSON file in a single transaction. Each project is created with a
+separate MsgCreateProject and the transaction author (--from) is the admin of every project. All projects are
+created atomically: if any project fails, none of the projects are created.
+
+Every entry is validated before the transaction is built and all invalid entries are reported.
+
+Parameters:
+  projects: path to JSON file containing the projects to create
+
+Example JSON:
+[
+  {
+    "class_id": "C01",
+    "jurisdiction": "US-WA",
+    "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+    "reference_id": "VCS-001"
+  },
+  {
+    "class_id": "C01",
+    "jurisdiction": "KE",
+    "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+  }
+]
+
+Note: "reference_id" is optional.
+		`,
+		Example: "regen tx ecocredit create-projects-from-file projects.json --from regen1...",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			projects, err := parseProjects(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			if len(projects) == 0 {
+				return sdkerrors.ErrInvalidRequest.Wrap("projects cannot be empty")
+			}
+
+			var failed []string
+			msgs := make([]sdk.Msg, len(projects))
+			for i, project := range projects {
+				project.Admin = clientCtx.GetFromAddress().String()
+				if err := project.ValidateBasic(); err != nil {
+					failed = append(failed, fmt.Sprintf("project %d: %s", i, err))
+				}
+				msgs[i] = project
+			}
+
+			if len(failed) != 0 {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid projects:\n%s", strings.Join(failed, "\n"))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
 func TxUpdateProjectAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-project-admin [project_id] [new_admin_address] [flags]",
@@ -654,9 +944,12 @@ func TxUpdateProjectAdminCmd() *cobra.Command {
 
 func TxUpdateProjectMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-project-metadata [project-id] [new_metadata]",
-		Short:   "Update the project metadata",
-		Long:    "Update the project metadata, overwriting the project's current metadata.",
+		Use:   "update-project-metadata [project-id] [new_metadata]",
+		Short: "Update the project metadata",
+		Long: `Update the project metadata, overwriting the project's current metadata.
+
+If --expected-metadata-hash is set, the update is rejected when the hex-encoded SHA-256 hash of the
+project's current metadata does not match the expected hash.`,
 		Example: `regen tx ecocredit update-project-metadata VERRA1 "some metadata"`,
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -665,10 +958,45 @@ func TxUpdateProjectMetadataCmd() *cobra.Command {
 				return err
 			}
 
+			expectedMetadataHash, err := cmd.Flags().GetString(FlagExpectedMetadataHash)
+			if err != nil {
+				return err
+			}
+
 			msg := core.MsgUpdateProjectMetadata{
-				Admin:       clientCtx.GetFromAddress().String(),
-				NewMetadata: args[1],
-				ProjectId:   args[0],
+				Admin:                clientCtx.GetFromAddress().String(),
+				NewMetadata:          args[1],
+				ProjectId:            args[0],
+				ExpectedMetadataHash: expectedMetadataHash,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpectedMetadataHash, "", "hex-encoded SHA-256 hash of the current project metadata")
+
+	return txFlags(cmd)
+}
+
+func TxAnnotateBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annotate-batch [batch_denom] [annotation]",
+		Short: "Append an annotation to a credit batch",
+		Long: `Append a timestamped annotation to a credit batch. Only the credit batch issuer can annotate the
+credit batch. Annotations do not overwrite the batch metadata or any previous annotations.`,
+		Example: `regen tx ecocredit annotate-batch C01-001-20200101-20210101-001 "verification report updated"`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgAnnotateBatch{
+				Issuer:     clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Annotation: args[1],
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCmd(t *testing.T) {
+	var cmd *cobra.Command
+	require.NotPanics(t, func() {
+		cmd = TxCmd("ecocredit")
+	})
+
+	send, _, err := cmd.Find([]string{"send"})
+	require.NoError(t, err)
+	require.NotNil(t, send.Flags().Lookup(FlagTransferNote))
+
+	multiSend, _, err := cmd.Find([]string{"multi-send"})
+	require.NoError(t, err)
+	require.NotNil(t, multiSend.Flags().Lookup(FlagTransferNote))
+}
@@ -101,3 +101,45 @@ func parseSendCredits(jsonFile string) ([]*core.MsgSend_SendCredits, error) {
 
 	return sendCredits, nil
 }
+
+func parseMultiSendTransfers(jsonFile string) ([]*core.MsgMultiSend_Transfer, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var transfers []*core.MsgMultiSend_Transfer
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func parseProjects(jsonFile string) ([]*core.MsgCreateProject, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var projects []*core.MsgCreateProject
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &projects)
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
@@ -250,3 +250,85 @@ func TestParseCredits(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProjects(t *testing.T) {
+	emptyJson := testutil.WriteToNewTempFile(t, `{}`).Name()
+	invalidJson := testutil.WriteToNewTempFile(t, `{foo:bar}`).Name()
+	duplicateJson := testutil.WriteToNewTempFile(t, `{"foo":"bar","foo":"baz"}`).Name()
+	validJson := testutil.WriteToNewTempFile(t, `[
+		{
+			"class_id": "C01",
+			"jurisdiction": "US-WA",
+			"metadata": "metadata",
+			"reference_id": "R01"
+		},
+		{
+			"class_id": "C02",
+			"jurisdiction": "KE",
+			"metadata": "metadata"
+		}
+	]`).Name()
+
+	testCases := []struct {
+		name      string
+		file      string
+		expErr    bool
+		expErrMsg string
+		expRes    []*core.MsgCreateProject
+	}{
+		{
+			name:      "empty file path",
+			file:      "",
+			expErr:    true,
+			expErrMsg: "no such file or directory",
+		},
+		{
+			name:      "empty json object",
+			file:      emptyJson,
+			expErr:    true,
+			expErrMsg: "cannot unmarshal object",
+		},
+		{
+			name:      "invalid file format",
+			file:      invalidJson,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys",
+			file:      duplicateJson,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name: "valid test",
+			file: validJson,
+			expRes: []*core.MsgCreateProject{
+				{
+					ClassId:      "C01",
+					Jurisdiction: "US-WA",
+					Metadata:     "metadata",
+					ReferenceId:  "R01",
+				},
+				{
+					ClassId:      "C02",
+					Jurisdiction: "KE",
+					Metadata:     "metadata",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseProjects(tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
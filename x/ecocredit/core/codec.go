@@ -19,15 +19,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateBatch{}, "regen.core/MsgCreateBatch", nil)
 	cdc.RegisterConcrete(&MsgMintBatchCredits{}, "regen.core/MsgMintBatchCredits", nil)
 	cdc.RegisterConcrete(&MsgSealBatch{}, "regen.core/MsgSealBatch", nil)
+	cdc.RegisterConcrete(&MsgAnnotateBatch{}, "regen.core/MsgAnnotateBatch", nil)
 	cdc.RegisterConcrete(&MsgRetire{}, "regen.core/MsgRetire", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "regen.core/MsgSend", nil)
+	cdc.RegisterConcrete(&MsgMultiSend{}, "regen.core/MsgMultiSend", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassAdmin{}, "regen.core/MsgUpdateClassAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassMetadata{}, "regen.core/MsgUpdateClassMetadata", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassIssuers{}, "regen.core/MsgUpdateClassIssuers", nil)
+	cdc.RegisterConcrete(&MsgAddClassIssuer{}, "regen.core/MsgAddClassIssuer", nil)
+	cdc.RegisterConcrete(&MsgRemoveClassIssuer{}, "regen.core/MsgRemoveClassIssuer", nil)
 	cdc.RegisterConcrete(&MsgUpdateProjectAdmin{}, "regen.core/MsgUpdateProjectAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateProjectMetadata{}, "regen.core/MsgUpdateProjectMetadata", nil)
 	cdc.RegisterConcrete(&CreditTypeProposal{}, "regen.core/CreditTypeProposal", nil)
 	cdc.RegisterConcrete(&MsgBridgeReceive{}, "regen.core/MsgBridgeReceive", nil)
+	cdc.RegisterConcrete(&MsgSetClassFee{}, "regen.core/MsgSetClassFee", nil)
+	cdc.RegisterConcrete(&MsgUpdateCreditTypePrecision{}, "regen.core/MsgUpdateCreditTypePrecision", nil)
+	cdc.RegisterConcrete(&MsgDeleteClass{}, "regen.core/MsgDeleteClass", nil)
+	cdc.RegisterConcrete(&MsgAddCreditType{}, "regen.core/MsgAddCreditType", nil)
 }
 
 var (
@@ -26,7 +26,9 @@ import (
 // ValidateGenesis performs basic validation for the following:
 // - params are valid param types with valid properties
 // - proto messages are valid proto messages
+// - the denom of each credit batch is unique
 // - the credit type referenced in each credit class exists
+// - the credit class referenced in each class issuer exists
 // - the credit class referenced in each project exists
 // - the tradable amount of each credit batch complies with the credit type precision
 // - the retired amount of each credit batch complies with the credit type precision
@@ -43,8 +45,17 @@ func ValidateGenesis(data json.RawMessage, params Params) error {
 		IndexStore:      db,
 	})
 
+	batchDenomToKey := make(map[string]uint64) // map of batch denom to batch key
 	ormdb, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{
 		JSONValidator: func(m proto.Message) error {
+			// the unique denom index of the batch table would also reject a
+			// duplicate on import but without naming the denom or batches
+			if batch, ok := m.(*api.Batch); ok {
+				if key, exists := batchDenomToKey[batch.Denom]; exists {
+					return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit batch denom %s for batch keys %d and %d", batch.Denom, key, batch.Key)
+				}
+				batchDenomToKey[batch.Denom] = batch.Key
+			}
 			return validateMsg(m)
 		},
 	})
@@ -63,12 +74,12 @@ func ValidateGenesis(data json.RawMessage, params Params) error {
 		return err
 	}
 
-	err = ormdb.ImportJSON(ormCtx, jsonSource)
-	if err != nil {
+	if err := ormdb.ValidateJSON(jsonSource); err != nil {
 		return err
 	}
 
-	if err := ormdb.ValidateJSON(jsonSource); err != nil {
+	err = ormdb.ImportJSON(ormCtx, jsonSource)
+	if err != nil {
 		return err
 	}
 
@@ -92,6 +103,7 @@ func ValidateGenesis(data json.RawMessage, params Params) error {
 	}
 	defer cItr.Close()
 
+	classKeys := make(map[uint64]bool) // set of class keys
 	// make sure credit type exist for class abbreviation in params
 	for cItr.Next() {
 		class, err := cItr.Value()
@@ -102,6 +114,24 @@ func ValidateGenesis(data json.RawMessage, params Params) error {
 		if _, ok := abbrevToPrecision[class.CreditTypeAbbrev]; !ok {
 			return sdkerrors.ErrNotFound.Wrapf("credit type not exist for %s abbreviation", class.CreditTypeAbbrev)
 		}
+		classKeys[class.Key] = true
+	}
+
+	ciItr, err := ss.ClassIssuerTable().List(ormCtx, api.ClassIssuerPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer ciItr.Close()
+
+	for ciItr.Next() {
+		classIssuer, err := ciItr.Value()
+		if err != nil {
+			return err
+		}
+
+		if !classKeys[classIssuer.ClassKey] {
+			return sdkerrors.ErrNotFound.Wrapf("credit class with key %d not exist for class issuer", classIssuer.ClassKey)
+		}
 	}
 
 	projectKeyToClassKey := make(map[uint64]uint64) // map of project key to class key
@@ -117,6 +147,10 @@ func ValidateGenesis(data json.RawMessage, params Params) error {
 			return err
 		}
 
+		if !classKeys[project.ClassKey] {
+			return sdkerrors.ErrNotFound.Wrapf("credit class with key %d not exist for project %s", project.ClassKey, project.Id)
+		}
+
 		if _, exists := projectKeyToClassKey[project.Key]; exists {
 			continue
 		}
@@ -395,6 +429,10 @@ func (c Class) Validate() error {
 		return ecocredit.ErrMaxLimit.Wrap("credit class metadata")
 	}
 
+	if len(c.MetadataSchema) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrap("credit class metadata schema")
+	}
+
 	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(c.Admin).String()); err != nil {
 		return sdkerrors.Wrap(err, "admin")
 	}
@@ -411,6 +449,10 @@ func (c Class) Validate() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("must specify a credit type abbreviation")
 	}
 
+	if c.MinBatchStartDate != nil && c.MaxBatchEndDate != nil && c.MaxBatchEndDate.Compare(*c.MinBatchStartDate) != 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("the max batch end date (%s) must be after the min batch start date (%s)", c.MaxBatchEndDate.String(), c.MinBatchStartDate.String())
+	}
+
 	return nil
 }
 
@@ -437,7 +479,9 @@ func (p Project) Validate() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("class key cannot be zero")
 	}
 
-	if err := ValidateJurisdiction(p.Jurisdiction); err != nil {
+	// only the format is checked because projects created before country codes
+	// were validated may have jurisdictions with unknown country codes
+	if err := ValidateJurisdictionFormat(p.Jurisdiction); err != nil {
 		return err
 	}
 
@@ -471,6 +515,9 @@ func (b Batch) Validate() error {
 	if b.EndDate.Compare(*b.StartDate) != 1 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("the batch end date (%s) must be the same as or after the batch start date (%s)", b.EndDate.String(), b.StartDate.String())
 	}
+	if b.ExpiryDate != nil && b.ExpiryDate.Compare(*b.EndDate) != 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("the batch expiry date (%s) must be after the batch end date (%s)", b.ExpiryDate.String(), b.EndDate.String())
+	}
 
 	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(b.Issuer).String()); err != nil {
 		return sdkerrors.Wrap(err, "issuer")
@@ -3,6 +3,7 @@ package core_test
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
@@ -96,10 +97,11 @@ func TestValidateGenesis(t *testing.T) {
 			Metadata:     "meta",
 		},
 		{
+			// jurisdictions in state are only checked for format
 			Id:           "P02-001",
 			Admin:        sdk.AccAddress("addr7"),
 			ClassKey:     2,
-			Jurisdiction: "AQ",
+			Jurisdiction: "AB-CDE FG1 345",
 			Metadata:     "meta",
 		},
 	}
@@ -112,7 +114,7 @@ func TestValidateGenesis(t *testing.T) {
 	genesisJson, err := target.JSON()
 	require.NoError(t, err)
 
-	params := core.Params{AllowlistEnabled: true}
+	params := core.Params{AllowlistEnabled: true, GasCostPerIteration: core.DefaultGasCostPerIteration, MaxCreditsPerMsg: core.DefaultMaxCreditsPerMsg}
 	err = core.ValidateGenesis(genesisJson, params)
 	require.NoError(t, err)
 }
@@ -180,14 +182,14 @@ func TestGenesisValidate(t *testing.T) {
 					Abbreviation: "C",
 					Name:         "carbon",
 					Unit:         "kg",
-					Precision:    7,
+					Precision:    19,
 				}))
 			},
 			func() core.Params {
 				return defaultParams
 			}(),
 			true,
-			"credit type precision is currently locked to 6",
+			"credit type precision must be between 6 and 18",
 		},
 		{
 			"invalid: bad addresses in allowlist",
@@ -215,6 +217,60 @@ func TestGenesisValidate(t *testing.T) {
 			true,
 			"credit type not exist",
 		},
+		{
+			"invalid: class issuer with unknown class",
+			func(ctx context.Context, ss api.StateStore) {
+				require.NoError(t, ss.CreditTypeTable().Insert(ctx, &api.CreditType{
+					Abbreviation: "C",
+					Name:         "carbon",
+					Unit:         "metric ton C02 equivalent",
+					Precision:    6,
+				}))
+				key, err := ss.ClassTable().InsertReturningID(ctx, &api.Class{
+					Id:               "C01",
+					Admin:            addr1,
+					CreditTypeAbbrev: "C",
+				})
+				require.NoError(t, err)
+				require.NoError(t, ss.ClassIssuerTable().Insert(ctx, &api.ClassIssuer{
+					ClassKey: key,
+					Issuer:   addr1,
+				}))
+				require.NoError(t, ss.ClassIssuerTable().Insert(ctx, &api.ClassIssuer{
+					ClassKey: key + 1,
+					Issuer:   addr2,
+				}))
+			},
+			defaultParams,
+			true,
+			"credit class with key 2 not exist for class issuer",
+		},
+		{
+			"invalid: project with unknown class",
+			func(ctx context.Context, ss api.StateStore) {
+				require.NoError(t, ss.CreditTypeTable().Insert(ctx, &api.CreditType{
+					Abbreviation: "C",
+					Name:         "carbon",
+					Unit:         "metric ton C02 equivalent",
+					Precision:    6,
+				}))
+				key, err := ss.ClassTable().InsertReturningID(ctx, &api.Class{
+					Id:               "C01",
+					Admin:            addr1,
+					CreditTypeAbbrev: "C",
+				})
+				require.NoError(t, err)
+				require.NoError(t, ss.ProjectTable().Insert(ctx, &api.Project{
+					Id:           "C02-001",
+					Admin:        addr1,
+					ClassKey:     key + 1,
+					Jurisdiction: "AQ",
+				}))
+			},
+			defaultParams,
+			true,
+			"credit class with key 2 not exist for project C02-001",
+		},
 		{
 			"expect error: balances are missing",
 			func(ctx context.Context, ss api.StateStore) {
@@ -605,7 +661,7 @@ func TestValidateGenesisWithBasketBalance(t *testing.T) {
 	genesisJson, err := target.JSON()
 	require.NoError(t, err)
 
-	params := core.Params{AllowlistEnabled: true}
+	params := core.Params{AllowlistEnabled: true, GasCostPerIteration: core.DefaultGasCostPerIteration, MaxCreditsPerMsg: core.DefaultMaxCreditsPerMsg}
 	err = core.ValidateGenesis(genesisJson, params)
 	require.NoError(t, err)
 }
@@ -624,3 +680,59 @@ func setupStateAndExportJSON(t *testing.T, setupFunc func(ctx context.Context, s
 	require.NoError(t, err)
 	return jsn
 }
+
+func TestValidateGenesisDuplicateBatchDenom(t *testing.T) {
+	t.Parallel()
+
+	addr1 := sdk.AccAddress("foobar")
+	denom1 := "C01-001-20200101-20210101-001"
+	denom2 := "C01-001-20200101-20210101-002"
+	jsn := setupStateAndExportJSON(t, func(ctx context.Context, ss api.StateStore) {
+		require.NoError(t, ss.CreditTypeTable().Insert(ctx, &api.CreditType{
+			Abbreviation: "C",
+			Name:         "carbon",
+			Unit:         "metric ton C02 equivalent",
+			Precision:    6,
+		}))
+		cKey, err := ss.ClassTable().InsertReturningID(ctx, &api.Class{
+			Id:               "C01",
+			Admin:            addr1,
+			CreditTypeAbbrev: "C",
+		})
+		require.NoError(t, err)
+		pKey, err := ss.ProjectTable().InsertReturningID(ctx, &api.Project{
+			Id:           "C01-001",
+			Admin:        addr1,
+			ClassKey:     cKey,
+			Jurisdiction: "AQ",
+		})
+		require.NoError(t, err)
+		for _, denom := range []string{denom1, denom2} {
+			bKey, err := ss.BatchTable().InsertReturningID(ctx, &api.Batch{
+				Issuer:       addr1,
+				ProjectKey:   pKey,
+				Denom:        denom,
+				StartDate:    &timestamppb.Timestamp{Seconds: 100},
+				EndDate:      &timestamppb.Timestamp{Seconds: 101},
+				IssuanceDate: &timestamppb.Timestamp{Seconds: 400},
+			})
+			require.NoError(t, err)
+			require.NoError(t, ss.BatchBalanceTable().Insert(ctx, &api.BatchBalance{
+				BatchKey:       bKey,
+				Address:        addr1,
+				TradableAmount: "10",
+			}))
+			require.NoError(t, ss.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
+				BatchKey:       bKey,
+				TradableAmount: "10",
+			}))
+		}
+	})
+	require.NoError(t, core.ValidateGenesis(jsn, core.DefaultParams()))
+
+	// the batch table can't hold duplicate denoms, so the genesis is edited directly
+	jsn = json.RawMessage(strings.ReplaceAll(string(jsn), denom2, denom1))
+	err := core.ValidateGenesis(jsn, core.DefaultParams())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicate credit batch denom "+denom1+" for batch keys 1 and 2")
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgAddCreditType(t *testing.T) {
+	t.Parallel()
+
+	authority := testutil.GenAddress()
+	creditType := &CreditType{Abbreviation: "BIO", Name: "biodiversity", Unit: "acres", Precision: 6}
+
+	tests := map[string]struct {
+		src    MsgAddCreditType
+		expErr string
+	}{
+		"valid": {
+			src: MsgAddCreditType{Authority: authority, CreditType: creditType},
+		},
+		"invalid authority": {
+			src:    MsgAddCreditType{Authority: "foo", CreditType: creditType},
+			expErr: "malformed authority address",
+		},
+		"nil credit type": {
+			src:    MsgAddCreditType{Authority: authority},
+			expErr: "credit type cannot be nil",
+		},
+		"invalid abbreviation": {
+			src: MsgAddCreditType{Authority: authority, CreditType: &CreditType{
+				Abbreviation: "bio", Name: "biodiversity", Unit: "acres", Precision: 6,
+			}},
+			expErr: "credit type abbreviation must be 1-3 uppercase latin letters",
+		},
+		"empty name": {
+			src: MsgAddCreditType{Authority: authority, CreditType: &CreditType{
+				Abbreviation: "BIO", Unit: "acres", Precision: 6,
+			}},
+			expErr: "name cannot be empty",
+		},
+		"empty unit": {
+			src: MsgAddCreditType{Authority: authority, CreditType: &CreditType{
+				Abbreviation: "BIO", Name: "biodiversity", Precision: 6,
+			}},
+			expErr: "unit cannot be empty",
+		},
+		"invalid precision": {
+			src: MsgAddCreditType{Authority: authority, CreditType: &CreditType{
+				Abbreviation: "BIO", Name: "biodiversity", Unit: "acres", Precision: 19,
+			}},
+			expErr: "credit type precision must be between 6 and 18",
+		},
+	}
+
+	for msg, test := range tests {
+		test := test
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgAnnotateBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAnnotateBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAnnotateBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAnnotateBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAnnotateBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrap(err, "malformed issuer address")
+	}
+
+	if err := ValidateBatchDenom(m.BatchDenom); err != nil {
+		return err
+	}
+
+	if len(m.Annotation) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("annotation cannot be empty")
+	}
+
+	if len(m.Annotation) > MaxNoteLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("annotation must be at most %d characters long", MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAnnotateBatch.
+func (m *MsgAnnotateBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgAnnotateBatch(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	issuer := testutil.GenAddress()
+
+	msg := MsgAnnotateBatch{}
+	require.Error(msg.ValidateBasic(), "empty issuer")
+
+	msg = MsgAnnotateBatch{Issuer: "abc", BatchDenom: batchDenom, Annotation: "note"}
+	require.Error(msg.ValidateBasic(), "invalid issuer")
+
+	msg = MsgAnnotateBatch{Issuer: issuer, BatchDenom: "ABC", Annotation: "note"}
+	require.Error(msg.ValidateBasic(), "invalid denom")
+
+	msg = MsgAnnotateBatch{Issuer: issuer, BatchDenom: batchDenom}
+	require.ErrorContains(msg.ValidateBasic(), "annotation cannot be empty")
+
+	msg = MsgAnnotateBatch{Issuer: issuer, BatchDenom: batchDenom, Annotation: strings.Repeat("x", MaxNoteLength+1)}
+	require.ErrorContains(msg.ValidateBasic(), "annotation must be at most 512 characters long")
+
+	msg = MsgAnnotateBatch{Issuer: issuer, BatchDenom: batchDenom, Annotation: strings.Repeat("x", MaxNoteLength)}
+	require.NoError(msg.ValidateBasic(), "valid annotation")
+}
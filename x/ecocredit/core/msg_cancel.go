@@ -1,6 +1,8 @@
 package core
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -42,10 +44,15 @@ func (m *MsgCancel) ValidateBasic() error {
 		}
 	}
 
-	if len(m.Reason) == 0 {
+	reason := strings.TrimSpace(m.Reason)
+	if len(reason) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("reason is required")
 	}
 
+	if len(reason) > MaxCancelReasonLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reason must be at most %d characters long", MaxCancelReasonLength)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -105,6 +106,45 @@ func TestMsgCancel(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid msg reason with only whitespace": {
+			src: MsgCancel{
+				Owner: addr1,
+				Credits: []*Credits{
+					{
+						BatchDenom: batchDenom,
+						Amount:     "1",
+					},
+				},
+				Reason: "  ",
+			},
+			expErr: true,
+		},
+		"valid msg reason with max length": {
+			src: MsgCancel{
+				Owner: addr1,
+				Credits: []*Credits{
+					{
+						BatchDenom: batchDenom,
+						Amount:     "1",
+					},
+				},
+				Reason: " " + strings.Repeat("x", MaxCancelReasonLength) + " ",
+			},
+			expErr: false,
+		},
+		"invalid msg reason exceeds max length": {
+			src: MsgCancel{
+				Owner: addr1,
+				Credits: []*Credits{
+					{
+						BatchDenom: batchDenom,
+						Amount:     "1",
+					},
+				},
+				Reason: strings.Repeat("x", MaxCancelReasonLength+1),
+			},
+			expErr: true,
+		},
 	}
 
 	for msg, test := range tests {
@@ -42,6 +42,11 @@ func (m *MsgCreateBatch) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("the batch end date (%s) must be the same as or after the batch start date (%s)", m.EndDate.Format("2006-01-02"), m.StartDate.Format("2006-01-02"))
 	}
 
+	// expiry date is not required when creating a credit batch
+	if m.ExpiryDate != nil && !m.ExpiryDate.After(*m.EndDate) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("the batch expiry date (%s) must be after the batch end date (%s)", m.ExpiryDate.Format("2006-01-02"), m.EndDate.Format("2006-01-02"))
+	}
+
 	if err := ValidateProjectId(m.ProjectId); err != nil {
 		return err
 	}
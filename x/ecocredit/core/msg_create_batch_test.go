@@ -17,6 +17,7 @@ func TestMsgCreateBatch(t *testing.T) {
 
 	startDate := time.Unix(10000, 10000).UTC()
 	endDate := time.Unix(10000, 10050).UTC()
+	expiryDate := time.Unix(20000, 0).UTC()
 
 	tests := map[string]struct {
 		src    MsgCreateBatch
@@ -226,6 +227,36 @@ func TestMsgCreateBatch(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"valid msg with expiry date": {
+			src: MsgCreateBatch{
+				Issuer:     issuer,
+				ProjectId:  "C01-001",
+				StartDate:  &startDate,
+				EndDate:    &endDate,
+				ExpiryDate: &expiryDate,
+			},
+			expErr: false,
+		},
+		"invalid msg with expiry date = enddate": {
+			src: MsgCreateBatch{
+				Issuer:     issuer,
+				ProjectId:  "C01-001",
+				StartDate:  &startDate,
+				EndDate:    &endDate,
+				ExpiryDate: &endDate,
+			},
+			expErr: true,
+		},
+		"invalid msg with expiry date < enddate": {
+			src: MsgCreateBatch{
+				Issuer:     issuer,
+				ProjectId:  "C01-001",
+				StartDate:  &startDate,
+				EndDate:    &endDate,
+				ExpiryDate: &startDate,
+			},
+			expErr: true,
+		},
 		"invalid metadata maxlength is exceeded": {
 			src: MsgCreateBatch{
 				Issuer:    issuer,
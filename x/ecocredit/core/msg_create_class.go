@@ -28,6 +28,10 @@ func (m *MsgCreateClass) ValidateBasic() error {
 		return ecocredit.ErrMaxLimit.Wrap("credit class metadata")
 	}
 
+	if len(m.MetadataSchema) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrap("credit class metadata schema")
+	}
+
 	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
 		return sdkerrors.Wrap(err, "admin")
 	}
@@ -64,6 +68,10 @@ func (m *MsgCreateClass) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("fee must be specified")
 	}
 
+	if m.MinBatchStartDate != nil && m.MaxBatchEndDate != nil && !m.MaxBatchEndDate.After(*m.MinBatchStartDate) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("max batch end date (%s) must be after min batch start date (%s)", m.MaxBatchEndDate, m.MinBatchStartDate)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/regen-network/regen-ledger/types/testutil"
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,9 @@ func TestMsgCreateClass(t *testing.T) {
 		Amount: sdk.NewInt(10),
 	}
 
+	minBatchStartDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxBatchEndDate := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := map[string]struct {
 		src    MsgCreateClass
 		expErr bool
@@ -93,6 +97,16 @@ func TestMsgCreateClass(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid metadata schema maxlength is exceeded": {
+			src: MsgCreateClass{
+				Admin:            addr1,
+				CreditTypeAbbrev: "C",
+				Issuers:          []string{addr1, addr2},
+				Fee:              validFee,
+				MetadataSchema:   strings.Repeat("x", 288),
+			},
+			expErr: true,
+		},
 		"invalid bad fee denom": {
 			src: MsgCreateClass{
 				Admin:            addr1,
@@ -113,6 +127,28 @@ func TestMsgCreateClass(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"valid msg with batch period": {
+			src: MsgCreateClass{
+				Admin:             addr1,
+				Issuers:           []string{addr1},
+				CreditTypeAbbrev:  "C",
+				Fee:               validFee,
+				MinBatchStartDate: &minBatchStartDate,
+				MaxBatchEndDate:   &maxBatchEndDate,
+			},
+			expErr: false,
+		},
+		"invalid batch period": {
+			src: MsgCreateClass{
+				Admin:             addr1,
+				Issuers:           []string{addr1},
+				CreditTypeAbbrev:  "C",
+				Fee:               validFee,
+				MinBatchStartDate: &maxBatchEndDate,
+				MaxBatchEndDate:   &minBatchStartDate,
+			},
+			expErr: true,
+		},
 		"invalid duplicate issuer": {
 			src: MsgCreateClass{
 				Admin:            addr1,
@@ -126,6 +162,7 @@ func TestMsgCreateClass(t *testing.T) {
 	}
 
 	for msg, test := range tests {
+		test := test
 		t.Run(msg, func(t *testing.T) {
 			t.Parallel()
 
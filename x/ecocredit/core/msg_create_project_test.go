@@ -24,7 +24,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        admin,
 				ClassId:      "A00",
 				Metadata:     "hello",
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			false,
 		},
@@ -34,7 +34,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        "invalid address",
 				ClassId:      "A00",
 				Metadata:     "hello",
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			true,
 		},
@@ -44,7 +44,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        admin,
 				ClassId:      "ABCD",
 				Metadata:     "hello",
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			true,
 		},
@@ -64,7 +64,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        admin,
 				ClassId:      "A01",
 				Metadata:     strings.Repeat("x", 288),
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			true,
 		},
@@ -74,7 +74,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        admin,
 				ClassId:      "A01",
 				Metadata:     "metadata",
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 				ReferenceId:  strings.Repeat("x", MaxReferenceIdLength+1),
 			},
 			true,
@@ -85,7 +85,7 @@ func TestMsgCreateProject(t *testing.T) {
 				Admin:        admin,
 				ClassId:      "A01",
 				Metadata:     "metadata",
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 				ReferenceId:  strings.Repeat("x", 10),
 			},
 			false,
@@ -21,8 +21,10 @@ func (m CreditType) Validate() error {
 	if len(m.Unit) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("unit cannot be empty")
 	}
-	if m.Precision != PRECISION {
-		return sdkerrors.ErrInvalidRequest.Wrapf("credit type precision is currently locked to %d", PRECISION)
+	if m.Precision < PRECISION || m.Precision > MaxPrecision {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit type precision must be between %d and %d", PRECISION, MaxPrecision,
+		)
 	}
 	return nil
 }
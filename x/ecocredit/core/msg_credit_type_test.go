@@ -75,7 +75,26 @@ func TestCreditType_Validate(t *testing.T) {
 				Unit:         "ton",
 				Precision:    3,
 			},
-			errMsg: "credit type precision is currently locked to 6",
+			errMsg: "credit type precision must be between 6 and 18",
+		},
+		{
+			name: "valid increased precision",
+			fields: fields{
+				Abbreviation: "C",
+				Name:         "carbon",
+				Unit:         "ton",
+				Precision:    9,
+			},
+		},
+		{
+			name: "invalid precision above maximum",
+			fields: fields{
+				Abbreviation: "C",
+				Name:         "carbon",
+				Unit:         "ton",
+				Precision:    19,
+			},
+			errMsg: "credit type precision must be between 6 and 18",
 		},
 	}
 	for _, tt := range tests {
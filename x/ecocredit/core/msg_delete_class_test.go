@@ -0,0 +1,50 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgDeleteClass(t *testing.T) {
+	t.Parallel()
+
+	authority := testutil.GenAddress()
+
+	tests := map[string]struct {
+		src    MsgDeleteClass
+		expErr string
+	}{
+		"valid": {
+			src: MsgDeleteClass{Authority: authority, ClassId: "C01"},
+		},
+		"invalid authority": {
+			src:    MsgDeleteClass{Authority: "foo", ClassId: "C01"},
+			expErr: "malformed authority address",
+		},
+		"empty class id": {
+			src:    MsgDeleteClass{Authority: authority},
+			expErr: "class ID didn't match the format: expected A00, got : parse error",
+		},
+		"invalid class id": {
+			src:    MsgDeleteClass{Authority: authority, ClassId: "foo"},
+			expErr: "class ID didn't match the format: expected A00, got foo: parse error",
+		},
+	}
+
+	for msg, test := range tests {
+		test := test
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -49,7 +49,7 @@ func (m *MsgMintBatchCredits) GetSigners() []sdk.AccAddress {
 
 func validateBatchIssuances(iss []*BatchIssuance) error {
 	if len(iss) == 0 {
-		errBadReq.Wrap("issuance list must not be empty")
+		return errBadReq.Wrap("issuance list must not be empty")
 	}
 	for idx, i := range iss {
 		if i == nil {
@@ -12,6 +12,7 @@ func TestMsgMintBatchCredits(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
 	issuer := testutil.GenAddress()
+	issuance := []*BatchIssuance{{Recipient: issuer, TradableAmount: "10"}}
 
 	tcs := []struct {
 		name string
@@ -20,8 +21,13 @@ func TestMsgMintBatchCredits(t *testing.T) {
 	}{
 		{"invalid issuer", "issuer", MsgMintBatchCredits{Issuer: "invalid"}},
 		{"invalid batch denom", "invalid batch denom", MsgMintBatchCredits{Issuer: issuer, BatchDenom: "XXX"}},
-		{"missing origin tx", "origin tx cannot be empty",
+		{"missing issuance", "issuance list must not be empty",
 			MsgMintBatchCredits{Issuer: issuer, BatchDenom: batchDenom}},
+		{"missing origin tx", "origin tx cannot be empty",
+			MsgMintBatchCredits{Issuer: issuer, BatchDenom: batchDenom, Issuance: issuance}},
+		{"valid", "",
+			MsgMintBatchCredits{Issuer: issuer, BatchDenom: batchDenom, Issuance: issuance,
+				OriginTx: &OriginTx{Id: "0x12345", Source: "polygon"}}},
 	}
 	for _, tc := range tcs {
 		err := tc.m.ValidateBasic()
@@ -0,0 +1,76 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgMultiSend{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgMultiSend) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgMultiSend) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMultiSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMultiSend) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if len(m.Transfers) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfers should not be empty")
+	}
+
+	if len(m.Note) > MaxNoteLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("note must be at most %d characters long", MaxNoteLength)
+	}
+
+	seen := make(map[string]bool)
+	for i, transfer := range m.Transfers {
+		if transfer == nil || len(transfer.Credits) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("transfers[%d]: credits should not be empty", i)
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(transfer.Recipient)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("transfers[%d]: %s", i, err)
+		}
+
+		if sender.Equals(recipient) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("transfers[%d]: sender and recipient cannot be the same", i)
+		}
+
+		for _, credit := range transfer.Credits {
+			if err := validateSendCredits(credit); err != nil {
+				return sdkerrors.Wrapf(err, "transfers[%d]", i)
+			}
+
+			key := transfer.Recipient + "/" + credit.BatchDenom
+			if seen[key] {
+				return sdkerrors.ErrInvalidRequest.Wrapf(
+					"transfers[%d]: duplicate recipient %s for batch denom %s", i, transfer.Recipient, credit.BatchDenom,
+				)
+			}
+			seen[key] = true
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMultiSend.
+func (m *MsgMultiSend) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgMultiSend(t *testing.T) {
+	t.Parallel()
+
+	addr1 := testutil.GenAddress()
+	addr2 := testutil.GenAddress()
+	addr3 := testutil.GenAddress()
+
+	credits := []*MsgSend_SendCredits{
+		{
+			BatchDenom:     batchDenom,
+			TradableAmount: "10",
+		},
+	}
+
+	tests := map[string]struct {
+		src    MsgMultiSend
+		expErr string
+	}{
+		"valid": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: credits},
+					{Recipient: addr3, Credits: credits},
+				},
+				Note: "distribution",
+			},
+		},
+		"invalid sender": {
+			src: MsgMultiSend{
+				Sender: "foo",
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: credits},
+				},
+			},
+			expErr: "sender",
+		},
+		"empty transfers": {
+			src: MsgMultiSend{
+				Sender: addr1,
+			},
+			expErr: "transfers should not be empty",
+		},
+		"nil transfer": {
+			src: MsgMultiSend{
+				Sender:    addr1,
+				Transfers: []*MsgMultiSend_Transfer{nil},
+			},
+			expErr: "transfers[0]: credits should not be empty",
+		},
+		"transfer without credits": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: credits},
+					{Recipient: addr3},
+				},
+			},
+			expErr: "transfers[1]: credits should not be empty",
+		},
+		"invalid recipient": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: "foo", Credits: credits},
+				},
+			},
+			expErr: "transfers[0]: decoding bech32 failed",
+		},
+		"recipient is sender": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr1, Credits: credits},
+				},
+			},
+			expErr: "transfers[0]: sender and recipient cannot be the same",
+		},
+		"invalid credits": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: []*MsgSend_SendCredits{
+						{BatchDenom: batchDenom, TradableAmount: "-10"},
+					}},
+				},
+			},
+			expErr: "transfers[0]",
+		},
+		"duplicate recipient for batch denom": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: credits},
+					{Recipient: addr3, Credits: credits},
+					{Recipient: addr2, Credits: credits},
+				},
+			},
+			expErr: "transfers[2]: duplicate recipient " + addr2 + " for batch denom " + batchDenom,
+		},
+		"note too long": {
+			src: MsgMultiSend{
+				Sender: addr1,
+				Transfers: []*MsgMultiSend_Transfer{
+					{Recipient: addr2, Credits: credits},
+				},
+				Note: strings.Repeat("x", MaxNoteLength+1),
+			},
+			expErr: "note must be at most 512 characters long",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgRemoveClassIssuer{}
+
+func (m MsgRemoveClassIssuer) Route() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgRemoveClassIssuer) Type() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgRemoveClassIssuer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgRemoveClassIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgRemoveClassIssuer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
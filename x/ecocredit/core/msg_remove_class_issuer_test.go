@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgRemoveClassIssuer(t *testing.T) {
+	t.Parallel()
+
+	admin := testutil.GenAddress()
+	issuer := testutil.GenAddress()
+
+	tests := map[string]struct {
+		src    MsgRemoveClassIssuer
+		expErr bool
+	}{
+		"valid": {
+			src:    MsgRemoveClassIssuer{Admin: admin, ClassId: "C01", Issuer: issuer},
+			expErr: false,
+		},
+		"valid: admin as issuer": {
+			src:    MsgRemoveClassIssuer{Admin: admin, ClassId: "C01", Issuer: admin},
+			expErr: false,
+		},
+		"invalid: bad ClassID": {
+			src:    MsgRemoveClassIssuer{Admin: admin, ClassId: "asl;dfjkdjk???fgs;dfljgk", Issuer: issuer},
+			expErr: true,
+		},
+		"invalid: bad admin addr": {
+			src:    MsgRemoveClassIssuer{Admin: "?!@%)(87", ClassId: "C01", Issuer: issuer},
+			expErr: true,
+		},
+		"invalid: bad issuer addr": {
+			src:    MsgRemoveClassIssuer{Admin: admin, ClassId: "C01", Issuer: "?!?@%?@$#6"},
+			expErr: true,
+		},
+		"invalid: empty issuer": {
+			src:    MsgRemoveClassIssuer{Admin: admin, ClassId: "C01"},
+			expErr: true,
+		},
+	}
+
+	for msg, test := range tests {
+		test := test
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
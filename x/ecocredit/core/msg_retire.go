@@ -46,6 +46,10 @@ func (m *MsgRetire) ValidateBasic() error {
 		return err
 	}
 
+	if len(m.Beneficiary) > MaxBeneficiaryLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("beneficiary must be at most %d characters long", MaxBeneficiaryLength)
+	}
+
 	return nil
 }
 
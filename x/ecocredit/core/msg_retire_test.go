@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,10 +27,38 @@ func TestMsgRetire(t *testing.T) {
 						Amount:     "10",
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: false,
 		},
+		"valid msg with beneficiary": {
+			src: MsgRetire{
+				Owner: addr1,
+				Credits: []*Credits{
+					{
+						BatchDenom: batchDenom,
+						Amount:     "10",
+					},
+				},
+				Jurisdiction: "AU-CDE FG1 345",
+				Beneficiary:  "Acme Corp",
+			},
+			expErr: false,
+		},
+		"invalid msg with beneficiary too long": {
+			src: MsgRetire{
+				Owner: addr1,
+				Credits: []*Credits{
+					{
+						BatchDenom: batchDenom,
+						Amount:     "10",
+					},
+				},
+				Jurisdiction: "AU-CDE FG1 345",
+				Beneficiary:  strings.Repeat("x", MaxBeneficiaryLength+1),
+			},
+			expErr: true,
+		},
 		"invalid msg without holder": {
 			src: MsgRetire{
 				Credits: []*Credits{
@@ -38,7 +67,7 @@ func TestMsgRetire(t *testing.T) {
 						Amount:     "10",
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
@@ -51,14 +80,14 @@ func TestMsgRetire(t *testing.T) {
 						Amount:     "10",
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
 		"invalid msg without credits": {
 			src: MsgRetire{
 				Owner:        addr1,
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
@@ -70,7 +99,7 @@ func TestMsgRetire(t *testing.T) {
 						Amount: "10",
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
@@ -82,7 +111,7 @@ func TestMsgRetire(t *testing.T) {
 						BatchDenom: batchDenom,
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
@@ -95,7 +124,7 @@ func TestMsgRetire(t *testing.T) {
 						Amount:     "abc",
 					},
 				},
-				Jurisdiction: "AB-CDE FG1 345",
+				Jurisdiction: "AU-CDE FG1 345",
 			},
 			expErr: true,
 		},
@@ -25,38 +25,60 @@ func (m MsgSend) GetSignBytes() []byte {
 // ValidateBasic does a sanity check on the provided data.
 func (m *MsgSend) ValidateBasic() error {
 
-	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
 		return sdkerrors.Wrap(err, "sender")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+	recipient, err := sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if sender.Equals(recipient) {
+		return sdkerrors.ErrInvalidRequest.Wrap("sender and recipient cannot be the same")
+	}
+
 	if len(m.Credits) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("credits should not be empty")
 	}
 
+	if len(m.Note) > MaxNoteLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("note must be at most %d characters long", MaxNoteLength)
+	}
+
 	for _, credit := range m.Credits {
-		if err := ValidateBatchDenom(credit.BatchDenom); err != nil {
+		if err := validateSendCredits(credit); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if _, err := math.NewNonNegativeDecFromString(credit.TradableAmount); err != nil {
-			return err
-		}
+// validateSendCredits validates the batch denom, amounts, and retirement
+// jurisdiction of credits being sent.
+func validateSendCredits(credit *MsgSend_SendCredits) error {
+	if err := ValidateBatchDenom(credit.BatchDenom); err != nil {
+		return err
+	}
 
-		retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
-		if err != nil {
-			return err
-		}
+	if _, err := math.NewNonNegativeDecFromString(credit.TradableAmount); err != nil {
+		return err
+	}
 
-		if !retiredAmount.IsZero() {
-			if err = ValidateJurisdiction(credit.RetirementJurisdiction); err != nil {
-				return err
-			}
+	retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
+	if err != nil {
+		return err
+	}
+
+	// the retirement jurisdiction is set per credit and is only required
+	// when credits are retired, but must be valid whenever it is provided
+	if !retiredAmount.IsZero() || credit.RetirementJurisdiction != "" {
+		if err = ValidateJurisdiction(credit.RetirementJurisdiction); err != nil {
+			return err
 		}
 	}
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -138,6 +139,60 @@ func TestMsgSend(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid msg with the same sender and recipient": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: addr1,
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:     batchDenom,
+						TradableAmount: "10",
+					},
+				},
+			},
+			expErr: true,
+		},
+		"invalid msg with the same sender and recipient in a different case": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: strings.ToUpper(addr1),
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:     batchDenom,
+						TradableAmount: "10",
+					},
+				},
+			},
+			expErr: true,
+		},
+		"valid msg with note": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: addr2,
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:     batchDenom,
+						TradableAmount: "10",
+					},
+				},
+				Note: strings.Repeat("x", MaxNoteLength),
+			},
+			expErr: false,
+		},
+		"invalid msg with note exceeding max length": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: addr2,
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:     batchDenom,
+						TradableAmount: "10",
+					},
+				},
+				Note: strings.Repeat("x", MaxNoteLength+1),
+			},
+			expErr: true,
+		},
 		"valid msg without Credits.RetirementJurisdiction(When RetiredAmount is zero)": {
 			src: MsgSend{
 				Sender:    addr1,
@@ -152,6 +207,39 @@ func TestMsgSend(t *testing.T) {
 			},
 			expErr: false,
 		},
+		"valid msg with a different Credits.RetirementJurisdiction per credit": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: addr2,
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:             batchDenom,
+						RetiredAmount:          "10",
+						RetirementJurisdiction: "US-OR",
+					},
+					{
+						BatchDenom:             batchDenom,
+						RetiredAmount:          "10",
+						RetirementJurisdiction: "ST-UVW XY Z12",
+					},
+				},
+			},
+			expErr: false,
+		},
+		"invalid msg with invalid Credits.RetirementJurisdiction (When RetiredAmount is zero)": {
+			src: MsgSend{
+				Sender:    addr1,
+				Recipient: addr2,
+				Credits: []*MsgSend_SendCredits{
+					{
+						BatchDenom:             batchDenom,
+						TradableAmount:         "10",
+						RetirementJurisdiction: "invalid jurisdiction",
+					},
+				},
+			},
+			expErr: true,
+		},
 		"invalid msg with wrong sender": {
 			src: MsgSend{
 				Sender:    "wrongSender",
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgSetClassFee(t *testing.T) {
+	t.Parallel()
+
+	authority := testutil.GenAddress()
+
+	tests := map[string]struct {
+		src    MsgSetClassFee
+		expErr string
+	}{
+		"valid": {
+			src: MsgSetClassFee{Authority: authority, Fee: sdk.NewCoins(sdk.NewInt64Coin("uregen", 20))},
+		},
+		"valid empty fee": {
+			src: MsgSetClassFee{Authority: authority},
+		},
+		"invalid authority": {
+			src:    MsgSetClassFee{Authority: "foo", Fee: sdk.NewCoins(sdk.NewInt64Coin("uregen", 20))},
+			expErr: "malformed authority address",
+		},
+		"invalid fee": {
+			src:    MsgSetClassFee{Authority: authority, Fee: sdk.Coins{sdk.Coin{Denom: "uregen", Amount: sdk.NewInt(-1)}}},
+			expErr: "invalid class fee",
+		},
+	}
+
+	for msg, test := range tests {
+		test := test
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateCreditTypePrecision{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateCreditTypePrecision) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateCreditTypePrecision) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateCreditTypePrecision) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateCreditTypePrecision) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("malformed authority address: %s", err)
+	}
+
+	if err := ValidateCreditTypeAbbreviation(m.Abbreviation); err != nil {
+		return err
+	}
+
+	if m.NewPrecision <= m.OldPrecision {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"new precision %d must be greater than old precision %d", m.NewPrecision, m.OldPrecision,
+		)
+	}
+
+	if m.NewPrecision > MaxPrecision {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"new precision %d cannot exceed %d", m.NewPrecision, MaxPrecision,
+		)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateCreditTypePrecision.
+func (m *MsgUpdateCreditTypePrecision) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestMsgUpdateCreditTypePrecision(t *testing.T) {
+	t.Parallel()
+
+	authority := testutil.GenAddress()
+
+	tests := map[string]struct {
+		src    MsgUpdateCreditTypePrecision
+		expErr string
+	}{
+		"valid": {
+			src: MsgUpdateCreditTypePrecision{Authority: authority, Abbreviation: "C", OldPrecision: 6, NewPrecision: 9},
+		},
+		"invalid authority": {
+			src:    MsgUpdateCreditTypePrecision{Authority: "foo", Abbreviation: "C", OldPrecision: 6, NewPrecision: 9},
+			expErr: "malformed authority address",
+		},
+		"invalid abbreviation": {
+			src:    MsgUpdateCreditTypePrecision{Authority: authority, Abbreviation: "c1", OldPrecision: 6, NewPrecision: 9},
+			expErr: "credit type abbreviation must be 1-3 uppercase latin letters",
+		},
+		"decrease": {
+			src:    MsgUpdateCreditTypePrecision{Authority: authority, Abbreviation: "C", OldPrecision: 6, NewPrecision: 3},
+			expErr: "new precision 3 must be greater than old precision 6",
+		},
+		"unchanged": {
+			src:    MsgUpdateCreditTypePrecision{Authority: authority, Abbreviation: "C", OldPrecision: 6, NewPrecision: 6},
+			expErr: "new precision 6 must be greater than old precision 6",
+		},
+		"above maximum": {
+			src:    MsgUpdateCreditTypePrecision{Authority: authority, Abbreviation: "C", OldPrecision: 6, NewPrecision: 19},
+			expErr: "new precision 19 cannot exceed 18",
+		},
+	}
+
+	for msg, test := range tests {
+		test := test
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.src.ValidateBasic()
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -20,6 +23,11 @@ func (m MsgUpdateProjectMetadata) ValidateBasic() error {
 	if err := ValidateProjectId(m.ProjectId); err != nil {
 		return err
 	}
+	if m.ExpectedMetadataHash != "" {
+		if bz, err := hex.DecodeString(m.ExpectedMetadataHash); err != nil || len(bz) != sha256.Size {
+			return sdkerrors.ErrInvalidRequest.Wrap("expected metadata hash must be a hex-encoded SHA-256 hash")
+		}
+	}
 	return nil
 }
 
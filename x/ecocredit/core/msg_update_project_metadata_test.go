@@ -14,9 +14,10 @@ import (
 func TestMsgUpdateProjectMetadata_ValidateBasic(t *testing.T) {
 	addr := sdk.AccAddress("addr1").String()
 	type fields struct {
-		Admin       string
-		NewMetadata string
-		ProjectId   string
+		Admin                string
+		NewMetadata          string
+		ProjectId            string
+		ExpectedMetadataHash string
 	}
 	tests := []struct {
 		name   string
@@ -55,13 +56,33 @@ func TestMsgUpdateProjectMetadata_ValidateBasic(t *testing.T) {
 			},
 			errMsg: "invalid project id",
 		},
+		{
+			name: "valid expected metadata hash",
+			fields: fields{
+				Admin:                addr,
+				NewMetadata:          "new metadata",
+				ProjectId:            "C01-001",
+				ExpectedMetadataHash: MetadataHash("metadata"),
+			},
+		},
+		{
+			name: "invalid expected metadata hash",
+			fields: fields{
+				Admin:                addr,
+				NewMetadata:          "new metadata",
+				ProjectId:            "C01-001",
+				ExpectedMetadataHash: "abc",
+			},
+			errMsg: "expected metadata hash must be a hex-encoded SHA-256 hash",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := MsgUpdateProjectMetadata{
-				Admin:       tt.fields.Admin,
-				NewMetadata: tt.fields.NewMetadata,
-				ProjectId:   tt.fields.ProjectId,
+				Admin:                tt.fields.Admin,
+				NewMetadata:          tt.fields.NewMetadata,
+				ProjectId:            tt.fields.ProjectId,
+				ExpectedMetadataHash: tt.fields.ExpectedMetadataHash,
 			}
 			if len(tt.errMsg) == 0 {
 				assert.NilError(t, m.ValidateBasic())
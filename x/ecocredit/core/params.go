@@ -4,22 +4,36 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
 var (
 	// This is a value of 20 REGEN
-	DefaultCreditClassFee   = sdk.NewInt(2e7)
-	DefaultBasketFee        = sdk.NewInt(2e7)
-	KeyCreditClassFee       = []byte("CreditClassFee")
-	KeyAllowedClassCreators = []byte("AllowedClassCreators")
-	KeyAllowlistEnabled     = []byte("AllowlistEnabled")
-	KeyBasketFee            = []byte("BasketFee")
+	DefaultCreditClassFee      = sdk.NewInt(2e7)
+	DefaultBasketFee           = sdk.NewInt(2e7)
+	DefaultMinRetirementAmount = "0"
+	DefaultGasCostPerIteration = ecocredit.GasCostPerIteration
+	DefaultMaxCreditsPerMsg    = uint64(1000)
+	KeyCreditClassFee          = []byte("CreditClassFee")
+	KeyAllowedClassCreators    = []byte("AllowedClassCreators")
+	KeyAllowlistEnabled        = []byte("AllowlistEnabled")
+	KeyBasketFee               = []byte("BasketFee")
+	KeyClassFeeDiscounts       = []byte("ClassFeeDiscounts")
+	KeyClassBufferPools        = []byte("ClassBufferPools")
+	KeyMinRetirementAmount     = []byte("MinRetirementAmount")
+	KeyGasCostPerIteration     = []byte("GasCostPerIteration")
+	KeyMaxCreditsPerMsg        = []byte("MaxCreditsPerMsg")
 )
 
 // TODO: remove after we allow standard SI units for precision
 
 const (
 	PRECISION uint32 = 6
+
+	// MaxPrecision is the maximum precision a credit type can be raised to
+	MaxPrecision uint32 = 18
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -33,6 +47,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAllowedClassCreators, &p.AllowedClassCreators, validateAllowedClassCreators),
 		paramtypes.NewParamSetPair(KeyAllowlistEnabled, &p.AllowlistEnabled, validateAllowlistEnabled),
 		paramtypes.NewParamSetPair(KeyBasketFee, &p.BasketFee, validateBasketFee),
+		paramtypes.NewParamSetPair(KeyClassFeeDiscounts, &p.ClassFeeDiscounts, validateClassFeeDiscounts),
+		paramtypes.NewParamSetPair(KeyClassBufferPools, &p.ClassBufferPools, validateClassBufferPools),
+		paramtypes.NewParamSetPair(KeyMinRetirementAmount, &p.MinRetirementAmount, validateMinRetirementAmount),
+		paramtypes.NewParamSetPair(KeyGasCostPerIteration, &p.GasCostPerIteration, validateGasCostPerIteration),
+		paramtypes.NewParamSetPair(KeyMaxCreditsPerMsg, &p.MaxCreditsPerMsg, validateMaxCreditsPerMsg),
 	}
 }
 
@@ -54,6 +73,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateClassFeeDiscounts(p.ClassFeeDiscounts); err != nil {
+		return err
+	}
+
+	if err := validateClassBufferPools(p.ClassBufferPools); err != nil {
+		return err
+	}
+
+	if err := validateMinRetirementAmount(p.MinRetirementAmount); err != nil {
+		return err
+	}
+
+	if err := validateGasCostPerIteration(p.GasCostPerIteration); err != nil {
+		return err
+	}
+
+	if err := validateMaxCreditsPerMsg(p.MaxCreditsPerMsg); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -106,6 +145,130 @@ func validateBasketFee(i interface{}) error {
 	return nil
 }
 
+func validateClassFeeDiscounts(i interface{}) error {
+	v, ok := i.([]*ClassFeeDiscount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, discount := range v {
+		if discount == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("class fee discount cannot be empty")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(discount.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid class fee discount address: %s", err.Error())
+		}
+
+		if seen[discount.Address] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class fee discount address: %s", discount.Address)
+		}
+		seen[discount.Address] = true
+
+		if discount.Multiplier == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("class fee discount multiplier cannot be empty")
+		}
+
+		multiplier, err := math.NewNonNegativeDecFromString(discount.Multiplier)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid class fee discount multiplier: %s", err.Error())
+		}
+
+		if multiplier.Cmp(math.NewDecFromInt64(1)) == 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class fee discount multiplier must be in the range [0, 1], got %s", discount.Multiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateClassBufferPools(i interface{}) error {
+	v, ok := i.([]*ClassBufferPool)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, pool := range v {
+		if pool == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("class buffer pool cannot be empty")
+		}
+
+		if err := ValidateClassId(pool.ClassId); err != nil {
+			return err
+		}
+
+		if seen[pool.ClassId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class buffer pool for class: %s", pool.ClassId)
+		}
+		seen[pool.ClassId] = true
+
+		if _, err := sdk.AccAddressFromBech32(pool.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid class buffer pool address: %s", err.Error())
+		}
+
+		if pool.Percentage == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("class buffer pool percentage cannot be empty")
+		}
+
+		percentage, err := math.NewNonNegativeDecFromString(pool.Percentage)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid class buffer pool percentage: %s", err.Error())
+		}
+
+		if percentage.Cmp(math.NewDecFromInt64(1)) != math.LessThan {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class buffer pool percentage must be in the range [0, 1), got %s", pool.Percentage)
+		}
+	}
+
+	return nil
+}
+
+func validateMinRetirementAmount(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	// an empty value is treated as zero
+	if v == "" {
+		return nil
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(v); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid min retirement amount: %s", err.Error())
+	}
+
+	return nil
+}
+
+func validateGasCostPerIteration(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gas cost per iteration must be greater than zero")
+	}
+
+	return nil
+}
+
+func validateMaxCreditsPerMsg(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max credits per message must be greater than zero")
+	}
+
+	return nil
+}
+
 // NewParams creates a new Params object.
 func NewParams(creditClassFee, basketFee sdk.Coins, allowlist []string, allowlistEnabled bool) Params {
 	return Params{
@@ -113,6 +276,11 @@ func NewParams(creditClassFee, basketFee sdk.Coins, allowlist []string, allowlis
 		AllowedClassCreators: allowlist,
 		AllowlistEnabled:     allowlistEnabled,
 		BasketFee:            basketFee,
+		ClassFeeDiscounts:    []*ClassFeeDiscount{},
+		ClassBufferPools:     []*ClassBufferPool{},
+		MinRetirementAmount:  DefaultMinRetirementAmount,
+		GasCostPerIteration:  DefaultGasCostPerIteration,
+		MaxCreditsPerMsg:     DefaultMaxCreditsPerMsg,
 	}
 }
 
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+)
+
+func TestParams_ClassFeeDiscounts(t *testing.T) {
+	t.Parallel()
+	addr := testutil.GenAddress()
+
+	tests := map[string]struct {
+		discounts []*ClassFeeDiscount
+		expErr    string
+	}{
+		"valid": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "0.5"}},
+		},
+		"valid full waiver": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "0"}},
+		},
+		"valid no discount": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "1"}},
+		},
+		"invalid address": {
+			discounts: []*ClassFeeDiscount{{Address: "foo", Multiplier: "0.5"}},
+			expErr:    "invalid class fee discount address",
+		},
+		"duplicate address": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "0.5"}, {Address: addr, Multiplier: "0.1"}},
+			expErr:    "duplicate class fee discount address",
+		},
+		"negative multiplier": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "-0.5"}},
+			expErr:    "invalid class fee discount multiplier",
+		},
+		"multiplier greater than one": {
+			discounts: []*ClassFeeDiscount{{Address: addr, Multiplier: "1.5"}},
+			expErr:    "must be in the range [0, 1]",
+		},
+		"empty multiplier": {
+			discounts: []*ClassFeeDiscount{{Address: addr}},
+			expErr:    "class fee discount multiplier cannot be empty",
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := DefaultParams()
+			params.ClassFeeDiscounts = tc.discounts
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ClassBufferPools(t *testing.T) {
+	t.Parallel()
+	addr := testutil.GenAddress()
+
+	tests := map[string]struct {
+		pools  []*ClassBufferPool
+		expErr string
+	}{
+		"valid": {
+			pools: []*ClassBufferPool{{ClassId: "C01", Address: addr, Percentage: "0.1"}},
+		},
+		"valid multiple classes": {
+			pools: []*ClassBufferPool{
+				{ClassId: "C01", Address: addr, Percentage: "0.1"},
+				{ClassId: "C02", Address: addr, Percentage: "0.2"},
+			},
+		},
+		"invalid class id": {
+			pools:  []*ClassBufferPool{{ClassId: "foo", Address: addr, Percentage: "0.1"}},
+			expErr: "class ID didn't match the format",
+		},
+		"duplicate class id": {
+			pools: []*ClassBufferPool{
+				{ClassId: "C01", Address: addr, Percentage: "0.1"},
+				{ClassId: "C01", Address: addr, Percentage: "0.2"},
+			},
+			expErr: "duplicate class buffer pool for class",
+		},
+		"invalid address": {
+			pools:  []*ClassBufferPool{{ClassId: "C01", Address: "foo", Percentage: "0.1"}},
+			expErr: "invalid class buffer pool address",
+		},
+		"empty percentage": {
+			pools:  []*ClassBufferPool{{ClassId: "C01", Address: addr}},
+			expErr: "class buffer pool percentage cannot be empty",
+		},
+		"negative percentage": {
+			pools:  []*ClassBufferPool{{ClassId: "C01", Address: addr, Percentage: "-0.1"}},
+			expErr: "invalid class buffer pool percentage",
+		},
+		"percentage of one": {
+			pools:  []*ClassBufferPool{{ClassId: "C01", Address: addr, Percentage: "1"}},
+			expErr: "must be in the range [0, 1)",
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := DefaultParams()
+			params.ClassBufferPools = tc.pools
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_MinRetirementAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		amount string
+		expErr string
+	}{
+		"valid": {
+			amount: "1.5",
+		},
+		"valid zero": {
+			amount: "0",
+		},
+		"valid empty": {
+			amount: "",
+		},
+		"negative": {
+			amount: "-1",
+			expErr: "invalid min retirement amount",
+		},
+		"not a decimal": {
+			amount: "foo",
+			expErr: "invalid min retirement amount",
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := DefaultParams()
+			params.MinRetirementAmount = tc.amount
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_GasCostPerIteration(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultParams()
+	require.Equal(t, uint64(10), params.GasCostPerIteration)
+	require.NoError(t, params.Validate())
+
+	params.GasCostPerIteration = 0
+	require.ErrorContains(t, params.Validate(), "gas cost per iteration must be greater than zero")
+}
+
+func TestParams_MaxCreditsPerMsg(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultParams()
+	require.Equal(t, uint64(1000), params.MaxCreditsPerMsg)
+	require.NoError(t, params.Validate())
+
+	params.MaxCreditsPerMsg = 0
+	require.ErrorContains(t, params.Validate(), "max credits per message must be greater than zero")
+}
@@ -113,6 +113,18 @@ type Class struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// credit_type_abbrev is the abbreviation of the credit type.
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// min_batch_start_date is the optional earliest start date allowed for
+	// credit batches issued within the credit class. If not set, the start date
+	// of credit batches is not constrained by the credit class.
+	MinBatchStartDate *types.Timestamp `protobuf:"bytes,6,opt,name=min_batch_start_date,json=minBatchStartDate,proto3" json:"min_batch_start_date,omitempty"`
+	// max_batch_end_date is the optional latest end date allowed for credit
+	// batches issued within the credit class. If not set, the end date of credit
+	// batches is not constrained by the credit class.
+	MaxBatchEndDate *types.Timestamp `protobuf:"bytes,7,opt,name=max_batch_end_date,json=maxBatchEndDate,proto3" json:"max_batch_end_date,omitempty"`
+	// metadata_schema is the optional URI of the metadata schema that project
+	// and credit batch metadata within the credit class must reference. If not
+	// set, project and credit batch metadata is not checked against a schema.
+	MetadataSchema string `protobuf:"bytes,8,opt,name=metadata_schema,json=metadataSchema,proto3" json:"metadata_schema,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -183,6 +195,27 @@ func (m *Class) GetCreditTypeAbbrev() string {
 	return ""
 }
 
+func (m *Class) GetMinBatchStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinBatchStartDate
+	}
+	return nil
+}
+
+func (m *Class) GetMaxBatchEndDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxBatchEndDate
+	}
+	return nil
+}
+
+func (m *Class) GetMetadataSchema() string {
+	if m != nil {
+		return m.MetadataSchema
+	}
+	return ""
+}
+
 // ClassIssuers is a JOIN table for Class Info that stores the credit class
 // issuers
 type ClassIssuer struct {
@@ -372,6 +405,11 @@ type Batch struct {
 	// open tells if it's possible to mint new credits in the future.
 	// Once `open` is set to false, it can't be toggled any more.
 	Open bool `protobuf:"varint,9,opt,name=open,proto3" json:"open,omitempty"`
+	// expiry_date is an optional timestamp after which tradable credits from the
+	// credit batch can no longer be sent, put into a basket, or sold or bought
+	// in the marketplace. Expired tradable credits may still be retired or
+	// cancelled.
+	ExpiryDate *types.Timestamp `protobuf:"bytes,10,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
 }
 
 func (m *Batch) Reset()         { *m = Batch{} }
@@ -470,6 +508,13 @@ func (m *Batch) GetOpen() bool {
 	return false
 }
 
+func (m *Batch) GetExpiryDate() *types.Timestamp {
+	if m != nil {
+		return m.ExpiryDate
+	}
+	return nil
+}
+
 // ClassSequence stores and increments the sequence number for credit classes
 // within a credit type.
 type ClassSequence struct {
@@ -823,6 +868,15 @@ type BatchOriginTx struct {
 	// source is the source chain or registry of the transaction originating
 	// the mint process (e.g. polygon, ethereum, verra).
 	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// batch_key is the table row identifier of the credit batch the credits
+	// were issued in. This links an origin tx to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// tradable_amount is the total amount of tradable credits issued from the
+	// originating transaction.
+	TradableAmount string `protobuf:"bytes,4,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the total amount of retired credits issued from the
+	// originating transaction.
+	RetiredAmount string `protobuf:"bytes,5,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
 }
 
 func (m *BatchOriginTx) Reset()         { *m = BatchOriginTx{} }
@@ -872,6 +926,294 @@ func (m *BatchOriginTx) GetSource() string {
 	return ""
 }
 
+func (m *BatchOriginTx) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *BatchOriginTx) GetTradableAmount() string {
+	if m != nil {
+		return m.TradableAmount
+	}
+	return ""
+}
+
+func (m *BatchOriginTx) GetRetiredAmount() string {
+	if m != nil {
+		return m.RetiredAmount
+	}
+	return ""
+}
+
+// BatchBuffer stores the credits of a credit batch that were allocated to the
+// buffer pool of the credit class when the credit batch was created.
+type BatchBuffer struct {
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a batch buffer to a credit batch.
+	BatchKey uint64 `protobuf:"varint,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// class_key is the table row identifier of the credit class used internally
+	// for efficient lookups. This links a batch buffer to a credit class.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// address is the address of the buffer pool account that received the
+	// credits.
+	Address []byte `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount of credits allocated to the buffer pool as retired
+	// credits.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *BatchBuffer) Reset()         { *m = BatchBuffer{} }
+func (m *BatchBuffer) String() string { return proto.CompactTextString(m) }
+func (*BatchBuffer) ProtoMessage()    {}
+func (*BatchBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{11}
+}
+func (m *BatchBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBuffer.Merge(m, src)
+}
+func (m *BatchBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchBuffer proto.InternalMessageInfo
+
+func (m *BatchBuffer) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *BatchBuffer) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
+	}
+	return 0
+}
+
+func (m *BatchBuffer) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *BatchBuffer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// BatchAnnotation is an annotation (note) appended to a credit batch by the
+// credit batch issuer after issuance. Annotations are never updated or
+// deleted, providing an append-only log of notes for the credit batch.
+type BatchAnnotation struct {
+	// id is the table row identifier of the batch annotation. This identifier
+	// is auto-incrementing and determines the order of the annotations.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a batch annotation to a credit batch.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// annotator is the address of the account that appended the annotation.
+	Annotator []byte `protobuf:"bytes,3,opt,name=annotator,proto3" json:"annotator,omitempty"`
+	// annotation is the content of the annotation.
+	Annotation string `protobuf:"bytes,4,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	// timestamp is the block time at which the annotation was appended.
+	Timestamp *types.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BatchAnnotation) Reset()         { *m = BatchAnnotation{} }
+func (m *BatchAnnotation) String() string { return proto.CompactTextString(m) }
+func (*BatchAnnotation) ProtoMessage()    {}
+func (*BatchAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{12}
+}
+func (m *BatchAnnotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAnnotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAnnotation.Merge(m, src)
+}
+func (m *BatchAnnotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAnnotation proto.InternalMessageInfo
+
+func (m *BatchAnnotation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BatchAnnotation) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *BatchAnnotation) GetAnnotator() []byte {
+	if m != nil {
+		return m.Annotator
+	}
+	return nil
+}
+
+func (m *BatchAnnotation) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *BatchAnnotation) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+// BatchRetirement records a retirement of credits from a credit batch that was
+// attributed to a beneficiary using Msg/Retire.
+type BatchRetirement struct {
+	// id is the table row identifier of the batch retirement. This identifier
+	// is auto-incrementing.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a batch retirement to a credit batch.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// owner is the address of the account that retired the credits.
+	Owner []byte `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary is the name of the entity the retirement is attributed to.
+	Beneficiary string `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// amount is the amount of credits retired.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the retirement jurisdiction of the credits.
+	Jurisdiction string `protobuf:"bytes,6,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// timestamp is the block time at which the credits were retired.
+	Timestamp *types.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BatchRetirement) Reset()         { *m = BatchRetirement{} }
+func (m *BatchRetirement) String() string { return proto.CompactTextString(m) }
+func (*BatchRetirement) ProtoMessage()    {}
+func (*BatchRetirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{13}
+}
+func (m *BatchRetirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRetirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRetirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchRetirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRetirement.Merge(m, src)
+}
+func (m *BatchRetirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRetirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRetirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRetirement proto.InternalMessageInfo
+
+func (m *BatchRetirement) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BatchRetirement) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *BatchRetirement) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *BatchRetirement) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *BatchRetirement) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *BatchRetirement) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *BatchRetirement) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
 	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
@@ -884,73 +1226,94 @@ func init() {
 	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
 	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
 	proto.RegisterType((*BatchOriginTx)(nil), "regen.ecocredit.v1.BatchOriginTx")
+	proto.RegisterType((*BatchBuffer)(nil), "regen.ecocredit.v1.BatchBuffer")
+	proto.RegisterType((*BatchAnnotation)(nil), "regen.ecocredit.v1.BatchAnnotation")
+	proto.RegisterType((*BatchRetirement)(nil), "regen.ecocredit.v1.BatchRetirement")
 }
 
 func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
 
 var fileDescriptor_6cfdca0a4aaabb36 = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0x23, 0x45,
-	0x17, 0x4e, 0xb9, 0xed, 0xd8, 0x3e, 0xbe, 0x75, 0xea, 0xcf, 0xe4, 0x6f, 0x99, 0xc1, 0x13, 0x1a,
-	0xd0, 0x04, 0xc9, 0xd8, 0x0a, 0x37, 0x81, 0x17, 0xa0, 0xc9, 0xc0, 0x62, 0x34, 0x12, 0x8c, 0x4c,
-	0x56, 0x6c, 0x4c, 0xb9, 0xfb, 0x8c, 0xd3, 0x19, 0xf7, 0x85, 0xea, 0x72, 0x26, 0xde, 0xf2, 0x00,
-	0x88, 0x25, 0x0b, 0xc4, 0x0b, 0xf0, 0x0a, 0x3c, 0x00, 0xec, 0x46, 0x62, 0xc3, 0x12, 0x25, 0x6f,
-	0x80, 0x78, 0x00, 0xd4, 0xa7, 0xab, 0xdb, 0x6e, 0xe3, 0x99, 0x89, 0xd8, 0xd5, 0xf9, 0xea, 0x5c,
-	0xbe, 0xf3, 0xd5, 0x39, 0x6d, 0x43, 0x4f, 0xe2, 0x0c, 0x83, 0x21, 0x3a, 0xa1, 0x23, 0xd1, 0xf5,
-	0xd4, 0xf0, 0xe2, 0x78, 0x18, 0x2b, 0xa1, 0x70, 0x10, 0xc9, 0x50, 0x85, 0x9c, 0xd3, 0xfd, 0x20,
-	0xbf, 0x1f, 0x5c, 0x1c, 0x77, 0x5f, 0x75, 0xc2, 0xd8, 0x0f, 0xe3, 0x61, 0x28, 0xfd, 0xe1, 0xc5,
-	0xb1, 0x98, 0x47, 0x67, 0xe2, 0x38, 0x31, 0xd2, 0x90, 0xee, 0x9d, 0x59, 0x18, 0xce, 0xe6, 0x38,
-	0x24, 0x6b, 0xba, 0x78, 0x3c, 0x54, 0x9e, 0x8f, 0xb1, 0x12, 0x7e, 0x94, 0x3a, 0xd8, 0x3f, 0x32,
-	0x80, 0xfb, 0x94, 0xed, 0x74, 0x19, 0x21, 0xb7, 0xa1, 0x29, 0xa6, 0x53, 0x89, 0x17, 0x9e, 0x50,
-	0x5e, 0x18, 0x58, 0xec, 0x90, 0x1d, 0xd5, 0xc7, 0x05, 0x8c, 0x73, 0x28, 0x07, 0xc2, 0x47, 0xab,
-	0x44, 0x77, 0x74, 0x4e, 0xb0, 0x45, 0xe0, 0x29, 0xcb, 0x48, 0xb1, 0xe4, 0xcc, 0x6f, 0x43, 0x3d,
-	0x92, 0xe8, 0x78, 0x71, 0x92, 0xa8, 0x7c, 0xc8, 0x8e, 0x5a, 0xe3, 0x15, 0x30, 0x7a, 0xe3, 0xaf,
-	0x9f, 0x7e, 0xff, 0xce, 0xe8, 0x41, 0xbb, 0x58, 0x91, 0x43, 0x9a, 0xdd, 0x64, 0x16, 0xb3, 0x98,
-	0xfd, 0x1b, 0x83, 0xca, 0xfd, 0xb9, 0x88, 0x63, 0x6e, 0x82, 0xf1, 0x04, 0x97, 0x44, 0xa8, 0x3c,
-	0x4e, 0x8e, 0xbc, 0x0d, 0x25, 0xcf, 0xd5, 0x2c, 0x4a, 0x9e, 0xcb, 0xf7, 0xa1, 0x22, 0x5c, 0xdf,
-	0x0b, 0x88, 0x44, 0x73, 0x9c, 0x1a, 0xbc, 0x0b, 0x35, 0x1f, 0x95, 0x70, 0x85, 0x12, 0x44, 0xa2,
-	0x3e, 0xce, 0x6d, 0xde, 0x07, 0x9e, 0x2a, 0x39, 0x51, 0xcb, 0x08, 0x27, 0x29, 0x0f, 0xab, 0x42,
-	0x5e, 0xa6, 0x93, 0xab, 0x72, 0x8f, 0xf0, 0xd1, 0xc7, 0xc4, 0xf8, 0x43, 0xa8, 0x12, 0x13, 0x93,
-	0xf1, 0x5a, 0x42, 0x20, 0x21, 0xca, 0xeb, 0xba, 0xb4, 0x59, 0xe2, 0x07, 0xdb, 0x72, 0x9a, 0x86,
-	0x55, 0xb2, 0xbf, 0x86, 0x06, 0xb5, 0xf2, 0x20, 0x8e, 0x17, 0x28, 0xf9, 0x2b, 0x50, 0x77, 0x12,
-	0x73, 0xb2, 0x6a, 0xab, 0x46, 0xc0, 0x43, 0x5c, 0xf2, 0x03, 0xd8, 0xf5, 0xc8, 0x8d, 0xfa, 0x6b,
-	0x8e, 0xb5, 0x35, 0xba, 0x4d, 0x1c, 0x0e, 0x80, 0x83, 0x99, 0x07, 0xf7, 0xb5, 0xa7, 0x61, 0xff,
-	0x5c, 0x82, 0xea, 0x23, 0x19, 0x9e, 0xa3, 0xa3, 0xfe, 0xb3, 0x5e, 0x05, 0x5a, 0xe5, 0x0d, 0x5a,
-	0x36, 0x34, 0xcf, 0x17, 0xd2, 0x8b, 0x5d, 0xcf, 0xa1, 0xf1, 0x48, 0xa5, 0x2a, 0x60, 0x05, 0xc1,
-	0x77, 0x37, 0x04, 0x7f, 0x0d, 0x9a, 0x12, 0x1f, 0xa3, 0xc4, 0xc0, 0xc1, 0x89, 0xe7, 0x5a, 0x55,
-	0xba, 0x6f, 0xe4, 0xd8, 0x03, 0x77, 0x74, 0x46, 0x1d, 0x4e, 0xb7, 0xa9, 0xcc, 0xa1, 0xb9, 0xd6,
-	0xb4, 0x6b, 0x96, 0xd6, 0x95, 0x37, 0xb8, 0x59, 0x4c, 0x6e, 0x96, 0x79, 0x17, 0x0e, 0x56, 0x01,
-	0x85, 0xbb, 0x8a, 0x55, 0xb6, 0x7f, 0x30, 0xa0, 0x72, 0x22, 0x94, 0x73, 0xb6, 0x45, 0xab, 0xe7,
-	0xe8, 0xcf, 0xef, 0x40, 0x23, 0x4a, 0x05, 0x26, 0x7d, 0x0c, 0x8a, 0x00, 0x0d, 0x25, 0x0a, 0xed,
-	0x43, 0xc5, 0xc5, 0x20, 0xf4, 0xf5, 0xac, 0xa5, 0x46, 0x41, 0x93, 0xca, 0x86, 0x26, 0x1f, 0x01,
-	0xc4, 0x4a, 0x48, 0x35, 0x71, 0x85, 0x42, 0x52, 0xac, 0xf1, 0x4e, 0x77, 0x90, 0xee, 0xed, 0x20,
-	0xdb, 0xdb, 0xc1, 0x69, 0xb6, 0xb7, 0xe3, 0x3a, 0x79, 0x7f, 0x2a, 0x14, 0xf2, 0xf7, 0xa1, 0x86,
-	0x81, 0x9b, 0x06, 0x56, 0x5f, 0x1a, 0x58, 0xc5, 0xc0, 0xa5, 0xb0, 0x4f, 0xa0, 0x95, 0xb4, 0x23,
-	0x12, 0x2d, 0x28, 0xb6, 0xf6, 0xd2, 0xd8, 0x66, 0x16, 0x40, 0x09, 0x38, 0x94, 0xc3, 0x08, 0x03,
-	0xab, 0x7e, 0xc8, 0x8e, 0x6a, 0x63, 0x3a, 0x8f, 0x1e, 0xd2, 0xbb, 0x7d, 0xb6, 0x7a, 0xb7, 0x86,
-	0x56, 0x82, 0x9e, 0xae, 0x53, 0xd0, 0xcd, 0x2c, 0xf1, 0xf6, 0x7a, 0xd7, 0xa6, 0xc1, 0x21, 0x13,
-	0xdc, 0x2c, 0x5b, 0x15, 0xfb, 0x5b, 0x06, 0x2d, 0xda, 0x95, 0x2f, 0xf1, 0x9b, 0x45, 0xf2, 0x66,
-	0xcf, 0x59, 0x55, 0xb6, 0x7d, 0x55, 0xf9, 0xeb, 0xd0, 0x0a, 0xf0, 0x52, 0x4d, 0x62, 0x1d, 0x4e,
-	0xaf, 0x58, 0x1e, 0x37, 0x13, 0x30, 0x4b, 0x39, 0xea, 0x11, 0x63, 0x0b, 0xf6, 0xb7, 0xa6, 0xde,
-	0xb5, 0xcf, 0xa1, 0xa3, 0x97, 0x29, 0x67, 0xf1, 0xc2, 0x9d, 0xbd, 0x51, 0xd1, 0x5b, 0x54, 0xb4,
-	0x03, 0x8d, 0xf5, 0x4c, 0x55, 0x3b, 0x80, 0x16, 0x8d, 0x62, 0x5e, 0x69, 0x63, 0xd0, 0xd8, 0xbf,
-	0x06, 0xed, 0x46, 0xd5, 0xfe, 0x4f, 0xd5, 0xf6, 0xa0, 0x55, 0xcc, 0x56, 0xb3, 0xff, 0x66, 0xd0,
-	0xa4, 0x82, 0x27, 0x62, 0x2e, 0x74, 0x67, 0xd3, 0xc4, 0x5e, 0xef, 0x8c, 0x80, 0xa4, 0x96, 0x05,
-	0x55, 0xe1, 0xba, 0x12, 0xe3, 0x58, 0xaf, 0x43, 0x66, 0xf2, 0xbb, 0xd0, 0x51, 0x52, 0xb8, 0x62,
-	0x3a, 0xc7, 0x89, 0xf0, 0xc3, 0x45, 0x90, 0xfd, 0x04, 0xb4, 0x33, 0xf8, 0x1e, 0xa1, 0xfc, 0x4d,
-	0x68, 0x4b, 0x54, 0x9e, 0x44, 0x37, 0xf3, 0x4b, 0x17, 0xa4, 0xa5, 0x51, 0xed, 0x76, 0x17, 0x3a,
-	0x18, 0x3b, 0x32, 0x7c, 0xba, 0xf2, 0x4b, 0xf7, 0xa5, 0x9d, 0xc1, 0xa9, 0xe3, 0xe8, 0x3d, 0xea,
-	0x6c, 0x00, 0xff, 0x83, 0x3d, 0xcd, 0xa5, 0x9f, 0xf3, 0xe7, 0xb7, 0x60, 0x2f, 0x37, 0xfa, 0xfa,
-	0xda, 0x64, 0x56, 0xdd, 0xfe, 0x85, 0x41, 0x23, 0xd5, 0x79, 0x11, 0x45, 0xf3, 0xe5, 0x8b, 0xbb,
-	0xde, 0xd2, 0x5b, 0xe9, 0x86, 0xbd, 0x19, 0xdb, 0x7a, 0x7b, 0x0b, 0x4c, 0x27, 0xd1, 0x7a, 0x3e,
-	0xdf, 0x14, 0xa1, 0x93, 0xe3, 0xba, 0xbb, 0xb5, 0x29, 0x59, 0xf1, 0x03, 0xfb, 0x73, 0x3d, 0x25,
-	0x5f, 0x48, 0x6f, 0xe6, 0x05, 0xa7, 0x97, 0xfa, 0x93, 0xce, 0xf2, 0x4f, 0xfa, 0x01, 0xec, 0xc6,
-	0xe1, 0x42, 0x3a, 0xd9, 0x8f, 0xb3, 0xb6, 0xd6, 0xf3, 0x79, 0x6e, 0x5f, 0xbb, 0x34, 0x4e, 0x1e,
-	0xfd, 0x7a, 0xd5, 0x63, 0xcf, 0xae, 0x7a, 0xec, 0xcf, 0xab, 0x1e, 0xfb, 0xfe, 0xba, 0xb7, 0xf3,
-	0xec, 0xba, 0xb7, 0xf3, 0xc7, 0x75, 0x6f, 0xe7, 0xab, 0x0f, 0x66, 0x9e, 0x3a, 0x5b, 0x4c, 0x07,
-	0x4e, 0xe8, 0x0f, 0xe9, 0x5f, 0xc7, 0xdb, 0x01, 0xaa, 0xa7, 0xa1, 0x7c, 0xa2, 0xad, 0x39, 0xba,
-	0x33, 0x94, 0xc3, 0xcb, 0xb5, 0x3f, 0x2b, 0x4e, 0x28, 0x71, 0xba, 0x4b, 0xdf, 0x8e, 0x77, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x95, 0x99, 0x65, 0xcb, 0x08, 0x00, 0x00,
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x92, 0xdb, 0x44,
+	0x10, 0xce, 0x58, 0x96, 0x7f, 0xda, 0x7f, 0xda, 0x61, 0xb3, 0x88, 0x4d, 0x70, 0x36, 0x02, 0x2a,
+	0x4b, 0xd5, 0x62, 0xd7, 0xf2, 0x57, 0xc1, 0x54, 0x41, 0x65, 0x03, 0x45, 0xa5, 0x72, 0x20, 0xe5,
+	0xe4, 0xc4, 0xc5, 0x8c, 0xa5, 0xd9, 0x5d, 0x25, 0x96, 0x64, 0x46, 0xe3, 0x8d, 0x7d, 0xe5, 0xc4,
+	0x09, 0x78, 0x00, 0x0a, 0x1e, 0x80, 0x47, 0x80, 0x07, 0xe0, 0x98, 0x2a, 0x0e, 0x70, 0xa4, 0x92,
+	0x37, 0xa0, 0xb8, 0x70, 0xa3, 0xd4, 0x33, 0x92, 0x25, 0xc7, 0x9b, 0xdd, 0xe5, 0xe6, 0x6e, 0x75,
+	0xf7, 0x7c, 0xdf, 0xd7, 0x3d, 0x3f, 0x86, 0xae, 0xe0, 0x47, 0x3c, 0xec, 0x73, 0x37, 0x72, 0x05,
+	0xf7, 0x7c, 0xd9, 0x3f, 0xd9, 0xef, 0xc7, 0x92, 0x49, 0xde, 0x9b, 0x8a, 0x48, 0x46, 0x94, 0xe2,
+	0xf7, 0x5e, 0xf6, 0xbd, 0x77, 0xb2, 0xbf, 0xfd, 0xaa, 0x1b, 0xc5, 0x41, 0x14, 0xf7, 0x23, 0x11,
+	0xf4, 0x4f, 0xf6, 0xd9, 0x64, 0x7a, 0xcc, 0xf6, 0x13, 0x43, 0xa5, 0x6c, 0x5f, 0x3b, 0x8a, 0xa2,
+	0xa3, 0x09, 0xef, 0xa3, 0x35, 0x9e, 0x1d, 0xf6, 0xa5, 0x1f, 0xf0, 0x58, 0xb2, 0x60, 0xaa, 0x02,
+	0x9c, 0x1f, 0x08, 0xc0, 0x6d, 0xac, 0xf6, 0x60, 0x31, 0xe5, 0xd4, 0x81, 0x26, 0x1b, 0x8f, 0x05,
+	0x3f, 0xf1, 0x99, 0xf4, 0xa3, 0xd0, 0x26, 0x3b, 0x64, 0xb7, 0x3e, 0x2c, 0xf8, 0x28, 0x85, 0x72,
+	0xc8, 0x02, 0x6e, 0x97, 0xf0, 0x1b, 0xfe, 0x4e, 0x7c, 0xb3, 0xd0, 0x97, 0xb6, 0xa1, 0x7c, 0xc9,
+	0x6f, 0x7a, 0x15, 0xea, 0x53, 0xc1, 0x5d, 0x3f, 0x4e, 0x0a, 0x95, 0x77, 0xc8, 0x6e, 0x6b, 0xb8,
+	0x74, 0x0c, 0x5e, 0xff, 0xfb, 0xc7, 0xdf, 0xbf, 0x35, 0xba, 0xd0, 0x2e, 0xae, 0x48, 0x41, 0x55,
+	0xb7, 0x88, 0x4d, 0x6c, 0xe2, 0x7c, 0x63, 0x80, 0x79, 0x7b, 0xc2, 0xe2, 0x98, 0x5a, 0x60, 0x3c,
+	0xe2, 0x0b, 0x04, 0x54, 0x1e, 0x26, 0x3f, 0x69, 0x1b, 0x4a, 0xbe, 0xa7, 0x51, 0x94, 0x7c, 0x8f,
+	0x6e, 0x82, 0xc9, 0xbc, 0xc0, 0x0f, 0x11, 0x44, 0x73, 0xa8, 0x0c, 0xba, 0x0d, 0xb5, 0x80, 0x4b,
+	0xe6, 0x31, 0xc9, 0x10, 0x44, 0x7d, 0x98, 0xd9, 0x74, 0x0f, 0xa8, 0x52, 0x72, 0x24, 0x17, 0x53,
+	0x3e, 0x52, 0x38, 0x6c, 0x13, 0xa3, 0x2c, 0x37, 0x53, 0xe5, 0x16, 0xfa, 0xe9, 0x5d, 0xd8, 0x0c,
+	0xfc, 0x70, 0x34, 0x66, 0xd2, 0x3d, 0x1e, 0xc5, 0x92, 0x09, 0x39, 0xf2, 0x98, 0xe4, 0x76, 0x65,
+	0x87, 0xec, 0x36, 0xde, 0xde, 0xee, 0x29, 0xa9, 0x7b, 0xa9, 0xd4, 0xbd, 0x07, 0xa9, 0xd4, 0xc3,
+	0x8d, 0xc0, 0x0f, 0x0f, 0x92, 0xb4, 0xfb, 0x49, 0xd6, 0x27, 0x4c, 0x72, 0xfa, 0x19, 0xd0, 0x80,
+	0xcd, 0x75, 0x31, 0x1e, 0x7a, 0xaa, 0x54, 0xf5, 0xcc, 0x52, 0x9d, 0x80, 0xcd, 0xb1, 0xd4, 0xa7,
+	0xa1, 0x87, 0x85, 0x6e, 0x40, 0x27, 0xe5, 0x33, 0x8a, 0xdd, 0x63, 0x1e, 0x30, 0xbb, 0x86, 0x04,
+	0xda, 0xa9, 0xfb, 0x3e, 0x7a, 0x07, 0x1f, 0xa1, 0xe0, 0x37, 0xa1, 0x8a, 0x42, 0x5a, 0x84, 0xd6,
+	0x12, 0xfd, 0x12, 0x9d, 0x69, 0x5d, 0x2b, 0x67, 0x95, 0xe8, 0xd6, 0x3a, 0x49, 0x2c, 0xc3, 0x2e,
+	0x39, 0x5f, 0x42, 0x03, 0x3b, 0x71, 0x27, 0x8e, 0x67, 0x5c, 0xd0, 0x2b, 0x50, 0x77, 0x13, 0x73,
+	0xb4, 0xec, 0x4a, 0x0d, 0x1d, 0x77, 0xf9, 0x82, 0x6e, 0x41, 0xc5, 0xc7, 0x30, 0x6c, 0x4f, 0x73,
+	0xa8, 0xad, 0xc1, 0x55, 0xc4, 0xb0, 0x05, 0x14, 0xac, 0x2c, 0x79, 0x4f, 0x47, 0x1a, 0xce, 0xcf,
+	0x25, 0xa8, 0xde, 0x13, 0xd1, 0x43, 0xee, 0xca, 0xff, 0xdd, 0xee, 0x02, 0xac, 0xf2, 0x0a, 0x2c,
+	0x07, 0x9a, 0x0f, 0x67, 0xc2, 0x8f, 0x3d, 0xdf, 0xc5, 0xe9, 0x56, 0x9d, 0x2e, 0xf8, 0x0a, 0xf3,
+	0x52, 0x59, 0x99, 0x97, 0xeb, 0xd0, 0x14, 0xfc, 0x90, 0x0b, 0x1e, 0xba, 0x7c, 0xe4, 0x7b, 0xd8,
+	0xae, 0xfa, 0xb0, 0x91, 0xf9, 0xee, 0x78, 0x83, 0x63, 0x64, 0x38, 0x5e, 0xa7, 0x32, 0x85, 0x66,
+	0x8e, 0xb4, 0x67, 0x95, 0xf2, 0xca, 0x1b, 0xd4, 0x2a, 0x16, 0xb7, 0xca, 0x74, 0x1b, 0xb6, 0x96,
+	0x09, 0x85, 0x6f, 0xa6, 0x5d, 0x76, 0xfe, 0x35, 0xc0, 0xc4, 0x49, 0x58, 0xa3, 0xd5, 0x29, 0xfa,
+	0xd3, 0x6b, 0xd0, 0x98, 0x2a, 0x81, 0x51, 0x1f, 0x03, 0x33, 0x40, 0xbb, 0x12, 0x85, 0x36, 0xc1,
+	0xf4, 0x78, 0x18, 0x05, 0x7a, 0xab, 0x28, 0xa3, 0xa0, 0x89, 0xb9, 0xa2, 0xc9, 0x07, 0x00, 0x17,
+	0xda, 0x0b, 0xf5, 0x38, 0xdb, 0x03, 0xef, 0x41, 0xed, 0x02, 0x93, 0x5f, 0xe5, 0x7a, 0xe2, 0x3f,
+	0x86, 0x56, 0x42, 0x87, 0x25, 0x5a, 0x60, 0x6e, 0xed, 0xcc, 0xdc, 0x66, 0x9a, 0x80, 0x05, 0x28,
+	0x94, 0xa3, 0x29, 0x0f, 0xed, 0xfa, 0x0e, 0xd9, 0xad, 0x0d, 0xf1, 0x37, 0xfd, 0x10, 0x1a, 0x7c,
+	0x3e, 0xf5, 0xc5, 0x42, 0x95, 0x84, 0x33, 0x4b, 0x82, 0x0a, 0x4f, 0x0a, 0x0e, 0x22, 0x6c, 0xba,
+	0xbf, 0x6c, 0x7a, 0x43, 0xcb, 0x88, 0x7d, 0xef, 0x14, 0x44, 0xb7, 0x4a, 0xb4, 0x9d, 0x97, 0xcc,
+	0x32, 0x28, 0xa4, 0xdd, 0xb2, 0xca, 0x49, 0x70, 0x0e, 0x87, 0x65, 0xd2, 0x8d, 0x15, 0xb6, 0x56,
+	0xc5, 0x36, 0x9d, 0xaf, 0x09, 0xb4, 0x70, 0x33, 0xde, 0xe7, 0x5f, 0xcd, 0x92, 0xa1, 0x38, 0xe5,
+	0x28, 0x23, 0xa7, 0x1c, 0x65, 0xaf, 0x41, 0x2b, 0xe4, 0x73, 0x39, 0x8a, 0x75, 0x3a, 0x8e, 0x49,
+	0x79, 0xd8, 0x4c, 0x9c, 0x69, 0xc9, 0x41, 0x17, 0x59, 0xd9, 0xb0, 0xb9, 0xb6, 0x74, 0xc5, 0x79,
+	0x08, 0x1d, 0xbd, 0x5b, 0x33, 0x14, 0x2f, 0x3c, 0x14, 0xce, 0xb5, 0xe8, 0x65, 0x5c, 0xb4, 0x03,
+	0x8d, 0x7c, 0xa5, 0xaa, 0x13, 0x42, 0x4b, 0x1d, 0xa0, 0xe9, 0x4a, 0x2b, 0x93, 0x4c, 0x9e, 0x9b,
+	0xe4, 0x73, 0xad, 0xf6, 0x32, 0xae, 0xb6, 0x01, 0xad, 0x62, 0xb5, 0x9a, 0xf3, 0x0f, 0x81, 0x26,
+	0x2e, 0x78, 0xc0, 0x26, 0x4c, 0x33, 0x53, 0x67, 0x75, 0x8e, 0x19, 0x3a, 0x92, 0xb5, 0x6c, 0xa8,
+	0x32, 0xcf, 0x13, 0x3c, 0x8e, 0xf5, 0x7e, 0x4b, 0xcd, 0xe4, 0x74, 0x96, 0x82, 0x79, 0x6c, 0x3c,
+	0xe1, 0x23, 0x16, 0x44, 0xb3, 0x30, 0xbd, 0x22, 0xdb, 0xa9, 0xfb, 0x16, 0x7a, 0xe9, 0x1b, 0xd0,
+	0x16, 0x5c, 0xfa, 0x82, 0x7b, 0x69, 0x9c, 0xda, 0x81, 0x2d, 0xed, 0xd5, 0x61, 0x37, 0xa0, 0xc3,
+	0x63, 0x57, 0x44, 0x8f, 0x97, 0x71, 0x6a, 0x43, 0xb6, 0x53, 0xb7, 0x0a, 0x1c, 0xbc, 0x8b, 0xcc,
+	0x7a, 0xf0, 0x12, 0x6c, 0x68, 0x2c, 0x7b, 0x19, 0x7e, 0x7a, 0x19, 0x36, 0x32, 0x63, 0x4f, 0x7f,
+	0xb6, 0x88, 0x5d, 0x77, 0x7e, 0x25, 0xd0, 0x50, 0x3a, 0xcf, 0xa6, 0xd3, 0xc9, 0xe2, 0xc5, 0xac,
+	0xd7, 0x70, 0x2b, 0x9d, 0x93, 0x9b, 0xb1, 0x8e, 0xdb, 0x9b, 0x60, 0xb9, 0x89, 0xd6, 0x93, 0xc9,
+	0xaa, 0x08, 0x9d, 0xcc, 0xaf, 0xd9, 0xe5, 0xa6, 0x64, 0x89, 0x0f, 0x9c, 0x5f, 0x88, 0x1e, 0x93,
+	0xcf, 0x85, 0x7f, 0xe4, 0x87, 0x0f, 0xe6, 0xfa, 0xd2, 0x20, 0xd9, 0xa5, 0xb1, 0x05, 0x95, 0x38,
+	0x9a, 0x09, 0x37, 0x7d, 0xbd, 0x68, 0xab, 0x48, 0xd4, 0x38, 0x9b, 0x68, 0xf9, 0x9c, 0x44, 0xcd,
+	0x35, 0x44, 0xf3, 0xe8, 0x7d, 0x6f, 0x4f, 0xe3, 0x69, 0x38, 0x3f, 0xa5, 0xe2, 0x1f, 0xcc, 0x0e,
+	0x0f, 0xd5, 0x0d, 0x7b, 0xba, 0xf8, 0x85, 0x9d, 0x56, 0x5a, 0xd9, 0x69, 0xb9, 0x79, 0x34, 0x8a,
+	0xf3, 0xb8, 0x05, 0x95, 0x02, 0x03, 0x6d, 0x65, 0xaf, 0xb1, 0xbc, 0xa0, 0xb4, 0x95, 0x5b, 0xc3,
+	0x22, 0x76, 0xd3, 0xf9, 0x83, 0x40, 0x07, 0x11, 0xde, 0x0a, 0xc3, 0x48, 0xaa, 0xf7, 0xda, 0x52,
+	0xe1, 0x32, 0x2a, 0x5c, 0x40, 0x5d, 0x5a, 0x41, 0x7d, 0x15, 0xea, 0x4c, 0xa5, 0x46, 0x42, 0x43,
+	0x5b, 0x3a, 0x68, 0x17, 0x80, 0x65, 0x85, 0x35, 0xc0, 0x9c, 0x87, 0xde, 0x84, 0x7a, 0xf6, 0x7c,
+	0x45, 0x65, 0xcf, 0xb8, 0x69, 0xb2, 0xe0, 0xc1, 0x35, 0xa4, 0xf7, 0x0a, 0x54, 0xd4, 0x65, 0x9c,
+	0x30, 0xcb, 0x40, 0x5a, 0xc4, 0x6e, 0x39, 0xdf, 0x95, 0x34, 0xb3, 0x21, 0x76, 0x2a, 0xe0, 0xa1,
+	0xbc, 0x18, 0xb3, 0x4d, 0x30, 0xa3, 0xc7, 0x21, 0x4f, 0x59, 0x29, 0x83, 0xee, 0x40, 0x63, 0xcc,
+	0x43, 0x7e, 0xe8, 0xbb, 0x3e, 0x13, 0x0b, 0x4d, 0x29, 0xef, 0xca, 0x35, 0xc4, 0xcc, 0x37, 0xe4,
+	0xb9, 0xa7, 0x4a, 0x65, 0xcd, 0x53, 0xa5, 0xa0, 0x47, 0xf5, 0x22, 0x7a, 0x5c, 0x47, 0x3d, 0xae,
+	0x64, 0x7a, 0x74, 0x0a, 0x38, 0x2d, 0x62, 0xb7, 0x0f, 0xee, 0xfd, 0xf6, 0xb4, 0x4b, 0x9e, 0x3c,
+	0xed, 0x92, 0xbf, 0x9e, 0x76, 0xc9, 0xf7, 0xcf, 0xba, 0x97, 0x9e, 0x3c, 0xeb, 0x5e, 0xfa, 0xf3,
+	0x59, 0xf7, 0xd2, 0x17, 0xef, 0x1f, 0xf9, 0xf2, 0x78, 0x36, 0xee, 0xb9, 0x51, 0xd0, 0xc7, 0x7f,
+	0x24, 0x6f, 0x85, 0x5c, 0x3e, 0x8e, 0xc4, 0x23, 0x6d, 0x4d, 0xb8, 0x77, 0xc4, 0x45, 0x7f, 0x9e,
+	0xfb, 0x23, 0xe3, 0x46, 0x82, 0x8f, 0x2b, 0x88, 0xe9, 0x9d, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x7e, 0x5b, 0x11, 0x2b, 0xe7, 0x0c, 0x00, 0x00,
 }
 
 func (m *CreditType) Marshal() (dAtA []byte, err error) {
@@ -1022,6 +1385,37 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataSchema) > 0 {
+		i -= len(m.MetadataSchema)
+		copy(dAtA[i:], m.MetadataSchema)
+		i = encodeVarintState(dAtA, i, uint64(len(m.MetadataSchema)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxBatchEndDate != nil {
+		{
+			size, err := m.MaxBatchEndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MinBatchStartDate != nil {
+		{
+			size, err := m.MinBatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.CreditTypeAbbrev) > 0 {
 		i -= len(m.CreditTypeAbbrev)
 		copy(dAtA[i:], m.CreditTypeAbbrev)
@@ -1181,6 +1575,18 @@ func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryDate != nil {
+		{
+			size, err := m.ExpiryDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Open {
 		i--
 		if m.Open {
@@ -1487,6 +1893,25 @@ func (m *BatchOriginTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Source) > 0 {
 		i -= len(m.Source)
 		copy(dAtA[i:], m.Source)
@@ -1504,22 +1929,201 @@ func (m *BatchOriginTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreditType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *BatchBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAnnotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAnnotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Annotator) > 0 {
+		i -= len(m.Annotator)
+		copy(dAtA[i:], m.Annotator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Annotator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchRetirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRetirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchRetirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Abbreviation)
 	if l > 0 {
@@ -1564,6 +2168,18 @@ func (m *Class) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.MinBatchStartDate != nil {
+		l = m.MinBatchStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxBatchEndDate != nil {
+		l = m.MaxBatchEndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.MetadataSchema)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
@@ -1657,6 +2273,10 @@ func (m *Batch) Size() (n int) {
 	if m.Open {
 		n += 2
 	}
+	if m.ExpiryDate != nil {
+		l = m.ExpiryDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
@@ -1772,6 +2392,102 @@ func (m *BatchOriginTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Annotator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchRetirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
@@ -2124,27 +2840,131 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			}
 			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchStartDate", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinBatchStartDate == nil {
+				m.MinBatchStartDate = &types.Timestamp{}
+			}
+			if err := m.MinBatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBatchEndDate == nil {
+				m.MaxBatchEndDate = &types.Timestamp{}
+			}
+			if err := m.MaxBatchEndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2791,6 +3611,42 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Open = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryDate == nil {
+				m.ExpiryDate = &types.Timestamp{}
+			}
+			if err := m.ExpiryDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3546,6 +4402,687 @@ func (m *BatchOriginTx) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAnnotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAnnotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotator = append(m.Annotator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Annotator == nil {
+				m.Annotator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRetirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRetirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRetirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -45,6 +45,26 @@ type Params struct {
 	// allowed_class_creators can create credit classes. When set to false, any
 	// address can create credit classes.
 	AllowlistEnabled bool `protobuf:"varint,4,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
+	// class_fee_discounts is a list of credit class creators that are charged a
+	// reduced credit class fee. The credit class fee charged to a listed creator
+	// is the credit class fee multiplied by the creator's multiplier.
+	ClassFeeDiscounts []*ClassFeeDiscount `protobuf:"bytes,5,rep,name=class_fee_discounts,json=classFeeDiscounts,proto3" json:"class_fee_discounts,omitempty"`
+	// class_buffer_pools is a list of credit classes that allocate a percentage
+	// of the tradable credits issued in each new credit batch to a buffer pool.
+	ClassBufferPools []*ClassBufferPool `protobuf:"bytes,6,rep,name=class_buffer_pools,json=classBufferPools,proto3" json:"class_buffer_pools,omitempty"`
+	// min_retirement_amount is a non-negative decimal defining the minimum
+	// amount of credits that can be retired from a credit batch in a single
+	// retirement. A value of zero (the default) allows retirements of any
+	// amount.
+	MinRetirementAmount string `protobuf:"bytes,7,opt,name=min_retirement_amount,json=minRetirementAmount,proto3" json:"min_retirement_amount,omitempty"`
+	// gas_cost_per_iteration is the amount of gas charged for each credit
+	// iterated over when sending, retiring, or cancelling credits. It must be
+	// greater than zero.
+	GasCostPerIteration uint64 `protobuf:"varint,8,opt,name=gas_cost_per_iteration,json=gasCostPerIteration,proto3" json:"gas_cost_per_iteration,omitempty"`
+	// max_credits_per_msg is the maximum number of credits that can be sent,
+	// retired, or cancelled in a single MsgSend, MsgRetire, or MsgCancel. It
+	// must be greater than zero.
+	MaxCreditsPerMsg uint64 `protobuf:"varint,9,opt,name=max_credits_per_msg,json=maxCreditsPerMsg,proto3" json:"max_credits_per_msg,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -108,6 +128,167 @@ func (m *Params) GetAllowlistEnabled() bool {
 	return false
 }
 
+func (m *Params) GetClassFeeDiscounts() []*ClassFeeDiscount {
+	if m != nil {
+		return m.ClassFeeDiscounts
+	}
+	return nil
+}
+
+func (m *Params) GetClassBufferPools() []*ClassBufferPool {
+	if m != nil {
+		return m.ClassBufferPools
+	}
+	return nil
+}
+
+func (m *Params) GetMinRetirementAmount() string {
+	if m != nil {
+		return m.MinRetirementAmount
+	}
+	return ""
+}
+
+func (m *Params) GetGasCostPerIteration() uint64 {
+	if m != nil {
+		return m.GasCostPerIteration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCreditsPerMsg() uint64 {
+	if m != nil {
+		return m.MaxCreditsPerMsg
+	}
+	return 0
+}
+
+// ClassFeeDiscount defines a reduced credit class fee for a credit class
+// creator.
+type ClassFeeDiscount struct {
+	// address is the address of the credit class creator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// multiplier is a decimal in the range [0, 1] that the credit class fee is
+	// multiplied by when the address creates a credit class (e.g. "0.5" for a
+	// 50% discount or "0" for a full waiver).
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (m *ClassFeeDiscount) Reset()         { *m = ClassFeeDiscount{} }
+func (m *ClassFeeDiscount) String() string { return proto.CompactTextString(m) }
+func (*ClassFeeDiscount) ProtoMessage()    {}
+func (*ClassFeeDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{1}
+}
+func (m *ClassFeeDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassFeeDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassFeeDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassFeeDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassFeeDiscount.Merge(m, src)
+}
+func (m *ClassFeeDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassFeeDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassFeeDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassFeeDiscount proto.InternalMessageInfo
+
+func (m *ClassFeeDiscount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClassFeeDiscount) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
+// ClassBufferPool defines the buffer pool of a credit class. When a credit
+// batch is created within the credit class, the percentage of the tradable
+// credits issued to each recipient is allocated to the buffer pool address as
+// retired credits.
+type ClassBufferPool struct {
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// address is the address of the buffer pool account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// percentage is a decimal in the range [0, 1) defining the fraction of the
+	// tradable credits issued that are allocated to the buffer pool.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (m *ClassBufferPool) Reset()         { *m = ClassBufferPool{} }
+func (m *ClassBufferPool) String() string { return proto.CompactTextString(m) }
+func (*ClassBufferPool) ProtoMessage()    {}
+func (*ClassBufferPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{2}
+}
+func (m *ClassBufferPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassBufferPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassBufferPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassBufferPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassBufferPool.Merge(m, src)
+}
+func (m *ClassBufferPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassBufferPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassBufferPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassBufferPool proto.InternalMessageInfo
+
+func (m *ClassBufferPool) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassBufferPool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClassBufferPool) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
 // Credits represents a simple structure for credits.
 type Credits struct {
 	// batch_denom is the denom of the credit batch.
@@ -120,7 +301,7 @@ func (m *Credits) Reset()         { *m = Credits{} }
 func (m *Credits) String() string { return proto.CompactTextString(m) }
 func (*Credits) ProtoMessage()    {}
 func (*Credits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{1}
+	return fileDescriptor_7b044b6b740b984f, []int{3}
 }
 func (m *Credits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +371,7 @@ func (m *BatchIssuance) Reset()         { *m = BatchIssuance{} }
 func (m *BatchIssuance) String() string { return proto.CompactTextString(m) }
 func (*BatchIssuance) ProtoMessage()    {}
 func (*BatchIssuance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{2}
+	return fileDescriptor_7b044b6b740b984f, []int{4}
 }
 func (m *BatchIssuance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +452,7 @@ func (m *OriginTx) Reset()         { *m = OriginTx{} }
 func (m *OriginTx) String() string { return proto.CompactTextString(m) }
 func (*OriginTx) ProtoMessage()    {}
 func (*OriginTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{3}
+	return fileDescriptor_7b044b6b740b984f, []int{5}
 }
 func (m *OriginTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +523,7 @@ type CreditTypeProposal struct {
 func (m *CreditTypeProposal) Reset()      { *m = CreditTypeProposal{} }
 func (*CreditTypeProposal) ProtoMessage() {}
 func (*CreditTypeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{4}
+	return fileDescriptor_7b044b6b740b984f, []int{6}
 }
 func (m *CreditTypeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +575,8 @@ func (m *CreditTypeProposal) GetCreditType() *CreditType {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "regen.ecocredit.v1.Params")
+	proto.RegisterType((*ClassFeeDiscount)(nil), "regen.ecocredit.v1.ClassFeeDiscount")
+	proto.RegisterType((*ClassBufferPool)(nil), "regen.ecocredit.v1.ClassBufferPool")
 	proto.RegisterType((*Credits)(nil), "regen.ecocredit.v1.Credits")
 	proto.RegisterType((*BatchIssuance)(nil), "regen.ecocredit.v1.BatchIssuance")
 	proto.RegisterType((*OriginTx)(nil), "regen.ecocredit.v1.OriginTx")
@@ -403,45 +586,58 @@ func init() {
 func init() { proto.RegisterFile("regen/ecocredit/v1/types.proto", fileDescriptor_7b044b6b740b984f) }
 
 var fileDescriptor_7b044b6b740b984f = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xb3, 0x49, 0x29, 0xcd, 0x44, 0x0d, 0xc5, 0xaa, 0x4a, 0xa8, 0xd0, 0x26, 0x8a, 0x84,
-	0x88, 0x84, 0xba, 0x4b, 0x0a, 0x02, 0x89, 0x0b, 0x22, 0x01, 0x24, 0xb8, 0x50, 0x45, 0x3d, 0x71,
-	0x89, 0xbc, 0xf6, 0x90, 0xba, 0xdd, 0xd8, 0x91, 0xed, 0xf4, 0xcf, 0x5b, 0x20, 0x71, 0xe1, 0xc8,
-	0x99, 0x07, 0xe0, 0x19, 0x7a, 0x42, 0x3d, 0x72, 0x02, 0xd4, 0xbe, 0x08, 0x5a, 0xdb, 0xf9, 0x83,
-	0xe0, 0xc8, 0x29, 0x9e, 0xef, 0x9b, 0xcc, 0xef, 0x9b, 0x95, 0x0d, 0xb1, 0xc6, 0x11, 0xca, 0x14,
-	0x99, 0x62, 0x1a, 0xb9, 0xb0, 0xe9, 0x71, 0x37, 0xb5, 0x67, 0x13, 0x34, 0xc9, 0x44, 0x2b, 0xab,
-	0x08, 0x71, 0x7e, 0x32, 0xf7, 0x93, 0xe3, 0xee, 0xf6, 0xe6, 0x48, 0x8d, 0x94, 0xb3, 0xd3, 0xe2,
-	0xe4, 0x3b, 0xb7, 0x63, 0xa6, 0xcc, 0x58, 0x99, 0x34, 0xa3, 0x06, 0xd3, 0xe3, 0x6e, 0x86, 0x96,
-	0x76, 0x53, 0xa6, 0x84, 0x9c, 0xf9, 0xff, 0x20, 0x19, 0x4b, 0x2d, 0x7a, 0xbf, 0xfd, 0xad, 0x0c,
-	0xab, 0x7b, 0x54, 0xd3, 0xb1, 0x21, 0x53, 0xd8, 0xf0, 0x3d, 0x43, 0x96, 0x53, 0x63, 0x86, 0xef,
-	0x11, 0x1b, 0x51, 0xab, 0xd2, 0xa9, 0xed, 0xde, 0x4e, 0x3c, 0x25, 0x29, 0x28, 0x49, 0xa0, 0x24,
-	0x7d, 0x25, 0x64, 0xef, 0xc1, 0xf9, 0x8f, 0x66, 0xe9, 0xcb, 0xcf, 0x66, 0x67, 0x24, 0xec, 0xc1,
-	0x34, 0x4b, 0x98, 0x1a, 0xa7, 0x21, 0x92, 0xff, 0xd9, 0x31, 0xfc, 0x28, 0xec, 0x56, 0xfc, 0xc1,
-	0x0c, 0xea, 0x1e, 0xd2, 0x2f, 0x18, 0xaf, 0x10, 0xc9, 0x21, 0x40, 0x46, 0xcd, 0x11, 0x5a, 0x07,
-	0x2c, 0xff, 0x7f, 0x60, 0xd5, 0x8f, 0x2f, 0x58, 0x8f, 0x60, 0x8b, 0xe6, 0xb9, 0x3a, 0x41, 0x1e,
-	0x76, 0x64, 0x1a, 0xa9, 0x55, 0xda, 0x34, 0x2a, 0xad, 0x4a, 0xa7, 0x3a, 0xd8, 0x0c, 0xae, 0x0b,
-	0xd7, 0x0f, 0x1e, 0xb9, 0x0f, 0x37, 0x9d, 0x9e, 0x0b, 0x63, 0x87, 0x28, 0x69, 0x96, 0x23, 0x6f,
-	0xac, 0xb4, 0xa2, 0xce, 0xda, 0x60, 0x63, 0x6e, 0xbc, 0xf4, 0x7a, 0xbb, 0x07, 0xd7, 0xfb, 0x6e,
-	0x41, 0x43, 0x9a, 0x50, 0xcb, 0xa8, 0x65, 0x07, 0x43, 0x8e, 0x52, 0x8d, 0x1b, 0x51, 0x2b, 0xea,
-	0x54, 0x07, 0xe0, 0xa4, 0x17, 0x85, 0x42, 0xb6, 0x60, 0x95, 0x8e, 0xd5, 0x54, 0xda, 0x46, 0xd9,
-	0x79, 0xa1, 0x6a, 0x7f, 0x8d, 0x60, 0xbd, 0x57, 0xb4, 0xbd, 0x36, 0x66, 0x4a, 0x25, 0x43, 0x72,
-	0x07, 0xaa, 0x1a, 0x99, 0x98, 0x08, 0x94, 0x36, 0x0c, 0x5a, 0x08, 0xe4, 0x1e, 0xdc, 0xb0, 0x9a,
-	0xf2, 0x22, 0xc0, 0xf0, 0x8f, 0x81, 0xf5, 0x99, 0xfc, 0xdc, 0xa9, 0xe4, 0x2e, 0xd4, 0x35, 0x5a,
-	0xa1, 0x91, 0xcf, 0xfa, 0x2a, 0xae, 0x6f, 0x3d, 0xa8, 0xa1, 0xed, 0x09, 0xdc, 0xf2, 0xc2, 0x18,
-	0xa5, 0x1d, 0x1e, 0x4e, 0xb5, 0x30, 0x5c, 0x30, 0x2b, 0x94, 0x74, 0x6b, 0x57, 0x07, 0x5b, 0x0b,
-	0xfb, 0xcd, 0x92, 0xdb, 0xce, 0x60, 0xed, 0xad, 0x16, 0x23, 0x21, 0xf7, 0x4f, 0x49, 0x1d, 0xca,
-	0x82, 0x87, 0xac, 0x65, 0xc1, 0x8b, 0x65, 0x8d, 0x9a, 0x6a, 0x86, 0xb3, 0x65, 0x7d, 0x45, 0xb6,
-	0x61, 0x8d, 0x29, 0x69, 0x35, 0x65, 0xb3, 0x34, 0xf3, 0x9a, 0x10, 0x58, 0x91, 0xca, 0x62, 0xa0,
-	0xba, 0x73, 0xfb, 0x63, 0x04, 0xc4, 0x7f, 0xe1, 0xfd, 0xb3, 0x09, 0xee, 0x69, 0x35, 0x51, 0x86,
-	0xe6, 0x64, 0x13, 0xae, 0x59, 0x61, 0x73, 0x0c, 0x44, 0x5f, 0x90, 0x16, 0xd4, 0x38, 0x1a, 0xa6,
-	0xc5, 0xc4, 0xa5, 0xf7, 0xe4, 0x65, 0x89, 0x3c, 0x83, 0x5a, 0xb8, 0xf5, 0xc5, 0x9d, 0x71, 0x09,
-	0x6a, 0xbb, 0x71, 0xf2, 0xf7, 0x03, 0x4c, 0x16, 0xd0, 0x01, 0xb0, 0xf9, 0xf9, 0xe9, 0xca, 0xa7,
-	0xcf, 0xcd, 0x52, 0x6f, 0xef, 0xfc, 0x32, 0x8e, 0x2e, 0x2e, 0xe3, 0xe8, 0xd7, 0x65, 0x1c, 0x7d,
-	0xb8, 0x8a, 0x4b, 0x17, 0x57, 0x71, 0xe9, 0xfb, 0x55, 0x5c, 0x7a, 0xf7, 0x78, 0xe9, 0xa2, 0xba,
-	0xa9, 0x3b, 0x12, 0xed, 0x89, 0xd2, 0x47, 0xa1, 0xca, 0x91, 0x8f, 0x50, 0xa7, 0xa7, 0x4b, 0x6f,
-	0x94, 0x29, 0x8d, 0xd9, 0xaa, 0x7b, 0xa0, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x71, 0x9b,
-	0x65, 0x9f, 0x2c, 0x04, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x34, 0x6d, 0x93, 0x17, 0x6d, 0x37, 0x3b, 0x2d, 0xc5, 0x5b, 0x21, 0x37, 0x0a,
+	0x20, 0x22, 0xa1, 0xda, 0xb4, 0x8b, 0x40, 0xe2, 0x82, 0x48, 0x16, 0xa4, 0x22, 0x10, 0xc1, 0xea,
+	0x89, 0x8b, 0x35, 0x1e, 0xbf, 0x7a, 0x67, 0x6b, 0x7b, 0xac, 0x99, 0x49, 0xb7, 0xfb, 0x29, 0x40,
+	0xe2, 0xc2, 0x91, 0x33, 0x1f, 0x80, 0xcf, 0xb0, 0xc7, 0x3d, 0x72, 0x02, 0xd4, 0x7e, 0x11, 0xe4,
+	0x99, 0x71, 0x9a, 0xed, 0x2e, 0xb7, 0x3d, 0xc5, 0xef, 0xff, 0x7f, 0x7e, 0xbf, 0xf7, 0xde, 0x38,
+	0x03, 0x81, 0xc4, 0x1c, 0xab, 0x08, 0x99, 0x60, 0x12, 0x33, 0xae, 0xa3, 0xcb, 0xe3, 0x48, 0x3f,
+	0xaf, 0x51, 0x85, 0xb5, 0x14, 0x5a, 0x10, 0x62, 0xfc, 0x70, 0xe5, 0x87, 0x97, 0xc7, 0x07, 0x7b,
+	0xb9, 0xc8, 0x85, 0xb1, 0xa3, 0xe6, 0xc9, 0x66, 0x1e, 0x04, 0x4c, 0xa8, 0x52, 0xa8, 0x28, 0xa5,
+	0x0a, 0xa3, 0xcb, 0xe3, 0x14, 0x35, 0x3d, 0x8e, 0x98, 0xe0, 0x55, 0xeb, 0xbf, 0x81, 0xa4, 0x34,
+	0xd5, 0x68, 0xfd, 0xc9, 0xcf, 0x9b, 0xb0, 0xb5, 0xa0, 0x92, 0x96, 0x8a, 0x2c, 0x61, 0x64, 0x73,
+	0x12, 0x56, 0x50, 0xa5, 0x92, 0x73, 0x44, 0xdf, 0x1b, 0x6f, 0x4c, 0x87, 0x27, 0x0f, 0x43, 0x4b,
+	0x09, 0x1b, 0x4a, 0xe8, 0x28, 0xe1, 0x5c, 0xf0, 0x6a, 0xf6, 0xc9, 0x8b, 0xbf, 0x0f, 0x3b, 0x7f,
+	0xfc, 0x73, 0x38, 0xcd, 0xb9, 0x7e, 0xb2, 0x4c, 0x43, 0x26, 0xca, 0xc8, 0xb5, 0x64, 0x7f, 0x8e,
+	0x54, 0x76, 0xe1, 0x66, 0x6b, 0x5e, 0x50, 0xf1, 0x8e, 0x85, 0xcc, 0x1b, 0xc6, 0x37, 0x88, 0xe4,
+	0x29, 0x40, 0x4a, 0xd5, 0x05, 0x6a, 0x03, 0xec, 0xbe, 0x7d, 0xe0, 0xc0, 0x96, 0x6f, 0x58, 0x9f,
+	0xc2, 0x3e, 0x2d, 0x0a, 0xf1, 0x0c, 0x33, 0x37, 0x23, 0x93, 0x48, 0xb5, 0x90, 0xca, 0xdf, 0x18,
+	0x6f, 0x4c, 0x07, 0xf1, 0x9e, 0x73, 0x4d, 0x73, 0x73, 0xe7, 0x91, 0x8f, 0xe1, 0x81, 0xd1, 0x0b,
+	0xae, 0x74, 0x82, 0x15, 0x4d, 0x0b, 0xcc, 0xfc, 0xde, 0xd8, 0x9b, 0xf6, 0xe3, 0xd1, 0xca, 0xf8,
+	0xda, 0xea, 0xe4, 0x0c, 0x76, 0x57, 0xeb, 0x4b, 0x32, 0xae, 0x98, 0x58, 0x56, 0x5a, 0xf9, 0x9b,
+	0x66, 0xae, 0x0f, 0xc2, 0xd7, 0x0f, 0x36, 0x6c, 0x37, 0xf1, 0xd8, 0x25, 0xc7, 0x0f, 0xd8, 0x1d,
+	0x45, 0x91, 0x1f, 0x81, 0xd8, 0xaa, 0xe9, 0xf2, 0xfc, 0x1c, 0x65, 0x52, 0x0b, 0x51, 0x28, 0x7f,
+	0xcb, 0x14, 0x7d, 0xff, 0x7f, 0x8b, 0xce, 0x4c, 0xf2, 0x42, 0x88, 0x22, 0x1e, 0xb1, 0x57, 0x05,
+	0x45, 0x4e, 0xe0, 0x9d, 0x92, 0x57, 0x89, 0x44, 0xcd, 0x25, 0x96, 0x58, 0xe9, 0x84, 0x96, 0x0d,
+	0xcc, 0xdf, 0x1e, 0x7b, 0xd3, 0x41, 0xbc, 0x5b, 0xf2, 0x2a, 0x5e, 0x79, 0x5f, 0x19, 0x8b, 0x3c,
+	0x82, 0xfd, 0x9c, 0xaa, 0x84, 0x09, 0xa5, 0x93, 0x1a, 0x65, 0xc2, 0x35, 0x4a, 0xaa, 0xb9, 0xa8,
+	0xfc, 0xfe, 0xd8, 0x9b, 0xf6, 0xe2, 0xdd, 0x9c, 0xaa, 0xb9, 0x50, 0x7a, 0x81, 0xf2, 0xb4, 0xb5,
+	0xc8, 0x11, 0xec, 0x96, 0xf4, 0x2a, 0xb1, 0xbd, 0x29, 0xf3, 0x5e, 0xa9, 0x72, 0x7f, 0x60, 0xde,
+	0x18, 0x95, 0xf4, 0x6a, 0x6e, 0x9d, 0x05, 0xca, 0xef, 0x55, 0x3e, 0xf9, 0x0e, 0x46, 0x77, 0x37,
+	0x42, 0x7c, 0xd8, 0xa6, 0x59, 0x26, 0x51, 0x29, 0xdf, 0x33, 0xdd, 0xb5, 0x21, 0x09, 0x00, 0xca,
+	0x65, 0xa1, 0x79, 0x5d, 0x70, 0x94, 0x7e, 0xd7, 0x98, 0x6b, 0xca, 0xe4, 0x1c, 0xee, 0xdf, 0x59,
+	0x05, 0x79, 0x08, 0x7d, 0xbb, 0x4b, 0x9e, 0xb5, 0xd5, 0x4c, 0x7c, 0x9a, 0xad, 0x73, 0xba, 0xaf,
+	0x71, 0x6a, 0x94, 0x0c, 0x2b, 0x4d, 0x73, 0xf4, 0x37, 0x2c, 0xe7, 0x56, 0x99, 0xcc, 0x60, 0xdb,
+	0x8d, 0x41, 0x0e, 0x61, 0x98, 0x52, 0xcd, 0x9e, 0x24, 0x19, 0x56, 0xa2, 0x74, 0x08, 0x30, 0xd2,
+	0xe3, 0x46, 0x21, 0xfb, 0xb0, 0xe5, 0x56, 0x6d, 0x21, 0x2e, 0x9a, 0xfc, 0xe9, 0xc1, 0xbd, 0x59,
+	0x93, 0x76, 0xaa, 0xd4, 0x92, 0x56, 0x0c, 0xc9, 0x7b, 0x30, 0x90, 0xc8, 0x78, 0xcd, 0xb1, 0xd2,
+	0xae, 0xd0, 0xad, 0x40, 0x3e, 0x82, 0xfb, 0x5a, 0xd2, 0xac, 0xf9, 0xee, 0x92, 0x57, 0x0a, 0xee,
+	0xb4, 0xb2, 0x3b, 0xb6, 0x0f, 0x61, 0xc7, 0x1e, 0x73, 0xd6, 0xe6, 0xd9, 0x01, 0xee, 0x39, 0xd5,
+	0xa5, 0x7d, 0x0e, 0xef, 0xae, 0x7d, 0x0d, 0x4f, 0x97, 0x92, 0xab, 0x8c, 0x33, 0x73, 0xbc, 0x3d,
+	0x93, 0xbf, 0x7f, 0x6b, 0x7f, 0xbb, 0xe6, 0x4e, 0x52, 0xe8, 0xff, 0x20, 0x79, 0xce, 0xab, 0xb3,
+	0x2b, 0xb2, 0x03, 0xdd, 0xd5, 0x5e, 0xbb, 0x3c, 0x6b, 0x86, 0x55, 0x62, 0x29, 0x19, 0xb6, 0xc3,
+	0xda, 0x88, 0x1c, 0x40, 0x9f, 0x89, 0x4a, 0x4b, 0xca, 0xda, 0x6e, 0x56, 0x31, 0x21, 0xd0, 0xab,
+	0x84, 0x46, 0x47, 0x35, 0xcf, 0x93, 0x5f, 0x3d, 0x20, 0x76, 0xc3, 0x67, 0xcf, 0x6b, 0x5c, 0x48,
+	0x51, 0x0b, 0x45, 0x0b, 0xb2, 0x07, 0x9b, 0x9a, 0xeb, 0x02, 0x1d, 0xd1, 0x06, 0x64, 0x0c, 0xc3,
+	0x0c, 0x15, 0x93, 0xbc, 0x36, 0xdd, 0x5b, 0xf2, 0xba, 0x44, 0xbe, 0x84, 0xa1, 0xbb, 0xec, 0x9a,
+	0xab, 0xc2, 0x74, 0x30, 0x3c, 0x09, 0xde, 0xf8, 0x4f, 0x5a, 0x41, 0x63, 0x60, 0xab, 0xe7, 0x2f,
+	0x7a, 0xbf, 0xfd, 0x7e, 0xd8, 0x99, 0x2d, 0x5e, 0x5c, 0x07, 0xde, 0xcb, 0xeb, 0xc0, 0xfb, 0xf7,
+	0x3a, 0xf0, 0x7e, 0xb9, 0x09, 0x3a, 0x2f, 0x6f, 0x82, 0xce, 0x5f, 0x37, 0x41, 0xe7, 0xa7, 0xcf,
+	0xd6, 0xee, 0x27, 0x53, 0xf5, 0xa8, 0x42, 0xfd, 0x4c, 0xc8, 0x0b, 0x17, 0x15, 0x98, 0xe5, 0x28,
+	0xa3, 0xab, 0xb5, 0xab, 0x99, 0x09, 0x89, 0xe9, 0x96, 0xb9, 0x97, 0x1f, 0xfd, 0x17, 0x00, 0x00,
+	0xff, 0xff, 0xb2, 0x06, 0xf0, 0x9d, 0x23, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -464,6 +660,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCreditsPerMsg != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCreditsPerMsg))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasCostPerIteration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasCostPerIteration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MinRetirementAmount) > 0 {
+		i -= len(m.MinRetirementAmount)
+		copy(dAtA[i:], m.MinRetirementAmount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MinRetirementAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ClassBufferPools) > 0 {
+		for iNdEx := len(m.ClassBufferPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassBufferPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClassFeeDiscounts) > 0 {
+		for iNdEx := len(m.ClassFeeDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassFeeDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.AllowlistEnabled {
 		i--
 		if m.AllowlistEnabled {
@@ -514,6 +755,87 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassFeeDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassFeeDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassFeeDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassBufferPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassBufferPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassBufferPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Credits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -740,6 +1062,66 @@ func (m *Params) Size() (n int) {
 	if m.AllowlistEnabled {
 		n += 2
 	}
+	if len(m.ClassFeeDiscounts) > 0 {
+		for _, e := range m.ClassFeeDiscounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ClassBufferPools) > 0 {
+		for _, e := range m.ClassBufferPools {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.MinRetirementAmount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasCostPerIteration != 0 {
+		n += 1 + sovTypes(uint64(m.GasCostPerIteration))
+	}
+	if m.MaxCreditsPerMsg != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCreditsPerMsg))
+	}
+	return n
+}
+
+func (m *ClassFeeDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassBufferPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -986,6 +1368,404 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowlistEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassFeeDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassFeeDiscounts = append(m.ClassFeeDiscounts, &ClassFeeDiscount{})
+			if err := m.ClassFeeDiscounts[len(m.ClassFeeDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassBufferPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassBufferPools = append(m.ClassBufferPools, &ClassBufferPool{})
+			if err := m.ClassBufferPools[len(m.ClassBufferPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRetirementAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinRetirementAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCostPerIteration", wireType)
+			}
+			m.GasCostPerIteration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCostPerIteration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreditsPerMsg", wireType)
+			}
+			m.MaxCreditsPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreditsPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassFeeDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassFeeDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassFeeDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassBufferPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassBufferPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassBufferPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
@@ -22,6 +24,14 @@ const (
 
 	// MaxNoteLength defines the max length for note fields.
 	MaxNoteLength = 512
+
+	// MaxCancelReasonLength defines the max length of the reason for
+	// cancelling credits.
+	MaxCancelReasonLength = 512
+
+	// MaxBeneficiaryLength defines the max length of the beneficiary a
+	// retirement is attributed to.
+	MaxBeneficiaryLength = 256
 )
 
 var (
@@ -139,13 +149,41 @@ func ValidateBatchDenom(denom string) error {
 	return nil
 }
 
-// ValidateJurisdiction checks that the country and region conform to ISO 3166 and
-// the postal code is valid. This is a simple regex check and doesn't check that
-// the country or subdivision codes actually exist. This is because the codes
-// could change at short notice and we don't want to hardfork to keep up-to-date
-// with that information. The return is nil if the jurisdiction is valid.
+// NormalizeJurisdiction returns the canonical form of a jurisdiction, with
+// surrounding whitespace removed and all letters uppercased, so that
+// equivalent jurisdictions (e.g. "us-wa" and "US-WA") are stored identically.
+// Jurisdictions should be normalized before they are stored or emitted.
+func NormalizeJurisdiction(jurisdiction string) string {
+	return strings.ToUpper(strings.TrimSpace(jurisdiction))
+}
+
+// ValidateJurisdiction checks that the jurisdiction conforms to the format
+// described in ValidateJurisdictionFormat and that the country code is an
+// officially assigned ISO 3166-1 alpha-2 code. Subdivision and postal codes
+// are only checked for format because they change more frequently and we
+// don't want to hardfork to keep up-to-date with that information. The
+// check is case-insensitive. The return is nil if the jurisdiction is valid.
 func ValidateJurisdiction(jurisdiction string) error {
-	matches := regexJurisdiction.FindStringSubmatch(jurisdiction)
+	if err := ValidateJurisdictionFormat(jurisdiction); err != nil {
+		return err
+	}
+
+	country := regexJurisdiction.FindStringSubmatch(strings.ToUpper(jurisdiction))[1]
+	if !IsValidCountryCode(country) {
+		return ecocredit.ErrParseFailure.Wrapf("invalid jurisdiction: %s, unknown country code %s", jurisdiction, country)
+	}
+
+	return nil
+}
+
+// ValidateJurisdictionFormat checks that the country and region conform to the
+// ISO 3166 format and the postal code is valid. This is a simple regex check
+// and doesn't check that the country or subdivision codes actually exist. It is
+// used to validate jurisdictions already in state, which may predate the country
+// code check, and allows test fixtures to use placeholder codes. The return is
+// nil if the jurisdiction format is valid. The check is case-insensitive.
+func ValidateJurisdictionFormat(jurisdiction string) error {
+	matches := regexJurisdiction.FindStringSubmatch(strings.ToUpper(jurisdiction))
 	if matches == nil {
 		return ecocredit.ErrParseFailure.Wrapf("invalid jurisdiction: %s, expected format <country-code>[-<region-code>[ <postal-code>]]", jurisdiction)
 	}
@@ -153,6 +191,12 @@ func ValidateJurisdiction(jurisdiction string) error {
 	return nil
 }
 
+// MetadataHash returns the hex-encoded SHA-256 hash of the metadata.
+func MetadataHash(metadata string) string {
+	hash := sha256.Sum256([]byte(metadata))
+	return hex.EncodeToString(hash[:])
+}
+
 // GetClassIdFromBatchDenom returns the credit class ID in a batch denom.
 func GetClassIdFromBatchDenom(denom string) string {
 	var s strings.Builder
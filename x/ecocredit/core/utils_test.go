@@ -141,3 +141,64 @@ var genTime = rapid.Custom(func(t *rapid.T) *time.Time {
 	time := time.Unix(secs, nanos)
 	return &time
 })
+
+func TestValidateJurisdiction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		jurisdiction string
+		expErr       string
+	}{
+		{"country", "US", ""},
+		{"country and region", "US-WA", ""},
+		{"country, region and postal code", "US-WA 98225", ""},
+		{"lowercase", "us-wa 98225", ""},
+		{"invalid format", "US_WA", "invalid jurisdiction: US_WA, expected format"},
+		{"surrounding whitespace", " US-WA", "invalid jurisdiction:  US-WA, expected format"},
+		{"unknown lowercase country", "zz", "invalid jurisdiction: zz, unknown country code ZZ"},
+		{"unknown country", "ZZ", "invalid jurisdiction: ZZ, unknown country code ZZ"},
+		{"unknown country with region", "AB-CDE FG1 345", "unknown country code AB"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateJurisdiction(test.jurisdiction)
+			if test.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expErr)
+			}
+		})
+	}
+}
+
+func TestValidateJurisdictionFormat(t *testing.T) {
+	require.NoError(t, ValidateJurisdictionFormat("AB-CDE FG1 345"))
+	require.NoError(t, ValidateJurisdictionFormat("ab-cde fg1 345"))
+	require.ErrorContains(t, ValidateJurisdictionFormat("US_WA"), "expected format")
+}
+
+func TestNormalizeJurisdiction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		jurisdictions []string
+		expected      string
+	}{
+		{[]string{"US", "us", " Us "}, "US"},
+		{[]string{"US-WA", "us-wa", "Us-Wa", "US-WA\n"}, "US-WA"},
+		{[]string{"AB-CDE FG1 345", "ab-cde fg1 345", " ab-CDE fg1 345 "}, "AB-CDE FG1 345"},
+	}
+
+	for _, test := range tests {
+		for _, jurisdiction := range test.jurisdictions {
+			normalized := NormalizeJurisdiction(jurisdiction)
+			require.Equal(t, test.expected, normalized, "jurisdiction %q", jurisdiction)
+			require.NoError(t, ValidateJurisdictionFormat(normalized))
+		}
+	}
+}
@@ -45,4 +45,9 @@ type ParamKeeper interface {
 
 	// GetParamSet fetches each parameter in the ParamSet.
 	GetParamSet(ctx sdk.Context, ps types.ParamSet)
+
+	// Set stores a value for given a parameter key assuming the parameter type has
+	// been registered. It will panic if the parameter type has not been registered
+	// or if the value cannot be encoded.
+	Set(ctx sdk.Context, key []byte, value interface{})
 }
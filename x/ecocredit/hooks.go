@@ -0,0 +1,50 @@
+package ecocredit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// RetireHooks defines the interface other modules can implement to be notified
+// when credits are retired.
+type RetireHooks interface {
+	// AfterCreditsRetired is called once for each credit batch retired in a
+	// MsgRetire after the balance and supply updates have been written. An
+	// error returned by the hook fails the retirement.
+	AfterCreditsRetired(ctx sdk.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, jurisdiction string) error
+}
+
+// NoOpRetireHooks is the default RetireHooks implementation that does nothing.
+type NoOpRetireHooks struct{}
+
+var _ RetireHooks = NoOpRetireHooks{}
+
+func (NoOpRetireHooks) AfterCreditsRetired(sdk.Context, sdk.AccAddress, string, math.Dec, string) error {
+	return nil
+}
+
+// SendHooks defines the interface other modules can implement to be notified
+// when credits are sent from one account to another.
+type SendHooks interface {
+	// BeforeCreditsSent is called for each credit batch in a send before any
+	// balances are updated. An error returned by the hook aborts the send.
+	BeforeCreditsSent(ctx sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, tradableAmount, retiredAmount math.Dec) error
+
+	// AfterCreditsSent is called for each credit batch in a send after the
+	// balance and supply updates have been written.
+	AfterCreditsSent(ctx sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, tradableAmount, retiredAmount math.Dec) error
+}
+
+// NoOpSendHooks is the default SendHooks implementation that does nothing.
+type NoOpSendHooks struct{}
+
+var _ SendHooks = NoOpSendHooks{}
+
+func (NoOpSendHooks) BeforeCreditsSent(sdk.Context, sdk.AccAddress, sdk.AccAddress, string, math.Dec, math.Dec) error {
+	return nil
+}
+
+func (NoOpSendHooks) AfterCreditsSent(sdk.Context, sdk.AccAddress, sdk.AccAddress, string, math.Dec, math.Dec) error {
+	return nil
+}
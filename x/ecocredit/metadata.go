@@ -0,0 +1,24 @@
+package ecocredit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetadataValidator defines the interface used to check project and credit
+// batch metadata against the metadata schema of a credit class.
+type MetadataValidator interface {
+	// ValidateMetadata returns an error if the metadata does not reference a
+	// version of the schema that is compatible with the given schema URI. It is
+	// only called when the credit class has a metadata schema set.
+	ValidateMetadata(ctx sdk.Context, schema string, metadata string) error
+}
+
+// NoOpMetadataValidator is the default MetadataValidator implementation that
+// accepts any metadata.
+type NoOpMetadataValidator struct{}
+
+var _ MetadataValidator = NoOpMetadataValidator{}
+
+func (NoOpMetadataValidator) ValidateMetadata(sdk.Context, string, string) error {
+	return nil
+}
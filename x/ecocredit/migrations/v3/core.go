@@ -328,9 +328,31 @@ func MigrateState(sdkCtx sdk.Context, storeKey storetypes.StoreKey,
 		return err
 	}
 
+	migrateParams(sdkCtx, subspace)
+
 	return nil
 }
 
+// migrateParams sets the params added in v4.0 to their default values. Params
+// that have already been set are left unchanged.
+func migrateParams(sdkCtx sdk.Context, subspace paramtypes.Subspace) {
+	if !subspace.Has(sdkCtx, core.KeyGasCostPerIteration) {
+		subspace.Set(sdkCtx, core.KeyGasCostPerIteration, core.DefaultGasCostPerIteration)
+	}
+	if !subspace.Has(sdkCtx, core.KeyClassFeeDiscounts) {
+		subspace.Set(sdkCtx, core.KeyClassFeeDiscounts, []*core.ClassFeeDiscount{})
+	}
+	if !subspace.Has(sdkCtx, core.KeyClassBufferPools) {
+		subspace.Set(sdkCtx, core.KeyClassBufferPools, []*core.ClassBufferPool{})
+	}
+	if !subspace.Has(sdkCtx, core.KeyMinRetirementAmount) {
+		subspace.Set(sdkCtx, core.KeyMinRetirementAmount, core.DefaultMinRetirementAmount)
+	}
+	if !subspace.Has(sdkCtx, core.KeyMaxCreditsPerMsg) {
+		subspace.Set(sdkCtx, core.KeyMaxCreditsPerMsg, core.DefaultMaxCreditsPerMsg)
+	}
+}
+
 // migrateBalances migrates ecocredit tradable and retired balances to orm v1
 func migrateBalances(store storetypes.KVStore, ss api.StateStore, ctx context.Context, batchDenomToBatchMap map[string]batchMapT) error {
 	// migrate tradable balances to ORM v1
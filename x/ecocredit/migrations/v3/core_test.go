@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -176,7 +177,8 @@ func TestMigrations(t *testing.T) {
 	basketStore, err := basketapi.NewStateStore(ormdb)
 	require.Nil(t, err)
 
-	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, paramStore)
+	coreParamStore := newCoreParamStore(encCfg, ecocreditKey, tecocreditKey)
+	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, coreParamStore)
 	require.NoError(t, err)
 
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -330,6 +332,36 @@ func TestMigrations(t *testing.T) {
 	bz = store.Get(tradableSKey1)
 	require.Nil(t, bz)
 
+	// verify params added in v4.0 are set to their default values
+	var gasCostPerIteration uint64
+	coreParamStore.Get(sdkCtx, core.KeyGasCostPerIteration, &gasCostPerIteration)
+	require.Equal(t, core.DefaultGasCostPerIteration, gasCostPerIteration)
+
+	var classFeeDiscounts []*core.ClassFeeDiscount
+	coreParamStore.Get(sdkCtx, core.KeyClassFeeDiscounts, &classFeeDiscounts)
+	require.True(t, coreParamStore.Has(sdkCtx, core.KeyClassFeeDiscounts))
+	require.Empty(t, classFeeDiscounts)
+
+	var classBufferPools []*core.ClassBufferPool
+	coreParamStore.Get(sdkCtx, core.KeyClassBufferPools, &classBufferPools)
+	require.True(t, coreParamStore.Has(sdkCtx, core.KeyClassBufferPools))
+	require.Empty(t, classBufferPools)
+
+	var minRetirementAmount string
+	coreParamStore.Get(sdkCtx, core.KeyMinRetirementAmount, &minRetirementAmount)
+	require.Equal(t, core.DefaultMinRetirementAmount, minRetirementAmount)
+
+	var maxCreditsPerMsg uint64
+	coreParamStore.Get(sdkCtx, core.KeyMaxCreditsPerMsg, &maxCreditsPerMsg)
+	require.Equal(t, core.DefaultMaxCreditsPerMsg, maxCreditsPerMsg)
+}
+
+// newCoreParamStore returns the ecocredit params subspace with the current
+// param key table, as registered by the ecocredit module when the migration
+// is run.
+func newCoreParamStore(encCfg simappparams.EncodingConfig, key, tkey sdk.StoreKey) paramtypes.Subspace {
+	return paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, key, tkey, ecocredit.ModuleName).
+		WithKeyTable(core.ParamKeyTable())
 }
 
 func formatBatchDenom(classId string, batchSeqNo uint64, startDate *time.Time, endDate *time.Time) string {
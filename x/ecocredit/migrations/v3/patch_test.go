@@ -150,7 +150,8 @@ func TestMainnetMigrations(t *testing.T) {
 	basketStore, err := basketapi.NewStateStore(ormdb)
 	require.Nil(t, err)
 
-	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, paramStore)
+	coreParamStore := newCoreParamStore(encCfg, ecocreditKey, tecocreditKey)
+	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, coreParamStore)
 	require.NoError(t, err)
 
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -578,7 +579,8 @@ func TestRedwoodMigrations(t *testing.T) {
 		Exponent: 6,
 	}))
 
-	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, paramStore)
+	coreParamStore := newCoreParamStore(encCfg, ecocreditKey, tecocreditKey)
+	err = v3.MigrateState(sdkCtx, ecocreditKey, encCfg.Marshaler, ss, basketStore, coreParamStore)
 	require.NoError(t, err)
 
 	// verify credit class data
@@ -284,3 +284,15 @@ func (mr *MockParamKeeperMockRecorder) GetParamSet(ctx, ps interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParamSet", reflect.TypeOf((*MockParamKeeper)(nil).GetParamSet), ctx, ps)
 }
+
+// Set mocks base method.
+func (m *MockParamKeeper) Set(ctx types.Context, key []byte, value interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", ctx, key, value)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockParamKeeperMockRecorder) Set(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockParamKeeper)(nil).Set), ctx, key, value)
+}
@@ -41,7 +41,11 @@ type Module struct {
 	paramSpace    paramtypes.Subspace
 	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
+	retireHooks   ecocredit.RetireHooks
+	sendHooks     ecocredit.SendHooks
 	Keeper        server.Keeper
+
+	metadataValidator ecocredit.MetadataValidator
 }
 
 // NewModule returns a new Module object.
@@ -61,6 +65,28 @@ func NewModule(
 	}
 }
 
+// SetRetireHooks sets the hooks called after credits are retired. It must be
+// called before RegisterServices.
+func (a *Module) SetRetireHooks(hooks ecocredit.RetireHooks) *Module {
+	a.retireHooks = hooks
+	return a
+}
+
+// SetSendHooks sets the hooks called before and after credits are sent. It
+// must be called before RegisterServices.
+func (a *Module) SetSendHooks(hooks ecocredit.SendHooks) *Module {
+	a.sendHooks = hooks
+	return a
+}
+
+// SetMetadataValidator sets the validator used to check project and credit
+// batch metadata against the metadata schema of a credit class. It must be
+// called before RegisterServices.
+func (a *Module) SetMetadataValidator(validator ecocredit.MetadataValidator) *Module {
+	a.metadataValidator = validator
+	return a
+}
+
 var _ module.AppModuleBasic = &Module{}
 var _ servermodule.Module = &Module{}
 var _ restmodule.Module = &Module{}
@@ -78,7 +104,7 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a *Module) RegisterServices(configurator servermodule.Configurator) {
-	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper)
+	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper, a.retireHooks, a.sendHooks, a.metadataValidator)
 }
 
 //nolint:errcheck
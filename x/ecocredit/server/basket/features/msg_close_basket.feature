@@ -0,0 +1,106 @@
+Feature: Msg/CloseBasket
+
+  A basket can be closed:
+  - when the basket exists
+  - when the user is the basket curator
+  - when the basket is not already closed
+  - when no basket tokens are outstanding or the user sets force to true
+  - the credits held by the basket are sent to the basket curator when no basket tokens are outstanding
+  - the credits held by the basket remain in the basket when basket tokens are outstanding
+  - the basket is marked as closed
+  - credits can no longer be put into the basket
+  - holders of outstanding basket tokens can still take credits from the basket
+
+  Background:
+    Given a credit type with abbreviation "C"
+    And a basket with credit balance "100"
+
+  Rule: The basket must exist
+
+    Scenario: basket exists
+      Given basket token supply amount "0"
+      When alice attempts to close basket "eco.uC.NCT"
+      Then expect no error
+
+    Scenario: basket does not exist
+      When alice attempts to close basket "eco.uC.FOO"
+      Then expect the error "basket eco.uC.FOO not found: not found"
+
+  Rule: The user must be the basket curator
+
+    Scenario: user is not the basket curator
+      When bob attempts to close basket "eco.uC.NCT"
+      Then expect error contains "expected curator"
+
+  Rule: The basket must not already be closed
+
+    Scenario: basket is already closed
+      Given basket token supply amount "0"
+      And alice has closed the basket
+      When alice attempts to close basket "eco.uC.NCT"
+      Then expect the error "basket eco.uC.NCT is already closed: invalid request"
+
+  Rule: Basket tokens must not be outstanding unless force is set
+
+    Scenario: basket tokens are not outstanding
+      Given basket token supply amount "0"
+      When alice attempts to close basket "eco.uC.NCT" with force "false"
+      Then expect no error
+
+    Scenario: basket tokens are outstanding
+      Given basket token supply amount "100000000"
+      When alice attempts to close basket "eco.uC.NCT" with force "false"
+      Then expect the error "basket eco.uC.NCT has 100000000 basket tokens outstanding: set force to close the basket anyway: invalid request"
+
+    Scenario: basket tokens are outstanding and force is set
+      Given basket token supply amount "100000000"
+      When alice attempts to close basket "eco.uC.NCT" with force "true"
+      Then expect no error
+
+  Rule: The credits held by the basket are sent to the curator and the basket is closed
+
+    Background:
+      Given basket token supply amount "0"
+
+    Scenario: credits are swept to the curator
+      When alice attempts to close basket "eco.uC.NCT"
+      Then expect alice tradable credit balance amount "100"
+      And expect the basket holds no credits
+      And expect the basket is closed
+      And expect the response
+      """
+      {
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          }
+        ]
+      }
+      """
+
+    Scenario: credits cannot be put into a closed basket
+      Given alice has closed the basket
+      When alice attempts to put credits into the basket
+      Then expect the error "basket eco.uC.NCT is closed: invalid request"
+
+  Rule: The credits backing outstanding basket tokens remain in the basket and can still be taken
+
+    Background:
+      Given bob holds basket token amount "100000000"
+
+    Scenario: credits are not swept to the curator when basket tokens are outstanding
+      When alice attempts to close basket "eco.uC.NCT" with force "true"
+      Then expect no error
+      And expect the response has no credits
+      And expect alice has no credit balance
+      And expect the basket holds credit amount "100"
+      And expect the basket is closed
+
+    Scenario: a token holder takes credits from a force closed basket
+      Given alice has closed the basket with force
+      When bob attempts to take credits amount "100000000" from the basket
+      Then expect no error
+      And expect bob retired credit balance amount "100"
+      And expect alice has no credit balance
+      And expect the basket holds no credits
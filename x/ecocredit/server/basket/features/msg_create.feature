@@ -9,6 +9,7 @@ Feature: Msg/Create
   - when the basket includes a credit type that exists
   - when the basket criteria includes credit classes that exist
   - when the basket criteria includes credit classes that match the credit type
+  - when the credit types of a blended basket have the same precision
   - the user token balance is updated and only the minimum fee is taken
   - the basket denom is formatted with a prefix based on credit type precision
   - the response includes the basket denom
@@ -161,7 +162,7 @@ Feature: Msg/Create
 
     Scenario: basket criteria credit class does not exist
       When alice attempts to create a basket with allowed class "C01"
-      Then expect the error "could not get credit class C01: not found: invalid request"
+      Then expect the error "credit class C01 not found: not found"
 
   Rule: The basket criteria must include a credit class that matches the credit type
 
@@ -178,6 +179,24 @@ Feature: Msg/Create
       When alice attempts to create a basket with credit type "C" and allowed class "BIO01"
       Then expect the error "basket specified credit type C, but class BIO01 is of type BIO: invalid request"
 
+  Rule: The credit types of a blended basket must have the same precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with id "BIO01"
+
+    Scenario: credit types have the same precision
+      Given a credit type with abbreviation "BIO" and precision "6"
+      When alice attempts to create a basket with credit types "C:0.6,BIO:0.4" and allowed class "BIO01"
+      Then expect no error
+      And expect the basket credit type "C" with weight "0.6"
+      And expect the basket credit type "BIO" with weight "0.4"
+
+    Scenario: credit types have a different precision
+      Given a credit type with abbreviation "BIO" and precision "0"
+      When alice attempts to create a basket with credit types "C:0.6,BIO:0.4" and allowed class "BIO01"
+      Then expect the error "credit type BIO has precision 0, but credit type C has precision 6: invalid request"
+
   Rule: The user token balance is updated and only the minimum fee is taken
 
     Background:
@@ -4,17 +4,20 @@ Feature: Msg/Put
   - when the basket exists
   - when the credit batch exists
   - when the credit class is allowed
+  - when the credit type is held by the basket
   - when the user has a credit balance
   - when the user has the credit amount
   - when the credit amount does not exceed maximum decimal places
   - when the credit batch start date is after or equal to minimum start date
   - when the credit batch start date is within or at the limit of start date window
   - when the credit batch start date is after or equal to years in the past
+  - when the credit batch has not expired
   - the user credit balance is updated
   - the basket credit balance is updated
   - the user token balance is updated
   - the basket token supply is updated
   - the response includes basket token amount received
+  - the credits are retired when the basket retires credits on put
 
   Rule: The basket must exist
 
@@ -62,6 +65,23 @@ Feature: Msg/Put
       When alice attempts to put credits from credit batch "A01-20200101-20210101-001" into the basket
       Then expect the error "credit class A01 is not allowed in this basket: invalid request"
 
+  Rule: The credit batch must be from a credit type that is held by the basket
+
+    Background:
+      Given a credit type with abbreviation "C"
+
+    Scenario: credit type is held by a blended basket
+      Given a blended basket with credit types "C,BIO" and allowed credit classes "C01,BIO01"
+      And alice owns credits from credit batch "BIO01-001-20200101-20210101-001"
+      When alice attempts to put credits from credit batch "BIO01-001-20200101-20210101-001" into the basket
+      Then expect no error
+
+    Scenario: credit type is not held by the basket
+      Given a basket with credit type "C" and allowed credit class "BIO01"
+      And alice owns credits from credit batch "BIO01-001-20200101-20210101-001"
+      When alice attempts to put credits from credit batch "BIO01-001-20200101-20210101-001" into the basket
+      Then expect the error "basket requires credit type C but a credit with type BIO was given: invalid request"
+
   Rule: The user must have a credit balance for the credits being put into the basket
 
     Background:
@@ -144,9 +164,9 @@ Feature: Msg/Put
         | equal to    | 2021-01-01       |
 
     Scenario: batch start date before minimum start date
-      Given alice owns credits with start date "2020-01-01"
+      Given alice owns credits with start date "2020-12-31"
       When alice attempts to put credits into the basket
-      Then expect error contains "cannot put a credit from a batch with start date"
+      Then expect the error "cannot put credits from batch C01-001-20200101-20210101-001 with start date 2020-12-31T00:00:00Z: basket requires a minimum start date of 2021-01-01T00:00:00Z: invalid request"
 
   Rule: Credits from a batch with a start date outside basket start date window cannot be put into the basket
 
@@ -165,10 +185,32 @@ Feature: Msg/Put
         | less than   | 2022-01-01       |
         | equal to    | 2021-01-01       |
 
-    Scenario: batch start date outside of basket start date window
-      Given alice owns credits with start date "2020-01-01"
+    Scenario Outline: batch start date outside of basket start date window
+      Given alice owns credits with start date "<batch-start-date>"
       When alice attempts to put credits into the basket
-      Then expect error contains "cannot put a credit from a batch with start date"
+      Then expect the error "cannot put credits from batch C01-001-20200101-20210101-001 with start date <batch-start-date>T00:00:00Z: basket start date window requires a start date of 2021-01-01T00:00:00Z or later: invalid request"
+
+      Examples:
+        | description          | batch-start-date |
+        | one day before limit | 2020-12-31       |
+        | one year before      | 2020-01-01       |
+
+  Rule: Credits from a batch with a start date before the basket minimum start date or outside the basket start date window cannot be put into the basket
+
+    Background:
+      Given a credit type
+      And the block time "2022-01-01"
+      And a basket with minimum start date "2021-06-01" and start date window "31536000"
+
+    Scenario: batch start date after or equal to minimum start date and within start date window
+      Given alice owns credits with start date "2021-06-01"
+      When alice attempts to put credits into the basket
+      Then expect no error
+
+    Scenario: batch start date within start date window but before minimum start date
+      Given alice owns credits with start date "2021-01-01"
+      When alice attempts to put credits into the basket
+      Then expect the error "cannot put credits from batch C01-001-20200101-20210101-001 with start date 2021-01-01T00:00:00Z: basket requires a minimum start date of 2021-06-01T00:00:00Z: invalid request"
 
   Rule: Credits from a batch with a start date before basket years in the past cannot be put into the basket
 
@@ -196,7 +238,7 @@ Feature: Msg/Put
       And a basket with years in the past "10"
       And alice owns credits with start date "<batch-start-date>"
       When alice attempts to put credits into the basket
-      Then expect error contains "cannot put a credit from a batch with start date"
+      Then expect the error "cannot put credits from batch C01-001-20200101-20210101-001 with start date <batch-start-date>T00:00:00Z: basket requires a start date of 2012-01-01T00:00:00Z or later: invalid request"
 
       Examples:
         | description             | batch-start-date |
@@ -204,6 +246,35 @@ Feature: Msg/Put
         | year before, day equal  | 2011-04-01       |
         | year before, day after  | 2011-07-01       |
 
+  Rule: Credits from an expired batch cannot be put into the basket
+
+    Background:
+      Given a credit type
+      And the block time "2022-01-01"
+
+    Scenario: batch expires after block time
+      Given a basket
+      And alice owns credits with expiry date "2023-01-01"
+      When alice attempts to put credits into the basket
+      Then expect no error
+
+    Scenario Outline: batch expired before or at block time
+      Given a basket
+      And alice owns credits with expiry date "<expiry-date>"
+      When alice attempts to put credits into the basket
+      Then expect the error "credits from batch C01-001-20200101-20210101-001 expired on <expiry-date> and can no longer be put into a basket: invalid request"
+
+      Examples:
+        | description | expiry-date |
+        | before      | 2021-01-01  |
+        | equal to    | 2022-01-01  |
+
+    Scenario: batch expired before block time and basket retires credits on put
+      Given a basket that retires credits on put
+      And alice owns credits with expiry date "2021-01-01"
+      When alice attempts to put credits into the basket with retirement jurisdiction "US-WA"
+      Then expect the error "credits from batch C01-001-20200101-20210101-001 expired on 2021-01-01 and can no longer be put into a basket: invalid request"
+
   Rule: The user credit balance is updated when credits are put into the basket
 
     Scenario: user credit balance is updated
@@ -283,3 +354,22 @@ Feature: Msg/Put
         | precision non-zero, amount decimal | 6         | 2.5           | 2500000      |
 
     # no failing scenario - response should always be empty when message execution fails
+
+  Rule: The credits are retired when put into a basket that retires credits on put
+
+    Background:
+      Given a credit type
+      And a basket that retires credits on put
+      And alice owns credits
+
+    Scenario: credits are retired with retirement jurisdiction
+      When alice attempts to put credits into the basket with retirement jurisdiction "US-WA"
+      Then expect alice credit balance amount "0"
+      And expect alice retired credit balance amount "100"
+      And expect batch supply retired amount "100"
+      And expect no basket credit balance
+      And expect alice basket token balance amount "100000100"
+
+    Scenario: retirement jurisdiction not provided
+      When alice attempts to put credits into the basket
+      Then expect the error "retirement jurisdiction is required: basket eco.uC.NCT retires credits on put: invalid request"
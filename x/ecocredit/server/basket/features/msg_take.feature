@@ -2,7 +2,9 @@ Feature: Msg/Take
 
   Credits can be taken from a basket:
   - when the basket exists
+  - when the basket does not retire credits on put
   - when the user token balance is greater than or equal to the token amount
+  - when the token amount is greater than or equal to the basket minimum take amount
   - when auto-retire is disabled and the user sets retire on take to true
   - when auto-retire is disabled and the user sets retire on take to false
   - when auto-retire is enabled and the user sets retire on take to true
@@ -12,6 +14,9 @@ Feature: Msg/Take
   - the user tradable credit balance is updated when the user sets retire on take to true
   - the basket credit balance is updated
   - the response includes the credits received
+  - credits are taken from each credit type of a blended basket proportionally to its weight
+  - credits are taken from the remaining credit types of a blended basket when a credit type is drained
+  - credits taken from a blended basket are rounded to the credit type precision
 
   Rule: The basket must exist
 
@@ -54,6 +59,27 @@ Feature: Msg/Take
         | no balance   | 0              | 100          |
         | balance less | 50             | 100          |
 
+  Rule: The token amount must be greater than or equal to the basket minimum take amount
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with min take "50"
+
+    Scenario Outline: token amount is greater than or equal to min take
+      Given alice owns basket token amount "100"
+      When alice attempts to take credits with basket token amount "<token-amount>"
+      Then expect no error
+
+      Examples:
+        | description    | token-amount |
+        | amount greater | 60           |
+        | amount equal   | 50           |
+
+    Scenario: token amount is less than min take
+      Given alice owns basket token amount "100"
+      When alice attempts to take credits with basket token amount "49"
+      Then expect the error "amount 49 is less than the minimum take amount 50 of basket eco.uC.NCT: invalid request"
+
   Rule: The user must set retire on take to true if auto-retire is enabled
 
     Background:
@@ -82,6 +108,23 @@ Feature: Msg/Take
       When alice attempts to take credits with retire on take "false"
       Then expect the error "can't disable retirement when taking from this basket"
 
+  Rule: The basket must not retire credits on put
+
+    Background:
+      Given a credit type
+
+    Scenario: basket does not retire credits on put
+      Given a basket with retire on put "false"
+      And alice owns basket tokens
+      When alice attempts to take credits with retire on take "true"
+      Then expect no error
+
+    Scenario: basket retires credits on put
+      Given a basket with retire on put "true"
+      And alice owns basket tokens
+      When alice attempts to take credits with retire on take "true"
+      Then expect the error "cannot take from basket eco.uC.NCT: credits are retired on put: invalid request"
+
  Rule: The user token balance is updated when credits are taken from the basket
 
     Scenario: user token balance is updated
@@ -184,3 +227,130 @@ Feature: Msg/Take
         | precision non-zero, credits decimal | 6         | 2500000      | 2.500000      |
 
     # no failing scenario - response should always be empty when message execution fails
+
+  Rule: Credits are taken from each credit type of a blended basket proportionally to its weight
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "0"
+      And a credit type with abbreviation "BIO" and precision "0"
+      And a blended basket with credit type "C" weight "0.6" and credit type "BIO" weight "0.4"
+
+    Scenario Outline: credits taken proportionally
+      Given basket token supply amount "200"
+      And alice owns basket token amount "<token-amount>"
+      When alice attempts to take credits with basket token amount "<token-amount>"
+      Then expect the response
+      """
+      {
+        "credits": [
+          {
+            "batch_denom": "BIO01-001-20200101-20210101-001",
+            "amount": "<bio-amount>"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "<c-amount>"
+          }
+        ]
+      }
+      """
+
+      Examples:
+        | description      | token-amount | bio-amount | c-amount |
+        | exact weights    | 10           | 4          | 6        |
+        | truncated weight | 7            | 2          | 5        |
+
+  Rule: Credits are taken from the remaining credit types of a blended basket when a credit type is drained
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "0"
+      And a credit type with abbreviation "BIO" and precision "0"
+      And a blended basket with credit type "C" weight "0.6" and credit type "BIO" weight "0.4"
+
+    Scenario: credit type drained by the take
+      Given basket token supply amount "200"
+      And alice owns basket token amount "200"
+      When alice attempts to take credits with basket token amount "200"
+      Then expect the response
+      """
+      {
+        "credits": [
+          {
+            "batch_denom": "BIO01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          }
+        ]
+      }
+      """
+
+    Scenario: credit type drained by a previous take
+      Given basket token supply amount "200"
+      And alice owns basket token amount "200"
+      And alice has taken credits with basket token amount "170"
+      When alice attempts to take credits with basket token amount "30"
+      Then expect the response
+      """
+      {
+        "credits": [
+          {
+            "batch_denom": "BIO01-001-20200101-20210101-001",
+            "amount": "30"
+          }
+        ]
+      }
+      """
+
+  Rule: Credits taken from a blended basket are rounded to the credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit type with abbreviation "BIO" and precision "6"
+      And a blended basket with credit type "C" weight "0.666667" and credit type "BIO" weight "0.333333"
+
+    Scenario Outline: credits taken do not divide evenly
+      Given basket token supply amount "<token-amount>"
+      And alice owns basket token amount "<token-amount>"
+      When alice attempts to take credits with basket token amount "<token-amount>"
+      Then expect the response
+      """
+      {
+        "credits": [
+          {
+            "batch_denom": "BIO01-001-20200101-20210101-001",
+            "amount": "<bio-amount>"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "<c-amount>"
+          }
+        ]
+      }
+      """
+
+      Examples:
+        | description      | token-amount | bio-amount | c-amount |
+        | divides evenly   | 1000000      | 0.333333   | 0.666667 |
+        | rounded down     | 1000001      | 0.333333   | 0.666668 |
+        | smallest amounts | 4            | 0.000001   | 0.000003 |
+
+  Rule: The retirement jurisdiction is normalized when credits are retired on take
+
+    Background:
+      Given a credit type
+
+    Scenario Outline: retirement jurisdiction is normalized
+      Given a basket with disable auto retire "false"
+      And alice owns basket tokens
+      When alice attempts to take credits with retirement jurisdiction "<jurisdiction>"
+      Then expect no error
+      And expect retirement jurisdiction "US-WA"
+
+      Examples:
+        | jurisdiction |
+        | US-WA        |
+        | us-wa        |
+        | Us-Wa        |
@@ -0,0 +1,83 @@
+Feature: Msg/UpdateBasketAllowedClasses
+
+  The allowed credit classes of a basket can be updated:
+  - when the basket exists
+  - when the user is the basket curator
+  - when the credit classes being added exist
+  - when the credit classes being added match a credit type of the basket
+  - when the credit classes being added are not already allowed
+  - when the credit classes being removed are allowed
+  - when the basket does not hold credits from the credit classes being removed
+  - when the basket allows at least one credit class after the update
+  - the allowed credit classes of the basket are updated
+
+  Background:
+    Given a credit type with abbreviation "C"
+    And a credit class with id "C01"
+    And a credit class with id "C02"
+    And a basket with allowed credit class "C01"
+
+  Rule: The basket must exist
+
+    Scenario: basket exists
+      When alice attempts to add credit class "C02" to basket "eco.uC.NCT"
+      Then expect no error
+
+    Scenario: basket does not exist
+      When alice attempts to add credit class "C02" to basket "eco.uC.FOO"
+      Then expect the error "basket eco.uC.FOO not found: not found"
+
+  Rule: The user must be the basket curator
+
+    Scenario: user is the basket curator
+      When alice attempts to add credit class "C02"
+      Then expect no error
+
+    Scenario: user is not the basket curator
+      When bob attempts to add credit class "C02"
+      Then expect error contains "expected curator"
+
+  Rule: The credit classes being added must exist and match a credit type of the basket
+
+    Scenario: credit class does not exist
+      When alice attempts to add credit class "C03"
+      Then expect the error "could not get credit class C03: not found: invalid request"
+
+    Scenario: credit class does not match the credit type
+      Given a credit class with id "BIO01"
+      When alice attempts to add credit class "BIO01"
+      Then expect the error "basket specified credit type C, but class BIO01 is of type BIO: invalid request"
+
+    Scenario: credit class is already allowed
+      When alice attempts to add credit class "C01"
+      Then expect the error "credit class C01 is already allowed in basket eco.uC.NCT: invalid request"
+
+  Rule: The credit classes being removed must be allowed and not have credits held in the basket
+
+    Background:
+      Given alice has added credit class "C02"
+
+    Scenario: credit class is removed
+      When alice attempts to remove credit class "C02"
+      Then expect no error
+      And expect the basket allowed credit classes "C01"
+
+    Scenario: credit class is not allowed
+      When alice attempts to remove credit class "C03"
+      Then expect the error "credit class C03 is not allowed in basket eco.uC.NCT: invalid request"
+
+    Scenario: basket holds credits from the credit class
+      Given the basket holds credits from credit batch "C02-001-20200101-20210101-001"
+      When alice attempts to remove credit class "C02"
+      Then expect the error "cannot remove credit class C02: basket eco.uC.NCT still holds credits from the class: invalid request"
+
+  Rule: The basket must allow at least one credit class
+
+    Scenario: last credit class is removed
+      When alice attempts to remove credit class "C01"
+      Then expect the error "basket must allow at least one credit class: invalid request"
+
+    Scenario: last credit class is replaced
+      When alice attempts to add credit class "C02" and remove credit class "C01"
+      Then expect no error
+      And expect the basket allowed credit classes "C02"
@@ -0,0 +1,50 @@
+Feature: Msg/UpdateBasketMinTake
+
+  The minimum take amount of a basket can be updated:
+  - when the basket exists
+  - when the user is the basket curator
+  - when the basket is not closed
+  - the minimum take amount of the basket is updated
+  - the minimum take amount of the basket is removed when empty
+
+  Background:
+    Given a basket with min take "1000000"
+
+  Rule: The basket must exist
+
+    Scenario: basket exists
+      When alice attempts to update the min take "2000000" of basket "eco.uC.NCT"
+      Then expect no error
+
+    Scenario: basket does not exist
+      When alice attempts to update the min take "2000000" of basket "eco.uC.FOO"
+      Then expect the error "basket eco.uC.FOO not found: not found"
+
+  Rule: The user must be the basket curator
+
+    Scenario: user is the basket curator
+      When alice attempts to update the min take "2000000"
+      Then expect no error
+
+    Scenario: user is not the basket curator
+      When bob attempts to update the min take "2000000"
+      Then expect error contains "expected curator"
+
+  Rule: The basket must not be closed
+
+    Scenario: basket is closed
+      Given the basket is closed
+      When alice attempts to update the min take "2000000"
+      Then expect the error "basket eco.uC.NCT is closed: invalid request"
+
+  Rule: The minimum take amount of the basket is updated
+
+    Scenario: min take is updated
+      When alice attempts to update the min take "2000000"
+      Then expect the basket min take "2000000"
+      And expect event with min take "2000000"
+
+    Scenario: min take is removed
+      When alice attempts to update the min take ""
+      Then expect the basket min take ""
+      And expect event with min take ""
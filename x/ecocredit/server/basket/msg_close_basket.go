@@ -0,0 +1,121 @@
+package basket
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// CloseBasket is an RPC to handle basket.MsgCloseBasket
+func (k Keeper) CloseBasket(ctx context.Context, req *baskettypes.MsgCloseBasket) (*baskettypes.MsgCloseBasketResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	curator, err := sdk.AccAddressFromBech32(req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, req.BasketDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("basket %s not found", req.BasketDenom)
+		}
+		return nil, err
+	}
+
+	if !curator.Equals(sdk.AccAddress(basket.Curator)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"expected curator %s, got %s", sdk.AccAddress(basket.Curator).String(), req.Curator,
+		)
+	}
+
+	if basket.Closed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("basket %s is already closed", req.BasketDenom)
+	}
+
+	supply := k.bankKeeper.GetSupply(sdkCtx, basket.BasketDenom)
+	if !req.Force && supply.IsPositive() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"basket %s has %s basket tokens outstanding: set force to close the basket anyway",
+			req.BasketDenom, supply.Amount,
+		)
+	}
+
+	// the credits backing outstanding basket tokens remain in the basket so that
+	// token holders can still redeem them with Take after the basket is closed
+	var credits []*baskettypes.BasketCredit
+	if !supply.IsPositive() {
+		credits, err = k.sweepBasketBalances(ctx, basket.Id, curator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	basket.Closed = true
+	if err = k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventCloseBasket{
+		Curator:     req.Curator,
+		BasketDenom: req.BasketDenom,
+		Credits:     credits,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgCloseBasketResponse{Credits: credits}, nil
+}
+
+// sweepBasketBalances sends the credits held by the basket to the basket curator
+// as tradable credits and removes the basket balances.
+func (k Keeper) sweepBasketBalances(ctx context.Context, basketId uint64, curator sdk.AccAddress) ([]*baskettypes.BasketCredit, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var balances []*api.BasketBalance
+	it, err := k.stateStore.BasketBalanceTable().List(ctx, api.BasketBalancePrimaryKey{}.WithBasketId(basketId))
+	if err != nil {
+		return nil, err
+	}
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			it.Close()
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	it.Close()
+
+	var credits []*baskettypes.BasketCredit
+	for _, balance := range balances {
+		amount, err := math.NewDecFromString(balance.Balance)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.addCreditBalance(ctx, curator, balance.BatchDenom, amount, false, ""); err != nil {
+			return nil, err
+		}
+
+		if err = k.stateStore.BasketBalanceTable().Delete(ctx, balance); err != nil {
+			return nil, err
+		}
+
+		credits = append(credits, &baskettypes.BasketCredit{
+			BatchDenom: balance.BatchDenom,
+			Amount:     balance.Balance,
+		})
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgCloseBasket balance iteration")
+	}
+
+	return credits, nil
+}
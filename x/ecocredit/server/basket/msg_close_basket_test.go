@@ -0,0 +1,279 @@
+package basket_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type closeBasketSuite struct {
+	*baseSuite
+	alice       sdk.AccAddress
+	bob         sdk.AccAddress
+	basketId    uint64
+	basketDenom string
+	batchDenom  string
+	res         *basket.MsgCloseBasketResponse
+	err         error
+}
+
+func TestCloseBasket(t *testing.T) {
+	gocuke.NewRunner(t, &closeBasketSuite{}).Path("./features/msg_close_basket.feature").Run()
+}
+
+func (s *closeBasketSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.basketDenom = "eco.uC.NCT"
+	s.batchDenom = "C01-001-20200101-20210101-001"
+
+	// TODO: remove after updating to cosmos-sdk v0.46 #857
+	sdk.SetCoinDenomRegex(func() string {
+		return types.CoinDenomRegex
+	})
+}
+
+func (s *closeBasketSuite) ACreditTypeWithAbbreviation(a string) {
+	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *closeBasketSuite) ABasketWithCreditBalance(a string) {
+	var err error
+	s.basketId, err = s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: "C",
+		Curator:          s.alice,
+		Exponent:         6,
+	})
+	require.NoError(s.t, err)
+
+	classId := core.GetClassIdFromBatchDenom(s.batchDenom)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: s.basketId,
+		ClassId:  classId,
+	})
+	require.NoError(s.t, err)
+
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               classId,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(classId),
+	})
+	require.NoError(s.t, err)
+
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       classId + "-001",
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
+	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchSupplyTable().Insert(s.ctx, &coreapi.BatchSupply{
+		BatchKey:       batchKey,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
+		BasketId:   s.basketId,
+		BatchDenom: s.batchDenom,
+		Balance:    a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *closeBasketSuite) BasketTokenSupplyAmount(a string) {
+	amount, ok := sdk.NewIntFromString(a)
+	require.True(s.t, ok)
+
+	s.bankKeeper.EXPECT().
+		GetSupply(gmAny, s.basketDenom).
+		Return(sdk.NewCoin(s.basketDenom, amount)).
+		AnyTimes()
+}
+
+func (s *closeBasketSuite) AliceHasClosedTheBasket() {
+	_, err := s.k.CloseBasket(s.ctx, &basket.MsgCloseBasket{
+		Curator:     s.alice.String(),
+		BasketDenom: s.basketDenom,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *closeBasketSuite) AliceHasClosedTheBasketWithForce() {
+	_, err := s.k.CloseBasket(s.ctx, &basket.MsgCloseBasket{
+		Curator:     s.alice.String(),
+		BasketDenom: s.basketDenom,
+		Force:       true,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *closeBasketSuite) AliceAttemptsToCloseBasket(a string) {
+	s.res, s.err = s.k.CloseBasket(s.ctx, &basket.MsgCloseBasket{
+		Curator:     s.alice.String(),
+		BasketDenom: a,
+	})
+}
+
+func (s *closeBasketSuite) AliceAttemptsToCloseBasketWithForce(a string, b string) {
+	force, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.CloseBasket(s.ctx, &basket.MsgCloseBasket{
+		Curator:     s.alice.String(),
+		BasketDenom: a,
+		Force:       force,
+	})
+}
+
+func (s *closeBasketSuite) BobAttemptsToCloseBasket(a string) {
+	s.res, s.err = s.k.CloseBasket(s.ctx, &basket.MsgCloseBasket{
+		Curator:     s.bob.String(),
+		BasketDenom: a,
+	})
+}
+
+func (s *closeBasketSuite) BobHoldsBasketTokenAmount(a string) {
+	amount, ok := sdk.NewIntFromString(a)
+	require.True(s.t, ok)
+
+	tokens := sdk.NewCoin(s.basketDenom, amount)
+
+	s.bankKeeper.EXPECT().
+		GetSupply(gmAny, s.basketDenom).
+		Return(tokens).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		GetBalance(gmAny, s.bob, s.basketDenom).
+		Return(tokens).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gmAny, s.bob, basket.BasketSubModuleName, sdk.NewCoins(tokens)).
+		Return(nil).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		BurnCoins(gmAny, basket.BasketSubModuleName, sdk.NewCoins(tokens)).
+		Return(nil).
+		AnyTimes()
+}
+
+func (s *closeBasketSuite) BobAttemptsToTakeCreditsAmountFromTheBasket(a string) {
+	_, s.err = s.k.Take(s.ctx, &basket.MsgTake{
+		Owner:                  s.bob.String(),
+		BasketDenom:            s.basketDenom,
+		Amount:                 a,
+		RetireOnTake:           true,
+		RetirementJurisdiction: "US-WA",
+	})
+}
+
+func (s *closeBasketSuite) AliceAttemptsToPutCreditsIntoTheBasket() {
+	_, s.err = s.k.Put(s.ctx, &basket.MsgPut{
+		Owner:       s.alice.String(),
+		BasketDenom: s.basketDenom,
+		Credits: []*basket.BasketCredit{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     "1",
+			},
+		},
+	})
+}
+
+func (s *closeBasketSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *closeBasketSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *closeBasketSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *closeBasketSuite) ExpectAliceTradableCreditBalanceAmount(a string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *closeBasketSuite) ExpectAliceHasNoCreditBalance() {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	found, err := s.coreStore.BatchBalanceTable().Has(s.ctx, s.alice, batch.Key)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *closeBasketSuite) ExpectBobRetiredCreditBalanceAmount(a string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.bob, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.RetiredAmount)
+}
+
+func (s *closeBasketSuite) ExpectTheBasketHoldsCreditAmount(a string) {
+	balance, err := s.stateStore.BasketBalanceTable().Get(s.ctx, s.basketId, s.batchDenom)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.Balance)
+}
+
+func (s *closeBasketSuite) ExpectTheResponseHasNoCredits() {
+	require.NotNil(s.t, s.res)
+	require.Empty(s.t, s.res.Credits)
+}
+
+func (s *closeBasketSuite) ExpectTheBasketHoldsNoCredits() {
+	found, err := s.stateStore.BasketBalanceTable().Has(s.ctx, s.basketId, s.batchDenom)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *closeBasketSuite) ExpectTheBasketIsClosed() {
+	b, err := s.stateStore.BasketTable().Get(s.ctx, s.basketId)
+	require.NoError(s.t, err)
+	require.True(s.t, b.Closed)
+}
+
+func (s *closeBasketSuite) ExpectTheResponse(a gocuke.DocString) {
+	res := &basket.MsgCloseBasketResponse{}
+	err := jsonpb.UnmarshalString(a.Content, res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, res, s.res)
+}
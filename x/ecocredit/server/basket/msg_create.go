@@ -2,8 +2,10 @@ package basket
 
 import (
 	"context"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/errors"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -26,6 +28,42 @@ func (k Keeper) Create(ctx context.Context, msg *basket.MsgCreate) (*basket.MsgC
 		return nil, err
 	}
 
+	// a basket holding more than one credit type uses the first credit type
+	// to form the basket denom and to convert credits to/from basket tokens
+	creditTypeAbbrevs := []string{msg.CreditTypeAbbrev}
+	if len(msg.CreditTypes) > 0 {
+		creditTypeAbbrevs = make([]string, len(msg.CreditTypes))
+		for i, ct := range msg.CreditTypes {
+			creditTypeAbbrevs[i] = ct.CreditTypeAbbrev
+		}
+	}
+
+	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, creditTypeAbbrevs[0])
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit type with abbreviation %s: %s", creditTypeAbbrevs[0], err.Error(),
+		)
+	}
+
+	for _, abbrev := range creditTypeAbbrevs[1:] {
+		ct, err := k.coreStore.CreditTypeTable().Get(ctx, abbrev)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"could not get credit type with abbreviation %s: %s", abbrev, err.Error(),
+			)
+		}
+		if ct.Precision != creditType.Precision {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit type %s has precision %d, but credit type %s has precision %d",
+				abbrev, ct.Precision, creditType.Abbreviation, creditType.Precision,
+			)
+		}
+	}
+
+	if err = k.validateAllowedClasses(ctx, msg.AllowedClasses, creditTypeAbbrevs); err != nil {
+		return nil, err
+	}
+
 	// In the next version of the basket package, this field will be updated to
 	// a single Coin rather than a list of Coins. In the meantime, the message
 	// will fail basic validation if more than one Coin is provided and only the
@@ -65,14 +103,7 @@ func (k Keeper) Create(ctx context.Context, msg *basket.MsgCreate) (*basket.MsgC
 		}
 	}
 
-	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, msg.CreditTypeAbbrev)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
-			"could not get credit type with abbreviation %s: %s", msg.CreditTypeAbbrev, err.Error(),
-		)
-	}
-
-	denom, displayDenom, err := basket.FormatBasketDenom(msg.Name, msg.CreditTypeAbbrev, creditType.Precision)
+	denom, displayDenom, err := basket.FormatBasketDenom(msg.Name, creditType.Abbreviation, creditType.Precision)
 	if err != nil {
 		return nil, err
 	}
@@ -81,15 +112,26 @@ func (k Keeper) Create(ctx context.Context, msg *basket.MsgCreate) (*basket.MsgC
 		Curator:           curator,
 		BasketDenom:       denom,
 		DisableAutoRetire: msg.DisableAutoRetire,
-		CreditTypeAbbrev:  msg.CreditTypeAbbrev,
+		CreditTypeAbbrev:  creditType.Abbreviation,
 		DateCriteria:      msg.DateCriteria.ToApi(),
 		Exponent:          creditType.Precision, // exponent is no longer used but set until removed
 		Name:              msg.Name,
+		RetireOnPut:       msg.RetireOnPut,
+		MinTake:           msg.MinTake,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "basket with name %s already exists", msg.Name)
 	}
-	if err = k.indexAllowedClasses(ctx, id, msg.AllowedClasses, msg.CreditTypeAbbrev); err != nil {
+	for _, ct := range msg.CreditTypes {
+		if err := k.stateStore.BasketCreditTypeTable().Insert(ctx, &api.BasketCreditType{
+			BasketId:         id,
+			CreditTypeAbbrev: ct.CreditTypeAbbrev,
+			Weight:           ct.Weight,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err = k.indexAllowedClasses(ctx, id, msg.AllowedClasses, creditTypeAbbrevs); err != nil {
 		return nil, err
 	}
 
@@ -129,9 +171,28 @@ func (k Keeper) Create(ctx context.Context, msg *basket.MsgCreate) (*basket.MsgC
 	return &basket.MsgCreateResponse{BasketDenom: denom}, err
 }
 
-// indexAllowedClasses checks that all `allowedClasses` both exist, and are of the specified credit type, then inserts
-// the class into the BasketClass table.
-func (k Keeper) indexAllowedClasses(ctx context.Context, basketID uint64, allowedClasses []string, creditTypeAbbrev string) error {
+// validateAllowedClasses checks that all `allowedClasses` exist and are of one of the specified credit types.
+func (k Keeper) validateAllowedClasses(ctx context.Context, allowedClasses []string, creditTypeAbbrevs []string) error {
+	for _, class := range allowedClasses {
+		classInfo, err := k.coreStore.ClassTable().GetById(ctx, class)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return sdkerrors.ErrNotFound.Wrapf("credit class %s not found", class)
+			}
+			return err
+		}
+
+		if !containsString(creditTypeAbbrevs, classInfo.CreditTypeAbbrev) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("basket specified credit type %s, but class %s is of type %s",
+				strings.Join(creditTypeAbbrevs, ","), class, classInfo.CreditTypeAbbrev)
+		}
+	}
+	return nil
+}
+
+// indexAllowedClasses checks that all `allowedClasses` both exist, and are of one of the specified credit types, then
+// inserts the class into the BasketClass table.
+func (k Keeper) indexAllowedClasses(ctx context.Context, basketID uint64, allowedClasses []string, creditTypeAbbrevs []string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, class := range allowedClasses {
 		classInfo, err := k.coreStore.ClassTable().GetById(ctx, class)
@@ -139,9 +200,9 @@ func (k Keeper) indexAllowedClasses(ctx context.Context, basketID uint64, allowe
 			return sdkerrors.ErrInvalidRequest.Wrapf("could not get credit class %s: %s", class, err.Error())
 		}
 
-		if classInfo.CreditTypeAbbrev != creditTypeAbbrev {
+		if !containsString(creditTypeAbbrevs, classInfo.CreditTypeAbbrev) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("basket specified credit type %s, but class %s is of type %s",
-				creditTypeAbbrev, class, classInfo.CreditTypeAbbrev)
+				strings.Join(creditTypeAbbrevs, ","), class, classInfo.CreditTypeAbbrev)
 		}
 
 		if err := k.stateStore.BasketClassTable().Insert(ctx,
@@ -157,3 +218,12 @@ func (k Keeper) indexAllowedClasses(ctx context.Context, basketID uint64, allowe
 	}
 	return nil
 }
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package basket_test
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -92,6 +93,7 @@ func (s *createSuite) ACreditTypeWithAbbreviationAndPrecision(a string, b string
 
 	err = s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
 		Abbreviation: a,
+		Name:         a,
 		Precision:    s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
@@ -199,6 +201,28 @@ func (s *createSuite) AliceAttemptsToCreateABasketWithNameAndCreditType(a string
 	})
 }
 
+func (s *createSuite) AliceAttemptsToCreateABasketWithCreditTypesAndAllowedClass(a string, b string) {
+	var creditTypes []*basket.CreditTypeWeight
+	for _, ct := range strings.Split(a, ",") {
+		parts := strings.Split(ct, ":")
+		require.Len(s.t, parts, 2)
+
+		creditTypes = append(creditTypes, &basket.CreditTypeWeight{
+			CreditTypeAbbrev: parts[0],
+			Weight:           parts[1],
+		})
+	}
+
+	s.createExpectCalls()
+
+	s.res, s.err = s.k.Create(s.ctx, &basket.MsgCreate{
+		Curator:        s.alice.String(),
+		Name:           s.basketName,
+		CreditTypes:    creditTypes,
+		AllowedClasses: []string{b},
+	})
+}
+
 func (s *createSuite) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -214,6 +238,16 @@ func (s *createSuite) ExpectAliceTokenBalance(a string) {
 	require.Equal(s.t, coin, s.aliceBalance)
 }
 
+func (s *createSuite) ExpectTheBasketCreditTypeWithWeight(a string, b string) {
+	bkt, err := s.stateStore.BasketTable().GetByName(s.ctx, s.basketName)
+	require.NoError(s.t, err)
+
+	creditType, err := s.stateStore.BasketCreditTypeTable().Get(s.ctx, bkt.Id, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, creditType.Weight)
+}
+
 func (s *createSuite) ExpectTheResponse(a gocuke.DocString) {
 	res := &basket.MsgCreateResponse{}
 	err := jsonpb.UnmarshalString(a.Content, res)
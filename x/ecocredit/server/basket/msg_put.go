@@ -14,9 +14,13 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	coreserver "github.com/regen-network/regen-ledger/x/ecocredit/server/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
-// Put deposits ecocredits into a basket, returning fungible coins to the depositor.
+// Put deposits ecocredits into a basket, returning fungible coins to the depositor. If the basket retires credits on
+// put, the credits are retired from the depositor's balance under the retirement jurisdiction of the message instead
+// of being held by the basket.
 // NOTE: the credits MUST adhere to the following specifications set by the basket: credit type, class, and date criteria.
 func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.MsgPutResponse, error) {
 	ownerAddr, err := sdk.AccAddressFromBech32(req.Owner)
@@ -33,6 +37,14 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 		return nil, err
 	}
 
+	if basket.Closed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("basket %s is closed", basket.BasketDenom)
+	}
+
+	if basket.RetireOnPut && req.RetirementJurisdiction == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("retirement jurisdiction is required: basket %s retires credits on put", basket.BasketDenom)
+	}
+
 	// get the credit type
 	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, basket.CreditTypeAbbrev)
 	if err != nil {
@@ -60,12 +72,22 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 		if err != nil {
 			return nil, err
 		}
-		// update the user and basket balances
-		if err = k.transferToBasket(ctx, ownerAddr, amt, basket.Id, batch, creditType.Precision); err != nil {
-			if sdkerrors.ErrInsufficientFunds.Is(err) {
-				return nil, ecocredit.ErrInsufficientCredits
+		if basket.RetireOnPut {
+			// retire the credits from the user balance
+			if err = k.retireOnPut(ctx, ownerAddr, amt, batch); err != nil {
+				if sdkerrors.ErrInsufficientFunds.Is(err) {
+					return nil, ecocredit.ErrInsufficientCredits
+				}
+				return nil, err
+			}
+		} else {
+			// update the user and basket balances
+			if err = k.transferToBasket(ctx, ownerAddr, amt, basket.Id, batch, creditType.Precision); err != nil {
+				if sdkerrors.ErrInsufficientFunds.Is(err) {
+					return nil, ecocredit.ErrInsufficientCredits
+				}
+				return nil, err
 			}
-			return nil, err
 		}
 		// get the amount of basket tokens to give to the depositor
 		tokens, err := creditAmountToBasketCoins(amt, creditType.Precision, basket.BasketDenom)
@@ -75,13 +97,24 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 		// update the total amount received so far
 		amountReceived = amountReceived.Add(tokens[0].Amount)
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
-			Sender:         ownerString,
-			Recipient:      moduleAddrString, // basket submodule
-			BatchDenom:     credit.BatchDenom,
-			TradableAmount: credit.Amount,
-		}); err != nil {
-			return nil, err
+		if basket.RetireOnPut {
+			if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
+				Owner:        ownerString,
+				BatchDenom:   credit.BatchDenom,
+				Amount:       credit.Amount,
+				Jurisdiction: core.NormalizeJurisdiction(req.RetirementJurisdiction),
+			}); err != nil {
+				return nil, err
+			}
+		} else {
+			if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
+				Sender:         ownerString,
+				Recipient:      moduleAddrString, // basket submodule
+				BatchDenom:     credit.BatchDenom,
+				TradableAmount: credit.Amount,
+			}); err != nil {
+				return nil, err
+			}
 		}
 
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgPut credit iteration")
@@ -111,34 +144,58 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 }
 
 // canBasketAcceptCredit checks that a credit adheres to the specifications of a basket. Specifically, it checks:
-//  - batch's start time is within the basket's specified time window or min start date
+//  - batch has not expired
+//  - batch's start date is not before the basket's min start date, start date window cutoff or years in the past
 //  - class is in the basket's allowed class store
-//  - type matches the baskets specified credit type.
+//  - type matches the baskets specified credit type (or one of the basket's credit types).
 func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, batch *ecoApi.Batch) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
 	errInvalidReq := sdkerrors.ErrInvalidRequest
 
+	// credits from an expired batch can only be retired or cancelled
+	if utils.IsBatchExpired(batch, blockTime) {
+		return errInvalidReq.Wrapf(
+			"credits from batch %s expired on %s and can no longer be put into a basket",
+			batch.Denom, batch.ExpiryDate.AsTime().Format("2006-01-02"),
+		)
+	}
+
 	if basket.DateCriteria != nil {
-		// check time window match
-		var minStartDate time.Time
-		var criteria = basket.DateCriteria
+		// check batch start date against each date criteria that is set
+		criteria := basket.DateCriteria
+		startDate := batch.StartDate.AsTime().UTC()
+
 		if criteria.MinStartDate != nil {
-			minStartDate = criteria.MinStartDate.AsTime()
-		} else if criteria.StartDateWindow != nil {
-			window := criteria.StartDateWindow.AsDuration()
-			minStartDate = blockTime.Add(-window)
-		} else if criteria.YearsInThePast != 0 {
-			year := blockTime.Year() - int(criteria.YearsInThePast)
-			minStartDate = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+			minStartDate := criteria.MinStartDate.AsTime().UTC()
+			if startDate.Before(minStartDate) {
+				return errInvalidReq.Wrapf(
+					"cannot put credits from batch %s with start date %s: basket requires a minimum start date of %s",
+					batch.Denom, startDate.Format(time.RFC3339), minStartDate.Format(time.RFC3339),
+				)
+			}
 		}
 
-		startDate := batch.StartDate.AsTime()
-		if startDate.Before(minStartDate) {
-			return errInvalidReq.Wrapf("cannot put a credit from a batch with start date %s "+
-				"into a basket that requires an earliest start date of %s", batch.StartDate.AsTime().String(), minStartDate.String())
+		if criteria.StartDateWindow != nil {
+			cutoff := blockTime.Add(-criteria.StartDateWindow.AsDuration()).UTC()
+			if startDate.Before(cutoff) {
+				return errInvalidReq.Wrapf(
+					"cannot put credits from batch %s with start date %s: basket start date window requires a start date of %s or later",
+					batch.Denom, startDate.Format(time.RFC3339), cutoff.Format(time.RFC3339),
+				)
+			}
 		}
 
+		if criteria.YearsInThePast != 0 {
+			year := blockTime.Year() - int(criteria.YearsInThePast)
+			minStartDate := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+			if startDate.Before(minStartDate) {
+				return errInvalidReq.Wrapf(
+					"cannot put credits from batch %s with start date %s: basket requires a start date of %s or later",
+					batch.Denom, startDate.Format(time.RFC3339), minStartDate.Format(time.RFC3339),
+				)
+			}
+		}
 	}
 
 	classId := core.GetClassIdFromBatchDenom(batch.Denom)
@@ -158,7 +215,14 @@ func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, b
 		return err
 	}
 	if class.CreditTypeAbbrev != basket.CreditTypeAbbrev {
-		return errInvalidReq.Wrapf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev)
+		// a basket holding more than one credit type accepts any of its credit types
+		found, err := k.stateStore.BasketCreditTypeTable().Has(ctx, basket.Id, class.CreditTypeAbbrev)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errInvalidReq.Wrapf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev)
+		}
 	}
 
 	return nil
@@ -167,26 +231,12 @@ func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, b
 // transferToBasket moves credits from the user's tradable balance, into the basket's balance
 func (k Keeper) transferToBasket(ctx context.Context, sender sdk.AccAddress, amt regenmath.Dec, basketId uint64, batch *ecoApi.Batch, exponent uint32) error {
 	// update user balance, subtracting from their tradable balance
-	userBal, err := k.coreStore.BatchBalanceTable().Get(ctx, sender, batch.Key)
-	if err != nil {
-		return ecocredit.ErrInsufficientCredits.Wrapf("could not get batch %s balance for %s", batch.Denom, sender.String())
-	}
-	tradable, err := regenmath.NewPositiveDecFromString(userBal.TradableAmount)
-	if err != nil {
-		return err
-	}
-	newTradable, err := regenmath.SafeSubBalance(tradable, amt)
-	if err != nil {
-		return ecocredit.ErrInsufficientCredits.Wrapf("cannot put %v credits into the basket with a balance of %v: %s", amt, tradable, err.Error())
-	}
-	userBal.TradableAmount = newTradable.String()
-	if err = k.coreStore.BatchBalanceTable().Update(ctx, userBal); err != nil {
+	if err := k.subtractTradableBalance(ctx, sender, amt, batch); err != nil {
 		return err
 	}
 
 	// update basket balance with amount sent, adding to the basket's balance.
-	var bal *api.BasketBalance
-	bal, err = k.stateStore.BasketBalanceTable().Get(ctx, basketId, batch.Denom)
+	bal, err := k.stateStore.BasketBalanceTable().Get(ctx, basketId, batch.Denom)
 	if err != nil {
 		if ormerrors.IsNotFound(err) {
 			bal = &api.BasketBalance{
@@ -215,6 +265,36 @@ func (k Keeper) transferToBasket(ctx context.Context, sender sdk.AccAddress, amt
 	return nil
 }
 
+// retireOnPut moves credits from the user's tradable balance to the user's retired balance and retires them from the
+// batch supply.
+func (k Keeper) retireOnPut(ctx context.Context, owner sdk.AccAddress, amt regenmath.Dec, batch *ecoApi.Batch) error {
+	if err := k.subtractTradableBalance(ctx, owner, amt, batch); err != nil {
+		return err
+	}
+	if err := coreserver.RetireAndSaveBalance(ctx, k.coreStore.BatchBalanceTable(), owner, batch.Key, amt); err != nil {
+		return err
+	}
+	return coreserver.RetireSupply(ctx, k.coreStore.BatchSupplyTable(), batch.Key, amt)
+}
+
+// subtractTradableBalance subtracts credits from the user's tradable balance.
+func (k Keeper) subtractTradableBalance(ctx context.Context, owner sdk.AccAddress, amt regenmath.Dec, batch *ecoApi.Batch) error {
+	userBal, err := k.coreStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf("could not get batch %s balance for %s", batch.Denom, owner.String())
+	}
+	tradable, err := regenmath.NewPositiveDecFromString(userBal.TradableAmount)
+	if err != nil {
+		return err
+	}
+	newTradable, err := regenmath.SafeSubBalance(tradable, amt)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf("cannot put %v credits into the basket with a balance of %v: %s", amt, tradable, err.Error())
+	}
+	userBal.TradableAmount = newTradable.String()
+	return k.coreStore.BatchBalanceTable().Update(ctx, userBal)
+}
+
 // creditAmountToBasketCoins calculates the tokens to award to the depositor
 func creditAmountToBasketCoins(creditAmt regenmath.Dec, exp uint32, denom string) (sdk.Coins, error) {
 	var coins sdk.Coins
@@ -110,6 +110,21 @@ func (s *putSuite) ABasket() {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) ABasketThatRetiresCreditsOnPut() {
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		RetireOnPut:      true,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: basketId,
+		ClassId:  s.classId,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) ABasketWithCreditType(a string) {
 	s.creditTypeAbbrev = a
 
@@ -156,6 +171,50 @@ func (s *putSuite) ABasketWithAllowedCreditClass(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) ABasketWithCreditTypeAndAllowedCreditClass(a string, b string) {
+	s.creditTypeAbbrev = a
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: basketId,
+		ClassId:  b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *putSuite) ABlendedBasketWithCreditTypesAndAllowedCreditClasses(a string, b string) {
+	creditTypes := strings.Split(a, ",")
+	s.creditTypeAbbrev = creditTypes[0]
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	for _, creditType := range creditTypes {
+		err = s.stateStore.BasketCreditTypeTable().Insert(s.ctx, &api.BasketCreditType{
+			BasketId:         basketId,
+			CreditTypeAbbrev: creditType,
+			Weight:           "0.5",
+		})
+		require.NoError(s.t, err)
+	}
+
+	for _, classId := range strings.Split(b, ",") {
+		err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+			BasketId: basketId,
+			ClassId:  classId,
+		})
+		require.NoError(s.t, err)
+	}
+}
+
 func (s *putSuite) ABasketWithMinimumStartDate(a string) {
 	minStartDate, err := types.ParseDate("start date", a)
 	require.NoError(s.t, err)
@@ -198,6 +257,32 @@ func (s *putSuite) ABasketWithStartDateWindow(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) ABasketWithMinimumStartDateAndStartDateWindow(a string, b string) {
+	minStartDate, err := types.ParseDate("start date", a)
+	require.NoError(s.t, err)
+
+	startDateWindow, err := strconv.ParseInt(b, 10, 32)
+	require.NoError(s.t, err)
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		DateCriteria: &api.DateCriteria{
+			MinStartDate: timestamppb.New(minStartDate),
+			StartDateWindow: &durationpb.Duration{
+				Seconds: startDateWindow,
+			},
+		},
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: basketId,
+		ClassId:  s.classId,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) ABasketWithYearsInThePast(a string) {
 	yearsInThePast, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -265,6 +350,14 @@ func (s *putSuite) AliceOwnsCredits() {
 		BatchKey:       batchKey,
 		Address:        s.alice,
 		TradableAmount: s.tradableCredits,
+		RetiredAmount:  "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchSupplyTable().Insert(s.ctx, &coreapi.BatchSupply{
+		BatchKey:       batchKey,
+		TradableAmount: s.tradableCredits,
+		RetiredAmount:  "0",
 	})
 	require.NoError(s.t, err)
 }
@@ -357,6 +450,36 @@ func (s *putSuite) AliceOwnsCreditsWithStartDate(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) AliceOwnsCreditsWithExpiryDate(a string) {
+	expiryDate, err := types.ParseDate("expiry-date", a)
+	require.NoError(s.t, err)
+
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               s.classId,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
+	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+		ProjectKey: pKey,
+		Denom:      s.batchDenom,
+		ExpiryDate: timestamppb.New(expiryDate),
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.alice,
+		TradableAmount: s.tradableCredits,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) AliceOwnsBasketTokenAmount(a string) {
 	amount, err := strconv.ParseInt(a, 10, 32)
 	require.NoError(s.t, err)
@@ -398,6 +521,22 @@ func (s *putSuite) AliceAttemptsToPutCreditsIntoTheBasket() {
 	})
 }
 
+func (s *putSuite) AliceAttemptsToPutCreditsIntoTheBasketWithRetirementJurisdiction(a string) {
+	s.putExpectCalls()
+
+	s.res, s.err = s.k.Put(s.ctx, &basket.MsgPut{
+		Owner:       s.alice.String(),
+		BasketDenom: s.basketDenom,
+		Credits: []*basket.BasketCredit{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     s.tradableCredits,
+			},
+		},
+		RetirementJurisdiction: a,
+	})
+}
+
 func (s *putSuite) AliceAttemptsToPutCreditsIntoBasketWithDenom(a string) {
 	s.basketDenom = a
 
@@ -488,6 +627,36 @@ func (s *putSuite) ExpectAliceCreditBalanceAmount(a string) {
 	require.Equal(s.t, a, balance.TradableAmount)
 }
 
+func (s *putSuite) ExpectAliceRetiredCreditBalanceAmount(a string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.RetiredAmount)
+}
+
+func (s *putSuite) ExpectBatchSupplyRetiredAmount(a string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	supply, err := s.coreStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, supply.RetiredAmount)
+}
+
+func (s *putSuite) ExpectNoBasketCreditBalance() {
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, s.basketDenom)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BasketBalanceTable().Has(s.ctx, basket.Id, s.batchDenom)
+	require.NoError(s.t, err)
+
+	require.False(s.t, found)
+}
+
 func (s *putSuite) ExpectAliceBasketTokenBalanceAmount(a string) {
 	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, s.basketDenom)
 	require.NoError(s.t, err)
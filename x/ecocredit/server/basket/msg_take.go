@@ -26,6 +26,10 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, err
 	}
 
+	if basket.RetireOnPut {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot take from basket %s: credits are retired on put", basket.BasketDenom)
+	}
+
 	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, basket.CreditTypeAbbrev)
 	if err != nil {
 		return nil, err
@@ -41,6 +45,20 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("bad integer %s", msg.Amount)
 	}
 
+	// holders of outstanding basket tokens must be able to redeem any amount
+	// from a closed basket, so the minimum take amount is not enforced
+	if len(basket.MinTake) != 0 && !basket.Closed {
+		minTake, ok := sdk.NewIntFromString(basket.MinTake)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("bad integer %s", basket.MinTake)
+		}
+		if amountBasketTokens.LT(minTake) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"amount %s is less than the minimum take amount %s of basket %s", msg.Amount, basket.MinTake, basket.BasketDenom,
+			)
+		}
+	}
+
 	acct, err := sdk.AccAddressFromBech32(msg.Owner)
 	if err != nil {
 		return nil, err
@@ -69,42 +87,225 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, err
 	}
 
-	multiplier := math.NewDecFinite(1, int32(creditType.Precision))
+	precision := creditType.Precision
+	multiplier := math.NewDecFinite(1, int32(precision))
 	amountCreditsNeeded, err := amountBasketCreditsDec.QuoExact(multiplier)
 	if err != nil {
 		return nil, err
 	}
 
+	// retirement_location is deprecated but still supported
+	var retirementJurisdiction string
+	if len(msg.RetirementJurisdiction) != 0 {
+		retirementJurisdiction = msg.RetirementJurisdiction
+	} else {
+		retirementJurisdiction = msg.RetirementLocation
+	}
+	retirementJurisdiction = coretypes.NormalizeJurisdiction(retirementJurisdiction)
+
+	creditTypes, err := k.getBasketCreditTypes(ctx, basket.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	var credits []*baskettypes.BasketCredit
-	for {
-		it, err := k.stateStore.BasketBalanceTable().List(ctx,
-			api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basket.Id),
-		)
+	if len(creditTypes) == 0 {
+		credits, err = k.takeCredits(ctx, basket, "", amountCreditsNeeded, acct, retire, retirementJurisdiction)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		amounts, err := k.getBlendedTakeAmounts(ctx, basket, creditTypes, amountCreditsNeeded, precision)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, creditType := range creditTypes {
+			if amounts[i].IsZero() {
+				continue
+			}
+
+			typeCredits, err := k.takeCredits(ctx, basket, creditType.CreditTypeAbbrev, amounts[i], acct, retire, retirementJurisdiction)
+			if err != nil {
+				return nil, err
+			}
+			credits = append(credits, typeCredits...)
+		}
+	}
+
+	err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&baskettypes.EventTake{
+		Owner:       msg.Owner,
+		BasketDenom: msg.BasketDenom,
+		Credits:     credits,    // deprecated
+		Amount:      msg.Amount, // deprecated
+	})
+	return &baskettypes.MsgTakeResponse{
+		Credits: credits,
+	}, err
+}
+
+// getBlendedTakeAmounts returns the amount of credits to take from each credit
+// type of a blended basket. Credits are taken from each credit type
+// proportionally to the weight of the credit type, rounding the credits taken
+// down to the credit type precision and taking the remainder from the last
+// credit type so that the credits taken always add up to the amount of basket
+// tokens burned. When a credit type does not hold enough credits, the shortfall
+// is taken from the credit types that still hold credits so that basket tokens
+// remain redeemable after the credits of a credit type have been drained.
+func (k Keeper) getBlendedTakeAmounts(ctx context.Context, basket *api.Basket, creditTypes []*api.BasketCreditType,
+	amountCreditsNeeded math.Dec, precision uint32) ([]math.Dec, error) {
+	available, err := k.getCreditTypeBalances(ctx, basket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make([]math.Dec, len(creditTypes))
+	remainingCredits := amountCreditsNeeded
+	shortfall := math.NewDecFromInt64(0)
+	for i, creditType := range creditTypes {
+		amount := remainingCredits
+		if i < len(creditTypes)-1 {
+			weight, err := math.NewPositiveDecFromString(creditType.Weight)
+			if err != nil {
+				return nil, err
+			}
+			amount, err = math.SafeMulBalance(amountCreditsNeeded, weight)
+			if err != nil {
+				return nil, err
+			}
+			amount = math.Truncate(amount, precision)
+		}
+
+		remainingCredits, err = remainingCredits.Sub(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, ok := available[creditType.CreditTypeAbbrev]
+		if !ok {
+			balance = math.NewDecFromInt64(0)
+			available[creditType.CreditTypeAbbrev] = balance
+		}
+		if amount.Cmp(balance) > 0 {
+			missing, err := amount.Sub(balance)
+			if err != nil {
+				return nil, err
+			}
+			shortfall, err = shortfall.Add(missing)
+			if err != nil {
+				return nil, err
+			}
+			amount = balance
+		}
+
+		amounts[i] = amount
+	}
+
+	for i, creditType := range creditTypes {
+		if shortfall.IsZero() {
+			break
+		}
+
+		spare, err := available[creditType.CreditTypeAbbrev].Sub(amounts[i])
 		if err != nil {
 			return nil, err
 		}
+		if spare.Cmp(shortfall) > 0 {
+			spare = shortfall
+		}
 
-		if !it.Next() {
-			return nil, fmt.Errorf("unexpected failure - balance invariant broken")
+		amounts[i], err = amounts[i].Add(spare)
+		if err != nil {
+			return nil, err
 		}
+		shortfall, err = shortfall.Sub(spare)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !shortfall.IsZero() {
+		return nil, ecocredit.ErrInsufficientCredits.Wrapf("basket %s does not hold enough credits", basket.BasketDenom)
+	}
 
+	return amounts, nil
+}
+
+// getCreditTypeBalances returns the total amount of credits of each credit
+// type held by a basket.
+func (k Keeper) getCreditTypeBalances(ctx context.Context, basketId uint64) (map[string]math.Dec, error) {
+	it, err := k.stateStore.BasketBalanceTable().List(ctx, api.BasketBalancePrimaryKey{}.WithBasketId(basketId))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	classCreditTypes := make(map[string]string)
+	balances := make(map[string]math.Dec)
+	for it.Next() {
 		basketBalance, err := it.Value()
 		if err != nil {
 			return nil, err
 		}
-		it.Close()
+
+		creditTypeAbbrev, err := k.getBatchCreditType(ctx, classCreditTypes, basketBalance.BatchDenom)
+		if err != nil {
+			return nil, err
+		}
 
 		balance, err := math.NewDecFromString(basketBalance.Balance)
 		if err != nil {
 			return nil, err
 		}
 
-		// retirement_location is deprecated but still supported
-		var retirementJurisdiction string
-		if len(msg.RetirementJurisdiction) != 0 {
-			retirementJurisdiction = msg.RetirementJurisdiction
-		} else {
-			retirementJurisdiction = msg.RetirementLocation
+		total, ok := balances[creditTypeAbbrev]
+		if !ok {
+			total = math.NewDecFromInt64(0)
+		}
+		balances[creditTypeAbbrev], err = total.Add(balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return balances, nil
+}
+
+// getBatchCreditType returns the credit type of a batch, caching the credit
+// type of each class in classCreditTypes to avoid repeated class lookups.
+func (k Keeper) getBatchCreditType(ctx context.Context, classCreditTypes map[string]string, batchDenom string) (string, error) {
+	classId := coretypes.GetClassIdFromBatchDenom(batchDenom)
+	if creditTypeAbbrev, ok := classCreditTypes[classId]; ok {
+		return creditTypeAbbrev, nil
+	}
+
+	class, err := k.coreStore.ClassTable().GetById(ctx, classId)
+	if err != nil {
+		return "", err
+	}
+	classCreditTypes[classId] = class.CreditTypeAbbrev
+
+	return class.CreditTypeAbbrev, nil
+}
+
+// takeCredits withdraws the given amount of credits from the basket, taking
+// credits from the batches with the earliest start date first. If
+// creditTypeAbbrev is not empty, only credits of the given credit type are
+// taken.
+func (k Keeper) takeCredits(ctx context.Context, basket *api.Basket, creditTypeAbbrev string, amountCreditsNeeded math.Dec,
+	acct sdk.AccAddress, retire bool, retirementJurisdiction string) ([]*baskettypes.BasketCredit, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	basketBalances, err := k.getTakeBalances(ctx, basket.Id, creditTypeAbbrev, amountCreditsNeeded)
+	if err != nil {
+		return nil, err
+	}
+
+	var credits []*baskettypes.BasketCredit
+	for _, basketBalance := range basketBalances {
+		balance, err := math.NewDecFromString(basketBalance.Balance)
+		if err != nil {
+			return nil, err
 		}
 
 		cmp := balance.Cmp(amountCreditsNeeded)
@@ -137,53 +338,98 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 				return nil, err
 			}
 
-			break
-		} else {
-			credits = append(credits, &baskettypes.BasketCredit{
-				BatchDenom: basketBalance.BatchDenom,
-				Amount:     balance.String(),
-			})
+			return credits, nil
+		}
 
-			err = k.addCreditBalance(
-				ctx,
-				acct,
-				basketBalance.BatchDenom,
-				balance,
-				retire,
-				retirementJurisdiction,
-			)
-			if err != nil {
-				return nil, err
-			}
+		credits = append(credits, &baskettypes.BasketCredit{
+			BatchDenom: basketBalance.BatchDenom,
+			Amount:     balance.String(),
+		})
 
-			err = k.stateStore.BasketBalanceTable().Delete(ctx, basketBalance)
-			if err != nil {
-				return nil, err
-			}
+		err = k.addCreditBalance(
+			ctx,
+			acct,
+			basketBalance.BatchDenom,
+			balance,
+			retire,
+			retirementJurisdiction,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-			// basket balance == credits needed
-			if cmp == 0 {
-				break
-			}
+		err = k.stateStore.BasketBalanceTable().Delete(ctx, basketBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		// basket balance == credits needed
+		if cmp == 0 {
+			return credits, nil
+		}
+
+		amountCreditsNeeded, err = amountCreditsNeeded.Sub(balance)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgTake balance iteration")
+	}
+
+	if creditTypeAbbrev != "" {
+		return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+			"basket %s does not hold enough credits of credit type %s", basket.BasketDenom, creditTypeAbbrev,
+		)
+	}
+	return nil, fmt.Errorf("unexpected failure - balance invariant broken")
+}
+
+// getTakeBalances returns the basket balances, ordered by earliest batch start
+// date, needed to cover the given amount of credits in a single pass over the
+// basket balances. If creditTypeAbbrev is not empty, only balances of batches
+// of the given credit type are returned. Fewer balances are returned if the
+// basket does not hold enough credits.
+func (k Keeper) getTakeBalances(ctx context.Context, basketId uint64, creditTypeAbbrev string, amountCreditsNeeded math.Dec) ([]*api.BasketBalance, error) {
+	it, err := k.stateStore.BasketBalanceTable().List(ctx,
+		api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basketId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	classCreditTypes := make(map[string]string)
+	total := math.NewDecFromInt64(0)
+	var basketBalances []*api.BasketBalance
+	for total.Cmp(amountCreditsNeeded) < 0 && it.Next() {
+		basketBalance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
 
-			amountCreditsNeeded, err = amountCreditsNeeded.Sub(balance)
+		if creditTypeAbbrev != "" {
+			batchCreditType, err := k.getBatchCreditType(ctx, classCreditTypes, basketBalance.BatchDenom)
 			if err != nil {
 				return nil, err
 			}
+			if batchCreditType != creditTypeAbbrev {
+				continue
+			}
 		}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgTake balance iteration")
+		balance, err := math.NewDecFromString(basketBalance.Balance)
+		if err != nil {
+			return nil, err
+		}
+		total, err = total.Add(balance)
+		if err != nil {
+			return nil, err
+		}
+
+		basketBalances = append(basketBalances, basketBalance)
 	}
 
-	err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&baskettypes.EventTake{
-		Owner:       msg.Owner,
-		BasketDenom: msg.BasketDenom,
-		Credits:     credits,    // deprecated
-		Amount:      msg.Amount, // deprecated
-	})
-	return &baskettypes.MsgTakeResponse{
-		Credits: credits,
-	}, err
+	return basketBalances, nil
 }
 
 func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction string) error {
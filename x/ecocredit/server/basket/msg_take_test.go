@@ -8,6 +8,7 @@ import (
 	"github.com/regen-network/gocuke"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -89,6 +90,7 @@ func (s *takeSuite) ACreditTypeWithAbbreviationAndPrecision(a string, b string)
 
 	err = s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
 		Abbreviation: s.creditTypeAbbrev,
+		Name:         s.creditTypeAbbrev,
 		Precision:    s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
@@ -133,6 +135,33 @@ func (s *takeSuite) ABasketWithDisableAutoRetire(a string) {
 	s.addBasketClassAndBalance(basketId, s.tokenAmount)
 }
 
+func (s *takeSuite) ABasketWithRetireOnPut(a string) {
+	retireOnPut, err := strconv.ParseBool(a)
+	require.NoError(s.t, err)
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		RetireOnPut:      retireOnPut,
+	})
+	require.NoError(s.t, err)
+
+	// add balance with credit amount = token amount
+	s.addBasketClassAndBalance(basketId, s.tokenAmount)
+}
+
+func (s *takeSuite) ABasketWithMinTake(a string) {
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		MinTake:          a,
+	})
+	require.NoError(s.t, err)
+
+	// add balance with credit amount = token amount
+	s.addBasketClassAndBalance(basketId, s.tokenAmount)
+}
+
 func (s *takeSuite) ABasketWithCreditBalance(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
@@ -172,6 +201,28 @@ func (s *takeSuite) ABasketWithCreditTypeAndCreditBalance(a string, b string) {
 	s.addBasketClassAndBalance(basketId, b)
 }
 
+func (s *takeSuite) ABlendedBasketWithCreditTypeWeightAndCreditTypeWeight(a, b, c, d string) {
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: a,
+	})
+	require.NoError(s.t, err)
+
+	for _, ct := range []struct{ abbrev, weight string }{{a, b}, {c, d}} {
+		err = s.stateStore.BasketCreditTypeTable().Insert(s.ctx, &api.BasketCreditType{
+			BasketId:         basketId,
+			CreditTypeAbbrev: ct.abbrev,
+			Weight:           ct.weight,
+		})
+		require.NoError(s.t, err)
+
+		// add balance of credits of each credit type
+		s.classId = ct.abbrev + "01"
+		s.batchDenom = s.classId + "-001-20200101-20210101-001"
+		s.addBasketClassAndBalance(basketId, s.tokenAmount)
+	}
+}
+
 func (s *takeSuite) AliceOwnsBasketTokens() {
 	amount, ok := sdk.NewIntFromString(s.tokenAmount)
 	require.True(s.t, ok)
@@ -230,6 +281,11 @@ func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketTokenAmount(a string) {
 	})
 }
 
+func (s *takeSuite) AliceHasTakenCreditsWithBasketTokenAmount(a string) {
+	s.AliceAttemptsToTakeCreditsWithBasketTokenAmount(a)
+	require.NoError(s.t, s.err)
+}
+
 func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketTokenAmountAndRetireOnTake(a string, b string) {
 	s.tokenAmount = a
 
@@ -262,6 +318,12 @@ func (s *takeSuite) AliceAttemptsToTakeCreditsWithRetireOnTake(a string) {
 	})
 }
 
+func (s *takeSuite) AliceAttemptsToTakeCreditsWithRetirementJurisdiction(a string) {
+	s.jurisdiction = a
+
+	s.AliceAttemptsToTakeCreditsWithRetireOnTake("true")
+}
+
 func (s *takeSuite) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -270,6 +332,20 @@ func (s *takeSuite) ExpectTheError(a string) {
 	require.EqualError(s.t, s.err, a)
 }
 
+func (s *takeSuite) ExpectRetirementJurisdiction(a string) {
+	var found bool
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		if event, ok := msg.(*core.EventRetire); ok {
+			found = true
+			require.Equal(s.t, a, event.Jurisdiction)
+		}
+	}
+	require.True(s.t, found, "expected EventRetire to be emitted")
+}
+
 func (s *takeSuite) ExpectAliceTradableCreditBalanceAmount(a string) {
 	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
 	require.NoError(s.t, err)
@@ -343,6 +419,7 @@ func (s *takeSuite) addBasketClassAndBalance(basketId uint64, creditAmount strin
 	require.NoError(s.t, err)
 
 	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       classId + "-001",
 		ClassKey: classKey,
 	})
 	require.NoError(s.t, err)
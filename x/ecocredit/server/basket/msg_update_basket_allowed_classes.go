@@ -0,0 +1,139 @@
+package basket
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateBasketAllowedClasses is an RPC to handle basket.MsgUpdateBasketAllowedClasses
+func (k Keeper) UpdateBasketAllowedClasses(ctx context.Context, req *baskettypes.MsgUpdateBasketAllowedClasses) (*baskettypes.MsgUpdateBasketAllowedClassesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	curator, err := sdk.AccAddressFromBech32(req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, req.BasketDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("basket %s not found", req.BasketDenom)
+		}
+		return nil, err
+	}
+
+	if !curator.Equals(sdk.AccAddress(basket.Curator)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"expected curator %s, got %s", sdk.AccAddress(basket.Curator).String(), req.Curator,
+		)
+	}
+
+	// remove classes
+	for _, class := range req.RemoveClasses {
+		found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, class)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit class %s is not allowed in basket %s", class, req.BasketDenom)
+		}
+
+		held, err := k.basketHoldsClassCredits(ctx, basket.Id, class)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"cannot remove credit class %s: basket %s still holds credits from the class", class, req.BasketDenom,
+			)
+		}
+
+		if err := k.stateStore.BasketClassTable().Delete(ctx, &api.BasketClass{
+			BasketId: basket.Id,
+			ClassId:  class,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgUpdateBasketAllowedClasses class iteration")
+	}
+
+	// add classes
+	for _, class := range req.AddClasses {
+		found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, class)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit class %s is already allowed in basket %s", class, req.BasketDenom)
+		}
+	}
+
+	creditTypes, err := k.getBasketCreditTypes(ctx, basket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	creditTypeAbbrevs := []string{basket.CreditTypeAbbrev}
+	for _, creditType := range creditTypes {
+		if creditType.CreditTypeAbbrev != basket.CreditTypeAbbrev {
+			creditTypeAbbrevs = append(creditTypeAbbrevs, creditType.CreditTypeAbbrev)
+		}
+	}
+
+	if err = k.indexAllowedClasses(ctx, basket.Id, req.AddClasses, creditTypeAbbrevs); err != nil {
+		return nil, err
+	}
+
+	// a basket must always allow at least one credit class
+	it, err := k.stateStore.BasketClassTable().List(ctx, api.BasketClassPrimaryKey{}.WithBasketId(basket.Id))
+	if err != nil {
+		return nil, err
+	}
+	hasClass := it.Next()
+	it.Close()
+	if !hasClass {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("basket must allow at least one credit class")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketAllowedClasses{
+		BasketDenom:   req.BasketDenom,
+		AddClasses:    req.AddClasses,
+		RemoveClasses: req.RemoveClasses,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketAllowedClassesResponse{}, nil
+}
+
+// basketHoldsClassCredits checks whether the basket holds credits from a batch
+// of the given credit class.
+func (k Keeper) basketHoldsClassCredits(ctx context.Context, basketId uint64, classId string) (bool, error) {
+	it, err := k.stateStore.BasketBalanceTable().List(ctx, api.BasketBalancePrimaryKey{}.WithBasketId(basketId))
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			return false, err
+		}
+
+		if core.GetClassIdFromBatchDenom(balance.BatchDenom) == classId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,155 @@
+package basket_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateBasketAllowedClassesSuite struct {
+	*baseSuite
+	alice       sdk.AccAddress
+	bob         sdk.AccAddress
+	basketId    uint64
+	basketDenom string
+	err         error
+}
+
+func TestUpdateBasketAllowedClasses(t *testing.T) {
+	gocuke.NewRunner(t, &updateBasketAllowedClassesSuite{}).Path("./features/msg_update_basket_allowed_classes.feature").Run()
+}
+
+func (s *updateBasketAllowedClassesSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.basketDenom = "eco.uC.NCT"
+}
+
+func (s *updateBasketAllowedClassesSuite) ACreditTypeWithAbbreviation(a string) {
+	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketAllowedClassesSuite) ACreditClassWithId(a string) {
+	err := s.coreStore.ClassTable().Insert(s.ctx, &coreapi.Class{
+		Id:               a,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(a),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketAllowedClassesSuite) ABasketWithAllowedCreditClass(a string) {
+	var err error
+	s.basketId, err = s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: "C",
+		Curator:          s.alice,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: s.basketId,
+		ClassId:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketAllowedClassesSuite) AliceHasAddedCreditClass(a string) {
+	_, err := s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:     s.alice.String(),
+		BasketDenom: s.basketDenom,
+		AddClasses:  []string{a},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketAllowedClassesSuite) TheBasketHoldsCreditsFromCreditBatch(a string) {
+	err := s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
+		BasketId:   s.basketId,
+		BatchDenom: a,
+		Balance:    "10",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketAllowedClassesSuite) AliceAttemptsToAddCreditClassToBasket(a string, b string) {
+	_, s.err = s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:     s.alice.String(),
+		BasketDenom: b,
+		AddClasses:  []string{a},
+	})
+}
+
+func (s *updateBasketAllowedClassesSuite) AliceAttemptsToAddCreditClass(a string) {
+	_, s.err = s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:     s.alice.String(),
+		BasketDenom: s.basketDenom,
+		AddClasses:  []string{a},
+	})
+}
+
+func (s *updateBasketAllowedClassesSuite) BobAttemptsToAddCreditClass(a string) {
+	_, s.err = s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:     s.bob.String(),
+		BasketDenom: s.basketDenom,
+		AddClasses:  []string{a},
+	})
+}
+
+func (s *updateBasketAllowedClassesSuite) AliceAttemptsToRemoveCreditClass(a string) {
+	_, s.err = s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:       s.alice.String(),
+		BasketDenom:   s.basketDenom,
+		RemoveClasses: []string{a},
+	})
+}
+
+func (s *updateBasketAllowedClassesSuite) AliceAttemptsToAddCreditClassAndRemoveCreditClass(a string, b string) {
+	_, s.err = s.k.UpdateBasketAllowedClasses(s.ctx, &basket.MsgUpdateBasketAllowedClasses{
+		Curator:       s.alice.String(),
+		BasketDenom:   s.basketDenom,
+		AddClasses:    []string{a},
+		RemoveClasses: []string{b},
+	})
+}
+
+func (s *updateBasketAllowedClassesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateBasketAllowedClassesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateBasketAllowedClassesSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateBasketAllowedClassesSuite) ExpectTheBasketAllowedCreditClasses(a string) {
+	it, err := s.stateStore.BasketClassTable().List(s.ctx, api.BasketClassPrimaryKey{}.WithBasketId(s.basketId))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	var classes []string
+	for it.Next() {
+		class, err := it.Value()
+		require.NoError(s.t, err)
+		classes = append(classes, class.ClassId)
+	}
+
+	require.Equal(s.t, strings.Split(a, ","), classes)
+}
@@ -0,0 +1,53 @@
+package basket
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketMinTake is an RPC to handle basket.MsgUpdateBasketMinTake
+func (k Keeper) UpdateBasketMinTake(ctx context.Context, req *baskettypes.MsgUpdateBasketMinTake) (*baskettypes.MsgUpdateBasketMinTakeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	curator, err := sdk.AccAddressFromBech32(req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, req.BasketDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("basket %s not found", req.BasketDenom)
+		}
+		return nil, err
+	}
+
+	if !curator.Equals(sdk.AccAddress(basket.Curator)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"expected curator %s, got %s", sdk.AccAddress(basket.Curator).String(), req.Curator,
+		)
+	}
+
+	if basket.Closed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("basket %s is closed", req.BasketDenom)
+	}
+
+	basket.MinTake = req.MinTake
+	if err = k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketMinTake{
+		BasketDenom: req.BasketDenom,
+		MinTake:     req.MinTake,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketMinTakeResponse{}, nil
+}
@@ -0,0 +1,112 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+type updateBasketMinTakeSuite struct {
+	*baseSuite
+	alice       sdk.AccAddress
+	bob         sdk.AccAddress
+	basketId    uint64
+	basketDenom string
+	err         error
+}
+
+func TestUpdateBasketMinTake(t *testing.T) {
+	gocuke.NewRunner(t, &updateBasketMinTakeSuite{}).Path("./features/msg_update_basket_min_take.feature").Run()
+}
+
+func (s *updateBasketMinTakeSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.basketDenom = "eco.uC.NCT"
+}
+
+func (s *updateBasketMinTakeSuite) ABasketWithMinTake(a string) {
+	var err error
+	s.basketId, err = s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: "C",
+		Curator:          s.alice,
+		MinTake:          a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketMinTakeSuite) TheBasketIsClosed() {
+	b, err := s.stateStore.BasketTable().Get(s.ctx, s.basketId)
+	require.NoError(s.t, err)
+
+	b.Closed = true
+
+	err = s.stateStore.BasketTable().Update(s.ctx, b)
+	require.NoError(s.t, err)
+}
+
+func (s *updateBasketMinTakeSuite) AliceAttemptsToUpdateTheMinTakeOfBasket(a string, b string) {
+	_, s.err = s.k.UpdateBasketMinTake(s.ctx, &basket.MsgUpdateBasketMinTake{
+		Curator:     s.alice.String(),
+		BasketDenom: b,
+		MinTake:     a,
+	})
+}
+
+func (s *updateBasketMinTakeSuite) AliceAttemptsToUpdateTheMinTake(a string) {
+	_, s.err = s.k.UpdateBasketMinTake(s.ctx, &basket.MsgUpdateBasketMinTake{
+		Curator:     s.alice.String(),
+		BasketDenom: s.basketDenom,
+		MinTake:     a,
+	})
+}
+
+func (s *updateBasketMinTakeSuite) BobAttemptsToUpdateTheMinTake(a string) {
+	_, s.err = s.k.UpdateBasketMinTake(s.ctx, &basket.MsgUpdateBasketMinTake{
+		Curator:     s.bob.String(),
+		BasketDenom: s.basketDenom,
+		MinTake:     a,
+	})
+}
+
+func (s *updateBasketMinTakeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateBasketMinTakeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateBasketMinTakeSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateBasketMinTakeSuite) ExpectTheBasketMinTake(a string) {
+	b, err := s.stateStore.BasketTable().Get(s.ctx, s.basketId)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, b.MinTake)
+}
+
+func (s *updateBasketMinTakeSuite) ExpectEventWithMinTake(a string) {
+	var found bool
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		if event, ok := msg.(*basket.EventUpdateBasketMinTake); ok {
+			found = true
+			require.Equal(s.t, s.basketDenom, event.BasketDenom)
+			require.Equal(s.t, a, event.MinTake)
+		}
+	}
+	require.True(s.t, found, "expected EventUpdateBasketMinTake to be emitted")
+}
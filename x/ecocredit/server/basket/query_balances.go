@@ -7,12 +7,16 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/ormutil"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 )
 
+// BasketBalances lists the balances of the credit batches held in a basket sorted by batch start date, oldest
+// first, which is the order in which credits are taken from the basket.
 func (k Keeper) BasketBalances(ctx context.Context, request *baskettypes.QueryBasketBalancesRequest) (*baskettypes.QueryBasketBalancesResponse, error) {
 	if request == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -28,7 +32,7 @@ func (k Keeper) BasketBalances(ctx context.Context, request *baskettypes.QueryBa
 		return nil, err
 	}
 
-	it, err := k.stateStore.BasketBalanceTable().List(ctx, api.BasketBalancePrimaryKey{}.WithBasketId(basket.Id),
+	it, err := k.stateStore.BasketBalanceTable().List(ctx, api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basket.Id),
 		ormlist.Paginate(pulsarPageReq),
 	)
 	if err != nil {
@@ -49,12 +53,20 @@ func (k Keeper) BasketBalances(ctx context.Context, request *baskettypes.QueryBa
 		res.Balances = append(res.Balances, balanceGogo)
 
 		res.BalancesInfo = append(res.BalancesInfo, &baskettypes.BasketBalanceInfo{
-			BatchDenom: bal.BatchDenom,
-			Balance:    bal.Balance,
+			BatchDenom:     bal.BatchDenom,
+			Balance:        bal.Balance,
+			BatchStartDate: types.ProtobufToGogoTimestamp(bal.BatchStartDate),
 		})
 	}
 	it.Close()
 
 	res.Pagination, err = ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	supply := k.bankKeeper.GetSupply(sdk.UnwrapSDKContext(ctx), basket.BasketDenom)
+	res.BasketTokenSupply = &supply
+
+	return res, nil
 }
@@ -3,8 +3,11 @@ package basket_test
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
@@ -25,30 +28,39 @@ func TestQueryBalances(t *testing.T) {
 		BasketId:       1,
 		BatchDenom:     batchDenoms[0],
 		Balance:        "100.50",
-		BatchStartDate: nil,
+		BatchStartDate: &timestamppb.Timestamp{Seconds: 200},
 	}))
 	require.NoError(t, s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
 		BasketId:       1,
 		BatchDenom:     batchDenoms[1],
 		Balance:        "4.20",
-		BatchStartDate: nil,
+		BatchStartDate: &timestamppb.Timestamp{Seconds: 300},
 	}))
 	require.NoError(t, s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
 		BasketId:       1,
 		BatchDenom:     batchDenoms[2],
 		Balance:        "6.10",
-		BatchStartDate: nil,
+		BatchStartDate: &timestamppb.Timestamp{Seconds: 100},
 	}))
 
-	// setup test keeper
+	supply := sdk.NewInt64Coin(basketDenom, 110)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), basketDenom).Return(supply).Times(2)
 
-	// query all
+	// query all (sorted oldest first)
 	res, err := s.k.BasketBalances(s.ctx, &baskettypes.QueryBasketBalancesRequest{BasketDenom: basketDenom})
 	require.NoError(t, err)
 	require.Len(t, res.Balances, 3)
-	require.Equal(t, "100.50", res.Balances[0].Balance)
-	require.Equal(t, "4.20", res.Balances[1].Balance)
-	require.Equal(t, "6.10", res.Balances[2].Balance)
+	require.Equal(t, "6.10", res.Balances[0].Balance)
+	require.Equal(t, "100.50", res.Balances[1].Balance)
+	require.Equal(t, "4.20", res.Balances[2].Balance)
+	require.Len(t, res.BalancesInfo, 3)
+	require.Equal(t, "qux", res.BalancesInfo[0].BatchDenom)
+	require.Equal(t, int64(100), res.BalancesInfo[0].BatchStartDate.Seconds)
+	require.Equal(t, "bar", res.BalancesInfo[1].BatchDenom)
+	require.Equal(t, int64(200), res.BalancesInfo[1].BatchStartDate.Seconds)
+	require.Equal(t, "baz", res.BalancesInfo[2].BatchDenom)
+	require.Equal(t, int64(300), res.BalancesInfo[2].BatchStartDate.Seconds)
+	require.Equal(t, &supply, res.BasketTokenSupply)
 
 	// paginate
 	res, err = s.k.BasketBalances(s.ctx, &baskettypes.QueryBasketBalancesRequest{
@@ -62,8 +74,8 @@ func TestQueryBalances(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, res.Pagination.Total, uint64(3))
 	require.Len(t, res.Balances, 2)
-	require.Equal(t, "6.10", res.Balances[0].Balance)
-	require.Equal(t, "4.20", res.Balances[1].Balance)
+	require.Equal(t, "4.20", res.Balances[0].Balance)
+	require.Equal(t, "100.50", res.Balances[1].Balance)
 
 	// bad query
 	res, err = s.k.BasketBalances(s.ctx, &baskettypes.QueryBasketBalancesRequest{BasketDenom: "nope"})
@@ -45,6 +45,11 @@ func (k Keeper) Basket(ctx context.Context, request *baskettypes.QueryBasketRequ
 
 	it.Close()
 
+	creditTypes, err := k.getCreditTypeWeights(ctx, basket.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	basketInfo := &baskettypes.BasketInfo{
 		BasketDenom:       basket.BasketDenom,
 		Name:              basket.Name,
@@ -52,6 +57,10 @@ func (k Keeper) Basket(ctx context.Context, request *baskettypes.QueryBasketRequ
 		DisableAutoRetire: basket.DisableAutoRetire,
 		Exponent:          basket.Exponent,
 		Curator:           sdk.AccAddress(basket.Curator).String(),
+		CreditTypes:       creditTypes,
+		RetireOnPut:       basket.RetireOnPut,
+		MinTake:           basket.MinTake,
+		Closed:            basket.Closed,
 	}
 
 	if basket.DateCriteria != nil {
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// BasketCriteria queries the date criteria of a basket, resolving the minimum start date into an RFC 3339 timestamp
+// and the start date window seconds into a duration string.
+func (k Keeper) BasketCriteria(ctx context.Context, request *baskettypes.QueryBasketCriteriaRequest) (*baskettypes.QueryBasketCriteriaResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, request.BasketDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &baskettypes.QueryBasketCriteriaResponse{}
+
+	criteria := basket.DateCriteria
+	if criteria == nil {
+		return res, nil
+	}
+
+	if criteria.MinStartDate != nil {
+		res.MinStartDate = criteria.MinStartDate.AsTime().UTC().Format(time.RFC3339)
+	}
+	if criteria.StartDateWindow != nil {
+		res.StartDateWindow = criteria.StartDateWindow.AsDuration().String()
+	}
+	res.YearsInThePast = criteria.YearsInThePast
+
+	return res, nil
+}
@@ -0,0 +1,63 @@
+package basket_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestKeeper_BasketCriteria(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	minStartDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, s.stateStore.BasketTable().Insert(s.ctx, &api.Basket{
+		BasketDenom:  "eco.C.min",
+		Name:         "min",
+		DateCriteria: &api.DateCriteria{MinStartDate: timestamppb.New(minStartDate)},
+	}))
+	require.NoError(t, s.stateStore.BasketTable().Insert(s.ctx, &api.Basket{
+		BasketDenom:  "eco.C.window",
+		Name:         "window",
+		DateCriteria: &api.DateCriteria{StartDateWindow: &durationpb.Duration{Seconds: 60 * 60 * 24 * 365}},
+	}))
+	require.NoError(t, s.stateStore.BasketTable().Insert(s.ctx, &api.Basket{
+		BasketDenom:  "eco.C.years",
+		Name:         "years",
+		DateCriteria: &api.DateCriteria{YearsInThePast: 10},
+	}))
+	require.NoError(t, s.stateStore.BasketTable().Insert(s.ctx, &api.Basket{
+		BasketDenom: "eco.C.none",
+		Name:        "none",
+	}))
+
+	// minimum start date
+	res, err := s.k.BasketCriteria(s.ctx, &baskettypes.QueryBasketCriteriaRequest{BasketDenom: "eco.C.min"})
+	require.NoError(t, err)
+	require.Equal(t, &baskettypes.QueryBasketCriteriaResponse{MinStartDate: "2020-01-01T00:00:00Z"}, res)
+
+	// start date window
+	res, err = s.k.BasketCriteria(s.ctx, &baskettypes.QueryBasketCriteriaRequest{BasketDenom: "eco.C.window"})
+	require.NoError(t, err)
+	require.Equal(t, &baskettypes.QueryBasketCriteriaResponse{StartDateWindow: "8760h0m0s"}, res)
+
+	// years in the past
+	res, err = s.k.BasketCriteria(s.ctx, &baskettypes.QueryBasketCriteriaRequest{BasketDenom: "eco.C.years"})
+	require.NoError(t, err)
+	require.Equal(t, &baskettypes.QueryBasketCriteriaResponse{YearsInThePast: 10}, res)
+
+	// no date criteria
+	res, err = s.k.BasketCriteria(s.ctx, &baskettypes.QueryBasketCriteriaRequest{BasketDenom: "eco.C.none"})
+	require.NoError(t, err)
+	require.Equal(t, &baskettypes.QueryBasketCriteriaResponse{}, res)
+
+	// bad query
+	_, err = s.k.BasketCriteria(s.ctx, &baskettypes.QueryBasketCriteriaRequest{BasketDenom: "eco.C.unknown"})
+	require.Error(t, err)
+}
@@ -53,6 +53,11 @@ func (k Keeper) Baskets(ctx context.Context, request *baskettypes.QueryBasketsRe
 			}
 		}
 
+		creditTypes, err := k.getCreditTypeWeights(ctx, basket.Id)
+		if err != nil {
+			return nil, err
+		}
+
 		res.BasketsInfo = append(res.BasketsInfo, &baskettypes.BasketInfo{
 			BasketDenom:       basket.BasketDenom,
 			Name:              basket.Name,
@@ -61,6 +66,10 @@ func (k Keeper) Baskets(ctx context.Context, request *baskettypes.QueryBasketsRe
 			Exponent:          basket.Exponent,
 			Curator:           sdk.AccAddress(basket.Curator).String(),
 			DateCriteria:      criteria,
+			CreditTypes:       creditTypes,
+			RetireOnPut:       basket.RetireOnPut,
+			MinTake:           basket.MinTake,
+			Closed:            basket.Closed,
 		})
 	}
 
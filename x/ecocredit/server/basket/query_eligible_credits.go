@@ -0,0 +1,78 @@
+package basket
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regenmath "github.com/regen-network/regen-ledger/types/math"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// EligibleCredits queries the tradable credit balances of an owner that can be put into a basket. Each balance is
+// checked with the same criteria as Put (see canBasketAcceptCredit). A closed basket accepts no credits.
+func (k Keeper) EligibleCredits(ctx context.Context, request *baskettypes.QueryEligibleCreditsRequest) (*baskettypes.QueryEligibleCreditsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, request.BasketDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &baskettypes.QueryEligibleCreditsResponse{}
+	if basket.Closed {
+		return res, nil
+	}
+
+	it, err := k.coreStore.BatchBalanceTable().List(ctx, ecoApi.BatchBalanceAddressBatchKeyIndexKey{}.WithAddress(owner))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		bal, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		tradable, err := regenmath.NewDecFromString(bal.TradableAmount)
+		if err != nil {
+			return nil, err
+		}
+		if !tradable.IsPositive() {
+			continue
+		}
+
+		batch, err := k.coreStore.BatchTable().Get(ctx, bal.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.canBasketAcceptCredit(ctx, basket, batch); err != nil {
+			if sdkerrors.ErrInvalidRequest.Is(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		res.Credits = append(res.Credits, &baskettypes.BasketCredit{
+			BatchDenom: batch.Denom,
+			Amount:     bal.TradableAmount,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,114 @@
+package basket_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestKeeper_EligibleCredits(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	owner := s.addrs[0]
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      "eco.C.foo",
+		Name:             "foo",
+		CreditTypeAbbrev: "C",
+		DateCriteria:     &api.DateCriteria{MinStartDate: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{BasketId: basketId, ClassId: "C01"}))
+	require.NoError(t, s.stateStore.BasketTable().Insert(s.ctx, &api.Basket{
+		BasketDenom:      "eco.C.closed",
+		Name:             "closed",
+		CreditTypeAbbrev: "C",
+		Closed:           true,
+	}))
+
+	insertBatch := func(classId, denom string, startDate time.Time, tradable string) {
+		classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{Id: classId, CreditTypeAbbrev: "C"})
+		if err != nil {
+			class, err := s.coreStore.ClassTable().GetById(s.ctx, classId)
+			require.NoError(t, err)
+			classKey = class.Key
+		}
+		projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{Id: denom, ClassKey: classKey})
+		require.NoError(t, err)
+		batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+			ProjectKey: projectKey,
+			Denom:      denom,
+			StartDate:  timestamppb.New(startDate),
+		})
+		require.NoError(t, err)
+		require.NoError(t, s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+			BatchKey:       batchKey,
+			Address:        owner,
+			TradableAmount: tradable,
+			RetiredAmount:  "10",
+		}))
+	}
+
+	// eligible batch
+	insertBatch("C01", "C01-001-20210101-20220101-001", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "10.5")
+	// no tradable balance
+	insertBatch("C01", "C01-001-20210101-20220101-002", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "0")
+	// start date before the basket minimum start date
+	insertBatch("C01", "C01-001-20190101-20200101-003", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), "5")
+	// class not allowed in the basket
+	insertBatch("C02", "C02-001-20210101-20220101-001", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "5")
+	// expired batch
+	insertBatch("C01", "C01-001-20210101-20220101-004", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "5")
+	expired, err := s.coreStore.BatchTable().GetByDenom(s.ctx, "C01-001-20210101-20220101-004")
+	require.NoError(t, err)
+	expired.ExpiryDate = timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, s.coreStore.BatchTable().Update(s.ctx, expired))
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	res, err := s.k.EligibleCredits(s.ctx, &baskettypes.QueryEligibleCreditsRequest{
+		Owner:       owner.String(),
+		BasketDenom: "eco.C.foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*baskettypes.BasketCredit{
+		{BatchDenom: "C01-001-20210101-20220101-001", Amount: "10.5"},
+	}, res.Credits)
+
+	// account without credits
+	res, err = s.k.EligibleCredits(s.ctx, &baskettypes.QueryEligibleCreditsRequest{
+		Owner:       s.addrs[1].String(),
+		BasketDenom: "eco.C.foo",
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Credits)
+
+	// closed basket
+	res, err = s.k.EligibleCredits(s.ctx, &baskettypes.QueryEligibleCreditsRequest{
+		Owner:       owner.String(),
+		BasketDenom: "eco.C.closed",
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Credits)
+
+	// bad queries
+	_, err = s.k.EligibleCredits(s.ctx, &baskettypes.QueryEligibleCreditsRequest{
+		Owner:       owner.String(),
+		BasketDenom: "eco.C.unknown",
+	})
+	require.Error(t, err)
+
+	_, err = s.k.EligibleCredits(s.ctx, &baskettypes.QueryEligibleCreditsRequest{
+		Owner:       "foo",
+		BasketDenom: "eco.C.foo",
+	})
+	require.Error(t, err)
+}
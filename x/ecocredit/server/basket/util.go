@@ -5,6 +5,7 @@ import (
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 )
 
 // GetBasketBalanceMap calculates credit balance of each batch within the basket
@@ -56,3 +57,43 @@ func (k Keeper) GetBasketBalanceMap(ctx context.Context) (map[uint64]math.Dec, e
 
 	return batchKeyToBalance, nil
 }
+
+// getBasketCreditTypes returns the credit types of a basket holding more than
+// one credit type, or an empty list for a basket holding a single credit type.
+func (k Keeper) getBasketCreditTypes(ctx context.Context, basketId uint64) ([]*api.BasketCreditType, error) {
+	it, err := k.stateStore.BasketCreditTypeTable().List(ctx, api.BasketCreditTypePrimaryKey{}.WithBasketId(basketId))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var creditTypes []*api.BasketCreditType
+	for it.Next() {
+		creditType, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		creditTypes = append(creditTypes, creditType)
+	}
+
+	return creditTypes, nil
+}
+
+// getCreditTypeWeights returns the credit types of a basket holding more than
+// one credit type formatted for query responses.
+func (k Keeper) getCreditTypeWeights(ctx context.Context, basketId uint64) ([]*baskettypes.CreditTypeWeight, error) {
+	creditTypes, err := k.getBasketCreditTypes(ctx, basketId)
+	if err != nil {
+		return nil, err
+	}
+
+	var weights []*baskettypes.CreditTypeWeight
+	for _, creditType := range creditTypes {
+		weights = append(weights, &baskettypes.CreditTypeWeight{
+			CreditTypeAbbrev: creditType.CreditTypeAbbrev,
+			Weight:           creditType.Weight,
+		})
+	}
+
+	return weights, nil
+}
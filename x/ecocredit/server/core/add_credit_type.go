@@ -1,6 +1,8 @@
 package core
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,16 +10,50 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// AddCreditType is a gov handler method that adds a new credit type to the network
-func (k Keeper) AddCreditType(ctx sdk.Context, ctp *core.CreditTypeProposal) error {
+// AddCreditType adds a new credit type to the network IFF the signer is the module authority.
+func (k Keeper) AddCreditType(ctx context.Context, req *core.MsgAddCreditType) (*core.MsgAddCreditTypeResponse, error) {
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.authority.Equals(authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s, got %s", k.authority, req.Authority)
+	}
+
+	if req.CreditType == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("credit type cannot be nil")
+	}
+
+	if err := k.addCreditType(ctx, req.CreditType); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgAddCreditTypeResponse{}, nil
+}
+
+// AddCreditTypeProposal is a gov handler method that adds a new credit type to the network
+func (k Keeper) AddCreditTypeProposal(ctx sdk.Context, ctp *core.CreditTypeProposal) error {
 	if ctp == nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
 	}
 	if err := ctp.ValidateBasic(); err != nil {
 		return err
 	}
-	ct := ctp.CreditType
-	if err := k.stateStore.CreditTypeTable().Insert(sdk.WrapSDKContext(ctx), &api.CreditType{
+	return k.addCreditType(sdk.WrapSDKContext(ctx), ctp.CreditType)
+}
+
+// addCreditType inserts a credit type, rejecting duplicate abbreviations, and emits EventAddCreditType.
+func (k Keeper) addCreditType(ctx context.Context, ct *core.CreditType) error {
+	found, err := k.stateStore.CreditTypeTable().Has(ctx, ct.Abbreviation)
+	if err != nil {
+		return err
+	}
+	if found {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit type with abbreviation %s already exists", ct.Abbreviation)
+	}
+
+	if err := k.stateStore.CreditTypeTable().Insert(ctx, &api.CreditType{
 		Abbreviation: ct.Abbreviation,
 		Name:         ct.Name,
 		Unit:         ct.Unit,
@@ -25,5 +61,6 @@ func (k Keeper) AddCreditType(ctx sdk.Context, ctp *core.CreditTypeProposal) err
 	}); err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf("could not insert credit type with abbreviation %s: %s", ct.Abbreviation, err.Error())
 	}
-	return ctx.EventManager().EmitTypedEvent(&core.EventAddCreditType{Abbreviation: ct.Abbreviation})
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventAddCreditType{Abbreviation: ct.Abbreviation})
 }
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestAddCreditType_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.AddCreditType(s.ctx, &core.MsgAddCreditType{
+		Authority: s.authority.String(),
+		CreditType: &core.CreditType{
+			Abbreviation: "BIO",
+			Name:         "biodiversity",
+			Unit:         "acres",
+			Precision:    6,
+		},
+	})
+	assert.NilError(t, err)
+
+	ct, err := s.stateStore.CreditTypeTable().Get(s.ctx, "BIO")
+	assert.NilError(t, err)
+	assert.Equal(t, "biodiversity", ct.Name)
+	assert.Equal(t, "acres", ct.Unit)
+	assert.Equal(t, uint32(6), ct.Precision)
+
+	var emitted bool
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventAddCreditType" {
+			continue
+		}
+		emitted = true
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "abbreviation" {
+				assert.Equal(t, `"BIO"`, string(attr.Value))
+			}
+		}
+	}
+	assert.Assert(t, emitted, "expected EventAddCreditType to be emitted")
+}
+
+func TestAddCreditType_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t) // setupBase gives us the "C" credit type
+
+	_, err := s.k.AddCreditType(s.ctx, &core.MsgAddCreditType{
+		Authority:  s.addr.String(),
+		CreditType: &core.CreditType{Abbreviation: "BIO", Name: "biodiversity", Unit: "acres", Precision: 6},
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.k.AddCreditType(s.ctx, &core.MsgAddCreditType{
+		Authority:  s.authority.String(),
+		CreditType: &core.CreditType{Abbreviation: "C", Name: "carbon2", Unit: "tons", Precision: 6},
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	assert.ErrorContains(t, err, "credit type with abbreviation C already exists")
+
+	// duplicate name
+	_, err = s.k.AddCreditType(s.ctx, &core.MsgAddCreditType{
+		Authority:  s.authority.String(),
+		CreditType: &core.CreditType{Abbreviation: "CO", Name: "carbon", Unit: "tons", Precision: 6},
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
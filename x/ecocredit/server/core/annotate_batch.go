@@ -0,0 +1,49 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// AnnotateBatch appends a timestamped annotation to a batch IFF the requester address matches the batch issuer
+// address. Annotations are stored in insertion order and never overwrite the batch metadata or prior annotations.
+func (k Keeper) AnnotateBatch(ctx context.Context, req *core.MsgAnnotateBatch) (*core.MsgAnnotateBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if !sdk.AccAddress(batch.Issuer).Equals(issuer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the batch issuer can annotate the batch")
+	}
+
+	if _, err := k.stateStore.BatchAnnotationTable().InsertReturningID(ctx, &api.BatchAnnotation{
+		BatchKey:   batch.Key,
+		Annotator:  issuer,
+		Annotation: req.Annotation,
+		Timestamp:  timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventAnnotateBatch{
+		BatchDenom: batch.Denom,
+		Annotation: req.Annotation,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgAnnotateBatchResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestAnnotateBatch_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	_, err := s.k.AnnotateBatch(s.ctx, &core.MsgAnnotateBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+		Annotation: "first note",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.k.AnnotateBatch(s.ctx, &core.MsgAnnotateBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+		Annotation: "second note",
+	})
+	assert.NilError(t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+
+	it, err := s.stateStore.BatchAnnotationTable().List(s.ctx, api.BatchAnnotationBatchKeyIndexKey{}.WithBatchKey(batch.Key))
+	assert.NilError(t, err)
+	defer it.Close()
+
+	var annotations []string
+	for it.Next() {
+		annotation, err := it.Value()
+		assert.NilError(t, err)
+		assert.Check(t, s.addr.Equals(sdk.AccAddress(annotation.Annotator)))
+		annotations = append(annotations, annotation.Annotation)
+	}
+	assert.DeepEqual(t, []string{"first note", "second note"}, annotations)
+
+	// metadata is left untouched
+	assert.Equal(t, "", batch.Metadata)
+}
+
+func TestAnnotateBatch_Unauthorized(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := s.k.AnnotateBatch(s.ctx, &core.MsgAnnotateBatch{
+		Issuer:     addr.String(),
+		BatchDenom: batchDenom,
+		Annotation: "note",
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestAnnotateBatch_BatchNotFound(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.AnnotateBatch(s.ctx, &core.MsgAnnotateBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: "C01-001-20200101-20210101-001",
+		Annotation: "note",
+	})
+	assert.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20210101-001")
+}
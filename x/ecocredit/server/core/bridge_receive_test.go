@@ -10,6 +10,7 @@ import (
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/testutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 func TestBridgeReceive_ProjectAndBatchExist(t *testing.T) {
@@ -60,6 +61,9 @@ func TestBridgeReceive_ProjectAndBatchExist(t *testing.T) {
 func TestBridgeReceive_ProjectNoBatch(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
 	recipient := testutil.GenAddress()
 	refId := "VCS-001"
 	project, batch := setupBridgeTest(s, refId)
@@ -107,6 +111,9 @@ func TestBridgeReceive_ProjectNoBatch(t *testing.T) {
 func TestBridgeReceive_None(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
 	setupBridgeTest(s, "VCS-002")
 	recipient := testutil.GenAddress()
 	start, end := time.Now(), time.Now()
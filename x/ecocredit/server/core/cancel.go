@@ -2,11 +2,10 @@ package core
 
 import (
 	"context"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/regen-network/regen-ledger/x/ecocredit"
-
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +24,12 @@ func (k Keeper) Cancel(ctx context.Context, req *core.MsgCancel) (*core.MsgCance
 		return nil, err
 	}
 
+	if err := k.assertMaxCreditsPerMsg(sdkCtx.Context, len(req.Credits)); err != nil {
+		return nil, err
+	}
+
+	gasCostPerIteration := k.getGasCostPerIteration(sdkCtx.Context)
+
 	for _, credit := range req.Credits {
 		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
 		if err != nil {
@@ -82,12 +87,12 @@ func (k Keeper) Cancel(ctx context.Context, req *core.MsgCancel) (*core.MsgCance
 			Owner:      owner.String(),
 			BatchDenom: credit.BatchDenom,
 			Amount:     credit.Amount,
-			Reason:     req.Reason,
+			Reason:     strings.TrimSpace(req.Reason),
 		}); err != nil {
 			return nil, err
 		}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgCancel credit iteration")
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "ecocredit/core/MsgCancel credit iteration")
 	}
 	return &core.MsgCancelResponse{}, nil
 }
@@ -42,6 +42,34 @@ func TestCancel_Valid(t *testing.T) {
 	assert.Equal(t, bal.RetiredAmount, "10.5")
 }
 
+func TestCancel_TrimsReason(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	_, err := s.k.Cancel(s.ctx, &core.MsgCancel{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{
+				BatchDenom: batchDenom,
+				Amount:     "1",
+			},
+		},
+		Reason: "  transferring credits to another registry \n",
+	})
+	assert.NilError(t, err)
+
+	// the reason is trimmed in the emitted event
+	events := s.sdkCtx.EventManager().Events()
+	event := events[len(events)-1]
+	assert.Equal(t, "regen.ecocredit.v1.EventCancel", event.Type)
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == "reason" {
+			assert.Equal(t, `"transferring credits to another registry"`, string(attr.Value))
+		}
+	}
+}
+
 func TestCancel_InsufficientFunds(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -93,3 +121,20 @@ func TestCancel_InvalidBatch(t *testing.T) {
 	})
 	assert.Error(t, err, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom C00-00000000-00000000-01: %s", ormerrors.NotFound.Error()).Error())
 }
+
+func TestCancel_MaxCreditsPerMsg(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	credits := make([]*core.Credits, core.DefaultMaxCreditsPerMsg+1)
+	for i := range credits {
+		credits[i] = &core.Credits{BatchDenom: batchDenom, Amount: "0.000001"}
+	}
+
+	_, err := s.k.Cancel(s.ctx, &core.MsgCancel{
+		Owner:   s.addr.String(),
+		Credits: credits,
+	})
+	assert.ErrorContains(t, err, "number of credits 1001 exceeds the maximum of 1000 per message")
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -18,6 +19,8 @@ import (
 
 // CreateBatch creates a new batch of credits.
 // Credits in the batch must not have more decimal places than the credit type's specified precision.
+// If the credit class has a buffer pool, the buffer pool percentage of the tradable credits issued to
+// each recipient is allocated to the buffer pool address as retired credits before crediting the recipient.
 func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*core.MsgCreateBatchResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -41,6 +44,14 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		return nil, err
 	}
 
+	if err = assertClassBatchPeriod(classInfo, *req.StartDate, *req.EndDate); err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassMetadataSchema(sdkCtx, classInfo, req.Metadata); err != nil {
+		return nil, err
+	}
+
 	batchSeqNo, err := k.getBatchSeqNo(ctx, projectInfo.Key)
 	if err != nil {
 		return nil, err
@@ -53,6 +64,12 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 
 	startDate, endDate := timestamppb.New(*req.StartDate), timestamppb.New(*req.EndDate)
 	issuanceDate := timestamppb.New(sdkCtx.BlockTime())
+
+	var expiryDate *timestamppb.Timestamp
+	if req.ExpiryDate != nil {
+		expiryDate = timestamppb.New(*req.ExpiryDate)
+	}
+
 	batchKey, err := k.stateStore.BatchTable().InsertReturningID(ctx, &api.Batch{
 		ProjectKey:   projectInfo.Key,
 		Issuer:       issuer,
@@ -61,6 +78,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		StartDate:    startDate,
 		EndDate:      endDate,
 		IssuanceDate: issuanceDate,
+		ExpiryDate:   expiryDate,
 	})
 	if err != nil {
 		return nil, err
@@ -74,6 +92,12 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 
 	tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
 
+	bufferPool, err := k.getClassBufferPool(sdkCtx, classInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	bufferAmount := math.NewDecFromInt64(0)
+
 	// set module address string once for better performance
 	moduleAddrString := k.moduleAddress.String()
 
@@ -85,6 +109,22 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		}
 		tradableAmount, retiredAmount := decs[0], decs[1]
 
+		// allocate the buffer pool percentage of the tradable amount to the buffer pool
+		if bufferPool != nil && !tradableAmount.IsZero() {
+			buffer, err := calculateBufferAllocation(tradableAmount, bufferPool.percentage, maxDecimalPlaces)
+			if err != nil {
+				return nil, err
+			}
+			tradableAmount, err = tradableAmount.Sub(buffer)
+			if err != nil {
+				return nil, err
+			}
+			bufferAmount, err = bufferAmount.Add(buffer)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		recipient, _ := sdk.AccAddressFromBech32(issuance.Recipient)
 
 		// get the current batch balance of the recipient account
@@ -152,7 +192,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 				Owner:        issuance.Recipient,
 				BatchDenom:   batchDenom,
 				Amount:       issuance.RetiredAmount,
-				Jurisdiction: issuance.RetirementJurisdiction,
+				Jurisdiction: core.NormalizeJurisdiction(issuance.RetirementJurisdiction),
 			}); err != nil {
 				return nil, err
 			}
@@ -170,7 +210,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			Sender:         moduleAddrString, // ecocredit module
 			Recipient:      issuance.Recipient,
 			BatchDenom:     batchDenom,
-			TradableAmount: issuance.TradableAmount,
+			TradableAmount: tradableAmount.String(),
 			RetiredAmount:  issuance.RetiredAmount,
 		}); err != nil {
 			return nil, err
@@ -179,6 +219,44 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgCreateBatch issuance iteration")
 	}
 
+	if !bufferAmount.IsZero() {
+		if err = RetireAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), bufferPool.address, batchKey, bufferAmount); err != nil {
+			return nil, err
+		}
+
+		retiredSupply, err = retiredSupply.Add(bufferAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.stateStore.BatchBufferTable().Insert(ctx, &api.BatchBuffer{
+			BatchKey: batchKey,
+			ClassKey: classInfo.Key,
+			Address:  bufferPool.address,
+			Amount:   bufferAmount.String(),
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
+			Sender:        moduleAddrString, // ecocredit module
+			Recipient:     bufferPool.address.String(),
+			BatchDenom:    batchDenom,
+			RetiredAmount: bufferAmount.String(),
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
+			Owner:        bufferPool.address.String(),
+			BatchDenom:   batchDenom,
+			Amount:       bufferAmount.String(),
+			Jurisdiction: projectInfo.Jurisdiction,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
 		BatchKey:        batchKey,
 		TradableAmount:  tradableSupply.String(),
@@ -190,8 +268,11 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 
 	if req.OriginTx != nil {
 		if err = k.stateStore.BatchOriginTxTable().Insert(ctx, &api.BatchOriginTx{
-			Id:     req.OriginTx.Id,
-			Source: req.OriginTx.Source,
+			Id:             req.OriginTx.Id,
+			Source:         req.OriginTx.Source,
+			BatchKey:       batchKey,
+			TradableAmount: tradableSupply.String(),
+			RetiredAmount:  retiredSupply.String(),
 		}); err != nil {
 			if ormerrors.PrimaryKeyConstraintViolation.Is(err) {
 				return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits already issued with tx id: %s", req.OriginTx.Id)
@@ -203,6 +284,10 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventCreateBatch{
 		BatchDenom: batchDenom,
 		OriginTx:   req.OriginTx,
+		ProjectId:  projectInfo.Id,
+		Issuer:     issuer.String(),
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
 	}); err != nil {
 		return nil, err
 	}
@@ -210,6 +295,85 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 	return &core.MsgCreateBatchResponse{BatchDenom: batchDenom}, nil
 }
 
+// assertClassBatchPeriod returns an error if the start date or end date of a credit batch fall outside
+// the batch period allowed by the credit class. Each bound is only checked if it is set on the credit class.
+func assertClassBatchPeriod(class *api.Class, startDate, endDate time.Time) error {
+	if class.MinBatchStartDate != nil {
+		if min := class.MinBatchStartDate.AsTime(); startDate.Before(min) {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"batch start date %s is before the minimum batch start date %s of class %s",
+				startDate.Format(time.RFC3339), min.Format(time.RFC3339), class.Id,
+			)
+		}
+	}
+
+	if class.MaxBatchEndDate != nil {
+		if max := class.MaxBatchEndDate.AsTime(); endDate.After(max) {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"batch end date %s is after the maximum batch end date %s of class %s",
+				endDate.Format(time.RFC3339), max.Format(time.RFC3339), class.Id,
+			)
+		}
+	}
+
+	return nil
+}
+
+type classBufferPool struct {
+	address    sdk.AccAddress
+	percentage math.Dec
+}
+
+// getClassBufferPool returns the buffer pool of the credit class or nil if the
+// credit class does not have a buffer pool.
+func (k Keeper) getClassBufferPool(ctx sdk.Context, classId string) (*classBufferPool, error) {
+	var pools []*core.ClassBufferPool
+	k.paramsKeeper.Get(ctx, core.KeyClassBufferPools, &pools)
+	for _, pool := range pools {
+		if pool.ClassId != classId {
+			ctx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgCreateBatch buffer pool iteration")
+			continue
+		}
+		address, err := sdk.AccAddressFromBech32(pool.Address)
+		if err != nil {
+			return nil, err
+		}
+		percentage, err := math.NewNonNegativeDecFromString(pool.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		if percentage.IsZero() {
+			return nil, nil
+		}
+		return &classBufferPool{address: address, percentage: percentage}, nil
+	}
+	return nil, nil
+}
+
+// calculateBufferAllocation returns the amount multiplied by the buffer pool
+// percentage, rounded towards zero to the given number of decimal places.
+func calculateBufferAllocation(amount, percentage math.Dec, decimalPlaces uint32) (math.Dec, error) {
+	buffer, err := amount.Mul(percentage)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	scale := math.NewDecFinite(1, int32(decimalPlaces))
+	scaled, err := buffer.Mul(scale)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	truncated, err := scaled.QuoInteger(math.NewDecFromInt64(1))
+	if err != nil {
+		return math.Dec{}, err
+	}
+	result, err := truncated.QuoExact(scale)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	result, _ = result.Reduce()
+	return result, nil
+}
+
 // getBatchSeqNo gets the batch sequence number
 func (k Keeper) getBatchSeqNo(ctx context.Context, projectKey uint64) (uint64, error) {
 	var seq uint64 = 1
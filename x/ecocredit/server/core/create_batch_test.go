@@ -5,19 +5,27 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 func TestCreateBatch_Valid(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -77,11 +85,32 @@ func TestCreateBatch_Valid(t *testing.T) {
 	seq, err := s.stateStore.BatchSequenceTable().Get(s.ctx, 1)
 	assert.NilError(t, err)
 	assert.Equal(t, uint64(2), seq.NextSequence)
+
+	// check the event
+	var event *core.EventCreateBatch
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != "regen.ecocredit.v1.EventCreateBatch" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		assert.NilError(t, err)
+		event = msg.(*core.EventCreateBatch)
+	}
+	assert.Assert(t, event != nil)
+	assert.Equal(t, res.BatchDenom, event.BatchDenom)
+	assert.Equal(t, "C01-001", event.ProjectId)
+	assert.Equal(t, s.addr.String(), event.Issuer)
+	assert.Check(t, event.StartDate.Equal(start))
+	assert.Check(t, event.EndDate.Equal(end))
 }
 
 func TestCreateBatch_BadPrecision(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
 
 	start, end := time.Now(), time.Now()
@@ -101,6 +130,142 @@ func TestCreateBatch_BadPrecision(t *testing.T) {
 	assert.ErrorContains(t, err, "exceeds maximum decimal places")
 }
 
+func TestCreateBatch_BufferPool(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, bufferAddr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	classBufferPools := []*core.ClassBufferPool{
+		{ClassId: "C01", Address: bufferAddr.String(), Percentage: "0.1"},
+	}
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
+	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
+
+	start, end := time.Now(), time.Now()
+	_, err := s.k.CreateBatch(s.ctx, &core.MsgCreateBatch{
+		Issuer:    s.addr.String(),
+		ProjectId: "C01-001",
+		Issuance: []*core.BatchIssuance{
+			{
+				Recipient:      s.addr.String(),
+				TradableAmount: "10",
+				RetiredAmount:  "5.3",
+			},
+			{
+				Recipient:      addr2.String(),
+				TradableAmount: "2.400005",
+			},
+		},
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	assert.NilError(t, err)
+
+	// check the buffer pool portion was deducted from the recipients' tradable balances
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "9", bal.TradableAmount)
+	assert.Equal(t, "5.3", bal.RetiredAmount)
+
+	bal2, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr2, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "2.160005", bal2.TradableAmount)
+
+	// check the buffer pool portion was retired to the buffer pool address
+	bufferBal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, bufferAddr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", bufferBal.TradableAmount)
+	assert.Equal(t, "1.24", bufferBal.RetiredAmount)
+
+	buffer, err := s.stateStore.BatchBufferTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "1.24", buffer.Amount)
+	assert.DeepEqual(t, bufferAddr.Bytes(), buffer.Address)
+
+	// check the supply includes the buffer pool portion as retired
+	sup, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "11.160005", sup.TradableAmount)
+	assert.Equal(t, "6.54", sup.RetiredAmount)
+}
+
+func TestCreateBatch_ClassBatchPeriod(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
+	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
+
+	minStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxEnd := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, "C01")
+	assert.NilError(t, err)
+	class.MinBatchStartDate = timestamppb.New(minStart)
+	class.MaxBatchEndDate = timestamppb.New(maxEnd)
+	assert.NilError(t, s.stateStore.ClassTable().Update(s.ctx, class))
+
+	createBatch := func(start, end time.Time) error {
+		_, err := s.k.CreateBatch(s.ctx, &core.MsgCreateBatch{
+			Issuer:    s.addr.String(),
+			ProjectId: "C01-001",
+			Issuance:  []*core.BatchIssuance{{Recipient: s.addr.String(), TradableAmount: "10"}},
+			StartDate: &start,
+			EndDate:   &end,
+		})
+		return err
+	}
+
+	// start date before the minimum batch start date
+	err = createBatch(minStart.Add(-time.Hour), maxEnd)
+	assert.ErrorContains(t, err, "is before the minimum batch start date")
+
+	// end date after the maximum batch end date
+	err = createBatch(minStart, maxEnd.Add(time.Hour))
+	assert.ErrorContains(t, err, "is after the maximum batch end date")
+
+	// start date and end date within the batch period
+	assert.NilError(t, createBatch(minStart, maxEnd))
+}
+
+func TestCreateBatch_MetadataSchema(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	s.k.metadataValidator = schemaPrefixValidator{}
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
+	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, "C01")
+	assert.NilError(t, err)
+	class.MetadataSchema = "regen:schema/v1"
+	assert.NilError(t, s.stateStore.ClassTable().Update(s.ctx, class))
+
+	start, end := time.Now(), time.Now().Add(time.Hour)
+	createBatch := func(metadata string) error {
+		_, err := s.k.CreateBatch(s.ctx, &core.MsgCreateBatch{
+			Issuer:    s.addr.String(),
+			ProjectId: "C01-001",
+			Issuance:  []*core.BatchIssuance{{Recipient: s.addr.String(), TradableAmount: "10"}},
+			Metadata:  metadata,
+			StartDate: &start,
+			EndDate:   &end,
+		})
+		return err
+	}
+
+	err = createBatch("foo")
+	assert.ErrorContains(t, err, "metadata does not conform to metadata schema regen:schema/v1 of class C01")
+
+	assert.NilError(t, createBatch("regen:schema/v1/batch"))
+}
+
 func TestCreateBatch_UnauthorizedIssuer(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -125,6 +290,10 @@ func TestCreateBatch_ProjectNotFound(t *testing.T) {
 func TestCreateBatch_WithOriginTx_Valid(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -158,11 +327,22 @@ func TestCreateBatch_WithOriginTx_Valid(t *testing.T) {
 		},
 	})
 	assert.NilError(t, err)
+
+	// check the origin tx records the credits issued
+	originTx, err := s.stateStore.BatchOriginTxTable().Get(s.ctx, "210985091248", "Ethereum")
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), originTx.BatchKey)
+	assert.Equal(t, "12.4", originTx.TradableAmount)
+	assert.Equal(t, "8.7", originTx.RetiredAmount)
 }
 
 func TestCreateBatch_WithOriginTx_Invalid(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
+
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 2)
+
 	batchTestSetup(t, s.ctx, s.stateStore, s.addr)
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -201,6 +381,7 @@ func TestCreateBatch_WithOriginTx_Invalid(t *testing.T) {
 
 	// create credit batch with same tx origin id
 	_, err = s.k.CreateBatch(s.ctx, batch)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
 	assert.ErrorContains(t, err, "credits already issued with tx id")
 }
 
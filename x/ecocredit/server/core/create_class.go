@@ -3,11 +3,14 @@ package core
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
@@ -16,7 +19,8 @@ import (
 //
 // The admin is charged a fee for creating the class. This is controlled by
 // the global parameter CreditClassFee, which can be updated through the
-// governance process.
+// governance process. If the admin is listed in the global parameter
+// ClassFeeDiscounts, the fee is reduced by the admin's multiplier.
 func (k Keeper) CreateClass(goCtx context.Context, req *core.MsgCreateClass) (*core.MsgCreateClassResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(goCtx)
 	adminAddress, err := sdk.AccAddressFromBech32(req.Admin)
@@ -36,14 +40,23 @@ func (k Keeper) CreateClass(goCtx context.Context, req *core.MsgCreateClass) (*c
 	if feeAmt.IsZero() {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in credit class fees", req.Fee.Denom)
 	}
+
+	feeAmt, err = k.applyClassFeeDiscount(sdkCtx, adminAddress, feeAmt)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Fee.Amount.LT(feeAmt) {
 		return nil, sdkerrors.ErrInsufficientFee.Wrapf("expected %v%s for fee, got %v", feeAmt, req.Fee.Denom, req.Fee)
 	}
 
 	// Charge the admin a fee to create the credit class
-	err = k.chargeCreditClassFee(sdkCtx, adminAddress, sdk.Coins{sdk.Coin{Denom: req.Fee.Denom, Amount: feeAmt}})
-	if err != nil {
-		return nil, err
+	chargedFee := sdk.Coin{Denom: req.Fee.Denom, Amount: feeAmt}
+	if !chargedFee.IsZero() {
+		err = k.chargeCreditClassFee(sdkCtx, adminAddress, sdk.Coins{chargedFee})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	creditType, err := k.stateStore.CreditTypeTable().Get(goCtx, req.CreditTypeAbbrev)
@@ -71,11 +84,22 @@ func (k Keeper) CreateClass(goCtx context.Context, req *core.MsgCreateClass) (*c
 
 	classID := core.FormatClassId(creditType.Abbreviation, seq)
 
+	var minBatchStartDate, maxBatchEndDate *timestamppb.Timestamp
+	if req.MinBatchStartDate != nil {
+		minBatchStartDate = timestamppb.New(*req.MinBatchStartDate)
+	}
+	if req.MaxBatchEndDate != nil {
+		maxBatchEndDate = timestamppb.New(*req.MaxBatchEndDate)
+	}
+
 	key, err := k.stateStore.ClassTable().InsertReturningID(goCtx, &api.Class{
-		Id:               classID,
-		Admin:            adminAddress,
-		Metadata:         req.Metadata,
-		CreditTypeAbbrev: creditType.Abbreviation,
+		Id:                classID,
+		Admin:             adminAddress,
+		Metadata:          req.Metadata,
+		CreditTypeAbbrev:  creditType.Abbreviation,
+		MinBatchStartDate: minBatchStartDate,
+		MaxBatchEndDate:   maxBatchEndDate,
+		MetadataSchema:    req.MetadataSchema,
 	})
 	if err != nil {
 		return nil, err
@@ -97,7 +121,10 @@ func (k Keeper) CreateClass(goCtx context.Context, req *core.MsgCreateClass) (*c
 	}
 
 	err = sdkCtx.EventManager().EmitTypedEvent(&core.EventCreateClass{
-		ClassId: classID,
+		ClassId:          classID,
+		Fee:              &chargedFee,
+		Admin:            adminAddress.String(),
+		CreditTypeAbbrev: creditType.Abbreviation,
 	})
 	if err != nil {
 		return nil, err
@@ -130,6 +157,33 @@ func (k Keeper) isCreatorAllowListed(ctx sdk.Context, allowlist []string, design
 	return false
 }
 
+// applyClassFeeDiscount multiplies the credit class fee by the admin's multiplier if the admin is listed in the
+// class fee discounts. The discounted fee is rounded towards zero.
+func (k Keeper) applyClassFeeDiscount(ctx sdk.Context, adminAddress sdk.AccAddress, feeAmt sdk.Int) (sdk.Int, error) {
+	var discounts []*core.ClassFeeDiscount
+	k.paramsKeeper.Get(ctx, core.KeyClassFeeDiscounts, &discounts)
+	for _, discount := range discounts {
+		addr, _ := sdk.AccAddressFromBech32(discount.Address)
+		if adminAddress.Equals(addr) {
+			multiplier, err := math.NewNonNegativeDecFromString(discount.Multiplier)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			fee, err := math.NewDecFromString(feeAmt.String())
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			discounted, err := fee.Mul(multiplier)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			return discounted.SdkIntTrim(), nil
+		}
+		ctx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgCreateClass fee discount iteration")
+	}
+	return feeAmt, nil
+}
+
 func (k Keeper) chargeCreditClassFee(ctx sdk.Context, creatorAddr sdk.AccAddress, creditClassFee sdk.Coins) error {
 	// Move the fee to the ecocredit module's account
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, ecocredit.ModuleName, creditClassFee)
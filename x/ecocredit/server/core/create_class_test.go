@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -8,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
@@ -20,10 +22,12 @@ func TestCreateClass_Valid(t *testing.T) {
 
 	allowListEnabled := true
 	creditClassFees := core.DefaultParams().CreditClassFee
+	var classFeeDiscounts []*core.ClassFeeDiscount
 	allowList := []string{s.addr.String()}
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	utils.ExpectParamGet(&allowList, s.paramsKeeper, core.KeyAllowedClassCreators, 1)
 	utils.ExpectParamGet(&creditClassFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).Return(nil).Times(1)
 	s.bankKeeper.EXPECT().BurnCoins(gmAny, gmAny, gmAny).Return(nil).Times(1)
 
@@ -78,8 +82,10 @@ func TestCreateClass_Sequence(t *testing.T) {
 
 	allowListEnabled := false
 	classFees := core.DefaultParams().CreditClassFee
+	var classFeeDiscounts []*core.ClassFeeDiscount
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).Return(nil).Times(2)
 	s.bankKeeper.EXPECT().BurnCoins(gmAny, gmAny, gmAny).Return(nil).Times(2)
@@ -95,6 +101,7 @@ func TestCreateClass_Sequence(t *testing.T) {
 
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 	res2, err := s.k.CreateClass(s.ctx, &core.MsgCreateClass{
 		Admin:            s.addr.String(),
 		Issuers:          []string{s.addr.String()},
@@ -114,6 +121,7 @@ func TestCreateClass_Fees(t *testing.T) {
 
 	allowListEnabled := false
 	classFees := core.DefaultParams().CreditClassFee
+	var classFeeDiscounts []*core.ClassFeeDiscount
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
 
@@ -130,6 +138,7 @@ func TestCreateClass_Fees(t *testing.T) {
 	// fee too low
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 	_, err = s.k.CreateClass(s.ctx, &core.MsgCreateClass{
 		Admin:            s.addr.String(),
 		Issuers:          []string{s.addr.String()},
@@ -139,3 +148,63 @@ func TestCreateClass_Fees(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, "expected 20000000stake for fee, got 1stake")
 }
+
+func TestCreateClass_FeeDiscount(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	gmAny := gomock.Any()
+	ccFee := core.DefaultParams().CreditClassFee[0]
+
+	allowListEnabled := false
+	classFees := core.DefaultParams().CreditClassFee
+	classFeeDiscounts := []*core.ClassFeeDiscount{
+		{Address: s.addr.String(), Multiplier: "0.25"},
+	}
+	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
+	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
+
+	// 20000000stake * 0.25 = 5000000stake
+	expectedFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, s.addr, ecocredit.ModuleName, expectedFee).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, expectedFee).Return(nil).Times(1)
+
+	_, err := s.k.CreateClass(s.ctx, &core.MsgCreateClass{
+		Admin:            s.addr.String(),
+		Issuers:          []string{s.addr.String()},
+		CreditTypeAbbrev: "C",
+		Fee:              &ccFee,
+	})
+	assert.NilError(t, err)
+
+	// the charged fee is recorded in the event
+	events := s.sdkCtx.EventManager().Events()
+	event := events[len(events)-1]
+	assert.Equal(t, "regen.ecocredit.v1.EventCreateClass", event.Type)
+	for _, attr := range event.Attributes {
+		switch string(attr.Key) {
+		case "fee":
+			assert.Equal(t, `{"denom":"stake","amount":"5000000"}`, string(attr.Value))
+		case "class_id":
+			assert.Equal(t, `"C01"`, string(attr.Value))
+		case "admin":
+			assert.Equal(t, fmt.Sprintf("%q", s.addr.String()), string(attr.Value))
+		case "credit_type_abbrev":
+			assert.Equal(t, `"C"`, string(attr.Value))
+		}
+	}
+
+	// full waiver does not charge a fee
+	classFeeDiscounts[0].Multiplier = "0"
+	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
+	utils.ExpectParamGet(&classFees, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
+
+	_, err = s.k.CreateClass(s.ctx, &core.MsgCreateClass{
+		Admin:            s.addr.String(),
+		Issuers:          []string{s.addr.String()},
+		CreditTypeAbbrev: "C",
+		Fee:              &ccFee,
+	})
+	assert.NilError(t, err)
+}
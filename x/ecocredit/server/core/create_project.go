@@ -30,6 +30,12 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 		return nil, err
 	}
 
+	if err = k.assertClassMetadataSchema(sdkCtx.Context, classInfo, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	jurisdiction := core.NormalizeJurisdiction(req.Jurisdiction)
+
 	projectID, err := k.genProjectID(ctx, classInfo.Key, classInfo.Id)
 	if err != nil {
 		return nil, err
@@ -39,7 +45,7 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 		Id:           projectID,
 		Admin:        adminAddress,
 		ClassKey:     classInfo.Key,
-		Jurisdiction: req.Jurisdiction,
+		Jurisdiction: jurisdiction,
 		Metadata:     req.Metadata,
 		ReferenceId:  req.ReferenceId,
 	}); err != nil {
@@ -47,7 +53,10 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 	}
 
 	if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventCreateProject{
-		ProjectId: projectID,
+		ProjectId:    projectID,
+		ClassId:      classInfo.Id,
+		Admin:        adminAddress.String(),
+		Jurisdiction: jurisdiction,
 	}); err != nil {
 		return nil, err
 	}
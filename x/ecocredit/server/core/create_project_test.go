@@ -2,8 +2,11 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +32,37 @@ func TestCreateProject_ValidProjectState(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, project.Admin, s.addr.Bytes())
 	assert.Equal(t, project.Jurisdiction, "US-NY")
+
+	// check the event
+	events := s.sdkCtx.EventManager().Events()
+	msg, err := types.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &core.EventCreateProject{
+		ProjectId:    "C01-001",
+		ClassId:      "C01",
+		Admin:        s.addr.String(),
+		Jurisdiction: "US-NY",
+	}, msg)
+}
+
+func TestCreateProject_NormalizesJurisdiction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	makeClass(t, s.ctx, s.stateStore, s.addr)
+
+	for i, jurisdiction := range []string{"US-NY", "us-ny", "Us-Ny"} {
+		res, err := s.k.CreateProject(s.ctx, &core.MsgCreateProject{
+			Admin:        s.addr.String(),
+			ClassId:      "C01",
+			Jurisdiction: jurisdiction,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, fmt.Sprintf("C01-00%d", i+1), res.ProjectId)
+
+		project, err := s.stateStore.ProjectTable().GetById(s.ctx, res.ProjectId)
+		assert.NilError(t, err)
+		assert.Equal(t, "US-NY", project.Jurisdiction)
+	}
 }
 
 func TestCreateProject_GeneratedProjectID(t *testing.T) {
@@ -98,3 +132,44 @@ func TestCreateProject_With_ReferenceId_ValidProjectState(t *testing.T) {
 	assert.Equal(t, project.Jurisdiction, "US-NY")
 	assert.Equal(t, project.ReferenceId, "Project1")
 }
+
+// schemaPrefixValidator is a MetadataValidator that requires metadata to be
+// prefixed with the metadata schema.
+type schemaPrefixValidator struct{}
+
+func (schemaPrefixValidator) ValidateMetadata(_ types.Context, schema string, metadata string) error {
+	if !strings.HasPrefix(metadata, schema) {
+		return fmt.Errorf("expected metadata to reference %s", schema)
+	}
+	return nil
+}
+
+func TestCreateProject_MetadataSchema(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	s.k.metadataValidator = schemaPrefixValidator{}
+	makeClass(t, s.ctx, s.stateStore, s.addr)
+
+	createProject := func(metadata string) error {
+		_, err := s.k.CreateProject(s.ctx, &core.MsgCreateProject{
+			Admin:        s.addr.String(),
+			ClassId:      "C01",
+			Metadata:     metadata,
+			Jurisdiction: "US-NY",
+		})
+		return err
+	}
+
+	// metadata is not checked when the class does not have a metadata schema
+	assert.NilError(t, createProject("foo"))
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, "C01")
+	assert.NilError(t, err)
+	class.MetadataSchema = "regen:schema/v1"
+	assert.NilError(t, s.stateStore.ClassTable().Update(s.ctx, class))
+
+	err = createProject("foo")
+	assert.ErrorContains(t, err, "metadata does not conform to metadata schema regen:schema/v1 of class C01")
+
+	assert.NilError(t, createProject("regen:schema/v1/project"))
+}
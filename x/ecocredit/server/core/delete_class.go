@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// DeleteClass deletes a credit class and its issuers IFF the signer is the module authority and no projects
+// reference the credit class.
+func (k Keeper) DeleteClass(ctx context.Context, req *core.MsgDeleteClass) (*core.MsgDeleteClassResponse, error) {
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.authority.Equals(authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s, got %s", k.authority, req.Authority)
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("could not get class with id %s: %s", req.ClassId, err)
+	}
+
+	count, err := k.countClassProjects(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot delete class %s: referenced by %d project(s)", class.Id, count,
+		)
+	}
+
+	if err := k.stateStore.ClassIssuerTable().DeleteBy(ctx, api.ClassIssuerClassKeyIssuerIndexKey{}.WithClassKey(class.Key)); err != nil {
+		return nil, err
+	}
+
+	if err := k.stateStore.ClassTable().Delete(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventDeleteClass{
+		ClassId: class.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgDeleteClassResponse{}, nil
+}
+
+// countClassProjects returns the number of projects within the credit class.
+func (k Keeper) countClassProjects(ctx context.Context, classKey uint64) (int, error) {
+	it, err := k.stateStore.ProjectTable().List(ctx, api.ProjectClassKeyIdIndexKey{}.WithClassKey(classKey))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	return count, nil
+}
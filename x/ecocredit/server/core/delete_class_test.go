@@ -0,0 +1,100 @@
+package core
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestDeleteClass_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+
+	_, err = s.k.DeleteClass(s.ctx, &core.MsgDeleteClass{
+		Authority: s.authority.String(),
+		ClassId:   "C01",
+	})
+	assert.NilError(t, err)
+
+	found, err := s.stateStore.ClassTable().HasById(s.ctx, "C01")
+	assert.NilError(t, err)
+	assert.Equal(t, found, false)
+
+	found, err = s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, s.addr)
+	assert.NilError(t, err)
+	assert.Equal(t, found, false)
+
+	var emitted bool
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventDeleteClass" {
+			continue
+		}
+		emitted = true
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "class_id" {
+				assert.Equal(t, `"C01"`, string(attr.Value))
+			}
+		}
+	}
+	assert.Assert(t, emitted, "expected EventDeleteClass to be emitted")
+}
+
+func TestDeleteClass_ReferencedByProjects(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classId, _, _ := s.setupClassProjectBatch(t)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, classId)
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       "C01-002",
+		ClassKey: class.Key,
+	}))
+
+	_, err = s.k.DeleteClass(s.ctx, &core.MsgDeleteClass{
+		Authority: s.authority.String(),
+		ClassId:   classId,
+	})
+	assert.ErrorContains(t, err, "cannot delete class C01: referenced by 2 project(s)")
+
+	// nothing is deleted
+	found, err := s.stateStore.ClassTable().HasById(s.ctx, classId)
+	assert.NilError(t, err)
+	assert.Equal(t, found, true)
+
+	found, err = s.stateStore.ClassIssuerTable().Has(s.ctx, class.Key, s.addr)
+	assert.NilError(t, err)
+	assert.Equal(t, found, true)
+}
+
+func TestDeleteClass_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.DeleteClass(s.ctx, &core.MsgDeleteClass{
+		Authority: s.addr.String(),
+		ClassId:   "C01",
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.k.DeleteClass(s.ctx, &core.MsgDeleteClass{
+		Authority: s.authority.String(),
+		ClassId:   "C01",
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
@@ -3,6 +3,7 @@ package core
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -16,18 +17,56 @@ type Keeper struct {
 	bankKeeper    ecocredit.BankKeeper
 	paramsKeeper  ecocredit.ParamKeeper
 	moduleAddress sdk.AccAddress
+
+	// basketStore is only read to account for the credits held in baskets
+	// when reconciling the supply of credit batches and to check for baskets
+	// when updating the precision of a credit type.
+	basketStore basketapi.StateStore
+
+	// authority is the address allowed to execute governance messages
+	// (typically the governance module account).
+	authority sdk.AccAddress
+
+	// retireHooks are called after credits are retired.
+	retireHooks ecocredit.RetireHooks
+
+	// sendHooks are called before and after credits are sent.
+	sendHooks ecocredit.SendHooks
+
+	// metadataValidator checks project and credit batch metadata against the
+	// metadata schema of the credit class.
+	metadataValidator ecocredit.MetadataValidator
 }
 
 func NewKeeper(
 	ss api.StateStore,
+	basketStore basketapi.StateStore,
 	bk ecocredit.BankKeeper,
 	pk ecocredit.ParamKeeper,
 	ma sdk.AccAddress,
+	authority sdk.AccAddress,
+	retireHooks ecocredit.RetireHooks,
+	sendHooks ecocredit.SendHooks,
+	metadataValidator ecocredit.MetadataValidator,
 ) Keeper {
+	if retireHooks == nil {
+		retireHooks = ecocredit.NoOpRetireHooks{}
+	}
+	if sendHooks == nil {
+		sendHooks = ecocredit.NoOpSendHooks{}
+	}
+	if metadataValidator == nil {
+		metadataValidator = ecocredit.NoOpMetadataValidator{}
+	}
 	return Keeper{
-		stateStore:    ss,
-		bankKeeper:    bk,
-		paramsKeeper:  pk,
-		moduleAddress: ma,
+		stateStore:        ss,
+		basketStore:       basketStore,
+		bankKeeper:        bk,
+		paramsKeeper:      pk,
+		moduleAddress:     ma,
+		authority:         authority,
+		retireHooks:       retireHooks,
+		sendHooks:         sendHooks,
+		metadataValidator: metadataValidator,
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -31,10 +32,12 @@ type baseSuite struct {
 	t            gocuke.TestingT
 	db           ormdb.ModuleDB
 	stateStore   api.StateStore
+	basketStore  basketapi.StateStore
 	ctx          context.Context
 	k            Keeper
 	ctrl         *gomock.Controller
 	addr         sdk.AccAddress
+	authority    sdk.AccAddress
 	bankKeeper   *mocks.MockBankKeeper
 	paramsKeeper *mocks.MockParamKeeper
 	storeKey     *sdk.KVStoreKey
@@ -49,6 +52,8 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	assert.NilError(t, err)
 	s.stateStore, err = api.NewStateStore(s.db)
 	assert.NilError(t, err)
+	s.basketStore, err = basketapi.NewStateStore(s.db)
+	assert.NilError(t, err)
 
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
@@ -64,6 +69,11 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
 	s.paramsKeeper = mocks.NewMockParamKeeper(s.ctrl)
+	// gas cost per iteration and max credits per message are read by every send, retire, and cancel
+	s.paramsKeeper.EXPECT().Get(gomock.Any(), core.KeyGasCostPerIteration, gomock.Any()).
+		SetArg(2, core.DefaultGasCostPerIteration).AnyTimes()
+	s.paramsKeeper.EXPECT().Get(gomock.Any(), core.KeyMaxCreditsPerMsg, gomock.Any()).
+		SetArg(2, core.DefaultMaxCreditsPerMsg).AnyTimes()
 	assert.NilError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
 		Abbreviation: "C",
 		Name:         "carbon",
@@ -71,7 +81,8 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 		Precision:    6,
 	}))
 	_, _, moduleAddress := testdata.KeyTestPubAddr()
-	s.k = NewKeeper(s.stateStore, s.bankKeeper, s.paramsKeeper, moduleAddress)
+	_, _, s.authority = testdata.KeyTestPubAddr()
+	s.k = NewKeeper(s.stateStore, s.basketStore, s.bankKeeper, s.paramsKeeper, moduleAddress, s.authority, nil, nil, nil)
 	_, _, s.addr = testdata.KeyTestPubAddr()
 
 	return s
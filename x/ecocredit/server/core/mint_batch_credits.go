@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
@@ -28,10 +29,12 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unable to mint credits: %s", err.Error())
 	}
 
-	if err = k.stateStore.BatchOriginTxTable().Insert(ctx, &api.BatchOriginTx{
-		Id:     req.OriginTx.Id,
-		Source: req.OriginTx.Source,
-	}); err != nil {
+	originTx := &api.BatchOriginTx{
+		Id:       req.OriginTx.Id,
+		Source:   req.OriginTx.Source,
+		BatchKey: batch.Key,
+	}
+	if err = k.stateStore.BatchOriginTxTable().Insert(ctx, originTx); err != nil {
 		if ormerrors.PrimaryKeyConstraintViolation.Is(err) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits already issued with tx id: %s", req.OriginTx.Id)
 		}
@@ -44,6 +47,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 	}
 	precision := ct.Precision
 	moduleAddrString := k.moduleAddress.String()
+	mintedTradable, mintedRetired := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
 	for _, iss := range req.Issuance {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
@@ -56,6 +60,15 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		}
 		tradable, retired := decs[0], decs[1]
 
+		mintedTradable, err = mintedTradable.Add(tradable)
+		if err != nil {
+			return nil, err
+		}
+		mintedRetired, err = mintedRetired.Add(retired)
+		if err != nil {
+			return nil, err
+		}
+
 		balance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), recipient, batch.Key)
 		if err != nil {
 			return nil, err
@@ -85,7 +98,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 				Owner:        iss.Recipient,
 				BatchDenom:   req.BatchDenom,
 				Amount:       iss.RetiredAmount,
-				Jurisdiction: iss.RetirementJurisdiction,
+				Jurisdiction: core.NormalizeJurisdiction(iss.RetirementJurisdiction),
 			}); err != nil {
 				return nil, err
 			}
@@ -129,6 +142,12 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		}
 	}
 
+	originTx.TradableAmount = mintedTradable.String()
+	originTx.RetiredAmount = mintedRetired.String()
+	if err := k.stateStore.BatchOriginTxTable().Update(ctx, originTx); err != nil {
+		return nil, err
+	}
+
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventMintBatchCredits{
 		BatchDenom: batch.Denom,
 		OriginTx:   req.OriginTx,
@@ -51,6 +51,13 @@ func TestMintBatchCredits_Valid(t *testing.T) {
 	assert.NilError(t, err)
 
 	assertCreditsMinted(t, balBefore, balAfter, supplyBefore, supplyAfter, issuance, 6)
+
+	// check the origin tx records the credits minted
+	originTx, err := s.stateStore.BatchOriginTxTable().Get(ctx, "0x12345", "polygon")
+	assert.NilError(t, err)
+	assert.Equal(t, batch.Key, originTx.BatchKey)
+	assert.Equal(t, "10", originTx.TradableAmount)
+	assert.Equal(t, "10", originTx.RetiredAmount)
 }
 
 func TestMintBatchCredits_Unauthorized(t *testing.T) {
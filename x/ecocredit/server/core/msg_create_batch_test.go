@@ -89,6 +89,9 @@ func (s *createBatchSuite) AliceCreatedAProject() {
 }
 
 func (s *createBatchSuite) AliceAttemptsToCreateACreditBatchWithTheIssuance(a gocuke.DocString) {
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	var issuance []*core.BatchIssuance
 	// unmarshal with json because issuance array is not a proto message
 	err := json.Unmarshal([]byte(a.Content), &issuance)
@@ -123,6 +126,8 @@ func (s *createBatchSuite) AliceHasCreatedACreditClassWithCreditType(a string) {
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	coinFee := sdk.Coins{fee}
 	utils.ExpectParamGet(&coinFee, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	var classFeeDiscounts []*core.ClassFeeDiscount
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).Return(nil).AnyTimes()
 
@@ -147,6 +152,9 @@ func (s *createBatchSuite) AliceHasCreatedAProjectWithCreditClassId(a string) {
 }
 
 func (s *createBatchSuite) AliceHasCreatedACreditBatchWithProjectId(a string) {
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	startDate, err := types.ParseDate("start date", "2020-01-01")
 	require.NoError(s.t, err)
 
@@ -168,6 +176,9 @@ func (s *createBatchSuite) AliceHasCreatedACreditBatchWithProjectId(a string) {
 }
 
 func (s *createBatchSuite) AliceCreatesACreditBatchWithProjectId(a string) {
+	var classBufferPools []*core.ClassBufferPool
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 1)
+
 	startDate, err := types.ParseDate("start date", "2020-01-01")
 	require.NoError(s.t, err)
 
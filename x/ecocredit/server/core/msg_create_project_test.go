@@ -50,6 +50,8 @@ func (s *createProjectSuite) AliceHasCreatedACreditClassWithCreditType(a string)
 	utils.ExpectParamGet(&allowListEnabled, s.paramsKeeper, core.KeyAllowlistEnabled, 1)
 	coinFee := sdk.Coins{fee}
 	utils.ExpectParamGet(&coinFee, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	var classFeeDiscounts []*core.ClassFeeDiscount
+	utils.ExpectParamGet(&classFeeDiscounts, s.paramsKeeper, core.KeyClassFeeDiscounts, 1)
 
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).Return(nil).AnyTimes()
 
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// MultiSend sends credits to multiple recipients. If any transfer fails, an
+// error is returned and none of the transfers are committed.
+func (k Keeper) MultiSend(ctx context.Context, req *core.MsgMultiSend) (*core.MsgMultiSendResponse, error) {
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	sender, _ := sdk.AccAddressFromBech32(req.Sender)
+
+	// the max credits per message applies to the credits of all transfers
+	numCredits := 0
+	for _, transfer := range req.Transfers {
+		numCredits += len(transfer.Credits)
+	}
+	if err := k.assertMaxCreditsPerMsg(sdkCtx.Context, numCredits); err != nil {
+		return nil, err
+	}
+
+	gasCostPerIteration := k.getGasCostPerIteration(sdkCtx.Context)
+
+	cache := newSendCache()
+	transfers := newMultiSendTransfers()
+	for _, transfer := range req.Transfers {
+		recipient, _ := sdk.AccAddressFromBech32(transfer.Recipient)
+
+		for _, credit := range transfer.Credits {
+			if err := k.sendEcocredits(ctx, cache, credit, recipient, sender); err != nil {
+				return nil, err
+			}
+			if err := transfers.add(transfer.Recipient, credit, cache.batches[credit.BatchDenom].precision); err != nil {
+				return nil, err
+			}
+
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "ecocredit/core/MsgMultiSend credit iteration")
+		}
+	}
+
+	// a single transfer event is emitted for each recipient and credit batch,
+	// in the order in which they first appear in the message
+	for _, t := range transfers.ordered {
+		if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
+			Sender:         req.Sender,
+			Recipient:      t.recipient,
+			BatchDenom:     t.batchDenom,
+			TradableAmount: t.tradable.String(),
+			RetiredAmount:  t.retired.String(),
+			Note:           req.Note,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &core.MsgMultiSendResponse{}, nil
+}
+
+// multiSendTransfers aggregates the credits sent with Msg/MultiSend by
+// recipient and credit batch.
+type multiSendTransfers struct {
+	byKey   map[string]*multiSendTransfer
+	ordered []*multiSendTransfer
+}
+
+type multiSendTransfer struct {
+	recipient  string
+	batchDenom string
+	tradable   math.Dec
+	retired    math.Dec
+}
+
+func newMultiSendTransfers() *multiSendTransfers {
+	return &multiSendTransfers{byKey: make(map[string]*multiSendTransfer)}
+}
+
+func (m *multiSendTransfers) add(recipient string, credit *core.MsgSend_SendCredits, precision uint32) error {
+	decs, err := utils.GetNonNegativeFixedDecs(precision, credit.TradableAmount, credit.RetiredAmount)
+	if err != nil {
+		return err
+	}
+
+	key := recipient + "/" + credit.BatchDenom
+	t, ok := m.byKey[key]
+	if !ok {
+		m.byKey[key] = &multiSendTransfer{
+			recipient:  recipient,
+			batchDenom: credit.BatchDenom,
+			tradable:   decs[0],
+			retired:    decs[1],
+		}
+		m.ordered = append(m.ordered, m.byKey[key])
+		return nil
+	}
+
+	if t.tradable, err = t.tradable.Add(decs[0]); err != nil {
+		return err
+	}
+	if t.retired, err = t.retired.Add(decs[1]); err != nil {
+		return err
+	}
+
+	return nil
+}
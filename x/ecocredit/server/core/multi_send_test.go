@@ -0,0 +1,195 @@
+package core
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestMultiSend_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient1 := testdata.KeyTestPubAddr()
+	_, _, recipient2 := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	// s.addr starting balance -> 10.5 tradable, 10.5 retired
+
+	_, err := s.k.MultiSend(s.ctx, &core.MsgMultiSend{
+		Sender: s.addr.String(),
+		Transfers: []*core.MsgMultiSend_Transfer{
+			{
+				Recipient: recipient1.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "2"},
+				},
+			},
+			{
+				Recipient: recipient2.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "1", RetiredAmount: "3", RetirementJurisdiction: "US-OR"},
+				},
+			},
+		},
+		Note: "distribution",
+	})
+	assert.NilError(t, err)
+
+	senderBal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, senderBal.TradableAmount, "4.5")
+	assert.Equal(t, senderBal.RetiredAmount, "10.5")
+
+	bal1, err := s.stateStore.BatchBalanceTable().Get(s.ctx, recipient1, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal1.TradableAmount, "2")
+	assert.Equal(t, bal1.RetiredAmount, "0")
+
+	bal2, err := s.stateStore.BatchBalanceTable().Get(s.ctx, recipient2, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal2.TradableAmount, "1")
+	assert.Equal(t, bal2.RetiredAmount, "3")
+
+	sup, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, sup.TradableAmount, "7.5")
+	assert.Equal(t, sup.RetiredAmount, "13.5")
+
+	// a transfer event is emitted for each recipient
+	recipients := make([]string, 0, 2)
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventTransfer" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "recipient" {
+				recipients = append(recipients, string(attr.Value))
+			}
+		}
+	}
+	assert.DeepEqual(t, []string{`"` + recipient1.String() + `"`, `"` + recipient2.String() + `"`}, recipients)
+}
+
+func TestMultiSend_AggregatesEvents(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient1 := testdata.KeyTestPubAddr()
+	_, _, recipient2 := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	_, err := s.k.MultiSend(s.ctx, &core.MsgMultiSend{
+		Sender: s.addr.String(),
+		Transfers: []*core.MsgMultiSend_Transfer{
+			{
+				Recipient: recipient1.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "1"},
+					{BatchDenom: batchDenom, TradableAmount: "0.5", RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+				},
+			},
+			{
+				Recipient: recipient2.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "2"},
+				},
+			},
+			{
+				Recipient: recipient1.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, RetiredAmount: "2", RetirementJurisdiction: "US-OR"},
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	// a single transfer event is emitted for each recipient and batch
+	events := make([]*core.EventTransfer, 0, 2)
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != "regen.ecocredit.v1.EventTransfer" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		assert.NilError(t, err)
+		events = append(events, msg.(*core.EventTransfer))
+	}
+	assert.Equal(t, 2, len(events))
+
+	assert.Equal(t, recipient1.String(), events[0].Recipient)
+	assert.Equal(t, batchDenom, events[0].BatchDenom)
+	assert.Equal(t, "1.5", events[0].TradableAmount)
+	assert.Equal(t, "3", events[0].RetiredAmount)
+
+	assert.Equal(t, recipient2.String(), events[1].Recipient)
+	assert.Equal(t, batchDenom, events[1].BatchDenom)
+	assert.Equal(t, "2", events[1].TradableAmount)
+	assert.Equal(t, "0", events[1].RetiredAmount)
+}
+
+func TestMultiSend_InsufficientCredits(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient1 := testdata.KeyTestPubAddr()
+	_, _, recipient2 := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	// the second transfer exceeds the remaining balance of the sender
+	_, err := s.k.MultiSend(s.ctx, &core.MsgMultiSend{
+		Sender: s.addr.String(),
+		Transfers: []*core.MsgMultiSend_Transfer{
+			{
+				Recipient: recipient1.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "10"},
+				},
+			},
+			{
+				Recipient: recipient2.String(),
+				Credits: []*core.MsgSend_SendCredits{
+					{BatchDenom: batchDenom, TradableAmount: "1"},
+				},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestMultiSend_MaxCreditsPerMsg(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient1 := testdata.KeyTestPubAddr()
+	_, _, recipient2 := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	credits := make([]*core.MsgSend_SendCredits, core.DefaultMaxCreditsPerMsg+1)
+	for i := range credits {
+		credits[i] = &core.MsgSend_SendCredits{BatchDenom: batchDenom, TradableAmount: "0.000001"}
+	}
+
+	// the credits of all transfers count towards the maximum
+	half := len(credits) / 2
+	_, err := s.k.MultiSend(s.ctx, &core.MsgMultiSend{
+		Sender: s.addr.String(),
+		Transfers: []*core.MsgMultiSend_Transfer{
+			{Recipient: recipient1.String(), Credits: credits[:half]},
+			{Recipient: recipient2.String(), Credits: credits[half:]},
+		},
+	})
+	assert.ErrorContains(t, err, "number of credits 1001 exceeds the maximum of 1000 per message")
+
+	// the maximum number of credits is allowed
+	_, err = s.k.MultiSend(s.ctx, &core.MsgMultiSend{
+		Sender: s.addr.String(),
+		Transfers: []*core.MsgMultiSend_Transfer{
+			{Recipient: recipient1.String(), Credits: credits[:half]},
+			{Recipient: recipient2.String(), Credits: credits[half:core.DefaultMaxCreditsPerMsg]},
+		},
+	})
+	assert.NilError(t, err)
+}
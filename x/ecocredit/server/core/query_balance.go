@@ -28,12 +28,18 @@ func (k Keeper) Balance(ctx context.Context, req *core.QueryBalanceRequest) (*co
 		return nil, err
 	}
 
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
 	info := core.BatchBalanceInfo{
 		Address:        addr.String(),
 		BatchDenom:     batch.Denom,
 		TradableAmount: balance.TradableAmount,
 		RetiredAmount:  balance.RetiredAmount,
 		EscrowedAmount: balance.EscrowedAmount,
+		Precision:      creditType.Precision,
 	}
 
 	return &core.QueryBalanceResponse{Balance: &info}, nil
@@ -18,6 +18,9 @@ func TestQuery_Balance(t *testing.T) {
 
 	batchDenom := "C01-001-20200101-20210101-001"
 
+	// insert class
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+
 	// insert batch
 	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
 		Denom: batchDenom,
@@ -44,6 +47,7 @@ func TestQuery_Balance(t *testing.T) {
 	assert.Equal(t, batchDenom, res.Balance.BatchDenom)
 	assert.Equal(t, balance.TradableAmount, res.Balance.TradableAmount)
 	assert.Equal(t, balance.RetiredAmount, res.Balance.RetiredAmount)
+	assert.Equal(t, uint32(6), res.Balance.Precision)
 
 	_, _, noBalance := testdata.KeyTestPubAddr()
 
@@ -9,8 +9,11 @@ import (
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/ormutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
+// Balances queries all credit balances for a given account, including the
+// precision of the credit type of each credit batch.
 func (k Keeper) Balances(ctx context.Context, req *core.QueryBalancesRequest) (*core.QueryBalancesResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
@@ -36,6 +39,14 @@ func (k Keeper) Balances(ctx context.Context, req *core.QueryBalancesRequest) (*
 		}
 
 		batch, err := k.stateStore.BatchTable().Get(ctx, balance.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
 
 		info := core.BatchBalanceInfo{
 			Address:        addr.String(),
@@ -43,6 +54,7 @@ func (k Keeper) Balances(ctx context.Context, req *core.QueryBalancesRequest) (*
 			TradableAmount: balance.TradableAmount,
 			RetiredAmount:  balance.RetiredAmount,
 			EscrowedAmount: balance.EscrowedAmount,
+			Precision:      creditType.Precision,
 		}
 
 		balances = append(balances, &info)
@@ -18,9 +18,19 @@ func TestQuery_Balances(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
 
+	// insert credit type "BIO" and classes for both batches
+	assert.NilError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "BIO",
+		Name:         "biodiversity",
+		Unit:         "acres",
+		Precision:    2,
+	}))
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "BIO01", CreditTypeAbbrev: "BIO"}))
+
 	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: "C01-20200101-20220101-001"})
 	assert.NilError(t, err)
-	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: "C02-20200101-20220101-001"})
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: "BIO01-20200101-20220101-001"})
 	assert.NilError(t, err)
 
 	balance1 := &api.BatchBalance{Address: s.addr, BatchKey: bKey1, TradableAmount: "15", RetiredAmount: "15", EscrowedAmount: "15"}
@@ -37,8 +47,18 @@ func TestQuery_Balances(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, 1, len(res.Balances))
 	assertBalanceEqual(t, s.ctx, s.k, res.Balances[0], balance1)
+	assert.Equal(t, uint32(6), res.Balances[0].Precision)
 	assert.Equal(t, uint64(2), res.Pagination.Total)
 
+	// query balances for s.addr with precision of each credit type
+	res, err = s.k.Balances(s.ctx, &core.QueryBalancesRequest{
+		Address: s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Balances))
+	assertBalanceEqual(t, s.ctx, s.k, res.Balances[1], balance2)
+	assert.Equal(t, uint32(2), res.Balances[1].Precision)
+
 	_, _, noBalAddr := testdata.KeyTestPubAddr()
 
 	// query balances for address with no balance
@@ -61,6 +81,7 @@ func assertBalanceEqual(t *testing.T, ctx context.Context, k Keeper, received *c
 		TradableAmount: balance.TradableAmount,
 		RetiredAmount:  balance.RetiredAmount,
 		EscrowedAmount: balance.EscrowedAmount,
+		Precision:      received.Precision,
 	}
 
 	assert.DeepEqual(t, info, *received)
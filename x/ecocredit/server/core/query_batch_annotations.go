@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BatchAnnotations queries for the annotations of a credit batch in the order they were appended.
+func (k Keeper) BatchAnnotations(ctx context.Context, req *core.QueryBatchAnnotationsRequest) (*core.QueryBatchAnnotationsResponse, error) {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchAnnotationTable().List(ctx, api.BatchAnnotationBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	annotations := make([]*core.BatchAnnotationInfo, 0, 8)
+	for it.Next() {
+		annotation, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		annotations = append(annotations, &core.BatchAnnotationInfo{
+			Annotator:  sdk.AccAddress(annotation.Annotator).String(),
+			Annotation: annotation.Annotation,
+			Timestamp:  types.ProtobufToGogoTimestamp(annotation.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBatchAnnotationsResponse{
+		Annotations: annotations,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BatchAnnotations(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	firstTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := s.sdkCtx.WithBlockTime(firstTime)
+	_, err := s.k.AnnotateBatch(sdk.WrapSDKContext(ctx), &core.MsgAnnotateBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+		Annotation: "first note",
+	})
+	assert.NilError(t, err)
+
+	secondTime := firstTime.Add(time.Hour)
+	ctx = s.sdkCtx.WithBlockTime(secondTime)
+	_, err = s.k.AnnotateBatch(sdk.WrapSDKContext(ctx), &core.MsgAnnotateBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+		Annotation: "second note",
+	})
+	assert.NilError(t, err)
+
+	// query all annotations in order
+	res, err := s.k.BatchAnnotations(s.ctx, &core.QueryBatchAnnotationsRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Annotations))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	assert.Equal(t, s.addr.String(), res.Annotations[0].Annotator)
+	assert.Equal(t, "first note", res.Annotations[0].Annotation)
+	assert.Equal(t, firstTime.Unix(), res.Annotations[0].Timestamp.Seconds)
+
+	assert.Equal(t, s.addr.String(), res.Annotations[1].Annotator)
+	assert.Equal(t, "second note", res.Annotations[1].Annotation)
+	assert.Equal(t, secondTime.Unix(), res.Annotations[1].Timestamp.Seconds)
+
+	// query annotations with pagination
+	res, err = s.k.BatchAnnotations(s.ctx, &core.QueryBatchAnnotationsRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Annotations))
+	assert.Equal(t, "first note", res.Annotations[0].Annotation)
+
+	// query annotations of an unknown batch
+	_, err = s.k.BatchAnnotations(s.ctx, &core.QueryBatchAnnotationsRequest{BatchDenom: "C01-001-20200101-20210101-001"})
+	assert.ErrorContains(t, err, "could not get batch with denom")
+}
@@ -39,5 +39,9 @@ func (k Keeper) Batch(ctx context.Context, request *core.QueryBatchRequest) (*co
 		Open:         batch.Open,
 	}
 
+	if batch.ExpiryDate != nil {
+		info.ExpiryDate = types.ProtobufToGogoTimestamp(batch.ExpiryDate)
+	}
+
 	return &core.QueryBatchResponse{Batch: &info}, nil
 }
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// BatchSupply queries the supply (tradable, retired, cancelled) of a given credit batch along with the precision
+// of the credit type of the credit batch.
+func (k Keeper) BatchSupply(ctx context.Context, request *core.QueryBatchSupplyRequest) (*core.QueryBatchSupplyResponse, error) {
+	if err := core.ValidateBatchDenom(request.BatchDenom); err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("batch with denom %s", request.BatchDenom)
+		}
+		return nil, err
+	}
+
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("supply of batch with denom %s", request.BatchDenom)
+		}
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBatchSupplyResponse{
+		BatchDenom:      batch.Denom,
+		TradableAmount:  supply.TradableAmount,
+		RetiredAmount:   supply.RetiredAmount,
+		CancelledAmount: supply.CancelledAmount,
+		Precision:       creditType.Precision,
+	}, nil
+}
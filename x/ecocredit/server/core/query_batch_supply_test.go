@@ -0,0 +1,51 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BatchSupply(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := "C01-001-20200101-20220101-001"
+
+	// make a class, batch and some supply
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		ProjectKey: 1,
+		Denom:      batchDenom,
+	}))
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        1,
+		TradableAmount:  "10.54321",
+		RetiredAmount:   "50.3214",
+		CancelledAmount: "0.3215",
+	}))
+
+	// valid query
+	res, err := s.k.BatchSupply(s.ctx, &core.QueryBatchSupplyRequest{BatchDenom: batchDenom})
+	assert.NilError(t, err)
+	assert.Equal(t, batchDenom, res.BatchDenom)
+	assert.Equal(t, "10.54321", res.TradableAmount)
+	assert.Equal(t, "50.3214", res.RetiredAmount)
+	assert.Equal(t, "0.3215", res.CancelledAmount)
+	assert.Equal(t, uint32(6), res.Precision)
+
+	// unknown denom query
+	_, err = s.k.BatchSupply(s.ctx, &core.QueryBatchSupplyRequest{BatchDenom: "C01-001-20200101-20220101-002"})
+	assert.ErrorContains(t, err, sdkerrors.ErrNotFound.Error())
+
+	// invalid denom query
+	_, err = s.k.BatchSupply(s.ctx, &core.QueryBatchSupplyRequest{BatchDenom: "foo"})
+	assert.ErrorContains(t, err, "invalid batch denom")
+}
@@ -12,8 +12,18 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Batches queries for all batches in the given credit class.
+// Batches queries for all batches in the given credit class. If only the total count is requested, the batches are
+// counted without being fetched.
 func (k Keeper) Batches(ctx context.Context, request *core.QueryBatchesRequest) (*core.QueryBatchesResponse, error) {
+	if request.CountOnly {
+		it, err := k.stateStore.BatchTable().List(ctx, api.BatchPrimaryKey{})
+		if err != nil {
+			return nil, err
+		}
+
+		return &core.QueryBatchesResponse{Batches: []*core.BatchInfo{}, Pagination: countEntries(it)}, nil
+	}
+
 	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
 	if err != nil {
 		return nil, err
@@ -50,6 +60,10 @@ func (k Keeper) Batches(ctx context.Context, request *core.QueryBatchesRequest)
 			Open:         batch.Open,
 		}
 
+		if batch.ExpiryDate != nil {
+			info.ExpiryDate = types.ProtobufToGogoTimestamp(batch.ExpiryDate)
+		}
+
 		batches = append(batches, &info)
 	}
 
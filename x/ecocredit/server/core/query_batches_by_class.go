@@ -57,6 +57,10 @@ func (k Keeper) BatchesByClass(ctx context.Context, request *core.QueryBatchesBy
 			Open:         batch.Open,
 		}
 
+		if batch.ExpiryDate != nil {
+			info.ExpiryDate = types.ProtobufToGogoTimestamp(batch.ExpiryDate)
+		}
+
 		batches = append(batches, &info)
 	}
 
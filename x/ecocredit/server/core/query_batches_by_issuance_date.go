@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BatchesByIssuanceDate queries for all credit batches with an issuance date within the given time window.
+// Both bounds of the time window are inclusive.
+func (k Keeper) BatchesByIssuanceDate(ctx context.Context, req *core.QueryBatchesByIssuanceDateRequest) (*core.QueryBatchesByIssuanceDateResponse, error) {
+	if req.From == nil || req.To == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("must provide both from and to timestamps")
+	}
+
+	from, to := types.GogoToProtobufTimestamp(req.From), types.GogoToProtobufTimestamp(req.To)
+	if to.AsTime().Before(from.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("to must be the same as or after from")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	fromKey, toKey := api.BatchIssuanceDateIndexKey{}.WithIssuanceDate(from), api.BatchIssuanceDateIndexKey{}.WithIssuanceDate(to)
+
+	// range iteration requires distinct keys so a time window of a single
+	// instant is listed by prefix instead.
+	var it api.BatchIterator
+	if from.AsTime().Equal(to.AsTime()) {
+		it, err = k.stateStore.BatchTable().List(ctx, fromKey, ormlist.Paginate(pg))
+	} else {
+		it, err = k.stateStore.BatchTable().ListRange(ctx, fromKey, toKey, ormlist.Paginate(pg))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	batches := make([]*core.BatchInfo, 0, 8)
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, &core.BatchInfo{
+			Issuer:       sdk.AccAddress(batch.Issuer).String(),
+			ProjectId:    project.Id,
+			Denom:        batch.Denom,
+			Metadata:     batch.Metadata,
+			StartDate:    types.ProtobufToGogoTimestamp(batch.StartDate),
+			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
+			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
+			Open:         batch.Open,
+			ExpiryDate:   types.ProtobufToGogoTimestamp(batch.ExpiryDate),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBatchesByIssuanceDateResponse{
+		Batches:    batches,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BatchesByIssuanceDate(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert project
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id: "C01-001",
+	})
+	assert.NilError(t, err)
+
+	// insert batches with different issuance dates
+	batch1 := &api.Batch{
+		Issuer:       s.addr,
+		ProjectKey:   pKey,
+		Denom:        "C01-001-20200101-20210101-001",
+		IssuanceDate: &timestamppb.Timestamp{Seconds: 100},
+	}
+	batch2 := &api.Batch{
+		Issuer:       s.addr,
+		ProjectKey:   pKey,
+		Denom:        "C01-001-20200101-20210101-002",
+		IssuanceDate: &timestamppb.Timestamp{Seconds: 200},
+	}
+	batch3 := &api.Batch{
+		Issuer:       s.addr,
+		ProjectKey:   pKey,
+		Denom:        "C01-001-20200101-20210101-003",
+		IssuanceDate: &timestamppb.Timestamp{Seconds: 300},
+	}
+	for _, batch := range []*api.Batch{batch1, batch2, batch3} {
+		assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, batch))
+	}
+
+	// query batches issued within window including batch1 and batch2
+	res, err := s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From:       &gogotypes.Timestamp{Seconds: 0},
+		To:         &gogotypes.Timestamp{Seconds: 250},
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Batches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, batch1.Denom, res.Batches[0].Denom)
+	assert.Equal(t, batch2.Denom, res.Batches[1].Denom)
+	assert.Equal(t, int64(100), res.Batches[0].IssuanceDate.Seconds)
+	assert.Equal(t, "C01-001", res.Batches[0].ProjectId)
+
+	// query batches with inclusive bounds matching batch2 and batch3
+	res, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From: &gogotypes.Timestamp{Seconds: 200},
+		To:   &gogotypes.Timestamp{Seconds: 300},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Batches))
+	assert.Equal(t, batch2.Denom, res.Batches[0].Denom)
+	assert.Equal(t, batch3.Denom, res.Batches[1].Denom)
+
+	// query batches with a single point window
+	res, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From: &gogotypes.Timestamp{Seconds: 300},
+		To:   &gogotypes.Timestamp{Seconds: 300},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Batches))
+	assert.Equal(t, batch3.Denom, res.Batches[0].Denom)
+
+	// query batches with pagination
+	res, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From:       &gogotypes.Timestamp{Seconds: 0},
+		To:         &gogotypes.Timestamp{Seconds: 400},
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Batches))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+
+	// query batches with a window before any issuance
+	res, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From: &gogotypes.Timestamp{Seconds: 0},
+		To:   &gogotypes.Timestamp{Seconds: 50},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Batches))
+
+	// query with to before from
+	_, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{
+		From: &gogotypes.Timestamp{Seconds: 300},
+		To:   &gogotypes.Timestamp{Seconds: 100},
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	assert.ErrorContains(t, err, "to must be the same as or after from")
+
+	// query without time window
+	_, err = s.k.BatchesByIssuanceDate(s.ctx, &core.QueryBatchesByIssuanceDateRequest{})
+	assert.ErrorContains(t, err, "must provide both from and to timestamps")
+}
@@ -55,6 +55,10 @@ func (k Keeper) BatchesByIssuer(ctx context.Context, req *core.QueryBatchesByIss
 			Open:         batch.Open,
 		}
 
+		if batch.ExpiryDate != nil {
+			info.ExpiryDate = types.ProtobufToGogoTimestamp(batch.ExpiryDate)
+		}
+
 		batches = append(batches, &info)
 	}
 
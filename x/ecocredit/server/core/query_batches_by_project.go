@@ -51,6 +51,10 @@ func (k Keeper) BatchesByProject(ctx context.Context, request *core.QueryBatches
 			Open:         batch.Open,
 		}
 
+		if batch.ExpiryDate != nil {
+			info.ExpiryDate = types.ProtobufToGogoTimestamp(batch.ExpiryDate)
+		}
+
 		batches = append(batches, &info)
 	}
 
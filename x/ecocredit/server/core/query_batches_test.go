@@ -59,6 +59,20 @@ func TestQuery_Batches(t *testing.T) {
 	assert.Equal(t, 1, len(res.Batches))
 	assertBatchEqual(t, s.ctx, s.k, res.Batches[0], batch)
 	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// count total without a limit still returns the batches
+	res, err = s.k.Batches(s.ctx, &core.QueryBatchesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Batches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query only the total count
+	res, err = s.k.Batches(s.ctx, &core.QueryBatchesRequest{CountOnly: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Batches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
 }
 
 func assertBatchEqual(t *testing.T, ctx context.Context, k Keeper, received *core.BatchInfo, batch *api.Batch) {
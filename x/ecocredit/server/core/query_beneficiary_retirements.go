@@ -0,0 +1,101 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BeneficiaryRetirements queries for the retirements attributed to a beneficiary across all credit batches along
+// with the total amount of credits retired to the beneficiary.
+func (k Keeper) BeneficiaryRetirements(ctx context.Context, req *core.QueryBeneficiaryRetirementsRequest) (*core.QueryBeneficiaryRetirementsResponse, error) {
+	if req.Beneficiary == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("beneficiary cannot be empty")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchRetirementTable().List(ctx, api.BatchRetirementBeneficiaryIndexKey{}.WithBeneficiary(req.Beneficiary), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*core.RetirementInfo, 0, 8)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		retirements = append(retirements, &core.RetirementInfo{
+			BatchDenom:   batch.Denom,
+			Owner:        sdk.AccAddress(retirement.Owner).String(),
+			Beneficiary:  retirement.Beneficiary,
+			Amount:       retirement.Amount,
+			Jurisdiction: retirement.Jurisdiction,
+			Timestamp:    types.ProtobufToGogoTimestamp(retirement.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := k.getBeneficiaryRetiredTotal(ctx, req.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBeneficiaryRetirementsResponse{
+		Retirements: retirements,
+		TotalAmount: total.String(),
+		Pagination:  pr,
+	}, nil
+}
+
+// getBeneficiaryRetiredTotal returns the total amount of credits retired to a beneficiary across all retirements.
+func (k Keeper) getBeneficiaryRetiredTotal(ctx context.Context, beneficiary string) (math.Dec, error) {
+	it, err := k.stateStore.BatchRetirementTable().List(ctx, api.BatchRetirementBeneficiaryIndexKey{}.WithBeneficiary(beneficiary))
+	if err != nil {
+		return math.Dec{}, err
+	}
+	defer it.Close()
+
+	total := math.NewDecFromInt64(0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		amount, err := math.NewNonNegativeDecFromString(retirement.Amount)
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		total, err = total.Add(amount)
+		if err != nil {
+			return math.Dec{}, err
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+func TestQuery_BeneficiaryRetirements(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, owner2 := testdata.KeyTestPubAddr()
+
+	minRetirementAmount := core.DefaultMinRetirementAmount
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 4)
+
+	// give a second owner tradable credits
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       1,
+		Address:        owner2,
+		TradableAmount: "5",
+		RetiredAmount:  "0",
+	}))
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	supply.TradableAmount = "15.5"
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Update(s.ctx, supply))
+
+	// retire to the beneficiary from both owners
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner:        s.addr.String(),
+		Credits:      []*core.Credits{{BatchDenom: batchDenom, Amount: "2.5"}},
+		Jurisdiction: "US-NY",
+		Beneficiary:  "Acme Corp",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner:        owner2.String(),
+		Credits:      []*core.Credits{{BatchDenom: batchDenom, Amount: "3"}},
+		Jurisdiction: "US-OR",
+		Beneficiary:  "Acme Corp",
+	})
+	assert.NilError(t, err)
+
+	// retire to another beneficiary and without a beneficiary
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner:        s.addr.String(),
+		Credits:      []*core.Credits{{BatchDenom: batchDenom, Amount: "1"}},
+		Jurisdiction: "US-NY",
+		Beneficiary:  "Other Inc",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner:        owner2.String(),
+		Credits:      []*core.Credits{{BatchDenom: batchDenom, Amount: "1"}},
+		Jurisdiction: "US-OR",
+	})
+	assert.NilError(t, err)
+
+	// query all retirements of the beneficiary
+	res, err := s.k.BeneficiaryRetirements(s.ctx, &core.QueryBeneficiaryRetirementsRequest{
+		Beneficiary: "Acme Corp",
+		Pagination:  &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Retirements))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "5.5", res.TotalAmount)
+
+	assert.Equal(t, batchDenom, res.Retirements[0].BatchDenom)
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+	assert.Equal(t, "Acme Corp", res.Retirements[0].Beneficiary)
+	assert.Equal(t, "2.5", res.Retirements[0].Amount)
+	assert.Equal(t, "US-NY", res.Retirements[0].Jurisdiction)
+
+	assert.Equal(t, batchDenom, res.Retirements[1].BatchDenom)
+	assert.Equal(t, owner2.String(), res.Retirements[1].Owner)
+	assert.Equal(t, "3", res.Retirements[1].Amount)
+	assert.Equal(t, "US-OR", res.Retirements[1].Jurisdiction)
+
+	// the total includes retirements outside of the page
+	res, err = s.k.BeneficiaryRetirements(s.ctx, &core.QueryBeneficiaryRetirementsRequest{
+		Beneficiary: "Acme Corp",
+		Pagination:  &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, "5.5", res.TotalAmount)
+
+	// query a beneficiary without retirements
+	res, err = s.k.BeneficiaryRetirements(s.ctx, &core.QueryBeneficiaryRetirementsRequest{Beneficiary: "Nobody"})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Retirements))
+	assert.Equal(t, "0", res.TotalAmount)
+
+	// query an empty beneficiary
+	_, err = s.k.BeneficiaryRetirements(s.ctx, &core.QueryBeneficiaryRetirementsRequest{})
+	assert.ErrorContains(t, err, "beneficiary cannot be empty")
+}
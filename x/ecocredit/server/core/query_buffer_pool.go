@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BufferPool queries for the credits allocated to the buffer pool of a credit class. If a batch denom is
+// provided, only the buffer pool credits allocated from the given credit batch are returned.
+func (k Keeper) BufferPool(ctx context.Context, req *core.QueryBufferPoolRequest) (*core.QueryBufferPoolResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	if req.BatchDenom != "" {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+		}
+
+		buffers := make([]*core.BatchBufferInfo, 0, 1)
+		buffer, err := k.stateStore.BatchBufferTable().Get(ctx, batch.Key)
+		if err != nil && !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if buffer != nil && buffer.ClassKey == class.Key {
+			buffers = append(buffers, &core.BatchBufferInfo{
+				ClassId:    class.Id,
+				BatchDenom: batch.Denom,
+				Address:    sdk.AccAddress(buffer.Address).String(),
+				Amount:     buffer.Amount,
+			})
+		}
+
+		return &core.QueryBufferPoolResponse{Buffers: buffers}, nil
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchBufferTable().List(ctx, api.BatchBufferClassKeyIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	buffers := make([]*core.BatchBufferInfo, 0, 8)
+	for it.Next() {
+		buffer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, buffer.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		buffers = append(buffers, &core.BatchBufferInfo{
+			ClassId:    class.Id,
+			BatchDenom: batch.Denom,
+			Address:    sdk.AccAddress(buffer.Address).String(),
+			Amount:     buffer.Amount,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBufferPoolResponse{
+		Buffers:    buffers,
+		Pagination: pr,
+	}, nil
+}
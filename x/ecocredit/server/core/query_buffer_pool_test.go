@@ -0,0 +1,100 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+func TestQuery_BufferPool(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, bufferAddr := testdata.KeyTestPubAddr()
+
+	classBufferPools := []*core.ClassBufferPool{
+		{ClassId: "C01", Address: bufferAddr.String(), Percentage: "0.1"},
+	}
+	utils.ExpectParamGet(&classBufferPools, s.paramsKeeper, core.KeyClassBufferPools, 2)
+
+	_, projectId := batchTestSetup(t, s.ctx, s.stateStore, s.addr)
+
+	// issue two credit batches with a buffer pool allocation
+	start, end := time.Now(), time.Now()
+	res1, err := s.k.CreateBatch(s.ctx, &core.MsgCreateBatch{
+		Issuer:    s.addr.String(),
+		ProjectId: projectId,
+		Issuance:  []*core.BatchIssuance{{Recipient: s.addr.String(), TradableAmount: "10"}},
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	assert.NilError(t, err)
+
+	res2, err := s.k.CreateBatch(s.ctx, &core.MsgCreateBatch{
+		Issuer:    s.addr.String(),
+		ProjectId: projectId,
+		Issuance:  []*core.BatchIssuance{{Recipient: s.addr.String(), TradableAmount: "25"}},
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	assert.NilError(t, err)
+
+	// insert a class without a buffer pool
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C02"}))
+
+	// query buffer pool by credit class
+	res, err := s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Buffers))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "C01", res.Buffers[0].ClassId)
+	assert.Equal(t, res1.BatchDenom, res.Buffers[0].BatchDenom)
+	assert.Equal(t, bufferAddr.String(), res.Buffers[0].Address)
+	assert.Equal(t, "1", res.Buffers[0].Amount)
+	assert.Equal(t, res2.BatchDenom, res.Buffers[1].BatchDenom)
+	assert.Equal(t, "2.5", res.Buffers[1].Amount)
+
+	// query buffer pool by credit class and credit batch
+	res, err = s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{
+		ClassId:    "C01",
+		BatchDenom: res2.BatchDenom,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Buffers))
+	assert.Equal(t, res2.BatchDenom, res.Buffers[0].BatchDenom)
+	assert.Equal(t, "2.5", res.Buffers[0].Amount)
+
+	// query buffer pool of a credit class without buffer pool allocations
+	res, err = s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{ClassId: "C02"})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Buffers))
+
+	// query buffer pool of a credit batch from another credit class
+	res, err = s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{
+		ClassId:    "C02",
+		BatchDenom: res1.BatchDenom,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Buffers))
+
+	// query buffer pool of an unknown credit class
+	_, err = s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+
+	// query buffer pool of an unknown credit batch
+	_, err = s.k.BufferPool(s.ctx, &core.QueryBufferPoolRequest{
+		ClassId:    "C01",
+		BatchDenom: "C01-001-20200101-20210101-099",
+	})
+	assert.ErrorContains(t, err, "could not get batch with denom")
+}
@@ -3,7 +3,6 @@ package core
 import (
 	"context"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -16,14 +15,10 @@ func (k Keeper) Class(ctx context.Context, request *core.QueryClassRequest) (*co
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", request.ClassId, err.Error())
 	}
 
-	admin := sdk.AccAddress(class.Admin)
-
-	info := core.ClassInfo{
-		Id:               class.Id,
-		Admin:            admin.String(),
-		Metadata:         class.Metadata,
-		CreditTypeAbbrev: class.CreditTypeAbbrev,
+	info, err := k.getClassInfo(ctx, class)
+	if err != nil {
+		return nil, err
 	}
 
-	return &core.QueryClassResponse{Class: &info}, nil
+	return &core.QueryClassResponse{Class: info}, nil
 }
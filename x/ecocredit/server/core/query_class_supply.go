@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ClassSupply queries for the total supply of credits summed across all credit batches within a credit class.
+// The totals are not stored in state but computed at query time by iterating over the credit batches of the credit
+// class, so pagination applies to the credit batches and the totals of each page must be added by the client.
+func (k Keeper) ClassSupply(ctx context.Context, req *core.QueryClassSupplyRequest) (*core.QueryClassSupplyResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// batch denoms are prefixed with the class id followed by a dash, and a dot sorts right after a dash and
+	// cannot appear in a batch denom, so the range covers exactly the credit batches of the credit class
+	fromKey := api.BatchDenomIndexKey{}.WithDenom(class.Id + "-")
+	toKey := api.BatchDenomIndexKey{}.WithDenom(class.Id + ".")
+	it, err := k.stateStore.BatchTable().ListRange(ctx, fromKey, toKey, ormlist.Paginate(limitClassSupplyPage(pg)))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	totals := newClassSupplyTotals()
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.addBatchSupplyTotals(ctx, batch.Key, totals); err != nil {
+			return nil, err
+		}
+	}
+
+	supply, err := totals.info(class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryClassSupplyResponse{
+		ClassSupply: supply,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ClassSupply(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two classes
+	class1Key, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+	_, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+
+	// insert two projects in class C01
+	p1Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001", ClassKey: class1Key})
+	assert.NilError(t, err)
+	p2Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-002", ClassKey: class1Key})
+	assert.NilError(t, err)
+
+	// insert batches with supplies across both projects
+	batches := []struct {
+		projectKey uint64
+		denom      string
+		supply     *api.BatchSupply
+	}{
+		{p1Key, "C01-001-20200101-20210101-001", &api.BatchSupply{TradableAmount: "10", RetiredAmount: "5.5", CancelledAmount: "1"}},
+		{p2Key, "C01-002-20200101-20210101-001", &api.BatchSupply{TradableAmount: "0.123456", RetiredAmount: "20", CancelledAmount: "0.5"}},
+	}
+	for _, b := range batches {
+		batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+			ProjectKey: b.projectKey,
+			Denom:      b.denom,
+		})
+		assert.NilError(t, err)
+		b.supply.BatchKey = batchKey
+		assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, b.supply))
+	}
+
+	// class C01 sums the supply of all batches across its projects
+	res, err := s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, "C01", res.ClassSupply.ClassId)
+	assert.Equal(t, "10.123456", res.ClassSupply.TradableAmount)
+	assert.Equal(t, "25.5", res.ClassSupply.RetiredAmount)
+	assert.Equal(t, "1.5", res.ClassSupply.CancelledAmount)
+	assert.Equal(t, "37.123456", res.ClassSupply.IssuedAmount)
+
+	// class C02 has no batches
+	res, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{ClassId: "C02"})
+	assert.NilError(t, err)
+	assert.Equal(t, "C02", res.ClassSupply.ClassId)
+	assert.Equal(t, "0", res.ClassSupply.IssuedAmount)
+
+	// class C03 does not exist
+	_, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, sdkerrors.ErrNotFound.Error())
+
+	// class C010 has class C01 as prefix and is not included in class C01
+	class3Key, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C010"})
+	assert.NilError(t, err)
+	p3Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C010-001", ClassKey: class3Key})
+	assert.NilError(t, err)
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: p3Key,
+		Denom:      "C010-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey: batchKey, TradableAmount: "7", RetiredAmount: "0", CancelledAmount: "0",
+	}))
+
+	res, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, "37.123456", res.ClassSupply.IssuedAmount)
+
+	res, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{ClassId: "C010"})
+	assert.NilError(t, err)
+	assert.Equal(t, "7", res.ClassSupply.IssuedAmount)
+
+	// pagination applies to batches and the totals of each page are added by the client
+	res, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "16.5", res.ClassSupply.IssuedAmount)
+	assert.Assert(t, res.Pagination.NextKey != nil)
+
+	res, err = s.k.ClassSupply(s.ctx, &core.QueryClassSupplyRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "20.623456", res.ClassSupply.IssuedAmount)
+	assert.Assert(t, res.Pagination.NextKey == nil)
+}
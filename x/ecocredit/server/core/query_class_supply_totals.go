@@ -0,0 +1,153 @@
+package core
+
+import (
+	"context"
+
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ClassSupplyTotals queries for all credit classes with the total supply of credits summed across all credit
+// batches within each credit class. The totals are not stored in state but computed at query time by iterating over
+// credit batches ordered by batch denom, which groups the credit batches of each credit class, so pagination applies
+// to the credit batches and a credit class whose credit batches span more than one page is included in each page.
+func (k Keeper) ClassSupplyTotals(ctx context.Context, req *core.QueryClassSupplyTotalsRequest) (*core.QueryClassSupplyTotalsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchTable().List(ctx, api.BatchDenomIndexKey{}, ormlist.Paginate(limitClassSupplyPage(pg)))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	supplies := make([]*core.ClassSupplyInfo, 0, 8)
+	var classId string
+	var totals *classSupplyTotals
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batchClassId := core.GetClassIdFromBatchDenom(batch.Denom)
+		if totals == nil || batchClassId != classId {
+			if totals != nil {
+				supply, err := totals.info(classId)
+				if err != nil {
+					return nil, err
+				}
+				supplies = append(supplies, supply)
+			}
+			classId = batchClassId
+			totals = newClassSupplyTotals()
+		}
+
+		if err := k.addBatchSupplyTotals(ctx, batch.Key, totals); err != nil {
+			return nil, err
+		}
+	}
+
+	if totals != nil {
+		supply, err := totals.info(classId)
+		if err != nil {
+			return nil, err
+		}
+		supplies = append(supplies, supply)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryClassSupplyTotalsResponse{
+		ClassSupplies: supplies,
+		Pagination:    pr,
+	}, nil
+}
+
+// classSupplyBatchLimit is the maximum number of credit batches whose supply is summed by a single page of
+// Query/ClassSupply or Query/ClassSupplyTotals.
+const classSupplyBatchLimit = 10000
+
+// limitClassSupplyPage bounds the page limit of a class supply query to classSupplyBatchLimit credit batches.
+func limitClassSupplyPage(pg *queryv1beta1.PageRequest) *queryv1beta1.PageRequest {
+	if pg == nil {
+		pg = &queryv1beta1.PageRequest{}
+	}
+	if pg.Limit == 0 || pg.Limit > classSupplyBatchLimit {
+		pg.Limit = classSupplyBatchLimit
+	}
+	return pg
+}
+
+// addBatchSupplyTotals adds the supply of the given credit batch to the totals.
+func (k Keeper) addBatchSupplyTotals(ctx context.Context, batchKey uint64, totals *classSupplyTotals) error {
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batchKey)
+	if err != nil {
+		return err
+	}
+
+	return totals.add(supply)
+}
+
+type classSupplyTotals struct {
+	tradable  math.Dec
+	retired   math.Dec
+	cancelled math.Dec
+}
+
+func newClassSupplyTotals() *classSupplyTotals {
+	return &classSupplyTotals{
+		tradable:  math.NewDecFromInt64(0),
+		retired:   math.NewDecFromInt64(0),
+		cancelled: math.NewDecFromInt64(0),
+	}
+}
+
+func (t *classSupplyTotals) info(classId string) (*core.ClassSupplyInfo, error) {
+	issued, err := t.tradable.Add(t.retired)
+	if err != nil {
+		return nil, err
+	}
+	issued, err = issued.Add(t.cancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.ClassSupplyInfo{
+		ClassId:         classId,
+		IssuedAmount:    issued.String(),
+		TradableAmount:  t.tradable.String(),
+		RetiredAmount:   t.retired.String(),
+		CancelledAmount: t.cancelled.String(),
+	}, nil
+}
+
+func (t *classSupplyTotals) add(supply *api.BatchSupply) error {
+	var err error
+	if t.tradable, err = addDecString(t.tradable, supply.TradableAmount); err != nil {
+		return err
+	}
+	if t.retired, err = addDecString(t.retired, supply.RetiredAmount); err != nil {
+		return err
+	}
+	t.cancelled, err = addDecString(t.cancelled, supply.CancelledAmount)
+	return err
+}
+
+func addDecString(x math.Dec, s string) (math.Dec, error) {
+	y, err := math.NewNonNegativeDecFromString(s)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return x.Add(y)
+}
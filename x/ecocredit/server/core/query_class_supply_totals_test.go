@@ -0,0 +1,110 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ClassSupplyTotals(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two classes
+	class1Key, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+	_, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+
+	// insert two projects in class C01
+	p1Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001", ClassKey: class1Key})
+	assert.NilError(t, err)
+	p2Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-002", ClassKey: class1Key})
+	assert.NilError(t, err)
+
+	// insert batches with supplies across both projects
+	batches := []struct {
+		projectKey uint64
+		denom      string
+		supply     *api.BatchSupply
+	}{
+		{p1Key, "C01-001-20200101-20210101-001", &api.BatchSupply{TradableAmount: "10", RetiredAmount: "5.5", CancelledAmount: "1"}},
+		{p1Key, "C01-001-20200101-20210101-002", &api.BatchSupply{TradableAmount: "2.25", RetiredAmount: "0", CancelledAmount: "0"}},
+		{p2Key, "C01-002-20200101-20210101-001", &api.BatchSupply{TradableAmount: "100", RetiredAmount: "20", CancelledAmount: "0.5"}},
+	}
+	for _, b := range batches {
+		batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+			ProjectKey: b.projectKey,
+			Denom:      b.denom,
+		})
+		assert.NilError(t, err)
+		b.supply.BatchKey = batchKey
+		assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, b.supply))
+	}
+
+	// insert a batch in another class whose id has class C01 as prefix
+	class3Key, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C010"})
+	assert.NilError(t, err)
+	p3Key, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C010-001", ClassKey: class3Key})
+	assert.NilError(t, err)
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: p3Key,
+		Denom:      "C010-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey: batchKey, TradableAmount: "7", RetiredAmount: "0", CancelledAmount: "0",
+	}))
+
+	res, err := s.k.ClassSupplyTotals(s.ctx, &core.QueryClassSupplyTotalsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.ClassSupplies))
+	assert.Equal(t, uint64(4), res.Pagination.Total)
+
+	// class C01 sums the supply of all batches across its projects
+	assert.Equal(t, "C01", res.ClassSupplies[0].ClassId)
+	assert.Equal(t, "112.25", res.ClassSupplies[0].TradableAmount)
+	assert.Equal(t, "25.5", res.ClassSupplies[0].RetiredAmount)
+	assert.Equal(t, "1.5", res.ClassSupplies[0].CancelledAmount)
+	assert.Equal(t, "139.25", res.ClassSupplies[0].IssuedAmount)
+
+	// class C010 is summed separately and class C02 has no batches
+	assert.Equal(t, "C010", res.ClassSupplies[1].ClassId)
+	assert.Equal(t, "7", res.ClassSupplies[1].TradableAmount)
+	assert.Equal(t, "7", res.ClassSupplies[1].IssuedAmount)
+
+	// pagination applies to batches, so class C01 spans both pages
+	res, err = s.k.ClassSupplyTotals(s.ctx, &core.QueryClassSupplyTotalsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.ClassSupplies))
+	assert.Equal(t, "C01", res.ClassSupplies[0].ClassId)
+	assert.Equal(t, "12.25", res.ClassSupplies[0].TradableAmount)
+	assert.Equal(t, "18.75", res.ClassSupplies[0].IssuedAmount)
+	assert.Assert(t, res.Pagination.NextKey != nil)
+
+	res, err = s.k.ClassSupplyTotals(s.ctx, &core.QueryClassSupplyTotalsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.ClassSupplies))
+	assert.Equal(t, "C01", res.ClassSupplies[0].ClassId)
+	assert.Equal(t, "100", res.ClassSupplies[0].TradableAmount)
+	assert.Equal(t, "120.5", res.ClassSupplies[0].IssuedAmount)
+	assert.Equal(t, "C010", res.ClassSupplies[1].ClassId)
+	assert.Assert(t, res.Pagination.NextKey == nil)
+
+	// the page limit is bounded
+	assert.Equal(t, uint64(classSupplyBatchLimit), limitClassSupplyPage(nil).Limit)
+	assert.Equal(t, uint64(classSupplyBatchLimit), limitClassSupplyPage(&queryv1beta1.PageRequest{Limit: 20000}).Limit)
+	assert.Equal(t, uint64(5), limitClassSupplyPage(&queryv1beta1.PageRequest{Limit: 5}).Limit)
+}
@@ -4,15 +4,24 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/ormutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Classes queries for all credit classes with pagination.
+// Classes queries for all credit classes with pagination. If only the total count is requested, the classes are
+// counted without being fetched.
 func (k Keeper) Classes(ctx context.Context, request *core.QueryClassesRequest) (*core.QueryClassesResponse, error) {
+	if request.CountOnly {
+		it, err := k.stateStore.ClassTable().List(ctx, &api.ClassPrimaryKey{})
+		if err != nil {
+			return nil, err
+		}
+
+		return &core.QueryClassesResponse{Classes: []*core.ClassInfo{}, Pagination: countEntries(it)}, nil
+	}
+
 	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
 	if err != nil {
 		return nil, err
@@ -31,16 +40,12 @@ func (k Keeper) Classes(ctx context.Context, request *core.QueryClassesRequest)
 			return nil, err
 		}
 
-		admin := sdk.AccAddress(class.Admin)
-
-		info := core.ClassInfo{
-			Id:               class.Id,
-			Admin:            admin.String(),
-			Metadata:         class.Metadata,
-			CreditTypeAbbrev: class.CreditTypeAbbrev,
+		info, err := k.getClassInfo(ctx, class)
+		if err != nil {
+			return nil, err
 		}
 
-		classes = append(classes, &info)
+		classes = append(classes, info)
 	}
 
 	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
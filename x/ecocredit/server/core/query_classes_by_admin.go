@@ -30,7 +30,6 @@ func (k Keeper) ClassesByAdmin(ctx context.Context, req *core.QueryClassesByAdmi
 	}
 	defer it.Close()
 
-	adminString := admin.String()
 	classes := make([]*core.ClassInfo, 0)
 	for it.Next() {
 		class, err := it.Value()
@@ -38,14 +37,12 @@ func (k Keeper) ClassesByAdmin(ctx context.Context, req *core.QueryClassesByAdmi
 			return nil, err
 		}
 
-		info := core.ClassInfo{
-			Id:               class.Id,
-			Admin:            adminString,
-			Metadata:         class.Metadata,
-			CreditTypeAbbrev: class.CreditTypeAbbrev,
+		info, err := k.getClassInfo(ctx, class)
+		if err != nil {
+			return nil, err
 		}
 
-		classes = append(classes, &info)
+		classes = append(classes, info)
 	}
 
 	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
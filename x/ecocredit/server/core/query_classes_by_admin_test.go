@@ -25,12 +25,19 @@ func TestQueryClassesByAdmin(t *testing.T) {
 	}
 
 	// insert two classes with s.addr as the admin
-	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, class))
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, class)
+	assert.NilError(t, err)
 	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
 		Id:    "C02",
 		Admin: s.addr,
 	}))
 
+	// add s.addr as an issuer of the first class
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+
 	// query classes by the admin s.addr
 	res, err := s.k.ClassesByAdmin(s.ctx, &core.QueryClassesByAdminRequest{
 		Admin:      s.addr.String(),
@@ -42,6 +49,7 @@ func TestQueryClassesByAdmin(t *testing.T) {
 	assert.Equal(t, s.addr.String(), res.Classes[0].Admin)
 	assert.Equal(t, class.Metadata, res.Classes[0].Metadata)
 	assert.Equal(t, class.CreditTypeAbbrev, res.Classes[0].CreditTypeAbbrev)
+	assert.DeepEqual(t, []string{s.addr.String()}, res.Classes[0].Issuers)
 	assert.Equal(t, uint64(2), res.Pagination.Total)
 
 	_, _, notAdmin := testdata.KeyTestPubAddr()
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ClassesByCreditType queries for all classes with a specific credit type abbreviation.
+func (k Keeper) ClassesByCreditType(ctx context.Context, req *core.QueryClassesByCreditTypeRequest) (*core.QueryClassesByCreditTypeResponse, error) {
+	if req.CreditTypeAbbrev == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("credit type abbreviation cannot be empty")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.ClassTable().List(ctx, api.ClassCreditTypeAbbrevIndexKey{}.WithCreditTypeAbbrev(req.CreditTypeAbbrev), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	classes := make([]*core.ClassInfo, 0)
+	for it.Next() {
+		class, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getClassInfo(ctx, class)
+		if err != nil {
+			return nil, err
+		}
+
+		classes = append(classes, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryClassesByCreditTypeResponse{Classes: classes, Pagination: pr}, nil
+}
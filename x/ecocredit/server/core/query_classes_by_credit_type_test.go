@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQueryClassesByCreditType(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	class := &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		Metadata:         "data",
+		CreditTypeAbbrev: "C",
+	}
+
+	// insert two classes with credit type "C" and one with credit type "BIO"
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, class)
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               "C02",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               "BIO01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "BIO",
+	}))
+
+	// add s.addr as an issuer of the first class
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+
+	// query classes by credit type "C"
+	res, err := s.k.ClassesByCreditType(s.ctx, &core.QueryClassesByCreditTypeRequest{
+		CreditTypeAbbrev: "C",
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Classes))
+	assert.Equal(t, class.Id, res.Classes[0].Id)
+	assert.Equal(t, s.addr.String(), res.Classes[0].Admin)
+	assert.Equal(t, class.Metadata, res.Classes[0].Metadata)
+	assert.Equal(t, class.CreditTypeAbbrev, res.Classes[0].CreditTypeAbbrev)
+	assert.DeepEqual(t, []string{s.addr.String()}, res.Classes[0].Issuers)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query classes by credit type "BIO"
+	res, err = s.k.ClassesByCreditType(s.ctx, &core.QueryClassesByCreditTypeRequest{
+		CreditTypeAbbrev: "BIO",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Classes))
+	assert.Equal(t, "BIO01", res.Classes[0].Id)
+
+	// query classes by a credit type with no classes
+	res, err = s.k.ClassesByCreditType(s.ctx, &core.QueryClassesByCreditTypeRequest{
+		CreditTypeAbbrev: "FOO",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Classes))
+
+	// query classes by an empty credit type
+	_, err = s.k.ClassesByCreditType(s.ctx, &core.QueryClassesByCreditTypeRequest{})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error())
+}
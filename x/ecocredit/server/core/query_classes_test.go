@@ -39,4 +39,18 @@ func TestQuery_Classes(t *testing.T) {
 	assert.Equal(t, class.Metadata, res.Classes[0].Metadata)
 	assert.Equal(t, class.CreditTypeAbbrev, res.Classes[0].CreditTypeAbbrev)
 	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// count total without a limit still returns the classes
+	res, err = s.k.Classes(s.ctx, &core.QueryClassesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Classes))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query only the total count
+	res, err = s.k.Classes(s.ctx, &core.QueryClassesRequest{CountOnly: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Classes))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
 }
@@ -3,14 +3,22 @@ package core
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/ormutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// CreditTypes queries the list of allowed types that credit classes can have.
-func (k Keeper) CreditTypes(ctx context.Context, _ *core.QueryCreditTypesRequest) (*core.QueryCreditTypesResponse, error) {
-	it, err := k.stateStore.CreditTypeTable().List(ctx, &api.CreditTypePrimaryKey{})
+// CreditTypes queries the list of allowed types that credit classes can have with pagination, sorted by
+// abbreviation.
+func (k Keeper) CreditTypes(ctx context.Context, req *core.QueryCreditTypesRequest) (*core.QueryCreditTypesResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.CreditTypeTable().List(ctx, &api.CreditTypePrimaryKey{}, ormlist.Paginate(pg))
 	if err != nil {
 		return nil, err
 	}
@@ -28,5 +36,11 @@ func (k Keeper) CreditTypes(ctx context.Context, _ *core.QueryCreditTypesRequest
 		}
 		creditTypes = append(creditTypes, &creditType)
 	}
-	return &core.QueryCreditTypesResponse{CreditTypes: creditTypes}, nil
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryCreditTypesResponse{CreditTypes: creditTypes, Pagination: pr}, nil
 }
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// CreditTypesByHolder queries the distinct credit types of all credit batches an account holds a non-zero
+// tradable, retired, or escrowed balance in. The credit type of each credit batch is resolved through the
+// project and the credit class of the credit batch.
+func (k Keeper) CreditTypesByHolder(ctx context.Context, req *core.QueryCreditTypesByHolderRequest) (*core.QueryCreditTypesByHolderResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalanceAddressBatchKeyIndexKey{}.WithAddress(addr))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// credit type abbreviations keyed by class key
+	classCreditTypes := make(map[uint64]string)
+	abbrevs := make(map[string]struct{})
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		held, err := isNonZeroBalance(balance)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			continue
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, balance.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		abbrev, ok := classCreditTypes[project.ClassKey]
+		if !ok {
+			class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+			if err != nil {
+				return nil, err
+			}
+			abbrev = class.CreditTypeAbbrev
+			classCreditTypes[project.ClassKey] = abbrev
+		}
+
+		abbrevs[abbrev] = struct{}{}
+	}
+
+	creditTypeAbbrevs := make([]string, 0, len(abbrevs))
+	for abbrev := range abbrevs {
+		creditTypeAbbrevs = append(creditTypeAbbrevs, abbrev)
+	}
+	sort.Strings(creditTypeAbbrevs)
+
+	return &core.QueryCreditTypesByHolderResponse{CreditTypeAbbrevs: creditTypeAbbrevs}, nil
+}
+
+// isNonZeroBalance returns true if any of the tradable, retired, or escrowed amounts of the balance is positive.
+func isNonZeroBalance(balance *api.BatchBalance) (bool, error) {
+	for _, amount := range []string{balance.TradableAmount, balance.RetiredAmount, balance.EscrowedAmount} {
+		dec, err := math.NewNonNegativeDecFromString(amount)
+		if err != nil {
+			return false, err
+		}
+		if !dec.IsZero() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_CreditTypesByHolder(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert classes and projects of three different credit types
+	classes := []struct {
+		id     string
+		abbrev string
+	}{
+		{"C01", "C"},
+		{"BIO01", "BIO"},
+		{"XYZ01", "XYZ"},
+	}
+	projectKeys := make([]uint64, len(classes))
+	for i, c := range classes {
+		classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+			Id:               c.id,
+			CreditTypeAbbrev: c.abbrev,
+		})
+		assert.NilError(t, err)
+		projectKeys[i], err = s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+			Id:       c.id + "-001",
+			ClassKey: classKey,
+		})
+		assert.NilError(t, err)
+	}
+
+	// insert balances in two batches of credit type C, one batch of credit
+	// type BIO, and a zero balance in a batch of credit type XYZ
+	balances := []struct {
+		projectKey uint64
+		denom      string
+		balance    *api.BatchBalance
+	}{
+		{projectKeys[0], "C01-001-20200101-20210101-001", &api.BatchBalance{TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0"}},
+		{projectKeys[0], "C01-001-20200101-20210101-002", &api.BatchBalance{TradableAmount: "0", RetiredAmount: "5", EscrowedAmount: "0"}},
+		{projectKeys[1], "BIO01-001-20200101-20210101-001", &api.BatchBalance{TradableAmount: "0", RetiredAmount: "0", EscrowedAmount: "1"}},
+		{projectKeys[2], "XYZ01-001-20200101-20210101-001", &api.BatchBalance{TradableAmount: "0", RetiredAmount: "0", EscrowedAmount: "0"}},
+	}
+	for _, b := range balances {
+		batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+			ProjectKey: b.projectKey,
+			Denom:      b.denom,
+		})
+		assert.NilError(t, err)
+		b.balance.Address = s.addr
+		b.balance.BatchKey = batchKey
+		assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, b.balance))
+	}
+
+	// query credit types held by s.addr
+	res, err := s.k.CreditTypesByHolder(s.ctx, &core.QueryCreditTypesByHolderRequest{
+		Address: s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"BIO", "C"}, res.CreditTypeAbbrevs)
+
+	// query credit types held by an address with no balance
+	_, _, noBalAddr := testdata.KeyTestPubAddr()
+	res, err = s.k.CreditTypesByHolder(s.ctx, &core.QueryCreditTypesByHolderRequest{
+		Address: noBalAddr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.CreditTypeAbbrevs))
+
+	// query credit types with an invalid address
+	_, err = s.k.CreditTypesByHolder(s.ctx, &core.QueryCreditTypesByHolderRequest{
+		Address: "foo",
+	})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
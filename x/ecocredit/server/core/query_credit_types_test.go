@@ -5,6 +5,8 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
@@ -26,4 +28,25 @@ func TestQuery_CreditTypes(t *testing.T) {
 	assert.Equal(t, "carbon", res.CreditTypes[1].Name)
 	assert.Equal(t, "C", res.CreditTypes[1].Abbreviation)
 	assert.Equal(t, "BIO", res.CreditTypes[0].Abbreviation)
+
+	// query with pagination
+	res, err = s.k.CreditTypes(s.ctx, &core.QueryCreditTypesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.CreditTypes))
+	assert.Equal(t, "BIO", res.CreditTypes[0].Abbreviation)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = s.k.CreditTypes(s.ctx, &core.QueryCreditTypesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.CreditTypes))
+	assert.Equal(t, "C", res.CreditTypes[0].Abbreviation)
+
+	// nil request should return all types
+	res, err = s.k.CreditTypes(s.ctx, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.CreditTypes))
 }
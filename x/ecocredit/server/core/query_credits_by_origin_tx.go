@@ -0,0 +1,40 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// CreditsByOriginTx queries for the credit batch and the amount of credits issued from an originating transaction.
+// Origin txs recorded before origin txs were linked to credit batches have no batch key and cannot be resolved to the
+// credits they issued, because the link is not recoverable from state.
+func (k Keeper) CreditsByOriginTx(ctx context.Context, req *core.QueryCreditsByOriginTxRequest) (*core.QueryCreditsByOriginTxResponse, error) {
+	originTx, err := k.stateStore.BatchOriginTxTable().Get(ctx, req.Id, req.Source)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("origin tx with source %s and id %s", req.Source, req.Id)
+		}
+		return nil, err
+	}
+
+	if originTx.BatchKey == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"origin tx with source %s and id %s is not linked to a credit batch", req.Source, req.Id,
+		)
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, originTx.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryCreditsByOriginTxResponse{
+		BatchDenom:     batch.Denom,
+		TradableAmount: originTx.TradableAmount,
+		RetiredAmount:  originTx.RetiredAmount,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_CreditsByOriginTx(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+
+	// insert origin tx
+	assert.NilError(t, s.stateStore.BatchOriginTxTable().Insert(s.ctx, &api.BatchOriginTx{
+		Id:             "0x12345",
+		Source:         "polygon",
+		BatchKey:       batch.Key,
+		TradableAmount: "10.5",
+		RetiredAmount:  "2",
+	}))
+
+	// query credits by origin tx
+	res, err := s.k.CreditsByOriginTx(s.ctx, &core.QueryCreditsByOriginTxRequest{
+		Source: "polygon",
+		Id:     "0x12345",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, batchDenom, res.BatchDenom)
+	assert.Equal(t, "10.5", res.TradableAmount)
+	assert.Equal(t, "2", res.RetiredAmount)
+
+	// query credits by origin tx with the same id from a different source
+	_, err = s.k.CreditsByOriginTx(s.ctx, &core.QueryCreditsByOriginTxRequest{
+		Source: "ethereum",
+		Id:     "0x12345",
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// query credits by origin tx recorded without a credit batch
+	assert.NilError(t, s.stateStore.BatchOriginTxTable().Insert(s.ctx, &api.BatchOriginTx{
+		Id:     "0xabcde",
+		Source: "polygon",
+	}))
+	_, err = s.k.CreditsByOriginTx(s.ctx, &core.QueryCreditsByOriginTxRequest{
+		Source: "polygon",
+		Id:     "0xabcde",
+	})
+	assert.ErrorContains(t, err, "is not linked to a credit batch")
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// query credits by unknown origin tx
+	_, err = s.k.CreditsByOriginTx(s.ctx, &core.QueryCreditsByOriginTxRequest{
+		Source: "polygon",
+		Id:     "0x67890",
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
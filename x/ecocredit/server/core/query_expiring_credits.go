@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ExpiringCredits queries for all credit batches with an expiry date within the given time window. If an address
+// is provided, the balances the address holds in the expiring credit batches are included in the response.
+func (k Keeper) ExpiringCredits(ctx context.Context, req *core.QueryExpiringCreditsRequest) (*core.QueryExpiringCreditsResponse, error) {
+	if req.From == nil || req.To == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("must provide both from and to timestamps")
+	}
+
+	from, to := types.GogoToProtobufTimestamp(req.From), types.GogoToProtobufTimestamp(req.To)
+	if to.AsTime().Before(from.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("to must be the same as or after from")
+	}
+
+	// we set the min to 1 ns because nil expiry dates are encoded as the 0 value timestamp,
+	// and batches without an expiry date should not be included.
+	if min := time.Unix(0, 1); from.AsTime().Before(min) {
+		from = timestamppb.New(min)
+	}
+
+	var holder sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		holder, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+		}
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// a time window ending before the min cannot include any batches
+	if to.AsTime().Before(from.AsTime()) {
+		return &core.QueryExpiringCreditsResponse{}, nil
+	}
+
+	fromKey, toKey := api.BatchExpiryDateIndexKey{}.WithExpiryDate(from), api.BatchExpiryDateIndexKey{}.WithExpiryDate(to)
+
+	// range iteration requires distinct keys so a time window of a single
+	// instant is listed by prefix instead.
+	var it api.BatchIterator
+	if from.AsTime().Equal(to.AsTime()) {
+		it, err = k.stateStore.BatchTable().List(ctx, fromKey, ormlist.Paginate(pg))
+	} else {
+		it, err = k.stateStore.BatchTable().ListRange(ctx, fromKey, toKey, ormlist.Paginate(pg))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	batches := make([]*core.BatchInfo, 0, 8)
+	balances := make([]*core.BatchBalanceInfo, 0)
+
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, &core.BatchInfo{
+			Issuer:       sdk.AccAddress(batch.Issuer).String(),
+			ProjectId:    project.Id,
+			Denom:        batch.Denom,
+			Metadata:     batch.Metadata,
+			StartDate:    types.ProtobufToGogoTimestamp(batch.StartDate),
+			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
+			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
+			Open:         batch.Open,
+			ExpiryDate:   types.ProtobufToGogoTimestamp(batch.ExpiryDate),
+		})
+
+		if holder == nil {
+			continue
+		}
+
+		balance, err := k.stateStore.BatchBalanceTable().Get(ctx, holder, batch.Key)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		balances = append(balances, &core.BatchBalanceInfo{
+			Address:        req.Address,
+			BatchDenom:     batch.Denom,
+			TradableAmount: balance.TradableAmount,
+			RetiredAmount:  balance.RetiredAmount,
+			EscrowedAmount: balance.EscrowedAmount,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryExpiringCreditsResponse{
+		Batches:    batches,
+		Balances:   balances,
+		Pagination: pr,
+	}, nil
+}
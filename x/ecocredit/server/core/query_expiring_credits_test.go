@@ -0,0 +1,134 @@
+package core
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQueryExpiringCredits(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert project
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id: "C01-001",
+	})
+	assert.NilError(t, err)
+
+	// insert batches with different expiry dates and one without an expiry date
+	batch1 := &api.Batch{
+		Issuer:     s.addr,
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20210101-001",
+		ExpiryDate: &timestamppb.Timestamp{Seconds: 100},
+	}
+	batch2 := &api.Batch{
+		Issuer:     s.addr,
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20210101-002",
+		ExpiryDate: &timestamppb.Timestamp{Seconds: 200},
+	}
+	batch3 := &api.Batch{
+		Issuer:     s.addr,
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20210101-003",
+		ExpiryDate: &timestamppb.Timestamp{Seconds: 300},
+	}
+	batch4 := &api.Batch{
+		Issuer:     s.addr,
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20210101-004",
+	}
+	for _, batch := range []*api.Batch{batch1, batch2, batch3, batch4} {
+		assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, batch))
+	}
+
+	// insert balance for s.addr in batch2
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batch2.Key,
+		Address:        s.addr,
+		TradableAmount: "10",
+		RetiredAmount:  "5",
+		EscrowedAmount: "0",
+	}))
+
+	// query batches expiring within window including batch1 and batch2
+	res, err := s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From:       &gogotypes.Timestamp{Seconds: 0},
+		To:         &gogotypes.Timestamp{Seconds: 200},
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Batches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, batch1.Denom, res.Batches[0].Denom)
+	assert.Equal(t, batch2.Denom, res.Batches[1].Denom)
+	assert.Equal(t, int64(100), res.Batches[0].ExpiryDate.Seconds)
+	assert.Equal(t, 0, len(res.Balances))
+
+	// query batches expiring within window including batch2 and batch3 with holder balances
+	res, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From:    &gogotypes.Timestamp{Seconds: 150},
+		To:      &gogotypes.Timestamp{Seconds: 350},
+		Address: s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Batches))
+	assert.Equal(t, batch2.Denom, res.Batches[0].Denom)
+	assert.Equal(t, batch3.Denom, res.Batches[1].Denom)
+	assert.Equal(t, 1, len(res.Balances))
+	assert.Equal(t, batch2.Denom, res.Balances[0].BatchDenom)
+	assert.Equal(t, "10", res.Balances[0].TradableAmount)
+	assert.Equal(t, "5", res.Balances[0].RetiredAmount)
+
+	// query window with no expiring batches
+	res, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From: &gogotypes.Timestamp{Seconds: 400},
+		To:   &gogotypes.Timestamp{Seconds: 500},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Batches))
+
+	// query a time window of a single instant
+	res, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From:    &gogotypes.Timestamp{Seconds: 200},
+		To:      &gogotypes.Timestamp{Seconds: 200},
+		Address: s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Batches))
+	assert.Equal(t, batch2.Denom, res.Batches[0].Denom)
+	assert.Equal(t, 1, len(res.Balances))
+
+	// query a time window of a single instant without an expiry date
+	res, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From: &gogotypes.Timestamp{Seconds: 0},
+		To:   &gogotypes.Timestamp{Seconds: 0},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Batches))
+
+	// query with to before from
+	_, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From: &gogotypes.Timestamp{Seconds: 200},
+		To:   &gogotypes.Timestamp{Seconds: 100},
+	})
+	assert.ErrorContains(t, err, "to must be the same as or after from")
+
+	// query with an invalid address
+	_, err = s.k.ExpiringCredits(s.ctx, &core.QueryExpiringCreditsRequest{
+		From:    &gogotypes.Timestamp{Seconds: 0},
+		To:      &gogotypes.Timestamp{Seconds: 200},
+		Address: "foobar",
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
@@ -11,8 +11,18 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Projects queries all projects.
+// Projects queries all projects. If only the total count is requested, the projects are counted without being
+// fetched.
 func (k Keeper) Projects(ctx context.Context, request *core.QueryProjectsRequest) (*core.QueryProjectsResponse, error) {
+	if request.CountOnly {
+		it, err := k.stateStore.ProjectTable().List(ctx, api.ProjectIdIndexKey{})
+		if err != nil {
+			return nil, err
+		}
+
+		return &core.QueryProjectsResponse{Projects: []*core.ProjectInfo{}, Pagination: countEntries(it)}, nil
+	}
+
 	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
 	if err != nil {
 		return nil, err
@@ -24,6 +34,10 @@ func (k Keeper) Projects(ctx context.Context, request *core.QueryProjectsRequest
 	}
 	defer it.Close()
 
+	// projects of a page usually share a few credit classes, so the class ids
+	// are cached to only fetch each credit class once
+	classIds := make(map[uint64]string)
+
 	projects := make([]*core.ProjectInfo, 0)
 	for it.Next() {
 		project, err := it.Value()
@@ -33,15 +47,20 @@ func (k Keeper) Projects(ctx context.Context, request *core.QueryProjectsRequest
 
 		admin := sdk.AccAddress(project.Admin)
 
-		class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
-		if err != nil {
-			return nil, err
+		classId, ok := classIds[project.ClassKey]
+		if !ok {
+			class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+			if err != nil {
+				return nil, err
+			}
+			classId = class.Id
+			classIds[project.ClassKey] = classId
 		}
 
 		info := core.ProjectInfo{
 			Id:           project.Id,
 			Admin:        admin.String(),
-			ClassId:      class.Id,
+			ClassId:      classId,
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
@@ -82,4 +82,9 @@ func TestQuery_Projects_By_Admin(t *testing.T) {
 	res, err = s.k.ProjectsByAdmin(s.ctx, &core.QueryProjectsByAdminRequest{Admin: admin2.String()})
 	assert.NilError(t, err)
 	assert.Equal(t, len(res.Projects), 1)
+
+	// query project by admin with no projects expect empty result
+	res, err = s.k.ProjectsByAdmin(s.ctx, &core.QueryProjectsByAdminRequest{Admin: sdk.AccAddress("noprojects").String()})
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Projects), 0)
 }
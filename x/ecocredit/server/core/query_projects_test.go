@@ -50,4 +50,36 @@ func TestQuery_Projects(t *testing.T) {
 	assert.Equal(t, "C01", res.Projects[0].ClassId)
 	assert.Equal(t, project.Jurisdiction, res.Projects[0].Jurisdiction)
 	assert.Equal(t, project.Metadata, res.Projects[0].Metadata)
+
+	// insert a project in a second credit class
+	classKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C02",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       "C02-001",
+		ClassKey: classKey2,
+	}))
+
+	// query all projects and check the class id of each project
+	res, err = s.k.Projects(s.ctx, &core.QueryProjectsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(res.Projects))
+	assert.Equal(t, "C01", res.Projects[0].ClassId)
+	assert.Equal(t, "C01", res.Projects[1].ClassId)
+	assert.Equal(t, "C02", res.Projects[2].ClassId)
+
+	// count total without a limit still returns the projects
+	res, err = s.k.Projects(s.ctx, &core.QueryProjectsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(res.Projects))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+
+	// query only the total count
+	res, err = s.k.Projects(s.ctx, &core.QueryProjectsRequest{CountOnly: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Projects))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
 }
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SimulateSend runs the send logic against a branch of the store that is never
+// written and returns the resulting balances and supply of each credit batch.
+func (k Keeper) SimulateSend(ctx context.Context, req *core.QuerySimulateSendRequest) (*core.QuerySimulateSendResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("request cannot be nil")
+	}
+
+	msg := &core.MsgSend{
+		Sender:    req.Sender,
+		Recipient: req.Recipient,
+		Credits:   req.Credits,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, _ := sdk.AccAddressFromBech32(req.Sender)
+	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+
+	// the cached context is discarded so no changes are committed
+	cacheCtx, _ := types.UnwrapSDKContext(ctx).CacheContext()
+	simCtx := sdk.WrapSDKContext(cacheCtx)
+
+	if err := k.sendCredits(simCtx, msg); err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(req.Credits))
+	seen := make(map[string]bool, len(req.Credits))
+	for _, credit := range req.Credits {
+		if !seen[credit.BatchDenom] {
+			seen[credit.BatchDenom] = true
+			denoms = append(denoms, credit.BatchDenom)
+		}
+	}
+
+	results := make([]*core.SimulateSendResult, len(denoms))
+	for i, denom := range denoms {
+		batch, err := k.stateStore.BatchTable().GetByDenom(simCtx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(simCtx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		senderBalance, err := utils.GetBalance(simCtx, k.stateStore.BatchBalanceTable(), sender, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		recipientBalance, err := utils.GetBalance(simCtx, k.stateStore.BatchBalanceTable(), recipient, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		supply, err := k.stateStore.BatchSupplyTable().Get(simCtx, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = &core.SimulateSendResult{
+			BatchDenom: batch.Denom,
+			SenderBalance: &core.BatchBalanceInfo{
+				Address:        sender.String(),
+				BatchDenom:     batch.Denom,
+				TradableAmount: senderBalance.TradableAmount,
+				RetiredAmount:  senderBalance.RetiredAmount,
+				EscrowedAmount: senderBalance.EscrowedAmount,
+				Precision:      creditType.Precision,
+			},
+			RecipientBalance: &core.BatchBalanceInfo{
+				Address:        recipient.String(),
+				BatchDenom:     batch.Denom,
+				TradableAmount: recipientBalance.TradableAmount,
+				RetiredAmount:  recipientBalance.RetiredAmount,
+				EscrowedAmount: recipientBalance.EscrowedAmount,
+				Precision:      creditType.Precision,
+			},
+			TradableSupply:  supply.TradableAmount,
+			RetiredSupply:   supply.RetiredAmount,
+			CancelledSupply: supply.CancelledAmount,
+		}
+	}
+
+	return &core.QuerySimulateSendResponse{Results: results}, nil
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormstore"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// setupStoreKeyBackend replaces the in-memory state store with one backed by
+// the multistore so that writes made within a cached context can be discarded.
+func setupStoreKeyBackend(t *testing.T, s *baseSuite) {
+	db, err := ormstore.NewStoreKeyDB(&ecocredit.ModuleSchema, s.storeKey, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	s.stateStore, err = api.NewStateStore(db)
+	assert.NilError(t, err)
+	s.k.stateStore = s.stateStore
+	assert.NilError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02",
+		Precision:    6,
+	}))
+}
+
+func TestQuery_SimulateSend(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	setupStoreKeyBackend(t, s)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	res, err := s.k.SimulateSend(s.ctx, &core.QuerySimulateSendRequest{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "2", RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+			{BatchDenom: batchDenom, TradableAmount: "0.5"},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Results), 1)
+
+	result := res.Results[0]
+	assert.Equal(t, result.BatchDenom, batchDenom)
+	assert.Equal(t, result.SenderBalance.Address, s.addr.String())
+	assert.Equal(t, result.SenderBalance.TradableAmount, "7.0")
+	assert.Equal(t, result.SenderBalance.RetiredAmount, "10.5")
+	assert.Equal(t, result.SenderBalance.Precision, uint32(6))
+	assert.Equal(t, result.RecipientBalance.Address, recipient.String())
+	assert.Equal(t, result.RecipientBalance.TradableAmount, "2.5")
+	assert.Equal(t, result.RecipientBalance.RetiredAmount, "1")
+	assert.Equal(t, result.TradableSupply, "9.5")
+	assert.Equal(t, result.RetiredSupply, "11.5")
+
+	// no state is committed
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal.TradableAmount, "10.5")
+	assert.Equal(t, bal.RetiredAmount, "10.5")
+
+	found, err := s.stateStore.BatchBalanceTable().Has(s.ctx, recipient, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, found, false)
+
+	sup, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, sup.TradableAmount, "10.5")
+	assert.Equal(t, sup.RetiredAmount, "10.5")
+}
+
+func TestQuery_SimulateSend_MatchesSendErrors(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	setupStoreKeyBackend(t, s)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	tooManyCredits := make([]*core.MsgSend_SendCredits, core.DefaultMaxCreditsPerMsg+1)
+	for i := range tooManyCredits {
+		tooManyCredits[i] = &core.MsgSend_SendCredits{BatchDenom: batchDenom, TradableAmount: "0.000001"}
+	}
+
+	testCases := map[string][]*core.MsgSend_SendCredits{
+		"too many credits":     tooManyCredits,
+		"insufficient credits": {{BatchDenom: batchDenom, TradableAmount: "11"}},
+		"unknown batch":        {{BatchDenom: "C01-001-20200101-20210101-099", TradableAmount: "1"}},
+		"invalid precision":    {{BatchDenom: batchDenom, TradableAmount: "1.1234567"}},
+	}
+
+	for name, credits := range testCases {
+		msg := &core.MsgSend{Sender: s.addr.String(), Recipient: recipient.String(), Credits: credits}
+
+		_, simErr := s.k.SimulateSend(s.ctx, &core.QuerySimulateSendRequest{
+			Sender:    msg.Sender,
+			Recipient: msg.Recipient,
+			Credits:   msg.Credits,
+		})
+		assert.Assert(t, simErr != nil, name)
+
+		_, sendErr := s.k.Send(s.ctx, msg)
+		assert.Error(t, simErr, sendErr.Error(), name)
+	}
+
+	// message validation errors are returned before running the send
+	_, err := s.k.SimulateSend(s.ctx, &core.QuerySimulateSendRequest{
+		Sender:    s.addr.String(),
+		Recipient: "foo",
+		Credits:   []*core.MsgSend_SendCredits{{BatchDenom: batchDenom, TradableAmount: "1"}},
+	})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
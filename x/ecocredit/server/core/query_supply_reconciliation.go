@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// SupplyReconciliation recomputes the supply of credit batches from the credit balances of all accounts and baskets
+// and returns the credit batches whose recorded supply doesn't match. The calculated supply follows the same rules as
+// genesis validation: escrowed and basket balances count as tradable credits and cancelled credits are not held by
+// any account. Pagination only applies to the credit batches.
+func (k Keeper) SupplyReconciliation(ctx context.Context, req *core.QuerySupplyReconciliationRequest) (*core.QuerySupplyReconciliationResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchTable().List(ctx, api.BatchPrimaryKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	basketIds, err := k.getBasketIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]*core.SupplyMismatch, 0)
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		mismatch, err := k.reconcileBatchSupply(ctx, batch, basketIds)
+		if err != nil {
+			return nil, err
+		}
+
+		if mismatch != nil {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QuerySupplyReconciliationResponse{
+		Mismatches: mismatches,
+		Pagination: pr,
+	}, nil
+}
+
+// reconcileBatchSupply compares the recorded supply of the credit batch with the supply calculated from balances and
+// returns a mismatch if they differ, or nil if they match.
+func (k Keeper) reconcileBatchSupply(ctx context.Context, batch *api.Batch, basketIds []uint64) (*core.SupplyMismatch, error) {
+	tradable, retired := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+
+	it, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		if tradable, err = addOptionalDecString(tradable, balance.TradableAmount); err != nil {
+			return nil, err
+		}
+		if tradable, err = addOptionalDecString(tradable, balance.EscrowedAmount); err != nil {
+			return nil, err
+		}
+		if retired, err = addOptionalDecString(retired, balance.RetiredAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, basketId := range basketIds {
+		balance, err := k.basketStore.BasketBalanceTable().Get(ctx, basketId, batch.Denom)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if tradable, err = addOptionalDecString(tradable, balance.Balance); err != nil {
+			return nil, err
+		}
+	}
+
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	recordedTradable, err := addOptionalDecString(math.NewDecFromInt64(0), supply.TradableAmount)
+	if err != nil {
+		return nil, err
+	}
+	recordedRetired, err := addOptionalDecString(math.NewDecFromInt64(0), supply.RetiredAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordedTradable.Equal(tradable) && recordedRetired.Equal(retired) {
+		return nil, nil
+	}
+
+	return &core.SupplyMismatch{
+		BatchDenom:               batch.Denom,
+		RecordedTradableAmount:   recordedTradable.String(),
+		CalculatedTradableAmount: tradable.String(),
+		RecordedRetiredAmount:    recordedRetired.String(),
+		CalculatedRetiredAmount:  retired.String(),
+	}, nil
+}
+
+// getBasketIds returns the ids of all baskets.
+func (k Keeper) getBasketIds(ctx context.Context) ([]uint64, error) {
+	it, err := k.basketStore.BasketTable().List(ctx, basketapi.BasketPrimaryKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var ids []uint64
+	for it.Next() {
+		basket, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, basket.Id)
+	}
+
+	return ids, nil
+}
+
+// addOptionalDecString adds the decimal string to x, treating an empty string as zero.
+func addOptionalDecString(x math.Dec, s string) (math.Dec, error) {
+	if s == "" {
+		return x, nil
+	}
+	return addDecString(x, s)
+}
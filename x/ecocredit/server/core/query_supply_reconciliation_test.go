@@ -0,0 +1,99 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_SupplyReconciliation(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	// the batch of setupClassProjectBatch has a matching supply and balance of 10.5 tradable and 10.5 retired
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	// insert a second batch with balances held by two accounts, in escrow and in a basket
+	batch2Denom := "C01-001-20200101-20210101-002"
+	b2Key, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{ProjectKey: 1, Denom: batch2Denom})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:       b2Key,
+		TradableAmount: "20",
+		RetiredAmount:  "5",
+	}))
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       b2Key,
+		Address:        s.addr,
+		TradableAmount: "8",
+		EscrowedAmount: "2",
+		RetiredAmount:  "5",
+	}))
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       b2Key,
+		Address:        addr2,
+		TradableAmount: "4",
+	}))
+	basketId, err := s.basketStore.BasketTable().InsertReturningID(s.ctx, &basketapi.Basket{BasketDenom: "eco.C.foo"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.basketStore.BasketBalanceTable().Insert(s.ctx, &basketapi.BasketBalance{
+		BasketId:   basketId,
+		BatchDenom: batch2Denom,
+		Balance:    "6",
+	}))
+
+	// supplies match the balances
+	res, err := s.k.SupplyReconciliation(s.ctx, &core.QuerySupplyReconciliationRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Mismatches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// the tradable supply of the first batch drifts from its balances
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	supply.TradableAmount = "11"
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Update(s.ctx, supply))
+
+	// the basket balance of the second batch drifts from its supply
+	assert.NilError(t, s.basketStore.BasketBalanceTable().Update(s.ctx, &basketapi.BasketBalance{
+		BasketId:   basketId,
+		BatchDenom: batch2Denom,
+		Balance:    "5",
+	}))
+
+	res, err = s.k.SupplyReconciliation(s.ctx, &core.QuerySupplyReconciliationRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Mismatches))
+	assert.DeepEqual(t, &core.SupplyMismatch{
+		BatchDenom:               batchDenom,
+		RecordedTradableAmount:   "11",
+		CalculatedTradableAmount: "10.5",
+		RecordedRetiredAmount:    "10.5",
+		CalculatedRetiredAmount:  "10.5",
+	}, res.Mismatches[0])
+	assert.DeepEqual(t, &core.SupplyMismatch{
+		BatchDenom:               batch2Denom,
+		RecordedTradableAmount:   "20",
+		CalculatedTradableAmount: "19",
+		RecordedRetiredAmount:    "5",
+		CalculatedRetiredAmount:  "5",
+	}, res.Mismatches[1])
+
+	// pagination applies to the batches
+	res, err = s.k.SupplyReconciliation(s.ctx, &core.QuerySupplyReconciliationRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Mismatches))
+	assert.Equal(t, batch2Denom, res.Mismatches[0].BatchDenom)
+}
@@ -8,11 +8,11 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
-	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
@@ -22,7 +22,20 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 	sdkCtx := types.UnwrapSDKContext(ctx)
 	owner, _ := sdk.AccAddressFromBech32(req.Owner)
 
-	for _, credit := range req.Credits {
+	if err := k.assertMaxCreditsPerMsg(sdkCtx.Context, len(req.Credits)); err != nil {
+		return nil, err
+	}
+
+	minRetirementAmount, err := k.getMinRetirementAmount(sdkCtx.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCostPerIteration := k.getGasCostPerIteration(sdkCtx.Context)
+	jurisdiction := core.NormalizeJurisdiction(req.Jurisdiction)
+
+	retired := make([]math.Dec, len(req.Credits))
+	for i, credit := range req.Credits {
 		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
@@ -42,6 +55,13 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 		}
 		amtToRetire, userTradableBalance := decs[0], decs[1]
 
+		if amtToRetire.Cmp(minRetirementAmount) == math.LessThan {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"retirement amount %s of %s is below the minimum retirement amount %s",
+				amtToRetire.String(), batch.Denom, minRetirementAmount.String(),
+			)
+		}
+
 		userTradableBalance, err = math.SafeSubBalance(userTradableBalance, amtToRetire)
 		if err != nil {
 			return nil, err
@@ -94,12 +114,46 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 			Owner:        req.Owner,
 			BatchDenom:   credit.BatchDenom,
 			Amount:       credit.Amount,
-			Jurisdiction: req.Jurisdiction,
+			Jurisdiction: jurisdiction,
+			Beneficiary:  req.Beneficiary,
 		}); err != nil {
 			return nil, err
 		}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgRetire credit iteration")
+		if req.Beneficiary != "" {
+			if _, err = k.stateStore.BatchRetirementTable().InsertReturningID(ctx, &api.BatchRetirement{
+				BatchKey:     batch.Key,
+				Owner:        owner,
+				Beneficiary:  req.Beneficiary,
+				Amount:       amtToRetire.String(),
+				Jurisdiction: jurisdiction,
+				Timestamp:    timestamppb.New(sdkCtx.BlockTime()),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		retired[i] = amtToRetire
+
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "ecocredit/core/MsgRetire credit iteration")
+	}
+
+	for i, credit := range req.Credits {
+		if err = k.retireHooks.AfterCreditsRetired(sdkCtx.Context, owner, credit.BatchDenom, retired[i], jurisdiction); err != nil {
+			return nil, err
+		}
 	}
+
 	return &core.MsgRetireResponse{}, nil
 }
+
+// getMinRetirementAmount returns the minimum amount of credits that can be
+// retired from a credit batch in a single retirement.
+func (k Keeper) getMinRetirementAmount(ctx sdk.Context) (math.Dec, error) {
+	var minRetirementAmount string
+	k.paramsKeeper.Get(ctx, core.KeyMinRetirementAmount, &minRetirementAmount)
+	if minRetirementAmount == "" {
+		return math.NewDecFromInt64(0), nil
+	}
+	return math.NewNonNegativeDecFromString(minRetirementAmount)
+}
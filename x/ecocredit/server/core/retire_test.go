@@ -1,14 +1,20 @@
 package core
 
 import (
+	"fmt"
 	"testing"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/regen-network/regen-ledger/types/math"
+
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 func TestRetire_Valid(t *testing.T) {
@@ -16,6 +22,9 @@ func TestRetire_Valid(t *testing.T) {
 	s := setupBase(t)
 	_, _, batchDenom := s.setupClassProjectBatch(t)
 
+	minRetirementAmount := core.DefaultMinRetirementAmount
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 1)
+
 	// starting balance
 	// tradable: 10.5
 	// retired: 10.5
@@ -45,11 +54,95 @@ func TestRetire_Valid(t *testing.T) {
 	assert.Equal(t, sup.RetiredAmount, "20.5")
 }
 
+type retireHooksRecorder struct {
+	calls []string
+	err   error
+}
+
+func (r *retireHooksRecorder) AfterCreditsRetired(_ sdk.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, jurisdiction string) error {
+	r.calls = append(r.calls, fmt.Sprintf("%s %s %s %s", owner, batchDenom, amount, jurisdiction))
+	return r.err
+}
+
+func TestRetire_Hooks(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	hooks := &retireHooksRecorder{}
+	s.k.retireHooks = hooks
+
+	minRetirementAmount := core.DefaultMinRetirementAmount
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 2)
+
+	_, err := s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "2.5"},
+		},
+		Jurisdiction: "US-NY",
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hooks.calls, []string{fmt.Sprintf("%s %s 2.5 US-NY", s.addr, batchDenom)})
+
+	// an error returned by the hook fails the retirement
+	hooks.err = fmt.Errorf("hook failed")
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "2.5"},
+		},
+		Jurisdiction: "US-NY",
+	})
+	assert.ErrorContains(t, err, "hook failed")
+}
+
+func TestRetire_NormalizesJurisdiction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	hooks := &retireHooksRecorder{}
+	s.k.retireHooks = hooks
+
+	minRetirementAmount := core.DefaultMinRetirementAmount
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 1)
+
+	_, err := s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "2.5"},
+		},
+		Jurisdiction: "us-ny",
+		Beneficiary:  "foo",
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hooks.calls, []string{fmt.Sprintf("%s %s 2.5 US-NY", s.addr, batchDenom)})
+
+	retirement, err := s.stateStore.BatchRetirementTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "US-NY", retirement.Jurisdiction)
+
+	var found bool
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		assert.NilError(t, err)
+		if event, ok := msg.(*core.EventRetire); ok {
+			found = true
+			assert.Equal(t, "US-NY", event.Jurisdiction)
+		}
+	}
+	assert.Assert(t, found, "expected EventRetire to be emitted")
+}
+
 func TestRetire_Invalid(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
 	_, _, batchDenom := s.setupClassProjectBatch(t)
 
+	minRetirementAmount := core.DefaultMinRetirementAmount
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 3)
+
 	// invalid batch denom
 	_, err := s.k.Retire(s.ctx, &core.MsgRetire{
 		Owner: s.addr.String(),
@@ -80,3 +173,66 @@ func TestRetire_Invalid(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, errors.ErrInsufficientFunds.Error())
 }
+
+func TestRetire_MinRetirementAmount(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	minRetirementAmount := "1.5"
+	utils.ExpectParamGet(&minRetirementAmount, s.paramsKeeper, core.KeyMinRetirementAmount, 3)
+
+	// amount below the minimum
+	_, err := s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "1.49"},
+		},
+		Jurisdiction: "US-NY",
+	})
+	assert.ErrorIs(t, err, errors.ErrInvalidRequest)
+	assert.ErrorContains(t, err, "retirement amount 1.49 of "+batchDenom+" is below the minimum retirement amount 1.5")
+
+	// amount equal to the minimum after precision normalization
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "1.500000"},
+		},
+		Jurisdiction: "US-NY",
+	})
+	assert.NilError(t, err)
+
+	// amount above the minimum
+	_, err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.addr.String(),
+		Credits: []*core.Credits{
+			{BatchDenom: batchDenom, Amount: "2"},
+		},
+		Jurisdiction: "US-NY",
+	})
+	assert.NilError(t, err)
+
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal.TradableAmount, "7.000000")
+	assert.Equal(t, bal.RetiredAmount, "14.000000")
+}
+
+func TestRetire_MaxCreditsPerMsg(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	credits := make([]*core.Credits, core.DefaultMaxCreditsPerMsg+1)
+	for i := range credits {
+		credits[i] = &core.Credits{BatchDenom: batchDenom, Amount: "0.000001"}
+	}
+
+	_, err := s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner:        s.addr.String(),
+		Credits:      credits,
+		Jurisdiction: "US-WA",
+	})
+	assert.ErrorContains(t, err, "number of credits 1001 exceeds the maximum of 1000 per message")
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // SealBatch sets the Open field to false in a batch IFF the requester address matches the batch issuer address.
-// This method is a no-op for batches which already have Open set to false.
+// Sealing a batch which already has Open set to false returns an error.
 func (k Keeper) SealBatch(ctx context.Context, req *core.MsgSealBatch) (*core.MsgSealBatchResponse, error) {
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
@@ -27,7 +27,7 @@ func (k Keeper) SealBatch(ctx context.Context, req *core.MsgSealBatch) (*core.Ms
 	}
 
 	if !batch.Open {
-		return &core.MsgSealBatchResponse{}, nil
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch %s is already sealed", batch.Denom)
 	}
 
 	batch.Open = false
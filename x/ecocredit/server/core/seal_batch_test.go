@@ -28,16 +28,47 @@ func TestSealBatch_Valid(t *testing.T) {
 	assert.Equal(t, false, batchAfter.Open)
 }
 
-func TestSealBatch_NoOp(t *testing.T) {
+func TestSealBatch_AlreadySealed(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
 	_, _, batchDenom := s.setupClassProjectBatch(t)
 
+	_, err := s.k.SealBatch(s.ctx, &core.MsgSealBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+	})
+	assert.ErrorContains(t, err, "is already sealed")
+}
+
+func TestSealBatch_MintAfterSeal(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	setupSealBatchTest(s, batchDenom)
+
 	_, err := s.k.SealBatch(s.ctx, &core.MsgSealBatch{
 		Issuer:     s.addr.String(),
 		BatchDenom: batchDenom,
 	})
 	assert.NilError(t, err)
+
+	_, err = s.k.MintBatchCredits(s.ctx, &core.MsgMintBatchCredits{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+		Issuance:   []*core.BatchIssuance{{Recipient: s.addr.String(), TradableAmount: "10"}},
+		OriginTx: &core.OriginTx{
+			Id:     "0x12345",
+			Source: "polygon",
+		},
+	})
+	assert.ErrorContains(t, err, "credits cannot be minted in a closed batch")
+
+	// sealing the batch a second time fails
+	_, err = s.k.SealBatch(s.ctx, &core.MsgSealBatch{
+		Issuer:     s.addr.String(),
+		BatchDenom: batchDenom,
+	})
+	assert.ErrorContains(t, err, "is already sealed")
 }
 
 func TestSealBatch_Unauthorized(t *testing.T) {
@@ -21,44 +21,96 @@ import (
 // Send also retires credits if the amount to retire is specified in the request.
 func (k Keeper) Send(ctx context.Context, req *core.MsgSend) (*core.MsgSendResponse, error) {
 	sdkCtx := types.UnwrapSDKContext(ctx)
-	sender, _ := sdk.AccAddressFromBech32(req.Sender)
-	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+
+	if err := k.sendCredits(ctx, req); err != nil {
+		return nil, err
+	}
 
 	for _, credit := range req.Credits {
-		err := k.sendEcocredits(ctx, credit, recipient, sender)
-		if err != nil {
-			return nil, err
-		}
-		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
+		if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
 			Sender:         req.Sender,
 			Recipient:      req.Recipient,
 			BatchDenom:     credit.BatchDenom,
 			TradableAmount: credit.TradableAmount,
 			RetiredAmount:  credit.RetiredAmount,
+			Note:           req.Note,
 		}); err != nil {
 			return nil, err
 		}
-
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgSend credit iteration")
 	}
 	return &core.MsgSendResponse{}, nil
 }
 
-func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCredits, to, from sdk.AccAddress) error {
-	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+// sendCredits checks the credits of a send request against the module params
+// and sends them from the sender to the recipient. It is shared by Msg/Send
+// and Query/SimulateSend so that a simulated send fails for the same reasons
+// as the send itself.
+func (k Keeper) sendCredits(ctx context.Context, req *core.MsgSend) error {
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	sender, _ := sdk.AccAddressFromBech32(req.Sender)
+	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+
+	if err := k.assertMaxCreditsPerMsg(sdkCtx.Context, len(req.Credits)); err != nil {
+		return err
+	}
+
+	gasCostPerIteration := k.getGasCostPerIteration(sdkCtx.Context)
+
+	cache := newSendCache()
+	for _, credit := range req.Credits {
+		if err := k.sendEcocredits(ctx, cache, credit, recipient, sender); err != nil {
+			return err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "ecocredit/core/MsgSend credit iteration")
+	}
+	return nil
+}
+
+// sendCache memoizes the credit batches and credit type precisions loaded
+// while sending the credits of a single message, so credits from the same
+// batch only load them once. Batch supplies are not cached because the send
+// hooks may update them between credits.
+type sendCache struct {
+	batches map[string]*sendBatch
+}
+
+type sendBatch struct {
+	batch     *api.Batch
+	precision uint32
+}
+
+func newSendCache() *sendCache {
+	return &sendCache{batches: make(map[string]*sendBatch)}
+}
+
+// getSendBatch returns the credit batch with the credit type precision,
+// loading them from the store if not cached.
+func (k Keeper) getSendBatch(ctx context.Context, cache *sendCache, batchDenom string) (*sendBatch, error) {
+	if b, ok := cache.batches[batchDenom]; ok {
+		return b, nil
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
 	if err != nil {
-		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err.Error())
 	}
 	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	precision := creditType.Precision
+	b := &sendBatch{batch: batch, precision: creditType.Precision}
+	cache.batches[batchDenom] = b
+	return b, nil
+}
 
-	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+func (k Keeper) sendEcocredits(ctx context.Context, cache *sendCache, credit *core.MsgSend_SendCredits, to, from sdk.AccAddress) error {
+	b, err := k.getSendBatch(ctx, cache, credit.BatchDenom)
 	if err != nil {
 		return err
 	}
+	batch, precision := b.batch, b.precision
+
 	fromBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, from, batch.Key)
 	if err != nil {
 		if err == ormerrors.NotFound {
@@ -80,16 +132,24 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 			return err
 		}
 	}
-	decs, err := utils.GetNonNegativeFixedDecs(precision, toBalance.TradableAmount, toBalance.RetiredAmount, fromBalance.TradableAmount, fromBalance.RetiredAmount, credit.TradableAmount, credit.RetiredAmount, batchSupply.TradableAmount, batchSupply.RetiredAmount)
+	decs, err := utils.GetNonNegativeFixedDecs(precision, toBalance.TradableAmount, toBalance.RetiredAmount, fromBalance.TradableAmount, fromBalance.RetiredAmount, credit.TradableAmount, credit.RetiredAmount)
 	if err != nil {
 		return err
 	}
 	toTradableBalance, toRetiredBalance,
 		fromTradableBalance, fromRetiredBalance,
-		sendAmtTradable, sendAmtRetired,
-		batchSupplyTradable, batchSupplyRetired := decs[0], decs[1], decs[2], decs[3], decs[4], decs[5], decs[6], decs[7]
+		sendAmtTradable, sendAmtRetired := decs[0], decs[1], decs[2], decs[3], decs[4], decs[5]
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = k.sendHooks.BeforeCreditsSent(sdkCtx, from, to, batch.Denom, sendAmtTradable, sendAmtRetired); err != nil {
+		return err
+	}
 
 	if !sendAmtTradable.IsZero() {
+		// tradable credits from an expired batch can only be retired or cancelled
+		if utils.IsBatchExpired(batch, sdkCtx.BlockTime()) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("tradable credits from batch %s expired on %s and can no longer be sent", batch.Denom, batch.ExpiryDate.AsTime().Format("2006-01-02"))
+		}
 		fromTradableBalance, err = math.SafeSubBalance(fromTradableBalance, sendAmtTradable)
 		if err != nil {
 			return err
@@ -111,14 +171,6 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 		if err != nil {
 			return err
 		}
-		batchSupplyRetired, err = batchSupplyRetired.Add(sendAmtRetired)
-		if err != nil {
-			return err
-		}
-		batchSupplyTradable, err = batchSupplyTradable.Sub(sendAmtRetired)
-		if err != nil {
-			return err
-		}
 	}
 	// update the "to" balance
 	if err := k.stateStore.BatchBalanceTable().Save(ctx, &api.BatchBalance{
@@ -141,22 +193,42 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 	}
 	// update the "retired" supply only if credits were retired
 	if didRetire {
-		if err := k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
-			BatchKey:        batch.Key,
-			TradableAmount:  batchSupplyTradable.String(),
-			RetiredAmount:   batchSupplyRetired.String(),
-			CancelledAmount: batchSupply.CancelledAmount,
-		}); err != nil {
+		if err := k.retireBatchSupply(ctx, batch.Key, precision, sendAmtRetired); err != nil {
 			return err
 		}
-		if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventRetire{
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 			Owner:        to.String(),
 			BatchDenom:   credit.BatchDenom,
 			Amount:       sendAmtRetired.String(),
-			Jurisdiction: credit.RetirementJurisdiction,
+			Jurisdiction: core.NormalizeJurisdiction(credit.RetirementJurisdiction),
 		}); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return k.sendHooks.AfterCreditsSent(sdkCtx, from, to, batch.Denom, sendAmtTradable, sendAmtRetired)
+}
+
+// retireBatchSupply moves amount from the tradable to the retired supply of a
+// batch. The supply is read from the store rather than the send cache so that
+// updates made by the send hooks are not overwritten.
+func (k Keeper) retireBatchSupply(ctx context.Context, batchKey uint64, precision uint32, amount math.Dec) error {
+	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batchKey)
+	if err != nil {
+		return err
+	}
+	decs, err := utils.GetNonNegativeFixedDecs(precision, batchSupply.TradableAmount, batchSupply.RetiredAmount)
+	if err != nil {
+		return err
+	}
+	tradable, retired := decs[0], decs[1]
+	if tradable, err = tradable.Sub(amount); err != nil {
+		return err
+	}
+	if retired, err = retired.Add(amount); err != nil {
+		return err
+	}
+	batchSupply.TradableAmount = tradable.String()
+	batchSupply.RetiredAmount = retired.String()
+	return k.stateStore.BatchSupplyTable().Update(ctx, batchSupply)
 }
@@ -1,12 +1,17 @@
 package core
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
@@ -54,6 +59,249 @@ func TestSend_Valid(t *testing.T) {
 	assert.Equal(t, "11.80", sup.RetiredAmount)
 }
 
+func TestSend_RepeatedBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	// s.Addr starting balance -> 10.5 tradable, 10.5 retired
+
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+			{BatchDenom: batchDenom, TradableAmount: "2", RetiredAmount: "1.5", RetirementJurisdiction: "US-OR"},
+			{BatchDenom: batchDenom, RetiredAmount: "0.25", RetirementJurisdiction: "US-OR"},
+		},
+	})
+	assert.NilError(t, err)
+
+	senderBal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "5.75", senderBal.TradableAmount)
+
+	recipientBal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, recipient, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "2", recipientBal.TradableAmount)
+	assert.Equal(t, "2.75", recipientBal.RetiredAmount)
+
+	// every retirement is applied to the supply
+	sup, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "7.75", sup.TradableAmount)
+	assert.Equal(t, "13.25", sup.RetiredAmount)
+}
+
+func BenchmarkSend(b *testing.B) {
+	s := setupBase(b)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(b)
+
+	// send many small amounts of credits from the same batch in a single message
+	credits := make([]*core.MsgSend_SendCredits, 10)
+	for i := range credits {
+		credits[i] = &core.MsgSend_SendCredits{
+			BatchDenom:             batchDenom,
+			TradableAmount:         "0.000001",
+			RetiredAmount:          "0.000001",
+			RetirementJurisdiction: "US-OR",
+		}
+	}
+	msg := &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits:   credits,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// the balances are reset by discarding the cached context
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		if _, err := s.k.Send(sdk.WrapSDKContext(sdkCtx), msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSend_RetirementJurisdictionPerCredit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+			{BatchDenom: batchDenom, RetiredAmount: "2", RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// each retirement event uses the jurisdiction of the corresponding credits
+	jurisdictions := make([]string, 0, 2)
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventRetire" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "jurisdiction" {
+				jurisdictions = append(jurisdictions, string(attr.Value))
+			}
+		}
+	}
+	assert.DeepEqual(t, []string{`"US-OR"`, `"US-WA"`}, jurisdictions)
+}
+
+func TestSend_Note(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "1"},
+			{BatchDenom: batchDenom, TradableAmount: "2"},
+		},
+		Note: "transfer for audit 123",
+	})
+	assert.NilError(t, err)
+
+	// each transfer event includes the note of the message
+	notes := make([]string, 0, 2)
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventTransfer" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "note" {
+				notes = append(notes, string(attr.Value))
+			}
+		}
+	}
+	assert.DeepEqual(t, []string{`"transfer for audit 123"`, `"transfer for audit 123"`}, notes)
+}
+
+type sendHooksRecorder struct {
+	calls     []string
+	beforeErr error
+}
+
+func (r *sendHooksRecorder) BeforeCreditsSent(_ sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, tradable, retired math.Dec) error {
+	r.calls = append(r.calls, fmt.Sprintf("before %s %s %s %s %s", sender, recipient, batchDenom, tradable, retired))
+	return r.beforeErr
+}
+
+func (r *sendHooksRecorder) AfterCreditsSent(_ sdk.Context, sender, recipient sdk.AccAddress, batchDenom string, tradable, retired math.Dec) error {
+	r.calls = append(r.calls, fmt.Sprintf("after %s %s %s %s %s", sender, recipient, batchDenom, tradable, retired))
+	return nil
+}
+
+func TestSend_Hooks(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	hooks := &sendHooksRecorder{}
+	s.k.sendHooks = hooks
+
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "2", RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+		},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hooks.calls, []string{
+		fmt.Sprintf("before %s %s %s 2 1", s.addr, recipient, batchDenom),
+		fmt.Sprintf("after %s %s %s 2 1", s.addr, recipient, batchDenom),
+	})
+
+	// an error returned by the before hook aborts the send
+	hooks.calls = nil
+	hooks.beforeErr = fmt.Errorf("send not allowed")
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "2"},
+		},
+	})
+	assert.ErrorContains(t, err, "send not allowed")
+	assert.Equal(t, len(hooks.calls), 1)
+
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, recipient, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal.TradableAmount, "2")
+}
+
+// cancelSendHooks cancels one tradable credit of the batch supply after each
+// send, the way a hook in another module may update the supply.
+type cancelSendHooks struct {
+	s *baseSuite
+}
+
+func (h cancelSendHooks) BeforeCreditsSent(sdk.Context, sdk.AccAddress, sdk.AccAddress, string, math.Dec, math.Dec) error {
+	return nil
+}
+
+func (h cancelSendHooks) AfterCreditsSent(sdkCtx sdk.Context, _, _ sdk.AccAddress, batchDenom string, _, _ math.Dec) error {
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	batch, err := h.s.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return err
+	}
+	supply, err := h.s.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+	tradable, err := math.NewDecFromString(supply.TradableAmount)
+	if err != nil {
+		return err
+	}
+	if tradable, err = tradable.Sub(math.NewDecFromInt64(1)); err != nil {
+		return err
+	}
+	supply.TradableAmount = tradable.String()
+	supply.CancelledAmount = "1"
+	return h.s.stateStore.BatchSupplyTable().Update(ctx, supply)
+}
+
+func TestSend_HooksUpdateSupply(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	s.k.sendHooks = cancelSendHooks{s: s}
+
+	// the supply updated by the hook after the first credits is not
+	// overwritten when the second credits from the same batch are retired
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+			{BatchDenom: batchDenom, RetiredAmount: "2", RetirementJurisdiction: "US-OR"},
+		},
+	})
+	assert.NilError(t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, supply.TradableAmount, "5.5")
+	assert.Equal(t, supply.RetiredAmount, "13.5")
+	assert.Equal(t, supply.CancelledAmount, "1")
+}
+
 func TestSend_Errors(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -80,3 +328,76 @@ func TestSend_Errors(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, "exceeds maximum decimal places")
 }
+
+func TestSend_Expired(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+	batch.ExpiryDate = &timestamppb.Timestamp{Seconds: 60}
+	assert.NilError(t, s.stateStore.BatchTable().Update(s.ctx, batch))
+
+	// sending before expiry succeeds
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Unix(59, 0))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "1"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// sending tradable credits at or after expiry fails
+	s.sdkCtx = s.sdkCtx.WithBlockTime(time.Unix(60, 0))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "1"},
+		},
+	})
+	assert.ErrorContains(t, err, "can no longer be sent")
+
+	// expired credits can still be retired
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, RetiredAmount: "1", RetirementJurisdiction: "US-OR"},
+		},
+	})
+	assert.NilError(t, err)
+}
+
+func TestSend_MaxCreditsPerMsg(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+
+	credits := make([]*core.MsgSend_SendCredits, core.DefaultMaxCreditsPerMsg+1)
+	for i := range credits {
+		credits[i] = &core.MsgSend_SendCredits{BatchDenom: batchDenom, TradableAmount: "0.000001"}
+	}
+
+	_, err := s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits:   credits,
+	})
+	assert.ErrorContains(t, err, "number of credits 1001 exceeds the maximum of 1000 per message")
+
+	// the maximum number of credits is allowed
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: recipient.String(),
+		Credits:   credits[:core.DefaultMaxCreditsPerMsg],
+	})
+	assert.NilError(t, err)
+}
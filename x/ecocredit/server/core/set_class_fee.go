@@ -0,0 +1,39 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// SetClassFee updates the credit class fee parameter IFF the signer is the module authority. No other
+// parameters are updated.
+func (k Keeper) SetClassFee(ctx context.Context, req *core.MsgSetClassFee) (*core.MsgSetClassFeeResponse, error) {
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.authority.Equals(authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s, got %s", k.authority, req.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var oldFee sdk.Coins
+	k.paramsKeeper.Get(sdkCtx, core.KeyCreditClassFee, &oldFee)
+
+	k.paramsKeeper.Set(sdkCtx, core.KeyCreditClassFee, req.Fee)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventSetClassFee{
+		OldFee: oldFee,
+		NewFee: req.Fee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgSetClassFeeResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+func TestSetClassFee_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	oldFee := sdk.NewCoins(sdk.NewInt64Coin("uregen", 20))
+	newFee := sdk.NewCoins(sdk.NewInt64Coin("uregen", 10), sdk.NewInt64Coin("uatom", 5))
+	utils.ExpectParamGet(&oldFee, s.paramsKeeper, core.KeyCreditClassFee, 1)
+	s.paramsKeeper.EXPECT().Set(gomock.Any(), core.KeyCreditClassFee, newFee).Times(1)
+
+	_, err := s.k.SetClassFee(s.ctx, &core.MsgSetClassFee{
+		Authority: s.authority.String(),
+		Fee:       newFee,
+	})
+	assert.NilError(t, err)
+
+	var found bool
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type != "regen.ecocredit.v1.EventSetClassFee" {
+			continue
+		}
+		found = true
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case "old_fee":
+				assert.Equal(t, `[{"denom":"uregen","amount":"20"}]`, string(attr.Value))
+			case "new_fee":
+				assert.Equal(t, `[{"denom":"uatom","amount":"5"},{"denom":"uregen","amount":"10"}]`, string(attr.Value))
+			}
+		}
+	}
+	assert.Assert(t, found, "expected EventSetClassFee to be emitted")
+}
+
+func TestSetClassFee_Unauthorized(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.SetClassFee(s.ctx, &core.MsgSetClassFee{
+		Authority: s.addr.String(),
+		Fee:       sdk.NewCoins(sdk.NewInt64Coin("uregen", 10)),
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.ErrorContains(t, err, "expected authority")
+}
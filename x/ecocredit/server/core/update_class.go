@@ -107,6 +107,122 @@ func (k Keeper) UpdateClassIssuers(ctx context.Context, req *core.MsgUpdateClass
 	return &core.MsgUpdateClassIssuersResponse{}, nil
 }
 
+// AddClassIssuer adds an issuer to the class issuer store.
+func (k Keeper) AddClassIssuer(ctx context.Context, req *core.MsgAddClassIssuer) (*core.MsgAddClassIssuerResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected admin %s, got %s", class.Admin, req.Admin)
+	}
+
+	if err = (core.ClassIssuer{ClassKey: class.Key, Issuer: issuer}).Validate(); err != nil {
+		return nil, err
+	}
+
+	found, err := k.stateStore.ClassIssuerTable().Has(ctx, class.Key, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is already an issuer of class %s", req.Issuer, req.ClassId)
+	}
+
+	if err = k.stateStore.ClassIssuerTable().Insert(ctx, &api.ClassIssuer{
+		ClassKey: class.Key,
+		Issuer:   issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&api.EventAddClassIssuer{
+		ClassId: req.ClassId,
+		Issuer:  req.Issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgAddClassIssuerResponse{}, nil
+}
+
+// RemoveClassIssuer removes an issuer from the class issuer store. A class must always have at least one issuer,
+// so the last issuer of a class cannot be removed.
+func (k Keeper) RemoveClassIssuer(ctx context.Context, req *core.MsgRemoveClassIssuer) (*core.MsgRemoveClassIssuerResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected admin %s, got %s", class.Admin, req.Admin)
+	}
+
+	found, err := k.stateStore.ClassIssuerTable().Has(ctx, class.Key, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not an issuer of class %s", req.Issuer, req.ClassId)
+	}
+
+	it, err := k.stateStore.ClassIssuerTable().List(ctx, api.ClassIssuerPrimaryKey{}.WithClassKey(class.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// we only need to know whether the class has another issuer
+	issuerCount := 0
+	for it.Next() && issuerCount < 2 {
+		issuerCount++
+	}
+	if issuerCount < 2 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot remove the last issuer of class %s", req.ClassId)
+	}
+
+	if err = k.stateStore.ClassIssuerTable().Delete(ctx, &api.ClassIssuer{
+		ClassKey: class.Key,
+		Issuer:   issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&api.EventRemoveClassIssuer{
+		ClassId: req.ClassId,
+		Issuer:  req.Issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgRemoveClassIssuerResponse{}, nil
+}
+
 // UpdateClassMetadata updates the metadata for the class.
 func (k Keeper) UpdateClassMetadata(ctx context.Context, req *core.MsgUpdateClassMetadata) (*core.MsgUpdateClassMetadataResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -41,6 +41,46 @@ func TestUpdateClass_UpdateAdmin(t *testing.T) {
 	assert.Check(t, newAdmin.Equals(types.AccAddress(cInfo.Admin)))
 }
 
+func TestUpdateClass_UpdateAdminPreservesIssuers(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	addrs := genAddrs(2)
+	newAdmin, issuer := addrs[0], addrs[1]
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+
+	for _, addr := range []types.AccAddress{s.addr, issuer} {
+		assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: classKey,
+			Issuer:   addr,
+		}))
+	}
+
+	_, err = s.k.UpdateClassAdmin(s.ctx, &core.MsgUpdateClassAdmin{
+		Admin:    s.addr.String(),
+		ClassId:  "C01",
+		NewAdmin: newAdmin.String(),
+	})
+	assert.NilError(t, err)
+
+	// the class issuers should not change when the admin is updated
+	for _, addr := range []types.AccAddress{s.addr, issuer} {
+		found, err := s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, addr)
+		assert.NilError(t, err)
+		assert.Check(t, found)
+	}
+
+	found, err := s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, newAdmin)
+	assert.NilError(t, err)
+	assert.Check(t, !found)
+}
+
 func TestUpdateClass_UpdateAdminErrs(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -192,6 +232,118 @@ func TestUpdateClass_IssuersErrs(t *testing.T) {
 	assert.ErrorContains(t, err, ormerrors.PrimaryKeyConstraintViolation.Error())
 }
 
+func TestUpdateClass_AddIssuer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	addrs := genAddrs(2)
+	issuer, notAdmin := addrs[0], addrs[1]
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+
+	_, err = s.k.AddClassIssuer(s.ctx, &core.MsgAddClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Issuer:  issuer.String(),
+	})
+	assert.NilError(t, err)
+
+	found, err := s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, issuer)
+	assert.NilError(t, err)
+	assert.Check(t, found)
+
+	// try to add an issuer that already exists
+	_, err = s.k.AddClassIssuer(s.ctx, &core.MsgAddClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Issuer:  issuer.String(),
+	})
+	assert.ErrorContains(t, err, "is already an issuer of class C01")
+
+	// try to add an issuer without being admin
+	_, err = s.k.AddClassIssuer(s.ctx, &core.MsgAddClassIssuer{
+		Admin:   notAdmin.String(),
+		ClassId: "C01",
+		Issuer:  notAdmin.String(),
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrUnauthorized.Error())
+
+	// try to add an issuer to a non-existent class
+	_, err = s.k.AddClassIssuer(s.ctx, &core.MsgAddClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "FOO",
+		Issuer:  issuer.String(),
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrNotFound.Error())
+}
+
+func TestUpdateClass_RemoveIssuer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	addrs := genAddrs(2)
+	issuer, notAdmin := addrs[0], addrs[1]
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+	for _, addr := range []types.AccAddress{s.addr, issuer} {
+		assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+			ClassKey: classKey,
+			Issuer:   addr,
+		}))
+	}
+
+	// try to remove an issuer without being admin
+	_, err = s.k.RemoveClassIssuer(s.ctx, &core.MsgRemoveClassIssuer{
+		Admin:   notAdmin.String(),
+		ClassId: "C01",
+		Issuer:  issuer.String(),
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrUnauthorized.Error())
+
+	// try to remove an address that is not an issuer
+	_, err = s.k.RemoveClassIssuer(s.ctx, &core.MsgRemoveClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Issuer:  notAdmin.String(),
+	})
+	assert.ErrorContains(t, err, "is not an issuer of class C01")
+
+	_, err = s.k.RemoveClassIssuer(s.ctx, &core.MsgRemoveClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Issuer:  issuer.String(),
+	})
+	assert.NilError(t, err)
+
+	found, err := s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, issuer)
+	assert.NilError(t, err)
+	assert.Check(t, !found)
+
+	// try to remove the last issuer
+	_, err = s.k.RemoveClassIssuer(s.ctx, &core.MsgRemoveClassIssuer{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Issuer:  s.addr.String(),
+	})
+	assert.ErrorContains(t, err, "cannot remove the last issuer of class C01")
+
+	found, err = s.stateStore.ClassIssuerTable().Has(s.ctx, classKey, s.addr)
+	assert.NilError(t, err)
+	assert.Check(t, found)
+}
+
 func TestUpdateClass_Metadata(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateCreditTypePrecision increases the precision of a credit type IFF the signer is the module authority. Balances
+// and supplies are stored as decimal strings that are valid at any higher precision, so they are left unchanged. The
+// update is rejected while any basket or sell order holds credits of the credit type, because basket tokens are
+// minted and redeemed based on the precision of the credit type.
+func (k Keeper) UpdateCreditTypePrecision(ctx context.Context, req *core.MsgUpdateCreditTypePrecision) (*core.MsgUpdateCreditTypePrecisionResponse, error) {
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.authority.Equals(authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s, got %s", k.authority, req.Authority)
+	}
+
+	creditType, err := k.stateStore.CreditTypeTable().Get(ctx, req.Abbreviation)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("could not get credit type with abbreviation %s: %s", req.Abbreviation, err)
+	}
+
+	if creditType.Precision != req.OldPrecision {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit type %s has precision %d, expected %d", creditType.Abbreviation, creditType.Precision, req.OldPrecision,
+		)
+	}
+
+	if req.NewPrecision <= creditType.Precision {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"new precision %d must be greater than current precision %d", req.NewPrecision, creditType.Precision,
+		)
+	}
+
+	if req.NewPrecision > core.MaxPrecision {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"new precision %d cannot exceed %d", req.NewPrecision, core.MaxPrecision,
+		)
+	}
+
+	if err := k.assertNoCreditTypeBaskets(ctx, creditType.Abbreviation); err != nil {
+		return nil, err
+	}
+
+	batchKeys, err := k.getCreditTypeBatchKeys(ctx, creditType.Abbreviation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batchKey := range batchKeys {
+		if err := k.assertNoEscrowedCredits(ctx, batchKey); err != nil {
+			return nil, err
+		}
+	}
+
+	creditType.Precision = req.NewPrecision
+	if err := k.stateStore.CreditTypeTable().Update(ctx, creditType); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventUpdateCreditTypePrecision{
+		Abbreviation: creditType.Abbreviation,
+		OldPrecision: req.OldPrecision,
+		NewPrecision: req.NewPrecision,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateCreditTypePrecisionResponse{}, nil
+}
+
+// getCreditTypeBatchKeys returns the keys of all credit batches issued within credit classes of the credit type.
+func (k Keeper) getCreditTypeBatchKeys(ctx context.Context, abbrev string) ([]uint64, error) {
+	classIt, err := k.stateStore.ClassTable().List(ctx, api.ClassCreditTypeAbbrevIndexKey{}.WithCreditTypeAbbrev(abbrev))
+	if err != nil {
+		return nil, err
+	}
+	defer classIt.Close()
+
+	var batchKeys []uint64
+	for classIt.Next() {
+		class, err := classIt.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		projectIt, err := k.stateStore.ProjectTable().List(ctx, api.ProjectClassKeyIdIndexKey{}.WithClassKey(class.Key))
+		if err != nil {
+			return nil, err
+		}
+
+		for projectIt.Next() {
+			project, err := projectIt.Value()
+			if err != nil {
+				projectIt.Close()
+				return nil, err
+			}
+
+			batchIt, err := k.stateStore.BatchTable().List(ctx, api.BatchProjectKeyIndexKey{}.WithProjectKey(project.Key))
+			if err != nil {
+				projectIt.Close()
+				return nil, err
+			}
+
+			for batchIt.Next() {
+				batch, err := batchIt.Value()
+				if err != nil {
+					batchIt.Close()
+					projectIt.Close()
+					return nil, err
+				}
+				batchKeys = append(batchKeys, batch.Key)
+			}
+			batchIt.Close()
+		}
+		projectIt.Close()
+	}
+
+	return batchKeys, nil
+}
+
+// assertNoCreditTypeBaskets returns an error if a basket of the credit type exists.
+func (k Keeper) assertNoCreditTypeBaskets(ctx context.Context, abbrev string) error {
+	it, err := k.basketStore.BasketTable().List(ctx, basketapi.BasketPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		basket, err := it.Value()
+		if err != nil {
+			return err
+		}
+		if basket.CreditTypeAbbrev == abbrev {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"cannot update the precision of credit type %s while basket %s exists", abbrev, basket.BasketDenom,
+			)
+		}
+	}
+
+	return nil
+}
+
+// assertNoEscrowedCredits returns an error if credits of the credit batch are escrowed in sell orders.
+func (k Keeper) assertNoEscrowedCredits(ctx context.Context, batchKey uint64) error {
+	it, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			return err
+		}
+		if balance.EscrowedAmount == "" {
+			continue
+		}
+		escrowed, err := math.NewDecFromString(balance.EscrowedAmount)
+		if err != nil {
+			return err
+		}
+		if escrowed.IsPositive() {
+			batch, err := k.stateStore.BatchTable().Get(ctx, batchKey)
+			if err != nil {
+				return err
+			}
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"cannot update the precision of the credit type while credits from batch %s are escrowed in sell orders", batch.Denom,
+			)
+		}
+	}
+
+	return nil
+}
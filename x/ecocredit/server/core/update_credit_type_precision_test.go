@@ -0,0 +1,235 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormjson"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gotest.tools/v3/assert"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestUpdateCreditTypePrecision_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, holder := testdata.KeyTestPubAddr()
+
+	// add a balance using all 6 decimal places of the current precision
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       1,
+		Address:        holder,
+		TradableAmount: "0.123456",
+		RetiredAmount:  "1.000001",
+		EscrowedAmount: "0",
+	}))
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Update(s.ctx, &api.BatchSupply{
+		BatchKey:        1,
+		TradableAmount:  "11.123456",
+		RetiredAmount:   "11.500001",
+		CancelledAmount: "0.000001",
+	}))
+
+	before := s.getBatchAmounts(t, 1, holder)
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.NilError(t, err)
+
+	creditType, err := s.stateStore.CreditTypeTable().Get(s.ctx, "C")
+	assert.NilError(t, err)
+	assert.Equal(t, creditType.Precision, uint32(9))
+
+	// balances and supply are numerically unchanged and valid at the new precision
+	after := s.getBatchAmounts(t, 1, holder)
+	assert.Equal(t, len(before), len(after))
+	for i := range before {
+		if before[i] == "" {
+			assert.Equal(t, after[i], "")
+			continue
+		}
+		beforeDec, err := math.NewDecFromString(before[i])
+		assert.NilError(t, err)
+		afterDec, err := math.NewNonNegativeFixedDecFromString(after[i], 9)
+		assert.NilError(t, err)
+		assert.Equal(t, beforeDec.Cmp(afterDec), math.EqualTo, "amount %d changed from %s to %s", i, before[i], after[i])
+	}
+
+	// amounts using the new precision are now accepted
+	_, err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    holder.String(),
+		Recipient: s.addr.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{BatchDenom: batchDenom, TradableAmount: "0.000000001"},
+		},
+	})
+	assert.NilError(t, err)
+
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, holder, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, bal.TradableAmount, "0.123455999")
+
+	var found bool
+	for _, event := range s.sdkCtx.EventManager().Events() {
+		if event.Type == "regen.ecocredit.v1.EventUpdateCreditTypePrecision" {
+			found = true
+		}
+	}
+	assert.Assert(t, found, "expected EventUpdateCreditTypePrecision to be emitted")
+}
+
+func TestUpdateCreditTypePrecision_Unauthorized(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.addr.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestUpdateCreditTypePrecision_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "BIO",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// old precision does not match the current precision
+	_, err = s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 3,
+		NewPrecision: 9,
+	})
+	assert.ErrorContains(t, err, "credit type C has precision 6, expected 3")
+
+	// precision cannot be decreased
+	_, err = s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 3,
+	})
+	assert.ErrorContains(t, err, "new precision 3 must be greater than current precision 6")
+
+	// precision cannot exceed the maximum
+	_, err = s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 19,
+	})
+	assert.ErrorContains(t, err, "new precision 19 cannot exceed 18")
+}
+
+func TestUpdateCreditTypePrecision_ExportGenesis(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	s.setupClassProjectBatch(t)
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.NilError(t, err)
+
+	// the exported state must pass genesis validation with the increased precision
+	target := ormjson.NewRawMessageTarget()
+	assert.NilError(t, s.db.ExportJSON(s.ctx, target))
+	genesisJson, err := target.JSON()
+	assert.NilError(t, err)
+
+	params := core.Params{GasCostPerIteration: core.DefaultGasCostPerIteration, MaxCreditsPerMsg: core.DefaultMaxCreditsPerMsg}
+	assert.NilError(t, core.ValidateGenesis(genesisJson, params))
+}
+
+func TestUpdateCreditTypePrecision_Basket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	s.setupClassProjectBatch(t)
+
+	// a basket of another credit type does not prevent the update
+	assert.NilError(t, s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      "eco.BIO.foo",
+		Name:             "foo",
+		CreditTypeAbbrev: "BIO",
+	}))
+	assert.NilError(t, s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      "eco.uC.bar",
+		Name:             "bar",
+		CreditTypeAbbrev: "C",
+	}))
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.ErrorContains(t, err, "cannot update the precision of credit type C while basket eco.uC.bar exists")
+
+	creditType, err := s.stateStore.CreditTypeTable().Get(s.ctx, "C")
+	assert.NilError(t, err)
+	assert.Equal(t, creditType.Precision, uint32(6))
+}
+
+func TestUpdateCreditTypePrecision_SellOrder(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _, batchDenom := s.setupClassProjectBatch(t)
+	_, _, seller := testdata.KeyTestPubAddr()
+
+	// credits escrowed in a sell order
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       1,
+		Address:        seller,
+		TradableAmount: "1",
+		RetiredAmount:  "0",
+		EscrowedAmount: "0.5",
+	}))
+
+	_, err := s.k.UpdateCreditTypePrecision(s.ctx, &core.MsgUpdateCreditTypePrecision{
+		Authority:    s.authority.String(),
+		Abbreviation: "C",
+		OldPrecision: 6,
+		NewPrecision: 9,
+	})
+	assert.ErrorContains(t, err, "credits from batch "+batchDenom+" are escrowed in sell orders")
+
+	creditType, err := s.stateStore.CreditTypeTable().Get(s.ctx, "C")
+	assert.NilError(t, err)
+	assert.Equal(t, creditType.Precision, uint32(6))
+}
+
+// getBatchAmounts returns the balance amounts of s.addr and holder followed by the supply amounts of a batch.
+func (s baseSuite) getBatchAmounts(t *testing.T, batchKey uint64, holder []byte) []string {
+	var amounts []string
+	for _, addr := range [][]byte{s.addr, holder} {
+		bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, batchKey)
+		assert.NilError(t, err)
+		amounts = append(amounts, bal.TradableAmount, bal.RetiredAmount, bal.EscrowedAmount)
+	}
+	sup, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batchKey)
+	assert.NilError(t, err)
+	return append(amounts, sup.TradableAmount, sup.RetiredAmount, sup.CancelledAmount)
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,7 +10,9 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// UpdateProjectMetadata updates the project metadata.
+// UpdateProjectMetadata updates the project metadata. If an expected metadata hash is provided, it is compared
+// case-insensitively with the hash of the current metadata. The check only guards against concurrent updates and is
+// not a version number: metadata that is changed and then changed back matches the same hash.
 func (k Keeper) UpdateProjectMetadata(ctx context.Context, req *core.MsgUpdateProjectMetadata) (*core.MsgUpdateProjectMetadataResponse, error) {
 	admin, err := sdk.AccAddressFromBech32(req.Admin)
 	if err != nil {
@@ -22,6 +25,9 @@ func (k Keeper) UpdateProjectMetadata(ctx context.Context, req *core.MsgUpdatePr
 	if !sdk.AccAddress(project.Admin).Equals(admin) {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the admin of project %s", req.Admin, req.ProjectId)
 	}
+	if req.ExpectedMetadataHash != "" && !strings.EqualFold(req.ExpectedMetadataHash, core.MetadataHash(project.Metadata)) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("metadata of project %s does not match the expected metadata hash", req.ProjectId)
+	}
 	project.Metadata = req.NewMetadata
 	if err := k.stateStore.ProjectTable().Update(ctx, project); err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,3 +57,51 @@ func TestUpdateProjectMetadata_Unauthorized(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, sdkerrors.ErrUnauthorized.Error())
 }
+
+func TestUpdateProjectMetadata_ExpectedMetadataHash(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	projectId := "VERRA1"
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:           projectId,
+		Admin:        s.addr,
+		ClassKey:     1,
+		Jurisdiction: "US-NY",
+		Metadata:     "hi",
+	}))
+
+	// update with matching hash succeeds
+	_, err := s.k.UpdateProjectMetadata(s.ctx, &core.MsgUpdateProjectMetadata{
+		Admin:                s.addr.String(),
+		NewMetadata:          "hello",
+		ProjectId:            projectId,
+		ExpectedMetadataHash: core.MetadataHash("hi"),
+	})
+	assert.NilError(t, err)
+
+	// update with stale hash fails
+	_, err = s.k.UpdateProjectMetadata(s.ctx, &core.MsgUpdateProjectMetadata{
+		Admin:                s.addr.String(),
+		NewMetadata:          "hello world",
+		ProjectId:            projectId,
+		ExpectedMetadataHash: core.MetadataHash("hi"),
+	})
+	assert.ErrorContains(t, err, "does not match the expected metadata hash")
+
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, projectId)
+	assert.NilError(t, err)
+	assert.Equal(t, "hello", project.Metadata)
+
+	// the hash is compared case-insensitively
+	_, err = s.k.UpdateProjectMetadata(s.ctx, &core.MsgUpdateProjectMetadata{
+		Admin:                s.addr.String(),
+		NewMetadata:          "hello world",
+		ProjectId:            projectId,
+		ExpectedMetadataHash: strings.ToUpper(core.MetadataHash("hello")),
+	})
+	assert.NilError(t, err)
+
+	project, err = s.stateStore.ProjectTable().GetById(s.ctx, projectId)
+	assert.NilError(t, err)
+	assert.Equal(t, "hello world", project.Metadata)
+}
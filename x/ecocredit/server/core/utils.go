@@ -4,12 +4,16 @@ import (
 	"context"
 
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // assertClassIssuer makes sure that the issuer is part of issuers of given class key.
@@ -25,6 +29,60 @@ func (k Keeper) assertClassIssuer(goCtx context.Context, classKey uint64, addr s
 	return nil
 }
 
+// assertClassMetadataSchema checks the metadata against the metadata schema of
+// the credit class using the configured MetadataValidator. No check is performed
+// if the credit class does not have a metadata schema.
+func (k Keeper) assertClassMetadataSchema(ctx sdk.Context, class *ecoApi.Class, metadata string) error {
+	if class.MetadataSchema == "" {
+		return nil
+	}
+
+	if err := k.metadataValidator.ValidateMetadata(ctx, class.MetadataSchema, metadata); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"metadata does not conform to metadata schema %s of class %s: %s", class.MetadataSchema, class.Id, err,
+		)
+	}
+
+	return nil
+}
+
+// getClassInfo converts a credit class into a ClassInfo including the addresses
+// of the class issuers.
+func (k Keeper) getClassInfo(ctx context.Context, class *ecoApi.Class) (*core.ClassInfo, error) {
+	info := &core.ClassInfo{
+		Id:               class.Id,
+		Admin:            sdk.AccAddress(class.Admin).String(),
+		Metadata:         class.Metadata,
+		CreditTypeAbbrev: class.CreditTypeAbbrev,
+		MetadataSchema:   class.MetadataSchema,
+		Issuers:          make([]string, 0),
+	}
+
+	if class.MinBatchStartDate != nil {
+		info.MinBatchStartDate = types.ProtobufToGogoTimestamp(class.MinBatchStartDate)
+	}
+
+	if class.MaxBatchEndDate != nil {
+		info.MaxBatchEndDate = types.ProtobufToGogoTimestamp(class.MaxBatchEndDate)
+	}
+
+	it, err := k.stateStore.ClassIssuerTable().List(ctx, ecoApi.ClassIssuerClassKeyIssuerIndexKey{}.WithClassKey(class.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		issuer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		info.Issuers = append(info.Issuers, sdk.AccAddress(issuer.Issuer).String())
+	}
+
+	return info, nil
+}
+
 // AddAndSaveBalance adds 'amt' to the addr's tradable balance.
 func AddAndSaveBalance(ctx context.Context, table ecoApi.BatchBalanceTable, addr sdk.AccAddress, batchKey uint64, amt math.Dec) error {
 	bal, err := utils.GetBalance(ctx, table, addr, batchKey)
@@ -102,3 +160,36 @@ func RetireSupply(ctx context.Context, table ecoApi.BatchSupplyTable, batchKey u
 
 	return table.Update(ctx, supply)
 }
+
+// getGasCostPerIteration returns the amount of gas charged for each credit
+// iterated over when sending, retiring, or cancelling credits.
+func (k Keeper) getGasCostPerIteration(ctx sdk.Context) uint64 {
+	var gasCostPerIteration uint64
+	k.paramsKeeper.Get(ctx, core.KeyGasCostPerIteration, &gasCostPerIteration)
+	return gasCostPerIteration
+}
+
+// assertMaxCreditsPerMsg makes sure that the number of credits sent, retired,
+// or cancelled in a single message doesn't exceed the max credits per message
+// param.
+func (k Keeper) assertMaxCreditsPerMsg(ctx sdk.Context, numCredits int) error {
+	var maxCreditsPerMsg uint64
+	k.paramsKeeper.Get(ctx, core.KeyMaxCreditsPerMsg, &maxCreditsPerMsg)
+	if uint64(numCredits) > maxCreditsPerMsg {
+		return sdkerrors.ErrInvalidRequest.Wrapf("number of credits %d exceeds the maximum of %d per message", numCredits, maxCreditsPerMsg)
+	}
+	return nil
+}
+
+// countEntries counts the remaining entries of the iterator without decoding
+// them and closes the iterator.
+func countEntries(it ormtable.Iterator) *query.PageResponse {
+	defer it.Close()
+
+	var total uint64
+	for it.Next() {
+		total++
+	}
+
+	return &query.PageResponse{Total: total}
+}
@@ -3,6 +3,7 @@ Feature: Msg/BuyDirect
   Credits can be bought directly:
   - when the sell order exists
   - when the buyer is not the seller
+  - when the credit batch has not expired
   - when the bid denom matches the sell denom
   - when the buyer has a bank balance greater than or equal to the total cost
   - when the buyer provides a bid price greater than or equal to the ask price
@@ -46,6 +47,27 @@ Feature: Msg/BuyDirect
       When alice attempts to buy credits with sell order id "1"
       Then expect the error "orders[0]: buyer account cannot be the same as seller account: unauthorized"
 
+  Rule: The credit batch must not have expired
+
+    Background:
+      Given a block time with timestamp "2020-01-01"
+      And a credit type
+
+    Scenario: the credit batch expires after block time
+      Given alice created a sell order with batch expiry date "2021-01-01"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect no error
+
+    Scenario Outline: the credit batch expired before or at block time
+      Given alice created a sell order with batch expiry date "<expiry-date>"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect the error "orders[0]: credits from batch C01-001-20200101-20210101-001 expired on <expiry-date> and can no longer be bought: invalid request"
+
+      Examples:
+        | description | expiry-date |
+        | before      | 2019-01-01  |
+        | equal to    | 2020-01-01  |
+
   Rule: The bid denom must match the sell denom
 
     Background:
@@ -2,6 +2,7 @@ Feature: Msg/Sell
 
   A sell order can be created:
     - when the credit batch exists
+    - when the credit batch has not expired
     - when the seller owns credits from the credit batch
     - when the seller owns greater than or equal to the quantity of credits
     - when the number of decimal places in quantity is less than or equal to the credit type precision
@@ -27,6 +28,28 @@ Feature: Msg/Sell
       When alice attempts to create a sell order with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "orders[0]: batch denom C01-001-20200101-20210101-001: not found: invalid request"
 
+  Rule: The credit batch must not have expired
+
+    Background:
+      Given a block time with timestamp "2020-01-01"
+      And a credit type
+      And an allowed denom
+
+    Scenario: credit batch expires after block time
+      Given alice has a tradable batch balance with expiry date "2021-01-01"
+      When alice attempts to create a sell order with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario Outline: credit batch expired before or at block time
+      Given alice has a tradable batch balance with expiry date "<expiry-date>"
+      When alice attempts to create a sell order with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "orders[0]: credits from batch C01-001-20200101-20210101-001 expired on <expiry-date> and can no longer be sold: invalid request"
+
+      Examples:
+        | description | expiry-date |
+        | before      | 2019-01-01  |
+        | equal to    | 2020-01-01  |
+
   Rule: The seller must own credits from the credit batch
 
     Background:
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
@@ -45,11 +46,20 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 			)
 		}
 
-		// check decimal places does not exceed credit type precision
 		batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
 		if err != nil {
 			return nil, err
 		}
+
+		// credits from an expired batch can only be retired or cancelled by the seller
+		if utils.IsBatchExpired(batch, sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: credits from batch %s expired on %s and can no longer be bought",
+				orderIndex, batch.Denom, batch.ExpiryDate.AsTime().Format("2006-01-02"),
+			)
+		}
+
+		// check decimal places does not exceed credit type precision
 		ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -105,7 +115,7 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 		if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, orderOptions{
 			autoRetire:   !order.DisableAutoRetire,
 			batchDenom:   batch.Denom,
-			jurisdiction: order.RetirementJurisdiction,
+			jurisdiction: core.NormalizeJurisdiction(order.RetirementJurisdiction),
 		}); err != nil {
 			return nil, err
 		}
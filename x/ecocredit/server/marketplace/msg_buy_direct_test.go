@@ -8,8 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/regen-network/gocuke"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
@@ -25,6 +27,7 @@ type buyDirectSuite struct {
 	creditTypeAbbrev  string
 	classId           string
 	batchDenom        string
+	batchExpiryDate   *timestamppb.Timestamp
 	sellOrderId       uint64
 	disableAutoRetire bool
 	quantity          string
@@ -65,6 +68,14 @@ func (s *buyDirectSuite) Before(t gocuke.TestingT) {
 	}
 }
 
+func (s *buyDirectSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
 func (s *buyDirectSuite) ACreditType() {
 	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
 		Abbreviation: s.creditTypeAbbrev,
@@ -163,6 +174,15 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithId(a string) {
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedASellOrderWithBatchExpiryDate(a string) {
+	expiryDate, err := types.ParseDate("expiry date", a)
+	require.NoError(s.t, err)
+
+	s.batchExpiryDate = timestamppb.New(expiryDate)
+
+	s.createSellOrders(1)
+}
+
 func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantity(a string) {
 	s.quantity = a
 
@@ -528,7 +548,8 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 	require.NoError(s.t, err)
 
 	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
-		Denom: s.batchDenom,
+		Denom:      s.batchDenom,
+		ExpiryDate: s.batchExpiryDate,
 	})
 	require.NoError(s.t, err)
 
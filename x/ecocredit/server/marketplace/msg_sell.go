@@ -40,6 +40,14 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			)
 		}
 
+		// credits from an expired batch can only be retired or cancelled
+		if utils.IsBatchExpired(batch, sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: credits from batch %s expired on %s and can no longer be sold",
+				orderIndex, batch.Denom, batch.ExpiryDate.AsTime().Format("2006-01-02"),
+			)
+		}
+
 		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -9,6 +9,7 @@ import (
 	"github.com/regen-network/gocuke"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,7 @@ type sellSuite struct {
 	creditTypeAbbrev    string
 	classId             string
 	batchDenom          string
+	batchExpiryDate     *timestamppb.Timestamp
 	askPrice            *sdk.Coin
 	quantity            string
 	expiration          *time.Time
@@ -150,6 +152,15 @@ func (s *sellSuite) AliceHasATradableBatchBalanceWithAmount(a string) {
 	s.aliceTradableBatchBalance()
 }
 
+func (s *sellSuite) AliceHasATradableBatchBalanceWithExpiryDate(a string) {
+	expiryDate, err := types.ParseDate("expiry date", a)
+	require.NoError(s.t, err)
+
+	s.batchExpiryDate = timestamppb.New(expiryDate)
+
+	s.aliceTradableBatchBalance()
+}
+
 func (s *sellSuite) AliceHasATradableBatchBalanceWithDenomAndAmount(a string, b string) {
 	s.batchDenom = a
 	s.classId = core.GetClassIdFromBatchDenom(s.batchDenom)
@@ -419,6 +430,7 @@ func (s *sellSuite) aliceTradableBatchBalance() {
 	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
 		ProjectKey: projectKey,
 		Denom:      s.batchDenom,
+		ExpiryDate: s.batchExpiryDate,
 	})
 	require.NoError(s.t, err)
 
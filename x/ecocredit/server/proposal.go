@@ -20,7 +20,7 @@ func (s serverImpl) AllowDenom(ctx sdk.Context, proposal *marketplace.AllowDenom
 }
 
 func (s serverImpl) AddCreditType(ctx sdk.Context, ctp *core.CreditTypeProposal) error {
-	return s.coreKeeper.AddCreditType(ctx, ctp)
+	return s.coreKeeper.AddCreditTypeProposal(ctx, ctp)
 }
 
 func NewProposalHandler(k ProposalKeeper) govtypes.Handler {
@@ -37,7 +37,7 @@ func setup(t *testing.T) baseSuite {
 	bankKeeper := mocks.NewMockBankKeeper(ctrl)
 	accountKeeper.EXPECT().GetModuleAddress(ecocredit.ModuleName).Return(sdk.AccAddress{}).Times(1)
 	accountKeeper.EXPECT().GetModuleAddress(basket.BasketSubModuleName).Return(sdk.AccAddress{}).Times(1)
-	s.server = newServer(storeKey, paramtypes.Subspace{}, accountKeeper, bankKeeper)
+	s.server = newServer(storeKey, paramtypes.Subspace{}, accountKeeper, bankKeeper, nil, nil, nil)
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
 	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
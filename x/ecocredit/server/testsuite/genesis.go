@@ -21,8 +21,9 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	require := s.Require()
 	ctx := s.genesisCtx
 
-	// Set the param set to empty values to properly test init
-	var ecocreditParams core.Params
+	// Set the param set to empty values to properly test init (gas cost per
+	// iteration must be non-zero to be a valid param)
+	ecocreditParams := core.Params{GasCostPerIteration: 1, MaxCreditsPerMsg: core.DefaultMaxCreditsPerMsg}
 	s.paramSpace.SetParamSet(ctx.Context, &ecocreditParams)
 
 	defaultParams := core.DefaultParams()
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -22,11 +23,20 @@ func GetCreditTypeFromBatchDenom(ctx context.Context, store api.StateStore, deno
 	return store.CreditTypeTable().Get(ctx, classInfo.CreditTypeAbbrev)
 }
 
+// IsBatchExpired returns true if the batch has an expiry date at or before the given block time.
+func IsBatchExpired(batch *api.Batch, blockTime time.Time) bool {
+	return batch.ExpiryDate != nil && !blockTime.Before(batch.ExpiryDate.AsTime())
+}
+
 // GetNonNegativeFixedDecs takes an arbitrary amount of decimal strings, and returns their corresponding fixed decimals
 // in a slice.
 func GetNonNegativeFixedDecs(precision uint32, decimals ...string) ([]math.Dec, error) {
 	decs := make([]math.Dec, len(decimals))
 	for i, decimal := range decimals {
+		if dec, ok := parseCanonicalFixedDec(decimal, precision); ok {
+			decs[i] = dec
+			continue
+		}
 		dec, err := math.NewNonNegativeFixedDecFromString(decimal, precision)
 		if err != nil {
 			return nil, err
@@ -36,6 +46,45 @@ func GetNonNegativeFixedDecs(precision uint32, decimals ...string) ([]math.Dec,
 	return decs, nil
 }
 
+// maxCanonicalDigits is the maximum number of digits of a canonical decimal string, so that its coefficient always
+// fits in an int64.
+const maxCanonicalDigits = 18
+
+// parseCanonicalFixedDec is a fast path of math.NewNonNegativeFixedDecFromString for the amounts stored by the module,
+// which are plain digits with an optional fractional part of at most precision digits (e.g. "10.5"). It returns false
+// for any other string, which must then be parsed with math.NewNonNegativeFixedDecFromString.
+func parseCanonicalFixedDec(s string, precision uint32) (math.Dec, bool) {
+	var coeff int64
+	digits, fracDigits, hasPoint := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.':
+			if hasPoint || i == 0 || i == len(s)-1 {
+				return math.Dec{}, false
+			}
+			hasPoint = true
+		case c >= '0' && c <= '9':
+			digits++
+			if digits > maxCanonicalDigits {
+				return math.Dec{}, false
+			}
+			if hasPoint {
+				fracDigits++
+			}
+			coeff = coeff*10 + int64(c-'0')
+		default:
+			return math.Dec{}, false
+		}
+	}
+
+	if digits == 0 || uint32(fracDigits) > precision {
+		return math.Dec{}, false
+	}
+
+	return math.NewDecFinite(coeff, -int32(fracDigits)), true
+}
+
 // GetBalance gets the balance from the account, returning a default, zero value balance if no balance is found.
 // NOTE: the default value is not inserted into the balance table in the `not found` case. Calling Update when the default
 // value is returned will cause an error. The `Save` method should be used when dealing with balances from this function.
@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -91,6 +92,60 @@ func TestUtils_GetNonNegativeFixedDecs(t *testing.T) {
 	assert.ErrorContains(t, err, "10.432 exceeds maximum decimal places: 2")
 }
 
+func TestUtils_ParseCanonicalFixedDec(t *testing.T) {
+	t.Parallel()
+	precision := uint32(6)
+
+	// canonical strings are parsed the same as with the generic decimal parser
+	for _, ds := range []string{"0", "0.0", "007", "10", "10.5", "10.50", "0.000001", "999999999999.999999", "123456789012345678"} {
+		dec, ok := parseCanonicalFixedDec(ds, precision)
+		assert.Check(t, ok, ds)
+		expected, err := math.NewNonNegativeFixedDecFromString(ds, precision)
+		assert.NilError(t, err)
+		assert.Check(t, dec.Equal(expected), ds)
+		assert.Equal(t, expected.String(), dec.String())
+		assert.Equal(t, expected.NumDecimalPlaces(), dec.NumDecimalPlaces())
+	}
+
+	// any other string falls back to the generic decimal parser
+	for _, ds := range []string{"", ".5", "5.", "1.2.3", "-1", "+1", "1e5", "NaN", "Infinity", "1_000", "0.0000001", "1234567890123456789"} {
+		_, ok := parseCanonicalFixedDec(ds, precision)
+		assert.Check(t, !ok, ds)
+	}
+
+	// the fallback keeps the behavior of the generic decimal parser
+	decs, err := GetNonNegativeFixedDecs(precision, "", "1e2", "1234567890123456789")
+	assert.NilError(t, err)
+	assert.Equal(t, "0", decs[0].String())
+	assert.Check(t, decs[1].Equal(math.NewDecFromInt64(100)))
+	assert.Equal(t, "1234567890123456789", decs[2].String())
+
+	_, err = GetNonNegativeFixedDecs(precision, "-1")
+	assert.ErrorContains(t, err, "expected a non-negative decimal")
+}
+
+func BenchmarkGetNonNegativeFixedDecs(b *testing.B) {
+	decimals := []string{"10.5", "2.51", "1.30", "1000000", "0.000001", "123456.789"}
+
+	b.Run("fast path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GetNonNegativeFixedDecs(6, decimals...); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("generic parser", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, decimal := range decimals {
+				if _, err := math.NewNonNegativeFixedDecFromString(decimal, 6); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
 func TestUtils_GetCreditTypeFromBatchDenom(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
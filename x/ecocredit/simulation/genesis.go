@@ -142,6 +142,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		AllowedClassCreators: allowedClassCreators,
 		AllowlistEnabled:     allowListEnabled,
 		BasketFee:            basketCreationFee,
+		GasCostPerIteration:  core.DefaultGasCostPerIteration,
+		MaxCreditsPerMsg:     core.DefaultMaxCreditsPerMsg,
 	}
 
 	db := dbm.NewMemDB()
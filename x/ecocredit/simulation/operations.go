@@ -440,7 +440,7 @@ func SimulateMsgCreateProject(ak ecocredit.AccountKeeper, bk ecocredit.BankKeepe
 			Admin:        admin,
 			ClassId:      class.Id,
 			Metadata:     simtypes.RandStringOfLength(r, 100),
-			Jurisdiction: "AB-CDE FG1 345",
+			Jurisdiction: "AU-CDE FG1 345",
 		}
 		txCtx := simulation.OperationInput{
 			R:               r,
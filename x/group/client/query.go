@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const FlagStatus = "status"
+
 // QueryCmd returns the cli query commands for the group module.
 func QueryCmd(name string) *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -26,11 +28,18 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByAdminCmd(),
 		QueryGroupAccountsByGroupCmd(),
 		QueryGroupAccountsByAdminCmd(),
+		QueryGroupAccountAddressCmd(),
 		QueryProposalCmd(),
+		QueryProposalFullCmd(),
+		QueryProposalProposersCmd(),
 		QueryProposalsByGroupAccountCmd(),
 		QueryVoteByProposalVoterCmd(),
 		QueryVotesByProposalCmd(),
 		QueryVotesByVoterCmd(),
+		QueryGroupParticipationCmd(),
+		QueryGroupExportCmd(),
+		QueryRequiredYesWeightCmd(),
+		QueryTallyResultCmd(),
 	)
 
 	return queryCmd
@@ -255,6 +264,41 @@ func QueryGroupAccountsByAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryGroupAccountAddressCmd creates a CLI command for Query/GroupAccountAddress.
+func QueryGroupAccountAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-account-address [group-id]",
+		Short: "Query for the address of the next group account created for a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupAccountAddress(cmd.Context(), &group.QueryGroupAccountAddressRequest{
+				GroupId: groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryProposalCmd creates a CLI command for Query/Proposal.
 func QueryProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -290,12 +334,92 @@ func QueryProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryProposalFullCmd creates a CLI command for Query/ProposalFull.
+func QueryProposalFullCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-full [id]",
+		Short: "Query for a proposal with its decision policy, tally and votes by id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalFull(cmd.Context(), &group.QueryProposalFullRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryProposalProposersCmd creates a CLI command for Query/ProposalProposers.
+func QueryProposalProposersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-proposers [id]",
+		Short: "Query for the proposers of a proposal by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalProposers(cmd.Context(), &group.QueryProposalProposersRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryProposalsByGroupAccountCmd creates a CLI command for Query/ProposalsByGroupAccount.
 func QueryProposalsByGroupAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "proposals-by-group-account [group-account]",
 		Short: "Query for proposals by group account address with pagination flags",
-		Args:  cobra.ExactArgs(1),
+		Long: `Query for proposals by group account address with pagination flags.
+
+Flags:
+  status: optional proposal status to filter by (e.g. STATUS_SUBMITTED, STATUS_CLOSED)`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -307,11 +431,24 @@ func QueryProposalsByGroupAccountCmd() *cobra.Command {
 				return err
 			}
 
+			var status group.Proposal_Status
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			if statusStr != "" {
+				status, err = group.ProposalStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+			}
+
 			queryClient := group.NewQueryClient(clientCtx)
 
 			res, err := queryClient.ProposalsByGroupAccount(cmd.Context(), &group.QueryProposalsByGroupAccountRequest{
 				Address:    args[0],
 				Pagination: pageReq,
+				Status:     status,
 			})
 			if err != nil {
 				return err
@@ -321,6 +458,7 @@ func QueryProposalsByGroupAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "filter proposals by status (e.g. STATUS_SUBMITTED, STATUS_CLOSED)")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -438,3 +576,151 @@ func QueryVotesByVoterCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryGroupParticipationCmd creates a CLI command for Query/GroupParticipation.
+func QueryGroupParticipationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-participation [group-id] [window]",
+		Short: "Query for the average voting participation of a group over its most recent proposals",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupParticipation(cmd.Context(), &group.QueryGroupParticipationRequest{
+				GroupId: groupID,
+				Window:  window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryGroupExportCmd creates a CLI command for Query/GroupExport.
+func QueryGroupExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-group [group-id]",
+		Short: "Export the full state of a group in the genesis JSON format",
+		Long: `Export the group, its members, its group accounts, their proposals and the votes on those proposals
+in the genesis JSON format.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupExport(cmd.Context(), &group.QueryGroupExportRequest{
+				GroupId: groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Genesis)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryRequiredYesWeightCmd creates a CLI command for Query/RequiredYesWeight.
+func QueryRequiredYesWeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-yes-weight [proposal-id]",
+		Short: "Query for the remaining yes weight needed for a proposal to pass",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RequiredYesWeight(cmd.Context(), &group.QueryRequiredYesWeightRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryTallyResultCmd creates a CLI command for Query/TallyResult.
+func QueryTallyResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-result [proposal-id]",
+		Short: "Query for the tally of a proposal, computed from its votes if it is still open",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TallyResult(cmd.Context(), &group.QueryTallyResultRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -39,9 +39,11 @@ func TxCmd(name string) *cobra.Command {
 		MsgUpdateGroupMetadataCmd(),
 		MsgUpdateGroupMembersCmd(),
 		MsgCreateGroupAccountCmd(),
+		MsgCreateGroupWithPolicyCmd(),
 		MsgUpdateGroupAccountAdminCmd(),
 		MsgUpdateGroupAccountDecisionPolicyCmd(),
 		MsgUpdateGroupAccountMetadataCmd(),
+		MsgUpdateGroupAccountDecisionPolicyMetadataCmd(),
 		MsgCreateProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
@@ -352,6 +354,97 @@ $ %s tx group create-group-account [admin] [group-id] [metadata] \
 	return cmd
 }
 
+// MsgCreateGroupWithPolicyCmd creates a CLI command for Msg/CreateGroupWithPolicy.
+func MsgCreateGroupWithPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-group-with-policy [admin] [group-metadata] [group-account-metadata] [members-json-file] [decision-policy]",
+		Short: "Create a group and a group account with a decision policy " +
+			"in a single transaction. Note, the '--from' flag is " +
+			"ignored as it is implied from [admin].",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group and a group account with a decision policy in a single transaction.
+Note, the '--from' flag is ignored as it is implied from [admin].
+Members accounts can be given through a members JSON file that contains an array of members.
+
+Example:
+$ %s tx group create-group-with-policy [admin] [group-metadata] [group-account-metadata] [members-json-file] \
+'{"@type":"/regen.group.v1alpha1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"1s"}'
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "addr1",
+			"weight": "1",
+			"metadata": "some metadata"
+		},
+		{
+			"address": "addr2",
+			"weight": "1",
+			"metadata": "some metadata"
+		}
+	]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupMetadata, err := base64.StdEncoding.DecodeString(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "group metadata is malformed, proper base64 string is required")
+			}
+
+			groupAccountMetadata, err := base64.StdEncoding.DecodeString(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "group account metadata is malformed, proper base64 string is required")
+			}
+
+			members, err := parseMembers(clientCtx, args[3])
+			if err != nil {
+				return err
+			}
+
+			var policy group.DecisionPolicy
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[4]), &policy); err != nil {
+				return err
+			}
+
+			msg, err := group.NewMsgCreateGroupWithPolicy(
+				clientCtx.GetFromAddress(),
+				members,
+				groupMetadata,
+				groupAccountMetadata,
+				policy,
+			)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgUpdateGroupAccountAdminCmd creates a CLI command for Msg/UpdateGroupAccountAdmin.
 func MsgUpdateGroupAccountAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -476,6 +569,46 @@ func MsgUpdateGroupAccountMetadataCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgUpdateGroupAccountDecisionPolicyMetadataCmd creates a CLI command for Msg/UpdateGroupAccountDecisionPolicyMetadata.
+func MsgUpdateGroupAccountDecisionPolicyMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-policy-metadata [admin] [group-account] [new-metadata]",
+		Short: "Update a group account decision policy metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			b, err := base64.StdEncoding.DecodeString(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "metadata is malformed, proper base64 string is required")
+			}
+
+			msg := &group.MsgUpdateGroupAccountDecisionPolicyMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Address:  args[1],
+				Metadata: b,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgCreateProposalCmd creates a CLI command for Msg/CreateProposal.
 func MsgCreateProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,9 +18,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateGroupMetadata{}, "cosmos-sdk/MsgUpdateGroupMetadata", nil)
 	cdc.RegisterConcrete(&MsgCreateGroupAccount{}, "cosmos-sdk/MsgCreateGroupAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateGroupWithPolicy{}, "cosmos-sdk/MsgCreateGroupWithPolicy", nil)
 	cdc.RegisterConcrete(&MsgUpdateGroupAccountAdmin{}, "cosmos-sdk/MsgUpdateGroupAccountAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateGroupAccountDecisionPolicy{}, "cosmos-sdk/MsgUpdateGroupAccountDecisionPolicy", nil)
 	cdc.RegisterConcrete(&MsgUpdateGroupAccountMetadata{}, "cosmos-sdk/MsgUpdateGroupAccountMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupAccountDecisionPolicyMetadata{}, "cosmos-sdk/MsgUpdateGroupAccountDecisionPolicyMetadata", nil)
 	cdc.RegisterConcrete(&MsgCreateProposal{}, "cosmos-sdk/group/MsgCreateProposal", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
 	cdc.RegisterConcrete(&MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
@@ -33,9 +35,11 @@ func RegisterTypes(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateGroupAdmin{},
 		&MsgUpdateGroupMetadata{},
 		&MsgCreateGroupAccount{},
+		&MsgCreateGroupWithPolicy{},
 		&MsgUpdateGroupAccountAdmin{},
 		&MsgUpdateGroupAccountDecisionPolicy{},
 		&MsgUpdateGroupAccountMetadata{},
+		&MsgUpdateGroupAccountDecisionPolicyMetadata{},
 		&MsgCreateProposal{},
 		&MsgVote{},
 		&MsgExec{},
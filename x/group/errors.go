@@ -5,12 +5,13 @@ import (
 )
 
 var (
-	ErrEmpty        = sdkerrors.Register(ModuleName, 202, "value is empty")
-	ErrDuplicate    = sdkerrors.Register(ModuleName, 203, "duplicate value")
-	ErrMaxLimit     = sdkerrors.Register(ModuleName, 204, "limit exceeded")
-	ErrType         = sdkerrors.Register(ModuleName, 205, "invalid type")
-	ErrInvalid      = sdkerrors.Register(ModuleName, 206, "invalid value")
-	ErrUnauthorized = sdkerrors.Register(ModuleName, 207, "unauthorized")
-	ErrModified     = sdkerrors.Register(ModuleName, 208, "modified")
-	ErrExpired      = sdkerrors.Register(ModuleName, 209, "expired")
+	ErrEmpty          = sdkerrors.Register(ModuleName, 202, "value is empty")
+	ErrDuplicate      = sdkerrors.Register(ModuleName, 203, "duplicate value")
+	ErrMaxLimit       = sdkerrors.Register(ModuleName, 204, "limit exceeded")
+	ErrType           = sdkerrors.Register(ModuleName, 205, "invalid type")
+	ErrInvalid        = sdkerrors.Register(ModuleName, 206, "invalid value")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 207, "unauthorized")
+	ErrModified       = sdkerrors.Register(ModuleName, 208, "modified")
+	ErrExpired        = sdkerrors.Register(ModuleName, 209, "expired")
+	ErrPredicateUnmet = sdkerrors.Register(ModuleName, 210, "execution predicate not met")
 )
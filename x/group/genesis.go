@@ -1,13 +1,131 @@
 package group
 
-import "github.com/cosmos/cosmos-sdk/codec/types"
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
 
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{}
 }
 
+// Validate performs basic validation of every entry and checks that:
+// - groups, group accounts, proposals, group members, votes and vote snapshots are unique
+// - every group member, group account and vote snapshot references an existing group or proposal
+// - the member weights of each group sum up to the group total weight
+// - every proposal references an existing group account
+// - every vote references an existing proposal
 func (s GenesisState) Validate() error {
+	groupWeights := make(map[uint64]math.Dec, len(s.Groups))
+	for _, g := range s.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group %d", g.GroupId)
+		}
+		if _, exists := groupWeights[g.GroupId]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "group %d", g.GroupId)
+		}
+		groupWeights[g.GroupId] = math.NewDecFromInt64(0)
+	}
+
+	members := make(map[uint64]map[string]bool, len(s.Groups))
+	for _, m := range s.GroupMembers {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group %d member", m.GroupId)
+		}
+		totalWeight, exists := groupWeights[m.GroupId]
+		if !exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "group %d of member %s", m.GroupId, m.Member.Address)
+		}
+		if members[m.GroupId] == nil {
+			members[m.GroupId] = make(map[string]bool)
+		}
+		if members[m.GroupId][m.Member.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "group %d member %s", m.GroupId, m.Member.Address)
+		}
+		members[m.GroupId][m.Member.Address] = true
+
+		weight, err := math.NewNonNegativeDecFromString(m.Member.Weight)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "group %d member %s weight", m.GroupId, m.Member.Address)
+		}
+		if groupWeights[m.GroupId], err = totalWeight.Add(weight); err != nil {
+			return sdkerrors.Wrapf(err, "group %d total weight", m.GroupId)
+		}
+	}
+
+	for _, g := range s.Groups {
+		totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "group %d total weight", g.GroupId)
+		}
+		if totalWeight.Cmp(groupWeights[g.GroupId]) != 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "group %d: total weight %s doesn't match member weights sum %s",
+				g.GroupId, g.TotalWeight, groupWeights[g.GroupId])
+		}
+	}
+
+	groupAccounts := make(map[string]bool, len(s.GroupAccounts))
+	for _, g := range s.GroupAccounts {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group account %s", g.Address)
+		}
+		if groupAccounts[g.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "group account %s", g.Address)
+		}
+		if _, exists := groupWeights[g.GroupId]; !exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "group %d of group account %s", g.GroupId, g.Address)
+		}
+		groupAccounts[g.Address] = true
+	}
+
+	proposals := make(map[uint64]bool, len(s.Proposals))
+	for _, p := range s.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", p.ProposalId)
+		}
+		if proposals[p.ProposalId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal %d", p.ProposalId)
+		}
+		if !groupAccounts[p.Address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "group account %s of proposal %d", p.Address, p.ProposalId)
+		}
+		proposals[p.ProposalId] = true
+	}
+
+	votes := make(map[uint64]map[string]bool, len(s.Proposals))
+	for _, v := range s.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d vote", v.ProposalId)
+		}
+		if !proposals[v.ProposalId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "proposal %d of vote by %s", v.ProposalId, v.Voter)
+		}
+		if votes[v.ProposalId] == nil {
+			votes[v.ProposalId] = make(map[string]bool)
+		}
+		if votes[v.ProposalId][v.Voter] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal %d vote by %s", v.ProposalId, v.Voter)
+		}
+		votes[v.ProposalId][v.Voter] = true
+	}
+
+	snapshots := make(map[uint64]bool, len(s.VoteSnapshots))
+	for _, v := range s.VoteSnapshots {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d vote snapshot", v.ProposalId)
+		}
+		if snapshots[v.ProposalId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal %d vote snapshot", v.ProposalId)
+		}
+		if !proposals[v.ProposalId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "proposal %d of vote snapshot", v.ProposalId)
+		}
+		snapshots[v.ProposalId] = true
+	}
+
 	return nil
 }
 
@@ -43,6 +43,9 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// vote_snapshots is the list of group memberships recorded when proposals
+	// were submitted.
+	VoteSnapshots []*VoteSnapshot `protobuf:"bytes,9,rep,name=vote_snapshots,json=voteSnapshots,proto3" json:"vote_snapshots,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +137,13 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetVoteSnapshots() []*VoteSnapshot {
+	if m != nil {
+		return m.VoteSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "regen.group.v1alpha1.GenesisState")
 }
@@ -143,29 +153,31 @@ func init() {
 }
 
 var fileDescriptor_6ccc5d002e96a4ab = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0x4e, 0x32, 0x41,
-	0x14, 0x86, 0xd9, 0x8f, 0x9f, 0x0f, 0x06, 0xd0, 0x38, 0xb1, 0xd8, 0x60, 0x32, 0x02, 0x85, 0x21,
-	0x26, 0xec, 0x8a, 0x16, 0x36, 0x36, 0x5a, 0x48, 0x2c, 0x48, 0xcc, 0x92, 0x58, 0xd8, 0x98, 0x05,
-	0x8f, 0x03, 0x11, 0x76, 0x86, 0x99, 0x01, 0xf5, 0x2e, 0xbc, 0x1e, 0xaf, 0xc0, 0x92, 0xd2, 0xd2,
-	0xc0, 0x8d, 0x18, 0xce, 0x2c, 0x41, 0x93, 0x0d, 0xdd, 0x9e, 0xb3, 0xcf, 0xfb, 0x3e, 0xa7, 0x18,
-	0x52, 0x57, 0xc0, 0x21, 0xf2, 0xb9, 0x12, 0x53, 0xe9, 0xcf, 0x5a, 0xe1, 0x48, 0x0e, 0xc2, 0x96,
-	0xcf, 0x21, 0x02, 0x3d, 0xd4, 0x9e, 0x54, 0xc2, 0x08, 0xba, 0x8f, 0x8c, 0x87, 0x8c, 0xb7, 0x66,
-	0x2a, 0xd5, 0xc4, 0xa4, 0x79, 0x93, 0x10, 0xe7, 0xea, 0x1f, 0x69, 0x52, 0x6a, 0xdb, 0xa6, 0xae,
-	0x09, 0x0d, 0xd0, 0x03, 0x52, 0x40, 0xfc, 0x41, 0xc3, 0xc4, 0x75, 0xaa, 0x4e, 0x23, 0x13, 0xe4,
-	0x71, 0xd1, 0x85, 0x09, 0x3d, 0x27, 0x39, 0xfc, 0xd6, 0xee, 0xbf, 0x6a, 0xba, 0x51, 0x3c, 0x3d,
-	0xf4, 0x92, 0xb4, 0x5e, 0x7b, 0x35, 0xde, 0x44, 0x4f, 0x22, 0x88, 0x71, 0x7a, 0x4d, 0xca, 0xb6,
-	0x75, 0x0c, 0xe3, 0x1e, 0x28, 0xed, 0xa6, 0x31, 0x5f, 0xdb, 0x92, 0xef, 0x20, 0x19, 0x94, 0xf8,
-	0x66, 0xd0, 0xf4, 0x98, 0xec, 0xd9, 0x9e, 0xb0, 0xdf, 0x17, 0xd3, 0xc8, 0xe0, 0x95, 0x19, 0xbc,
-	0x72, 0x17, 0x7f, 0x5c, 0xda, 0xfd, 0xea, 0xd8, 0x0e, 0xd9, 0xf9, 0xc3, 0x6a, 0x37, 0x8b, 0xd2,
-	0xa3, 0x2d, 0xd2, 0x38, 0x8e, 0xb7, 0x97, 0x7f, 0x17, 0x6a, 0x5a, 0x23, 0x25, 0xa9, 0x84, 0x14,
-	0x3a, 0x1c, 0xa1, 0x35, 0x87, 0xd6, 0xe2, 0x7a, 0xb7, 0x32, 0x5e, 0x90, 0xc2, 0x7a, 0xd4, 0xee,
-	0x7f, 0x94, 0xb1, 0x64, 0xd9, 0x6d, 0x8c, 0x05, 0x9b, 0x00, 0x3d, 0x21, 0xd9, 0x99, 0x30, 0xa0,
-	0xdd, 0x3c, 0x26, 0x2b, 0xc9, 0xc9, 0x3b, 0x61, 0x20, 0xb0, 0xe0, 0x55, 0xfb, 0x73, 0xc1, 0x9c,
-	0xf9, 0x82, 0x39, 0xdf, 0x0b, 0xe6, 0xbc, 0x2f, 0x59, 0x6a, 0xbe, 0x64, 0xa9, 0xaf, 0x25, 0x4b,
-	0xdd, 0x37, 0xf9, 0xd0, 0x0c, 0xa6, 0x3d, 0xaf, 0x2f, 0xc6, 0x3e, 0xd6, 0x34, 0x23, 0x30, 0x2f,
-	0x42, 0x3d, 0xc7, 0xd3, 0x08, 0x1e, 0x39, 0x28, 0xff, 0xd5, 0x3e, 0x8d, 0x5e, 0x0e, 0x1f, 0xc3,
-	0xd9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x89, 0x43, 0xa8, 0x6a, 0x02, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3d, 0x4f, 0xc2, 0x50,
+	0x14, 0x86, 0xa9, 0x7c, 0x08, 0x97, 0x0f, 0xe3, 0x8d, 0x43, 0x83, 0x49, 0x05, 0x06, 0x43, 0x4c,
+	0x68, 0x45, 0x07, 0x17, 0x17, 0x1d, 0x24, 0x0c, 0x24, 0xa6, 0x24, 0x0e, 0x2e, 0xa4, 0xe0, 0xb1,
+	0x10, 0xa1, 0xb7, 0xdc, 0x73, 0x41, 0xfd, 0x01, 0xee, 0xfe, 0x2c, 0x47, 0x46, 0x47, 0x03, 0x7f,
+	0xc4, 0xf4, 0xb4, 0x04, 0x4c, 0x2a, 0x5b, 0xcf, 0xe9, 0xf3, 0xbc, 0xef, 0x19, 0x2e, 0xab, 0x49,
+	0x70, 0xc1, 0xb3, 0x5c, 0x29, 0x66, 0xbe, 0x35, 0x6f, 0x3a, 0x63, 0x7f, 0xe8, 0x34, 0x2d, 0x17,
+	0x3c, 0xc0, 0x11, 0x9a, 0xbe, 0x14, 0x4a, 0xf0, 0x23, 0x62, 0x4c, 0x62, 0xcc, 0x35, 0x53, 0xae,
+	0xc4, 0x9a, 0xea, 0xdd, 0x87, 0xc8, 0xab, 0x7d, 0xa4, 0x58, 0xa1, 0x15, 0x26, 0x75, 0x95, 0xa3,
+	0x80, 0x1f, 0xb3, 0x1c, 0xe1, 0x3d, 0x84, 0xa9, 0xae, 0x55, 0xb4, 0x7a, 0xca, 0xce, 0xd2, 0xa2,
+	0x0b, 0x53, 0x7e, 0xc5, 0x32, 0xf4, 0x8d, 0xfa, 0x5e, 0x25, 0x59, 0xcf, 0x5f, 0x9c, 0x98, 0x71,
+	0xb5, 0x66, 0x2b, 0x18, 0xdb, 0xde, 0xb3, 0xb0, 0x23, 0x9c, 0xdf, 0xb1, 0x62, 0x98, 0x3a, 0x81,
+	0x49, 0x1f, 0x24, 0xea, 0x49, 0xf2, 0xab, 0x3b, 0xfc, 0x0e, 0x91, 0x76, 0xc1, 0xdd, 0x0c, 0xc8,
+	0xcf, 0xd8, 0x61, 0x98, 0xe3, 0x0c, 0x06, 0x62, 0xe6, 0x29, 0xba, 0x32, 0x45, 0x57, 0x1e, 0xd0,
+	0x8f, 0x9b, 0x70, 0x1f, 0x1c, 0xdb, 0x61, 0xa5, 0x3f, 0x2c, 0xea, 0x69, 0x2a, 0x3d, 0xdd, 0x51,
+	0x1a, 0xe9, 0x74, 0x7b, 0x71, 0x3b, 0x10, 0x79, 0x95, 0x15, 0x7c, 0x29, 0x7c, 0x81, 0xce, 0x98,
+	0x5a, 0x33, 0xd4, 0x9a, 0x5f, 0xef, 0x82, 0xc6, 0x6b, 0x96, 0x5b, 0x8f, 0xa8, 0xef, 0x53, 0x99,
+	0x11, 0x5f, 0x76, 0x1f, 0x61, 0xf6, 0x46, 0xe0, 0xe7, 0x2c, 0x3d, 0x17, 0x0a, 0x50, 0xcf, 0x92,
+	0x59, 0x8e, 0x37, 0x1f, 0x84, 0x02, 0x3b, 0x04, 0x79, 0x9b, 0x95, 0x82, 0x8f, 0x1e, 0x7a, 0x8e,
+	0x8f, 0x43, 0xa1, 0x50, 0xcf, 0x91, 0x5a, 0xfb, 0x5f, 0xed, 0x46, 0xa8, 0x5d, 0x9c, 0x6f, 0x4d,
+	0x78, 0xdb, 0xfa, 0x5a, 0x1a, 0xda, 0x62, 0x69, 0x68, 0x3f, 0x4b, 0x43, 0xfb, 0x5c, 0x19, 0x89,
+	0xc5, 0xca, 0x48, 0x7c, 0xaf, 0x8c, 0xc4, 0x63, 0xc3, 0x1d, 0xa9, 0xe1, 0xac, 0x6f, 0x0e, 0xc4,
+	0xc4, 0xa2, 0xd8, 0x86, 0x07, 0xea, 0x55, 0xc8, 0x97, 0x68, 0x1a, 0xc3, 0x93, 0x0b, 0xd2, 0x7a,
+	0x0b, 0x5f, 0x59, 0x3f, 0x43, 0xef, 0xea, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x76, 0xb4, 0xce,
+	0xb3, 0xb5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -188,6 +200,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteSnapshots) > 0 {
+		for iNdEx := len(m.VoteSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -332,6 +358,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VoteSnapshots) > 0 {
+		for _, e := range m.VoteSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -597,6 +629,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteSnapshots = append(m.VoteSnapshots, &VoteSnapshot{})
+			if err := m.VoteSnapshots[len(m.VoteSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,164 @@
+package group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	proto "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member1 := testdata.KeyTestPubAddr()
+	_, _, member2 := testdata.KeyTestPubAddr()
+	_, _, accountAddr := testdata.KeyTestPubAddr()
+
+	now := time.Now()
+	submittedAt, err := proto.TimestampProto(now)
+	require.NoError(t, err)
+	timeout, err := proto.TimestampProto(now.Add(time.Second))
+	require.NoError(t, err)
+
+	validGenesis := func() GenesisState {
+		account := &GroupAccountInfo{
+			Address:       accountAddr.String(),
+			GroupId:       1,
+			Admin:         admin.String(),
+			Version:       1,
+			DerivationKey: []byte("derivation key"),
+		}
+		require.NoError(t, account.SetDecisionPolicy(&ThresholdDecisionPolicy{
+			Threshold: "1",
+			Timeout:   proto.Duration{Seconds: 1},
+		}))
+		return GenesisState{
+			GroupSeq: 1,
+			Groups: []*GroupInfo{
+				{GroupId: 1, Admin: admin.String(), Version: 1, TotalWeight: "3"},
+			},
+			GroupMembers: []*GroupMember{
+				{GroupId: 1, Member: &Member{Address: member1.String(), Weight: "1"}},
+				{GroupId: 1, Member: &Member{Address: member2.String(), Weight: "2.0"}},
+			},
+			GroupAccountSeq: 1,
+			GroupAccounts:   []*GroupAccountInfo{account},
+			ProposalSeq:     1,
+			Proposals: []*Proposal{{
+				ProposalId:          1,
+				Address:             accountAddr.String(),
+				Proposers:           []string{member1.String()},
+				SubmittedAt:         *submittedAt,
+				GroupVersion:        1,
+				GroupAccountVersion: 1,
+				Status:              ProposalStatusSubmitted,
+				Result:              ProposalResultUnfinalized,
+				VoteState:           Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+				Timeout:             *timeout,
+				ExecutorResult:      ProposalExecutorResultNotRun,
+			}},
+			Votes: []*Vote{
+				{ProposalId: 1, Voter: member1.String(), Choice: Choice_CHOICE_YES, SubmittedAt: *submittedAt},
+			},
+			VoteSnapshots: []*VoteSnapshot{{
+				ProposalId:  1,
+				TotalWeight: "3",
+				Members: []Member{
+					{Address: member1.String(), Weight: "1"},
+					{Address: member2.String(), Weight: "2"},
+				},
+			}},
+		}
+	}
+
+	specs := map[string]struct {
+		malleate func(g *GenesisState)
+		expErr   string
+	}{
+		"valid genesis": {
+			malleate: func(g *GenesisState) {},
+		},
+		"empty genesis": {
+			malleate: func(g *GenesisState) { *g = *NewGenesisState() },
+		},
+		"invalid group": {
+			malleate: func(g *GenesisState) { g.Groups[0].Version = 0 },
+			expErr:   "group 1: version: value is empty",
+		},
+		"duplicate group": {
+			malleate: func(g *GenesisState) { g.Groups = append(g.Groups, g.Groups[0]) },
+			expErr:   "group 1: duplicate value",
+		},
+		"member without member": {
+			malleate: func(g *GenesisState) { g.GroupMembers[0].Member = nil },
+			expErr:   "group 1 member: member: value is empty",
+		},
+		"member of unknown group": {
+			malleate: func(g *GenesisState) { g.GroupMembers[1].GroupId = 2 },
+			expErr:   "group 2 of member " + member2.String() + ": not found",
+		},
+		"duplicate member": {
+			malleate: func(g *GenesisState) { g.GroupMembers[1].Member.Address = member1.String() },
+			expErr:   "group 1 member " + member1.String() + ": duplicate value",
+		},
+		"invalid member weight": {
+			malleate: func(g *GenesisState) { g.GroupMembers[1].Member.Weight = "-1" },
+			expErr:   "group 1 member: member: weight",
+		},
+		"total weight doesn't match member weights": {
+			malleate: func(g *GenesisState) { g.Groups[0].TotalWeight = "4" },
+			expErr:   "group 1: total weight 4 doesn't match member weights sum 3",
+		},
+		"group without members with non zero total weight": {
+			malleate: func(g *GenesisState) { g.GroupMembers = nil },
+			expErr:   "group 1: total weight 3 doesn't match member weights sum 0",
+		},
+		"group account of unknown group": {
+			malleate: func(g *GenesisState) { g.GroupAccounts[0].GroupId = 2 },
+			expErr:   "group 2 of group account " + accountAddr.String() + ": not found",
+		},
+		"duplicate group account": {
+			malleate: func(g *GenesisState) { g.GroupAccounts = append(g.GroupAccounts, g.GroupAccounts[0]) },
+			expErr:   "group account " + accountAddr.String() + ": duplicate value",
+		},
+		"proposal of unknown group account": {
+			malleate: func(g *GenesisState) { g.Proposals[0].Address = member2.String() },
+			expErr:   "group account " + member2.String() + " of proposal 1: not found",
+		},
+		"duplicate proposal": {
+			malleate: func(g *GenesisState) { g.Proposals = append(g.Proposals, g.Proposals[0]) },
+			expErr:   "proposal 1: duplicate value",
+		},
+		"vote on unknown proposal": {
+			malleate: func(g *GenesisState) { g.Votes[0].ProposalId = 2 },
+			expErr:   "proposal 2 of vote by " + member1.String() + ": not found",
+		},
+		"duplicate vote": {
+			malleate: func(g *GenesisState) { g.Votes = append(g.Votes, g.Votes[0]) },
+			expErr:   "proposal 1 vote by " + member1.String() + ": duplicate value",
+		},
+		"vote snapshot of unknown proposal": {
+			malleate: func(g *GenesisState) { g.VoteSnapshots[0].ProposalId = 2 },
+			expErr:   "proposal 2 of vote snapshot: not found",
+		},
+		"duplicate vote snapshot": {
+			malleate: func(g *GenesisState) { g.VoteSnapshots = append(g.VoteSnapshots, g.VoteSnapshots[0]) },
+			expErr:   "proposal 1 vote snapshot: duplicate value",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			genesis := validGenesis()
+			spec.malleate(&genesis)
+			err := genesis.Validate()
+			if spec.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), spec.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
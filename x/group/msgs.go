@@ -245,6 +245,9 @@ func (m MsgCreateGroupAccount) ValidateBasic() error {
 	if m.GroupId == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "group")
 	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "group account metadata")
+	}
 
 	policy := m.GetDecisionPolicy()
 	if policy == nil {
@@ -430,6 +433,48 @@ func (m MsgUpdateGroupAccountMetadata) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicyMetadata{}
+var _ legacytx.LegacyMsg = &MsgUpdateGroupAccountDecisionPolicyMetadata{}
+
+// Route Implements Msg.
+func (m MsgUpdateGroupAccountDecisionPolicyMetadata) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgUpdateGroupAccountDecisionPolicyMetadata) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgUpdateGroupAccountDecisionPolicyMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAccountDecisionPolicyMetadata.
+func (m MsgUpdateGroupAccountDecisionPolicyMetadata) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupAccountDecisionPolicyMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "decision policy metadata")
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgCreateGroupAccount{}
 var _ legacytx.LegacyMsg = &MsgCreateGroupAccount{}
 var _ types.UnpackInterfacesMessage = MsgCreateGroupAccount{}
@@ -487,6 +532,97 @@ func (m MsgCreateGroupAccount) UnpackInterfaces(unpacker types.AnyUnpacker) erro
 	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
 }
 
+var _ sdk.Msg = &MsgCreateGroupWithPolicy{}
+var _ legacytx.LegacyMsg = &MsgCreateGroupWithPolicy{}
+
+// NewMsgCreateGroupWithPolicy creates a new MsgCreateGroupWithPolicy.
+func NewMsgCreateGroupWithPolicy(admin sdk.AccAddress, members []Member, groupMetadata []byte, groupAccountMetadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupWithPolicy, error) {
+	m := &MsgCreateGroupWithPolicy{
+		Admin:                admin.String(),
+		Members:              members,
+		GroupMetadata:        groupMetadata,
+		GroupAccountMetadata: groupAccountMetadata,
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Route Implements Msg.
+func (m MsgCreateGroupWithPolicy) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgCreateGroupWithPolicy) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgCreateGroupWithPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateGroupWithPolicy.
+func (m MsgCreateGroupWithPolicy) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCreateGroupWithPolicy) ValidateBasic() error {
+	createGroup := MsgCreateGroup{
+		Admin:    m.Admin,
+		Members:  m.Members,
+		Metadata: m.GroupMetadata,
+	}
+	if err := createGroup.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(m.GroupAccountMetadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "group account metadata")
+	}
+
+	policy := m.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+	return nil
+}
+
+func (m *MsgCreateGroupWithPolicy) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+func (m *MsgCreateGroupWithPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return fmt.Errorf("can't proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateGroupWithPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
 var _ sdk.Msg = &MsgCreateProposal{}
 var _ legacytx.LegacyMsg = &MsgCreateProposal{}
 
@@ -551,12 +687,22 @@ func (m MsgCreateProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "proposers")
 	}
 
+	if len(m.Msgs) > MaxProposalMsgs {
+		return sdkerrors.Wrapf(ErrMaxLimit, "proposal msgs: got %d, max %d", len(m.Msgs), MaxProposalMsgs)
+	}
+
 	msgs := m.GetMsgs()
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "msg %d", i)
 		}
 	}
+
+	if m.ExecPredicate != nil {
+		if err := m.ExecPredicate.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "exec predicate")
+		}
+	}
 	return nil
 }
 
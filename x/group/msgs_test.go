@@ -1,6 +1,7 @@
 package group
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -125,6 +126,7 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 		group     uint64
 		threshold string
 		timeout   proto.Duration
+		metadata  []byte
 		expErr    bool
 	}{
 		"all good with minimum fields set": {
@@ -133,6 +135,21 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 			threshold: "1",
 			timeout:   proto.Duration{Seconds: 1},
 		},
+		"all good with max length metadata": {
+			admin:     myAddr,
+			group:     1,
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+			metadata:  bytes.Repeat([]byte{1}, MaxMetadataLength),
+		},
+		"metadata too long": {
+			admin:     myAddr,
+			group:     1,
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+			metadata:  bytes.Repeat([]byte{1}, MaxMetadataLength+1),
+			expErr:    true,
+		},
 		"zero threshold not allowed": {
 			admin:     myAddr,
 			group:     1,
@@ -189,7 +206,99 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 			m, err := NewMsgCreateGroupAccount(
 				spec.admin,
 				spec.group,
-				nil,
+				spec.metadata,
+				&ThresholdDecisionPolicy{
+					Threshold: spec.threshold,
+					Timeout:   spec.timeout,
+				},
+			)
+			require.NoError(t, err)
+
+			if spec.expErr {
+				require.Error(t, m.ValidateBasic())
+			} else {
+				require.NoError(t, m.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCreateGroupWithPolicy(t *testing.T) {
+	_, _, myAddr := testdata.KeyTestPubAddr()
+	_, _, memberAddr := testdata.KeyTestPubAddr()
+	members := []Member{{Address: memberAddr.String(), Weight: "1"}}
+
+	specs := map[string]struct {
+		admin                sdk.AccAddress
+		members              []Member
+		groupMetadata        []byte
+		groupAccountMetadata []byte
+		threshold            string
+		timeout              proto.Duration
+		expErr               bool
+	}{
+		"all good with minimum fields set": {
+			admin:     myAddr,
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+		},
+		"all good with members and metadata": {
+			admin:                myAddr,
+			members:              members,
+			groupMetadata:        []byte("group"),
+			groupAccountMetadata: bytes.Repeat([]byte{1}, MaxMetadataLength),
+			threshold:            "1",
+			timeout:              proto.Duration{Seconds: 1},
+		},
+		"admin required": {
+			members:   members,
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+			expErr:    true,
+		},
+		"invalid member weight": {
+			admin:     myAddr,
+			members:   []Member{{Address: memberAddr.String(), Weight: "0"}},
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+			expErr:    true,
+		},
+		"duplicate members": {
+			admin:     myAddr,
+			members:   append(members, members...),
+			threshold: "1",
+			timeout:   proto.Duration{Seconds: 1},
+			expErr:    true,
+		},
+		"group account metadata too long": {
+			admin:                myAddr,
+			members:              members,
+			groupAccountMetadata: bytes.Repeat([]byte{1}, MaxMetadataLength+1),
+			threshold:            "1",
+			timeout:              proto.Duration{Seconds: 1},
+			expErr:               true,
+		},
+		"decision policy without threshold": {
+			admin:   myAddr,
+			members: members,
+			timeout: proto.Duration{Seconds: 1},
+			expErr:  true,
+		},
+		"decision policy without timeout": {
+			admin:     myAddr,
+			members:   members,
+			threshold: "1",
+			expErr:    true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			m, err := NewMsgCreateGroupWithPolicy(
+				spec.admin,
+				spec.members,
+				spec.groupMetadata,
+				spec.groupAccountMetadata,
 				&ThresholdDecisionPolicy{
 					Threshold: spec.threshold,
 					Timeout:   spec.timeout,
@@ -213,6 +322,19 @@ func TestMsgCreateProposalRequest(t *testing.T) {
 	_, _, addr = testdata.KeyTestPubAddr()
 	memberAddr := addr.String()
 
+	proposalWithMsgs := func(n int) MsgCreateProposal {
+		msgs := make([]sdk.Msg, n)
+		for i := range msgs {
+			msgs[i] = testdata.NewTestMsg(addr)
+		}
+		m := MsgCreateProposal{
+			Address:   groupAccAddr,
+			Proposers: []string{memberAddr},
+		}
+		require.NoError(t, m.SetMsgs(msgs))
+		return m
+	}
+
 	specs := map[string]struct {
 		src    MsgCreateProposal
 		expErr bool
@@ -256,6 +378,102 @@ func TestMsgCreateProposalRequest(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with exec predicate": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    groupAccAddr,
+					Denom:      "test",
+					Comparison: ComparisonGTE,
+					Amount:     "100",
+				},
+			},
+		},
+		"exec predicate with invalid address": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    "invalid-address",
+					Denom:      "test",
+					Comparison: ComparisonGTE,
+					Amount:     "100",
+				},
+			},
+			expErr: true,
+		},
+		"exec predicate with invalid denom": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    groupAccAddr,
+					Denom:      "1",
+					Comparison: ComparisonGTE,
+					Amount:     "100",
+				},
+			},
+			expErr: true,
+		},
+		"exec predicate comparison required": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address: groupAccAddr,
+					Denom:   "test",
+					Amount:  "100",
+				},
+			},
+			expErr: true,
+		},
+		"exec predicate with unknown comparison": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    groupAccAddr,
+					Denom:      "test",
+					Comparison: 10,
+					Amount:     "100",
+				},
+			},
+			expErr: true,
+		},
+		"exec predicate with negative amount": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    groupAccAddr,
+					Denom:      "test",
+					Comparison: ComparisonLTE,
+					Amount:     "-1",
+				},
+			},
+			expErr: true,
+		},
+		"all good with max number of msgs": {
+			src: proposalWithMsgs(MaxProposalMsgs),
+		},
+		"too many msgs": {
+			src:    proposalWithMsgs(MaxProposalMsgs + 1),
+			expErr: true,
+		},
+		"exec predicate with decimal amount": {
+			src: MsgCreateProposal{
+				Address:   groupAccAddr,
+				Proposers: []string{memberAddr},
+				ExecPredicate: &ExecPredicate{
+					Address:    groupAccAddr,
+					Denom:      "test",
+					Comparison: ComparisonLTE,
+					Amount:     "1.5",
+				},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
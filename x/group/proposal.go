@@ -72,6 +72,12 @@ func (p Proposal) ValidateBasic() error {
 	if _, ok := Proposal_ExecutorResult_name[int32(p.ExecutorResult)]; !ok {
 		return sdkerrors.Wrap(ErrInvalid, "executor result")
 	}
+	if _, ok := Proposal_Reason_name[int32(p.Reason)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "reason")
+	}
+	if p.Reason != ProposalReasonUnspecified && p.Result != ProposalResultRejected {
+		return sdkerrors.Wrap(ErrInvalid, "reason is only allowed for rejected proposals")
+	}
 	if err := p.VoteState.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "vote state")
 	}
@@ -84,6 +90,63 @@ func (p Proposal) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "message %d", i)
 		}
 	}
+	if p.ExecPredicate != nil {
+		if err := p.ExecPredicate.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "exec predicate")
+		}
+	}
+	return nil
+}
+
+func (p ExecPredicate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if p.Comparison == ComparisonInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "comparison")
+	}
+	if _, ok := ExecPredicate_Comparison_name[int32(p.Comparison)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "comparison")
+	}
+	if _, err := p.GetAmountInt(); err != nil {
+		return sdkerrors.Wrap(err, "amount")
+	}
+	return nil
+}
+
+// GetAmountInt parses the amount of the predicate as a non-negative integer.
+func (p ExecPredicate) GetAmountInt() (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(p.Amount)
+	if !ok || amount.IsNegative() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "expected a non-negative integer, got %q", p.Amount)
+	}
+	return amount, nil
+}
+
+// Check returns an ErrPredicateUnmet error if the given balance doesn't satisfy the predicate.
+func (p ExecPredicate) Check(balance sdk.Int) error {
+	amount, err := p.GetAmountInt()
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	switch p.Comparison {
+	case ComparisonGTE:
+		ok = balance.GTE(amount)
+	case ComparisonLTE:
+		ok = balance.LTE(amount)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "comparison %s", p.Comparison)
+	}
+
+	if !ok {
+		return sdkerrors.Wrapf(ErrPredicateUnmet, "balance of %s%s for %s is not %s %s%s",
+			balance, p.Denom, p.Address, p.Comparison, p.Amount, p.Denom)
+	}
 	return nil
 }
 
@@ -38,6 +38,10 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 		return nil, errors.Wrap(err, "votes")
 	}
 
+	if err := s.voteSnapshotTable.Import(ctx, genesisState.VoteSnapshots, 0); err != nil {
+		return nil, errors.Wrap(err, "vote snapshots")
+	}
+
 	return []abci.ValidatorUpdate{}, nil
 }
 
@@ -82,6 +86,13 @@ func (s serverImpl) ExportGenesis(ctx types.Context, cdc codec.Codec) (json.RawM
 	}
 	genesisState.Votes = votes
 
+	var voteSnapshots []*group.VoteSnapshot
+	_, err = s.voteSnapshotTable.Export(ctx, &voteSnapshots)
+	if err != nil {
+		return nil, errors.Wrap(err, "vote snapshots")
+	}
+	genesisState.VoteSnapshots = voteSnapshots
+
 	genesisBytes := cdc.MustMarshalJSON(genesisState)
 	return genesisBytes, nil
 }
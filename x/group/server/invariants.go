@@ -12,15 +12,17 @@ import (
 )
 
 const (
-	votesInvariant    = "Tally-Votes"
-	weightInvariant   = "Group-TotalWeight"
-	votesSumInvariant = "Tally-Votes-Sum"
+	votesInvariant       = "Tally-Votes"
+	weightInvariant      = "Group-TotalWeight"
+	votesSumInvariant    = "Tally-Votes-Sum"
+	proposalAccInvariant = "Proposal-GroupAccount"
 )
 
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(group.ModuleName, votesInvariant, s.tallyVotesInvariant())
 	ir.RegisterRoute(group.ModuleName, weightInvariant, s.groupTotalWeightInvariant())
 	ir.RegisterRoute(group.ModuleName, votesSumInvariant, s.tallyVotesSumInvariant())
+	ir.RegisterRoute(group.ModuleName, proposalAccInvariant, s.proposalGroupAccountInvariant())
 }
 
 func (s serverImpl) tallyVotesInvariant() sdk.Invariant {
@@ -44,11 +46,18 @@ func (s serverImpl) groupTotalWeightInvariant() sdk.Invariant {
 
 func (s serverImpl) tallyVotesSumInvariant() sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broken := tallyVotesSumInvariant(ctx, s.groupTable, s.proposalTable, s.groupMemberTable, s.voteByProposalIndex, s.groupAccountTable)
+		msg, broken := tallyVotesSumInvariant(ctx, s.groupTable, s.proposalTable, s.groupMemberTable, s.voteByProposalIndex, s.groupAccountTable, s.voteSnapshotTable)
 		return sdk.FormatInvariant(group.ModuleName, votesSumInvariant, msg), broken
 	}
 }
 
+func (s serverImpl) proposalGroupAccountInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := proposalGroupAccountInvariant(ctx, s.proposalTable, s.groupAccountTable)
+		return sdk.FormatInvariant(group.ModuleName, proposalAccInvariant, msg), broken
+	}
+}
+
 func tallyVotesInvariant(ctx sdk.Context, prevCtx sdk.Context, proposalTable orm.AutoUInt64Table) (string, bool) {
 
 	var msg string
@@ -191,7 +200,7 @@ func groupTotalWeightInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table,
 	return msg, broken
 }
 
-func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, proposalTable orm.AutoUInt64Table, groupMemberTable orm.PrimaryKeyTable, voteByProposalIndex orm.Index, groupAccountTable orm.PrimaryKeyTable) (string, bool) {
+func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, proposalTable orm.AutoUInt64Table, groupMemberTable orm.PrimaryKeyTable, voteByProposalIndex orm.Index, groupAccountTable orm.PrimaryKeyTable, voteSnapshotTable orm.PrimaryKeyTable) (string, bool) {
 	var msg string
 	var broken bool
 
@@ -244,7 +253,18 @@ func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, pro
 			return msg, broken
 		}
 
-		if groupInfo.Version != proposal.GroupVersion {
+		var snapshot group.VoteSnapshot
+		hasSnapshot := true
+		err = voteSnapshotTable.GetOne(ctx, orm.PrimaryKey(&group.VoteSnapshot{ProposalId: proposal.ProposalId}), &snapshot)
+		switch {
+		case orm.ErrNotFound.Is(err):
+			hasSnapshot = false
+		case err != nil:
+			msg += fmt.Sprintf("error while getting vote snapshot for proposal with ID %d\n%v\n", proposal.ProposalId, err)
+			return msg, broken
+		}
+
+		if !hasSnapshot && groupInfo.Version != proposal.GroupVersion {
 			msg += fmt.Sprintf("group with id %d was modified\n", groupInfo.GroupId)
 			return msg, broken
 		}
@@ -262,17 +282,28 @@ func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, pro
 				break
 			}
 
-			groupMem = group.GroupMember{GroupId: groupAcc.GroupId, Member: &group.Member{Address: vote.Voter}}
+			var weight string
+			if hasSnapshot {
+				var ok bool
+				weight, ok = snapshot.MemberWeight(vote.Voter)
+				if !ok {
+					msg += fmt.Sprintf("voter %s not found in vote snapshot of proposal with ID %d\n", vote.Voter, proposal.ProposalId)
+					return msg, broken
+				}
+			} else {
+				groupMem = group.GroupMember{GroupId: groupAcc.GroupId, Member: &group.Member{Address: vote.Voter}}
 
-			err = groupMemberTable.GetOne(ctx, orm.PrimaryKey(&groupMem), &groupMem)
-			if err != nil {
-				msg += fmt.Sprintf("group member not found with group ID %d and group member %s\n%v\n", groupAcc.GroupId, vote.Voter, err)
-				return msg, broken
+				err = groupMemberTable.GetOne(ctx, orm.PrimaryKey(&groupMem), &groupMem)
+				if err != nil {
+					msg += fmt.Sprintf("group member not found with group ID %d and group member %s\n%v\n", groupAcc.GroupId, vote.Voter, err)
+					return msg, broken
+				}
+				weight = groupMem.Member.Weight
 			}
 
-			curMemVotingWeight, err := regenmath.NewNonNegativeDecFromString(groupMem.Member.Weight)
+			curMemVotingWeight, err := regenmath.NewNonNegativeDecFromString(weight)
 			if err != nil {
-				msg += fmt.Sprintf("error while parsing non-negative decimal for group member %s\n%v\n", groupMem.Member.Address, err)
+				msg += fmt.Sprintf("error while parsing non-negative decimal for group member %s\n%v\n", vote.Voter, err)
 				return msg, broken
 			}
 			totalVotingWeight, err = totalVotingWeight.Add(curMemVotingWeight)
@@ -349,3 +380,43 @@ func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, pro
 	}
 	return msg, broken
 }
+
+// proposalGroupAccountInvariant checks that the group account of every
+// proposal exists, reporting all proposals that reference a missing group
+// account.
+func proposalGroupAccountInvariant(ctx sdk.Context, proposalTable orm.AutoUInt64Table, groupAccountTable orm.PrimaryKeyTable) (string, bool) {
+	var msg string
+	var broken bool
+
+	var proposal group.Proposal
+
+	proposalIt, err := proposalTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		msg += fmt.Sprintf("PrefixScan failure on proposal table\n%v\n", err)
+		return msg, broken
+	}
+	defer proposalIt.Close()
+
+	for {
+		_, err := proposalIt.LoadNext(&proposal)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			msg += fmt.Sprintf("error while loading proposal\n%v\n", err)
+			return msg, broken
+		}
+
+		address, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			msg += fmt.Sprintf("error while converting proposal address of type string to type AccAddress\n%v\n", err)
+			return msg, broken
+		}
+
+		if !groupAccountTable.Has(ctx, orm.AddLengthPrefix(address.Bytes())) {
+			broken = true
+			msg += fmt.Sprintf("proposal with ID %d references group account %s which does not exist\n", proposal.ProposalId, proposal.Address)
+		}
+	}
+	return msg, broken
+}
@@ -345,6 +345,11 @@ func TestTallyVotesSumInvariant(t *testing.T) {
 	require.NoError(t, err)
 	voteTable := voteTableBuilder.Build()
 
+	// Vote Snapshot Table
+	voteSnapshotTableBuilder, err := orm.NewPrimaryKeyTableBuilder(VoteSnapshotTablePrefix, key, &group.VoteSnapshot{}, cdc)
+	require.NoError(t, err)
+	voteSnapshotTable := voteSnapshotTableBuilder.Build()
+
 	_, _, adminAddr := testdata.KeyTestPubAddr()
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -358,8 +363,63 @@ func TestTallyVotesSumInvariant(t *testing.T) {
 		groupMembers []*group.GroupMember
 		proposal     *group.Proposal
 		votes        []*group.Vote
+		voteSnapshot *group.VoteSnapshot
 		expBroken    bool
 	}{
+		"invariant not broken with vote snapshot after membership change": {
+			groupsInfo: &group.GroupInfo{
+				GroupId:     1,
+				Admin:       adminAddr.String(),
+				Version:     2,
+				TotalWeight: "3",
+			},
+			groupAcc: &group.GroupAccountInfo{
+				Address:       addr1.String(),
+				GroupId:       1,
+				Admin:         adminAddr.String(),
+				Version:       1,
+				DerivationKey: []byte("derivation-key"),
+			},
+			groupMembers: []*group.GroupMember{
+				{
+					GroupId: 1,
+					Member: &group.Member{
+						Address: addr2.String(),
+						Weight:  "3",
+					},
+				},
+			},
+			proposal: &group.Proposal{
+				ProposalId:          1,
+				Address:             addr1.String(),
+				Proposers:           []string{addr1.String()},
+				SubmittedAt:         *curBlockTime,
+				GroupVersion:        1,
+				GroupAccountVersion: 1,
+				Status:              group.ProposalStatusSubmitted,
+				Result:              group.ProposalResultUnfinalized,
+				VoteState:           group.Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+				Timeout:             gogotypes.Timestamp{Seconds: 600},
+				ExecutorResult:      group.ProposalExecutorResultNotRun,
+			},
+			votes: []*group.Vote{
+				{
+					ProposalId:  1,
+					Voter:       addr1.String(),
+					Choice:      group.Choice_CHOICE_YES,
+					SubmittedAt: *gogotypes.TimestampNow(),
+				},
+			},
+			voteSnapshot: &group.VoteSnapshot{
+				ProposalId:  1,
+				TotalWeight: "7",
+				Members: []group.Member{
+					{Address: addr1.String(), Weight: "4"},
+					{Address: addr2.String(), Weight: "3"},
+				},
+			},
+			expBroken: false,
+		},
 		"invariant not broken": {
 			groupsInfo: &group.GroupInfo{
 				GroupId:     1,
@@ -569,7 +629,91 @@ func TestTallyVotesSumInvariant(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		_, broken := tallyVotesSumInvariant(cacheCurCtx, groupTable, proposalTable, groupMemberTable, voteByProposalIndex, groupAccountTable)
+		if spec.voteSnapshot != nil {
+			err = voteSnapshotTable.Create(cacheCurCtx, spec.voteSnapshot)
+			require.NoError(t, err)
+		}
+
+		_, broken := tallyVotesSumInvariant(cacheCurCtx, groupTable, proposalTable, groupMemberTable, voteByProposalIndex, groupAccountTable, voteSnapshotTable)
+		require.Equal(t, spec.expBroken, broken)
+	}
+}
+
+func TestProposalGroupAccountInvariant(t *testing.T) {
+	curCtx, cdc, key := getCtxCodecKey(t)
+
+	// Group Account Table
+	groupAccountTableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupAccountTablePrefix, key, &group.GroupAccountInfo{}, cdc)
+	require.NoError(t, err)
+	groupAccountTable := groupAccountTableBuilder.Build()
+
+	// Proposal Table
+	proposalTableBuilder, err := orm.NewAutoUInt64TableBuilder(ProposalTablePrefix, ProposalTableSeqPrefix, key, &group.Proposal{}, cdc)
+	require.NoError(t, err)
+	proposalTable := proposalTableBuilder.Build()
+
+	_, _, adminAddr := testdata.KeyTestPubAddr()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	curBlockTime, err := gogotypes.TimestampProto(curCtx.BlockTime())
+	require.NoError(t, err)
+
+	groupAcc := &group.GroupAccountInfo{
+		Address:       addr1.String(),
+		GroupId:       1,
+		Admin:         adminAddr.String(),
+		Version:       1,
+		DerivationKey: []byte("derivation-key"),
+	}
+	err = groupAcc.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	require.NoError(t, err)
+
+	proposal := &group.Proposal{
+		ProposalId:          1,
+		Address:             addr1.String(),
+		Proposers:           []string{addr1.String()},
+		SubmittedAt:         *curBlockTime,
+		GroupVersion:        1,
+		GroupAccountVersion: 1,
+		Status:              group.ProposalStatusSubmitted,
+		Result:              group.ProposalResultUnfinalized,
+		VoteState:           group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		Timeout:             gogotypes.Timestamp{Seconds: 600},
+		ExecutorResult:      group.ProposalExecutorResultNotRun,
+	}
+
+	specs := map[string]struct {
+		deleteGroupAcc bool
+		expBroken      bool
+	}{
+		"invariant not broken": {
+			deleteGroupAcc: false,
+			expBroken:      false,
+		},
+		"group account of proposal was deleted": {
+			deleteGroupAcc: true,
+			expBroken:      true,
+		},
+	}
+
+	for _, spec := range specs {
+		cacheCurCtx, _ := curCtx.CacheContext()
+
+		err := groupAccountTable.Create(cacheCurCtx, groupAcc)
+		require.NoError(t, err)
+
+		_, err = proposalTable.Create(cacheCurCtx, proposal)
+		require.NoError(t, err)
+
+		if spec.deleteGroupAcc {
+			err = groupAccountTable.Delete(cacheCurCtx, groupAcc)
+			require.NoError(t, err)
+		}
+
+		msg, broken := proposalGroupAccountInvariant(cacheCurCtx, proposalTable, groupAccountTable)
 		require.Equal(t, spec.expBroken, broken)
+		if spec.expBroken {
+			require.Contains(t, msg, addr1.String())
+		}
 	}
 }
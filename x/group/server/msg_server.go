@@ -1,9 +1,7 @@
 package server
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
 	"fmt"
 	"reflect"
 
@@ -257,27 +255,42 @@ func (s serverImpl) CreateGroupAccount(goCtx context.Context, req *group.MsgCrea
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group admin")
 	}
 
+	// Prevent a decision policy that can never be satisfied by the group.
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	if err := policy.Validate(g); err != nil {
+		return nil, err
+	}
+
 	// Generate group account address.
 	var accountAddr sdk.AccAddress
 	var accountDerivationKey []byte
+	salt, err := s.nextGroupAccountSalt(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
 	// loop here in the rare case of a collision
-	for {
-		nextAccVal := s.groupAccountSeq.NextVal(ctx)
-		buf := bytes.NewBuffer(nil)
-		err = binary.Write(buf, binary.LittleEndian, nextAccVal)
-		if err != nil {
-			return nil, err
-		}
+	for ; ; salt++ {
+		accountDerivationKey = group.GroupAccountDerivationKey(groupID, salt)
+		accountAddr = group.DeriveGroupAccountAddress(groupID, salt)
 
-		accountDerivationKey = buf.Bytes()
-		accountID := s.key.Derive(accountDerivationKey)
-		accountAddr = accountID.Address()
-
-		if s.accKeeper.GetAccount(ctx.Context, accountAddr) != nil {
+		existing := s.accKeeper.GetAccount(ctx.Context, accountAddr)
+		if !groupAccountAddressAvailable(existing) {
 			// handle a rare collision
 			continue
 		}
 
+		if existing != nil {
+			// The address has been funded ahead of the group account
+			// creation, keep its account number.
+			s.accKeeper.SetAccount(ctx.Context, &authtypes.ModuleAccount{
+				BaseAccount: authtypes.NewBaseAccount(accountAddr, nil, existing.GetAccountNumber(), 0),
+				Name:        accountAddr.String(),
+			})
+			break
+		}
+
 		acc := s.accKeeper.NewAccount(ctx.Context, &authtypes.ModuleAccount{
 			BaseAccount: &authtypes.BaseAccount{
 				Address: accountAddr.String(),
@@ -314,6 +327,69 @@ func (s serverImpl) CreateGroupAccount(goCtx context.Context, req *group.MsgCrea
 	return &group.MsgCreateGroupAccountResponse{Address: accountAddr.String()}, nil
 }
 
+// groupAccountAddressAvailable returns true if a group account can be created
+// at the address of the given account, that is if there is no account yet or if
+// the account has only received funds, i.e. it is a base account without a
+// public key and which never signed a transaction.
+func groupAccountAddressAvailable(acc authtypes.AccountI) bool {
+	if acc == nil {
+		return true
+	}
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	return ok && baseAcc.GetPubKey() == nil && baseAcc.GetSequence() == 0
+}
+
+// nextGroupAccountSalt returns the first salt to try when deriving the address
+// of the next group account of a group, which is one more than the number of
+// group accounts of the group. Salts are scoped to the group, so the next
+// group account address of a group only changes when a group account is
+// created for that group.
+func (s serverImpl) nextGroupAccountSalt(ctx types.Context, groupID uint64) (uint64, error) {
+	it, err := s.groupAccountByGroupIndex.Get(ctx, groupID)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	salt := uint64(1)
+	for {
+		var info group.GroupAccountInfo
+		if _, err := it.LoadNext(&info); err != nil {
+			if orm.ErrIteratorDone.Is(err) {
+				return salt, nil
+			}
+			return 0, err
+		}
+		salt++
+	}
+}
+
+func (s serverImpl) CreateGroupWithPolicy(goCtx context.Context, req *group.MsgCreateGroupWithPolicy) (*group.MsgCreateGroupWithPolicyResponse, error) {
+	groupRes, err := s.CreateGroup(goCtx, &group.MsgCreateGroup{
+		Admin:    req.Admin,
+		Members:  req.Members,
+		Metadata: req.GroupMetadata,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	accountRes, err := s.CreateGroupAccount(goCtx, &group.MsgCreateGroupAccount{
+		Admin:          req.Admin,
+		GroupId:        groupRes.GroupId,
+		Metadata:       req.GroupAccountMetadata,
+		DecisionPolicy: req.DecisionPolicy,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+
+	return &group.MsgCreateGroupWithPolicyResponse{
+		GroupId:             groupRes.GroupId,
+		GroupAccountAddress: accountRes.Address,
+	}, nil
+}
+
 func (s serverImpl) UpdateGroupAccountAdmin(goCtx context.Context, req *group.MsgUpdateGroupAccountAdmin) (*group.MsgUpdateGroupAccountAdminResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	action := func(groupAccount *group.GroupAccountInfo) error {
@@ -335,7 +411,20 @@ func (s serverImpl) UpdateGroupAccountDecisionPolicy(goCtx context.Context, req
 	policy := req.GetDecisionPolicy()
 
 	action := func(groupAccount *group.GroupAccountInfo) error {
-		err := groupAccount.SetDecisionPolicy(policy)
+		g, err := s.getGroupInfo(ctx, groupAccount.GroupId)
+		if err != nil {
+			return err
+		}
+
+		// Prevent a decision policy that can never be satisfied by the group.
+		if policy == nil {
+			return sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+		}
+		if err := policy.Validate(g); err != nil {
+			return err
+		}
+
+		err = groupAccount.SetDecisionPolicy(policy)
 		if err != nil {
 			return err
 		}
@@ -374,6 +463,29 @@ func (s serverImpl) UpdateGroupAccountMetadata(goCtx context.Context, req *group
 	return &group.MsgUpdateGroupAccountMetadataResponse{}, nil
 }
 
+func (s serverImpl) UpdateGroupAccountDecisionPolicyMetadata(goCtx context.Context, req *group.MsgUpdateGroupAccountDecisionPolicyMetadata) (*group.MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	metadata := req.GetMetadata()
+
+	// The version is not incremented as the decision policy metadata doesn't
+	// affect the result of running proposals.
+	action := func(groupAccount *group.GroupAccountInfo) error {
+		groupAccount.DecisionPolicyMetadata = metadata
+		return s.groupAccountTable.Update(ctx, groupAccount)
+	}
+
+	if err := assertMetadataLength(metadata, "decision policy metadata"); err != nil {
+		return nil, err
+	}
+
+	err := s.doUpdateGroupAccount(ctx, req.Address, req.Admin, action, "decision policy metadata updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupAccountDecisionPolicyMetadataResponse{}, nil
+}
+
 func (s serverImpl) CreateProposal(goCtx context.Context, req *group.MsgCreateProposal) (*group.MsgCreateProposalResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	accountAddress, err := sdk.AccAddressFromBech32(req.Address)
@@ -450,6 +562,7 @@ func (s serverImpl) CreateProposal(goCtx context.Context, req *group.MsgCreatePr
 		Status:              group.ProposalStatusSubmitted,
 		ExecutorResult:      group.ProposalExecutorResultNotRun,
 		Timeout:             *endTime,
+		ExecPredicate:       req.ExecPredicate,
 		VoteState: group.Tally{
 			YesCount:     "0",
 			NoCount:      "0",
@@ -466,6 +579,10 @@ func (s serverImpl) CreateProposal(goCtx context.Context, req *group.MsgCreatePr
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
+	if err := s.createVoteSnapshot(ctx, id, g); err != nil {
+		return nil, sdkerrors.Wrap(err, "create vote snapshot")
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(&group.EventCreateProposal{ProposalId: id})
 	if err != nil {
 		return nil, err
@@ -522,40 +639,55 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 	if proposal.Status != group.ProposalStatusSubmitted {
 		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal not open for voting")
 	}
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, address.Bytes())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+
+	// Votes are accepted until the end of the vote grace period of the
+	// decision policy after the proposal timeout.
 	votingPeriodEnd, err := gogotypes.TimestampFromProto(&proposal.Timeout)
 	if err != nil {
 		return nil, err
 	}
+	gracePeriod := accountInfo.GetDecisionPolicy().GetVoteGracePeriod()
+	gracePeriodDuration, err := gogotypes.DurationFromProto(&gracePeriod)
+	if err != nil {
+		return nil, err
+	}
+	votingPeriodEnd = votingPeriodEnd.Add(gracePeriodDuration)
 	if votingPeriodEnd.Before(ctx.BlockTime()) || votingPeriodEnd.Equal(ctx.BlockTime()) {
 		return nil, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
 	}
 
 	// Ensure that group account hasn't been modified since the proposal submission.
-	address, err := sdk.AccAddressFromBech32(proposal.Address)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "group account")
-	}
-	accountInfo, err := s.getGroupAccountInfo(ctx, address.Bytes())
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "load group account")
-	}
 	if proposal.GroupAccountVersion != accountInfo.Version {
 		return nil, sdkerrors.Wrap(group.ErrModified, "group account was modified")
 	}
 
-	// Ensure that group hasn't been modified since the proposal submission.
 	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
 	if err != nil {
 		return nil, err
 	}
-	if electorate.Version != proposal.GroupVersion {
+	snapshot, err := s.getVoteSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that group hasn't been modified since the proposal submission
+	// if the group membership wasn't snapshotted at submission.
+	if snapshot == nil && electorate.Version != proposal.GroupVersion {
 		return nil, sdkerrors.Wrap(group.ErrModified, "group was modified")
 	}
 
 	// Count and store votes.
 	voterAddr := req.Voter
-	voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: voterAddr}}
-	if err := s.groupMemberTable.GetOne(ctx, orm.PrimaryKey(&voter), &voter); err != nil {
+	weight, err := s.getVoterWeight(ctx, snapshot, electorate.GroupId, voterAddr)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "address: %s", voterAddr)
 	}
 	newVote := group.Vote{
@@ -565,7 +697,7 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 		Metadata:    metadata,
 		SubmittedAt: *blockTime,
 	}
-	if err := proposal.VoteState.Add(newVote, voter.Member.Weight); err != nil {
+	if err := proposal.VoteState.Add(newVote, weight); err != nil {
 		return nil, sdkerrors.Wrap(err, "add new vote")
 	}
 
@@ -576,7 +708,7 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 	}
 
 	// Run tally with new votes to close early.
-	if err := doTally(ctx, &proposal, electorate, accountInfo); err != nil {
+	if err := doTally(ctx, &proposal, electorate, accountInfo, snapshot); err != nil {
 		return nil, err
 	}
 
@@ -604,25 +736,101 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 }
 
 // doTally updates the proposal status and tally if necessary based on the group account's decision policy.
-func doTally(ctx types.Context, p *group.Proposal, electorate group.GroupInfo, accountInfo group.GroupAccountInfo) error {
-	policy := accountInfo.GetDecisionPolicy()
-	submittedAt, err := gogotypes.TimestampFromProto(&p.SubmittedAt)
-	if err != nil {
-		return err
-	}
-	switch result, err := policy.Allow(p.VoteState, electorate.TotalWeight, ctx.BlockTime().Sub(submittedAt)); {
+// The total weight of the vote snapshot is used if the proposal has one, otherwise the current total weight
+// of the group.
+func doTally(ctx types.Context, p *group.Proposal, electorate group.GroupInfo, accountInfo group.GroupAccountInfo, snapshot *group.VoteSnapshot) error {
+	switch result, err := policyResult(ctx, *p, electorate, accountInfo, snapshot); {
 	case err != nil:
-		return sdkerrors.Wrap(err, "policy execution")
+		return err
 	case result.Allow && result.Final:
 		p.Result = group.ProposalResultAccepted
 		p.Status = group.ProposalStatusClosed
+		p.FinalTallyResult = p.VoteState
 	case !result.Allow && result.Final:
 		p.Result = group.ProposalResultRejected
 		p.Status = group.ProposalStatusClosed
+		p.Reason = result.Reason
+		p.FinalTallyResult = p.VoteState
 	}
 	return nil
 }
 
+// policyResult returns the result of the group account's decision policy for the current tally of the proposal.
+func policyResult(ctx types.Context, p group.Proposal, electorate group.GroupInfo, accountInfo group.GroupAccountInfo, snapshot *group.VoteSnapshot) (group.DecisionPolicyResult, error) {
+	policy := accountInfo.GetDecisionPolicy()
+	submittedAt, err := gogotypes.TimestampFromProto(&p.SubmittedAt)
+	if err != nil {
+		return group.DecisionPolicyResult{}, err
+	}
+	totalWeight := electorate.TotalWeight
+	if snapshot != nil {
+		totalWeight = snapshot.TotalWeight
+	}
+	result, err := policy.Allow(p.VoteState, totalWeight, ctx.BlockTime().Sub(submittedAt))
+	if err != nil {
+		return group.DecisionPolicyResult{}, sdkerrors.Wrap(err, "policy execution")
+	}
+	return result, nil
+}
+
+// createVoteSnapshot stores the current members of the group and their weights
+// as the vote snapshot of the proposal.
+func (s serverImpl) createVoteSnapshot(ctx types.Context, proposalID uint64, g group.GroupInfo) error {
+	it, err := s.groupMemberByGroupIndex.Get(ctx, g.GroupId)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var groupMembers []*group.GroupMember
+	if _, err := orm.ReadAll(it, &groupMembers); err != nil {
+		return err
+	}
+
+	snapshot := group.VoteSnapshot{
+		ProposalId:  proposalID,
+		TotalWeight: g.TotalWeight,
+		Members:     make([]group.Member, len(groupMembers)),
+	}
+	for i, gm := range groupMembers {
+		snapshot.Members[i] = group.Member{Address: gm.Member.Address, Weight: gm.Member.Weight}
+	}
+
+	return s.voteSnapshotTable.Create(ctx, &snapshot)
+}
+
+// getVoteSnapshot returns the vote snapshot of the proposal, or nil if the
+// proposal was submitted before vote snapshots were recorded.
+func (s serverImpl) getVoteSnapshot(ctx types.Context, proposalID uint64) (*group.VoteSnapshot, error) {
+	var snapshot group.VoteSnapshot
+	err := s.voteSnapshotTable.GetOne(ctx, orm.PrimaryKey(&group.VoteSnapshot{ProposalId: proposalID}), &snapshot)
+	switch {
+	case orm.ErrNotFound.Is(err):
+		return nil, nil
+	case err != nil:
+		return nil, sdkerrors.Wrap(err, "load vote snapshot")
+	}
+	return &snapshot, nil
+}
+
+// getVoterWeight returns the weight of the voter from the vote snapshot, or
+// from the current group membership if snapshot is nil.
+func (s serverImpl) getVoterWeight(ctx types.Context, snapshot *group.VoteSnapshot, groupID uint64, voter string) (string, error) {
+	if snapshot != nil {
+		weight, ok := snapshot.MemberWeight(voter)
+		if !ok {
+			return "", orm.ErrNotFound
+		}
+		return weight, nil
+	}
+
+	member := group.GroupMember{GroupId: groupID, Member: &group.Member{Address: voter}}
+	if err := s.groupMemberTable.GetOne(ctx, orm.PrimaryKey(&member), &member); err != nil {
+		return "", err
+	}
+	return member.Member.Weight, nil
+}
+
 // Exec executes the messages from a proposal.
 func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
@@ -665,20 +873,30 @@ func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgE
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "load group")
 		}
+		snapshot, err := s.getVoteSnapshot(ctx, id)
+		if err != nil {
+			return nil, err
+		}
 
-		// Ensure that group hasn't been modified before tally.
-		if electorate.Version != proposal.GroupVersion {
+		// Ensure that group hasn't been modified before tally if the group
+		// membership wasn't snapshotted at submission.
+		if snapshot == nil && electorate.Version != proposal.GroupVersion {
 			proposal.Result = group.ProposalResultUnfinalized
 			proposal.Status = group.ProposalStatusAborted
 			return storeUpdates()
 		}
-		if err := doTally(ctx, &proposal, electorate, accountInfo); err != nil {
+		if err := doTally(ctx, &proposal, electorate, accountInfo, snapshot); err != nil {
 			return nil, err
 		}
 	}
 
 	// Execute proposal payload.
 	if proposal.Status == group.ProposalStatusClosed && proposal.Result == group.ProposalResultAccepted && proposal.ExecutorResult != group.ProposalExecutorResultSuccess {
+		// Abort execution if the proposal predicate doesn't hold.
+		if err := s.checkExecPredicate(ctx.Context, proposal.ExecPredicate); err != nil {
+			return nil, err
+		}
+
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
 		// Cashing context so that we don't update the store in case of failure.
 		ctx, flush := ctx.CacheContext()
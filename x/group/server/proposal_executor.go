@@ -27,6 +27,22 @@ func (s serverImpl) execMsgs(ctx context.Context, derivationKey []byte, proposal
 	return nil
 }
 
+// checkExecPredicate checks that the given proposal predicate, if any, holds
+// against the current spendable balance of the predicate account.
+func (s serverImpl) checkExecPredicate(ctx sdk.Context, predicate *group.ExecPredicate) error {
+	if predicate == nil {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(predicate.Address)
+	if err != nil {
+		return errors.Wrap(err, "exec predicate address")
+	}
+
+	balance := s.bankKeeper.SpendableCoins(ctx, addr).AmountOf(predicate.Denom)
+	return predicate.Check(balance)
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them are equal to the given group account.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
 	for i := range msgs {
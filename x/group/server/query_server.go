@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/group"
 )
 
@@ -22,6 +24,7 @@ func (s serverImpl) GroupInfo(goCtx context.Context, request *group.QueryGroupIn
 	return &group.QueryGroupInfoResponse{Info: &groupInfo}, nil
 }
 
+// getGroupInfo returns the group with the given id.
 func (s serverImpl) getGroupInfo(goCtx context.Context, id uint64) (group.GroupInfo, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	var obj group.GroupInfo
@@ -43,6 +46,7 @@ func (s serverImpl) GroupAccountInfo(goCtx context.Context, request *group.Query
 	return &group.QueryGroupAccountInfoResponse{Info: &groupAccountInfo}, nil
 }
 
+// getGroupAccountInfo returns the current version of the group account with the given address.
 func (s serverImpl) getGroupAccountInfo(goCtx context.Context, accountAddress sdk.AccAddress) (group.GroupAccountInfo, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	var obj group.GroupAccountInfo
@@ -153,6 +157,26 @@ func (s serverImpl) getGroupAccountsByAdmin(ctx types.Context, admin sdk.AccAddr
 	return s.groupAccountByAdminIndex.GetPaginated(ctx, admin.Bytes(), pageRequest)
 }
 
+// GroupAccountAddress queries the address that would be assigned to the next group account created for a group.
+func (s serverImpl) GroupAccountAddress(goCtx context.Context, request *group.QueryGroupAccountAddressRequest) (*group.QueryGroupAccountAddressResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	if _, err := s.getGroupInfo(ctx, request.GroupId); err != nil {
+		return nil, err
+	}
+
+	// mirror the collision handling of Msg/CreateGroupAccount
+	salt, err := s.nextGroupAccountSalt(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	for ; ; salt++ {
+		addr := group.DeriveGroupAccountAddress(request.GroupId, salt)
+		if groupAccountAddressAvailable(s.accKeeper.GetAccount(ctx.Context, addr)) {
+			return &group.QueryGroupAccountAddressResponse{Address: addr.String(), Salt: salt}, nil
+		}
+	}
+}
+
 func (s serverImpl) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	proposalID := request.ProposalId
@@ -164,13 +188,66 @@ func (s serverImpl) Proposal(goCtx context.Context, request *group.QueryProposal
 	return &group.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// ProposalFull queries a proposal together with the current decision policy of its group account, its tally and
+// a page of its votes.
+func (s serverImpl) ProposalFull(goCtx context.Context, request *group.QueryProposalFullRequest) (*group.QueryProposalFullResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, err
+	}
+	groupAccountInfo, err := s.getGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+
+	it, err := s.getVotesByProposal(ctx, proposal.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []*group.Vote
+	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalFullResponse{
+		Proposal:       &proposal,
+		DecisionPolicy: groupAccountInfo.DecisionPolicy,
+		Tally:          proposal.VoteState,
+		Votes:          votes,
+		Pagination:     pageRes,
+	}, nil
+}
+
+func (s serverImpl) ProposalProposers(goCtx context.Context, request *group.QueryProposalProposersRequest) (*group.QueryProposalProposersResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalProposersResponse{Proposers: proposal.Proposers}, nil
+}
+
 func (s serverImpl) ProposalsByGroupAccount(goCtx context.Context, request *group.QueryProposalsByGroupAccountRequest) (*group.QueryProposalsByGroupAccountResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	addr, err := sdk.AccAddressFromBech32(request.Address)
 	if err != nil {
 		return nil, err
 	}
-	it, err := s.getProposalsByGroupAccount(ctx, addr, request.Pagination)
+	var it orm.Iterator
+	if request.Status == group.ProposalStatusInvalid {
+		it, err = s.getProposalsByGroupAccount(ctx, addr, request.Pagination)
+	} else {
+		it, err = s.proposalByGroupAccountStatusIndex.GetPaginated(ctx, groupAccountStatusKey(addr, request.Status), request.Pagination)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +268,7 @@ func (s serverImpl) getProposalsByGroupAccount(ctx types.Context, account sdk.Ac
 	return s.proposalByGroupAccountIndex.GetPaginated(ctx, account.Bytes(), pageRequest)
 }
 
+// getProposal returns the proposal with the given id.
 func (s serverImpl) getProposal(ctx types.Context, proposalID uint64) (group.Proposal, error) {
 	var p group.Proposal
 	if _, err := s.proposalTable.GetOne(ctx, proposalID, &p); err != nil {
@@ -270,3 +348,334 @@ func (s serverImpl) getVotesByProposal(ctx types.Context, proposalID uint64, pag
 func (s serverImpl) getVotesByVoter(ctx types.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
 	return s.voteByVoterIndex.GetPaginated(ctx, voter.Bytes(), pageRequest)
 }
+
+// GroupParticipation queries the average fraction of the group total weight that voted on the most recent
+// proposals of the group, across all of its group accounts. The participation of each proposal is computed
+// against the current total weight of the group.
+func (s serverImpl) GroupParticipation(goCtx context.Context, request *group.QueryGroupParticipationRequest) (*group.QueryGroupParticipationResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	if request.Window == 0 {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "window")
+	}
+	if request.Window > group.MaxParticipationWindow {
+		return nil, sdkerrors.Wrapf(group.ErrMaxLimit, "window: got %d, max %d", request.Window, group.MaxParticipationWindow)
+	}
+
+	groupInfo, err := s.getGroupInfo(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	totalWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := s.getRecentProposalsByGroup(ctx, request.GroupId, request.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := math.NewDecFromInt64(0)
+	for _, p := range proposals {
+		if totalWeight.IsZero() {
+			break
+		}
+		voted, err := p.VoteState.TotalCounts()
+		if err != nil {
+			return nil, err
+		}
+		participation, err := voted.Quo(totalWeight)
+		if err != nil {
+			return nil, err
+		}
+		sum, err = sum.Add(participation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rate := math.NewDecFromInt64(0)
+	if len(proposals) > 0 {
+		rate, err = sum.Quo(math.NewDecFromInt64(int64(len(proposals))))
+		if err != nil {
+			return nil, err
+		}
+	}
+	rate, _ = rate.Reduce()
+
+	return &group.QueryGroupParticipationResponse{
+		ParticipationRate: rate.String(),
+		ProposalCount:     uint64(len(proposals)),
+	}, nil
+}
+
+// getRecentProposalsByGroup returns the limit most recent proposals of all the group accounts of the given group,
+// ordered from the most recent. At most limit proposals are read for each group account.
+func (s serverImpl) getRecentProposalsByGroup(ctx types.Context, groupID uint64, limit uint64) ([]*group.Proposal, error) {
+	accIt, err := s.groupAccountByGroupIndex.Get(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(accIt, &accounts); err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	for _, account := range accounts {
+		accountProposals, err := s.getRecentProposalsByGroupAccount(ctx, account.Address, limit)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, accountProposals...)
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].ProposalId > proposals[j].ProposalId
+	})
+	if uint64(len(proposals)) > limit {
+		proposals = proposals[:limit]
+	}
+	return proposals, nil
+}
+
+// getRecentProposalsByGroupAccount returns the limit most recent proposals of the given group account, ordered from
+// the most recent. Proposal ids are assigned in increasing order, so the proposals are read by iterating over the
+// proposals of the group account in reverse.
+func (s serverImpl) getRecentProposalsByGroupAccount(ctx types.Context, address string, limit uint64) ([]*group.Proposal, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the end of the range is the next address of the same length, or the end of the index if there is none, in
+	// which case proposals of other group accounts are skipped by checking the address of each proposal
+	start := addr.Bytes()
+	_, end := orm.PrefixRange(start)
+	var endKey interface{}
+	if end != nil {
+		endKey = end
+	}
+
+	it, err := s.proposalByGroupAccountIndex.ReversePrefixScan(ctx, start, endKey)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var proposals []*group.Proposal
+	for uint64(len(proposals)) < limit {
+		var proposal group.Proposal
+		_, err := it.LoadNext(&proposal)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if proposal.Address != address {
+			continue
+		}
+		proposals = append(proposals, &proposal)
+	}
+	return proposals, nil
+}
+
+// GroupExport exports the group, its members, its group accounts, the proposals of its group accounts and the
+// votes on those proposals in the genesis format. The sequences are left unset as they are global to the module.
+func (s serverImpl) GroupExport(goCtx context.Context, request *group.QueryGroupExportRequest) (*group.QueryGroupExportResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	groupInfo, err := s.getGroupInfo(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	genesisState := group.NewGenesisState()
+	genesisState.Groups = []*group.GroupInfo{&groupInfo}
+
+	memberIt, err := s.groupMemberByGroupIndex.Get(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := orm.ReadAll(memberIt, &genesisState.GroupMembers); err != nil {
+		return nil, sdkerrors.Wrap(err, "group members")
+	}
+
+	accountIt, err := s.groupAccountByGroupIndex.Get(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := orm.ReadAll(accountIt, &genesisState.GroupAccounts); err != nil {
+		return nil, sdkerrors.Wrap(err, "group accounts")
+	}
+
+	for _, account := range genesisState.GroupAccounts {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			return nil, err
+		}
+		proposalIt, err := s.proposalByGroupAccountIndex.Get(ctx, addr.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		var proposals []*group.Proposal
+		if _, err := orm.ReadAll(proposalIt, &proposals); err != nil {
+			return nil, sdkerrors.Wrap(err, "proposals")
+		}
+		genesisState.Proposals = append(genesisState.Proposals, proposals...)
+	}
+
+	for _, proposal := range genesisState.Proposals {
+		voteIt, err := s.voteByProposalIndex.Get(ctx, proposal.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+		var votes []*group.Vote
+		if _, err := orm.ReadAll(voteIt, &votes); err != nil {
+			return nil, sdkerrors.Wrap(err, "votes")
+		}
+		genesisState.Votes = append(genesisState.Votes, votes...)
+	}
+
+	return &group.QueryGroupExportResponse{Genesis: genesisState}, nil
+}
+
+// RequiredYesWeight queries the remaining yes weight needed for a proposal to pass given its current tally and the
+// decision policy of its group account. The proposal is tallied against the decision policy of the group account
+// version it was submitted with, so the query fails once the group account has been modified since submission.
+func (s serverImpl) RequiredYesWeight(goCtx context.Context, request *group.QueryRequiredYesWeightRequest) (*group.QueryRequiredYesWeightResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, err
+	}
+	groupAccountInfo, err := s.getGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+
+	// the decision policy is only the one the proposal was submitted with if the group account hasn't been modified
+	if proposal.GroupAccountVersion != groupAccountInfo.Version {
+		return nil, sdkerrors.Wrap(group.ErrModified, "group account was modified")
+	}
+
+	policy := groupAccountInfo.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+
+	required, err := policy.RequiredYesWeight(proposal.VoteState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryRequiredYesWeightResponse{RequiredYesWeight: required}, nil
+}
+
+// TallyResult queries the tally of a proposal. The final tally result is returned for closed proposals, while the tally
+// of proposals open for voting is computed from their recorded votes.
+func (s serverImpl) TallyResult(goCtx context.Context, request *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch proposal.Status {
+	case group.ProposalStatusSubmitted:
+	case group.ProposalStatusClosed:
+		tally := proposal.FinalTallyResult
+		// proposals closed before the final tally result was recorded
+		if tally.YesCount == "" {
+			tally = proposal.VoteState
+		}
+		return &group.QueryTallyResultResponse{
+			Tally:   tally,
+			Passing: proposal.Result == group.ProposalResultAccepted,
+			Final:   true,
+		}, nil
+	default:
+		return &group.QueryTallyResultResponse{Tally: proposal.VoteState, Final: true}, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, err
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group")
+	}
+	snapshot, err := s.getVoteSnapshot(ctx, proposal.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proposal is aborted on execution if it was modified since its submission.
+	if proposal.GroupAccountVersion != accountInfo.Version || (snapshot == nil && electorate.Version != proposal.GroupVersion) {
+		return &group.QueryTallyResultResponse{Tally: proposal.VoteState, Final: true}, nil
+	}
+
+	tally, err := s.tallyVotes(ctx, proposal.ProposalId, snapshot, electorate.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	proposal.VoteState = tally
+
+	result, err := policyResult(ctx, proposal, electorate, accountInfo, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryTallyResultResponse{
+		Tally:   tally,
+		Passing: result.Allow,
+		Final:   result.Final,
+	}, nil
+}
+
+// tallyVotes sums up the recorded votes of a proposal weighted by the vote snapshot, or by the current group membership
+// if snapshot is nil.
+func (s serverImpl) tallyVotes(ctx types.Context, proposalID uint64, snapshot *group.VoteSnapshot, groupID uint64) (group.Tally, error) {
+	tally := group.Tally{
+		YesCount:     "0",
+		NoCount:      "0",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}
+
+	it, err := s.voteByProposalIndex.Get(ctx, proposalID)
+	if err != nil {
+		return group.Tally{}, err
+	}
+	defer it.Close()
+
+	for {
+		var vote group.Vote
+		_, err := it.LoadNext(&vote)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return group.Tally{}, err
+		}
+
+		weight, err := s.getVoterWeight(ctx, snapshot, groupID, vote.Voter)
+		if err != nil {
+			return group.Tally{}, sdkerrors.Wrapf(err, "address: %s", vote.Voter)
+		}
+		if err := tally.Add(vote, weight); err != nil {
+			return group.Tally{}, err
+		}
+	}
+
+	return tally, nil
+}
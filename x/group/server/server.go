@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/regen-network/regen-ledger/orm"
 	servermodule "github.com/regen-network/regen-ledger/types/module/server"
@@ -32,15 +33,19 @@ const (
 	GroupAccountByAdminIndexPrefix byte = 0x23
 
 	// Proposal Table
-	ProposalTablePrefix               byte = 0x30
-	ProposalTableSeqPrefix            byte = 0x31
-	ProposalByGroupAccountIndexPrefix byte = 0x32
-	ProposalByProposerIndexPrefix     byte = 0x33
+	ProposalTablePrefix                     byte = 0x30
+	ProposalTableSeqPrefix                  byte = 0x31
+	ProposalByGroupAccountIndexPrefix       byte = 0x32
+	ProposalByProposerIndexPrefix           byte = 0x33
+	ProposalByGroupAccountStatusIndexPrefix byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Vote Snapshot Table
+	VoteSnapshotTablePrefix byte = 0x50
 )
 
 type serverImpl struct {
@@ -59,20 +64,26 @@ type serverImpl struct {
 	groupMemberByMemberIndex orm.Index
 
 	// Group Account Table
+	// groupAccountSeq is no longer used to derive group account addresses, it
+	// is only kept for genesis compatibility.
 	groupAccountSeq          orm.Sequence
 	groupAccountTable        orm.PrimaryKeyTable
 	groupAccountByGroupIndex orm.Index
 	groupAccountByAdminIndex orm.Index
 
 	// Proposal Table
-	proposalTable               orm.AutoUInt64Table
-	proposalByGroupAccountIndex orm.Index
-	proposalByProposerIndex     orm.Index
+	proposalTable                     orm.AutoUInt64Table
+	proposalByGroupAccountIndex       orm.Index
+	proposalByProposerIndex           orm.Index
+	proposalByGroupAccountStatusIndex orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
+
+	// Vote Snapshot Table
+	voteSnapshotTable orm.PrimaryKeyTable
 }
 
 func newServer(storeKey servermodule.RootModuleKey, accKeeper exported.AccountKeeper, bankKeeper exported.BankKeeper, cdc codec.Codec) serverImpl {
@@ -176,6 +187,17 @@ func newServer(storeKey servermodule.RootModuleKey, accKeeper exported.AccountKe
 	if err != nil {
 		panic(err.Error())
 	}
+	s.proposalByGroupAccountStatusIndex, err = orm.NewIndex(proposalTableBuilder, ProposalByGroupAccountStatusIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		addr, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{groupAccountStatusKey(addr, proposal.Status)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	s.proposalTable = proposalTableBuilder.Build()
 
 	// Vote Table
@@ -201,6 +223,13 @@ func newServer(storeKey servermodule.RootModuleKey, accKeeper exported.AccountKe
 	}
 	s.voteTable = voteTableBuilder.Build()
 
+	// Vote Snapshot Table
+	voteSnapshotTableBuilder, err := orm.NewPrimaryKeyTableBuilder(VoteSnapshotTablePrefix, storeKey, &group.VoteSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	s.voteSnapshotTable = voteSnapshotTableBuilder.Build()
+
 	return s
 }
 
@@ -218,3 +247,9 @@ func RegisterServices(configurator servermodule.Configurator, accountKeeper expo
 	configurator.RequireServer((*basket.MsgServer)(nil))
 	configurator.RequireServer((*data.MsgServer)(nil))
 }
+
+// groupAccountStatusKey returns the proposal index key for the given group
+// account address and proposal status.
+func groupAccountStatusKey(addr sdk.AccAddress, status group.Proposal_Status) []byte {
+	return append(address.MustLengthPrefix(addr), byte(status))
+}
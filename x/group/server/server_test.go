@@ -90,7 +90,7 @@ func TestServer(t *testing.T) {
 
 	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper)
 	ff.SetModules([]module.Module{
-		group.Module{AccountKeeper: accountKeeper},
+		group.Module{AccountKeeper: accountKeeper, BankKeeper: bankKeeper},
 		ecocreditModule,
 		data.Module{},
 	})
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/regen-network/regen-ledger/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	proto "github.com/gogo/protobuf/types"
@@ -14,7 +16,8 @@ import (
 
 func (s *IntegrationTestSuite) TestInitExportGenesis() {
 	require := s.Require()
-	ctx := s.genesisCtx
+	sdkCtx, _ := s.genesisCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
 	cdc := s.fixture.Codec()
 
 	now := time.Now()
@@ -158,6 +161,114 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 
 }
 
+func (s *IntegrationTestSuite) TestGenesisRoundTrip() {
+	require := s.Require()
+	cdc := s.fixture.Codec()
+
+	ecocreditmodule := module.NewModule(s.paramSpace, s.accountKeeper, s.bankKeeper)
+	initGenesis := func(groupGenesis json.RawMessage) types.Context {
+		sdkCtx, _ := s.genesisCtx.CacheContext()
+		ctx := types.Context{Context: sdkCtx}
+		_, err := s.fixture.InitGenesis(ctx.Context, map[string]json.RawMessage{
+			group.ModuleName:     groupGenesis,
+			ecocredit.ModuleName: ecocreditmodule.DefaultGenesis(cdc),
+		})
+		require.NoError(err)
+		return ctx
+	}
+
+	// populate the group state of a new chain through the msg server
+	srcCtx := initGenesis(cdc.MustMarshalJSON(group.NewGenesisState()))
+
+	groupRes, err := s.msgClient.CreateGroup(srcCtx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1", Metadata: []byte("member 2")},
+			{Address: s.addr3.String(), Weight: "2", Metadata: []byte("member 3")},
+		},
+		Metadata: []byte("group metadata"),
+	})
+	require.NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:    s.addr1.String(),
+		GroupId:  groupRes.GroupId,
+		Metadata: []byte("account metadata"),
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold:     "2",
+		Timeout:       proto.Duration{Seconds: 10},
+		VetoThreshold: "1",
+	})
+	require.NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(srcCtx, accountReq)
+	require.NoError(err)
+
+	for _, voter := range []sdk.AccAddress{s.addr2, s.addr3} {
+		proposalReq := &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{voter.String()},
+			Metadata:  []byte("proposal metadata"),
+		}
+		err = proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountRes.Address,
+			ToAddress:   s.addr4.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		require.NoError(err)
+		proposalRes, err := s.msgClient.CreateProposal(srcCtx, proposalReq)
+		require.NoError(err)
+		_, err = s.msgClient.Vote(srcCtx, &group.MsgVote{
+			ProposalId: proposalRes.ProposalId,
+			Voter:      voter.String(),
+			Choice:     group.Choice_CHOICE_NO,
+			Metadata:   []byte("vote metadata"),
+		})
+		require.NoError(err)
+	}
+
+	exported, err := s.fixture.ExportGenesis(srcCtx.Context)
+	require.NoError(err)
+
+	var exportedGenesisState group.GenesisState
+	require.NoError(cdc.UnmarshalJSON(exported[group.ModuleName], &exportedGenesisState))
+	require.NoError(exportedGenesisState.Validate())
+	require.Len(exportedGenesisState.Groups, 1)
+	require.Len(exportedGenesisState.GroupMembers, 2)
+	require.Len(exportedGenesisState.GroupAccounts, 1)
+	require.Len(exportedGenesisState.Proposals, 2)
+	require.Len(exportedGenesisState.Votes, 2)
+	require.Len(exportedGenesisState.VoteSnapshots, 2)
+
+	// import the exported state into another new chain and export it again
+	dstCtx := initGenesis(exported[group.ModuleName])
+	reexported, err := s.fixture.ExportGenesis(dstCtx.Context)
+	require.NoError(err)
+	require.JSONEq(string(exported[group.ModuleName]), string(reexported[group.ModuleName]))
+
+	// the decision policy and proposal messages are unpacked after import
+	accountInfoRes, err := s.queryClient.GroupAccountInfo(dstCtx, &group.QueryGroupAccountInfoRequest{
+		Address: accountRes.Address,
+	})
+	require.NoError(err)
+	s.assertGroupAccountsEqual(exportedGenesisState.GroupAccounts[0], accountInfoRes.Info)
+
+	for _, p := range exportedGenesisState.Proposals {
+		proposalRes, err := s.queryClient.Proposal(dstCtx, &group.QueryProposalRequest{
+			ProposalId: p.ProposalId,
+		})
+		require.NoError(err)
+		s.assertProposalsEqual(p, proposalRes.Proposal)
+	}
+
+	// the sequences continue from the imported values
+	nextGroupRes, err := s.msgClient.CreateGroup(dstCtx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	require.NoError(err)
+	require.Equal(exportedGenesisState.GroupSeq+1, nextGroupRes.GroupId)
+}
+
 func (s *IntegrationTestSuite) assertGroupAccountsEqual(g *group.GroupAccountInfo, other *group.GroupAccountInfo) {
 	require := s.Require()
 	require.Equal(g.Address, other.Address)
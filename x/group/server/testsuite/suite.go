@@ -8,7 +8,9 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
@@ -724,7 +726,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
 		Admin:    s.addr1.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: s.addr1.String(), Weight: "1"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
@@ -756,6 +758,7 @@ func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 				"10",
 				gogotypes.Duration{Seconds: 1},
 			),
+			expErr: true,
 		},
 		"group id does not exists": {
 			req: &group.MsgCreateGroupAccount{
@@ -824,6 +827,195 @@ func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCreateGroupWithPolicy() {
+	members := []group.Member{
+		{Address: s.addr5.String(), Weight: "1"},
+		{Address: s.addr6.String(), Weight: "2"},
+	}
+
+	specs := map[string]struct {
+		req    *group.MsgCreateGroupWithPolicy
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		"all good": {
+			req: &group.MsgCreateGroupWithPolicy{
+				Admin:   s.addr1.String(),
+				Members: members,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"3",
+				gogotypes.Duration{Seconds: 1},
+			),
+		},
+		"all good with metadata": {
+			req: &group.MsgCreateGroupWithPolicy{
+				Admin:                s.addr1.String(),
+				Members:              members,
+				GroupMetadata:        []byte("group"),
+				GroupAccountMetadata: []byte("group account"),
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"1",
+				gogotypes.Duration{Seconds: 1},
+			),
+		},
+		"group metadata too long": {
+			req: &group.MsgCreateGroupWithPolicy{
+				Admin:         s.addr1.String(),
+				Members:       members,
+				GroupMetadata: []byte(strings.Repeat("a", 256)),
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"1",
+				gogotypes.Duration{Seconds: 1},
+			),
+			expErr: true,
+		},
+		"group account metadata too long": {
+			req: &group.MsgCreateGroupWithPolicy{
+				Admin:                s.addr1.String(),
+				Members:              members,
+				GroupAccountMetadata: []byte(strings.Repeat("a", 256)),
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"1",
+				gogotypes.Duration{Seconds: 1},
+			),
+			expErr: true,
+		},
+		"decision policy threshold > total group weight": {
+			req: &group.MsgCreateGroupWithPolicy{
+				Admin:   s.addr1.String(),
+				Members: members,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"4",
+				gogotypes.Duration{Seconds: 1},
+			),
+			expErr: true,
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			err := spec.req.SetDecisionPolicy(spec.policy)
+			s.Require().NoError(err)
+
+			groupsRes, err := s.queryClient.GroupsByAdmin(s.ctx, &group.QueryGroupsByAdminRequest{Admin: spec.req.Admin})
+			s.Require().NoError(err)
+			groupsBefore := len(groupsRes.Groups)
+
+			res, err := s.msgClient.CreateGroupWithPolicy(s.ctx, spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+
+				// then no group was created
+				groupsRes, err := s.queryClient.GroupsByAdmin(s.ctx, &group.QueryGroupsByAdminRequest{Admin: spec.req.Admin})
+				s.Require().NoError(err)
+				s.Assert().Len(groupsRes.Groups, groupsBefore)
+				return
+			}
+			s.Require().NoError(err)
+
+			// then the group is persisted
+			groupRes, err := s.queryClient.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: res.GroupId})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.req.Admin, groupRes.Info.Admin)
+			s.Assert().Equal(spec.req.GroupMetadata, groupRes.Info.Metadata)
+			s.Assert().Equal("3", groupRes.Info.TotalWeight)
+
+			// and the group account is persisted
+			groupAccountRes, err := s.queryClient.GroupAccountInfo(s.ctx, &group.QueryGroupAccountInfoRequest{Address: res.GroupAccountAddress})
+			s.Require().NoError(err)
+
+			groupAccount := groupAccountRes.Info
+			s.Assert().Equal(res.GroupAccountAddress, groupAccount.Address)
+			s.Assert().Equal(res.GroupId, groupAccount.GroupId)
+			s.Assert().Equal(spec.req.Admin, groupAccount.Admin)
+			s.Assert().Equal(spec.req.GroupAccountMetadata, groupAccount.Metadata)
+			s.Assert().Equal(uint64(1), groupAccount.Version)
+			s.Assert().Equal(spec.policy.(*group.ThresholdDecisionPolicy), groupAccount.GetDecisionPolicy())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGroupAccountAddress() {
+	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr1.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	myGroupID := groupRes.GroupId
+
+	_, err = s.queryClient.GroupAccountAddress(s.ctx, &group.QueryGroupAccountAddressRequest{GroupId: 9999})
+	s.Require().Error(err)
+
+	addrRes, err := s.queryClient.GroupAccountAddress(s.ctx, &group.QueryGroupAccountAddressRequest{GroupId: myGroupID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.DeriveGroupAccountAddress(myGroupID, addrRes.Salt).String(), addrRes.Address)
+
+	// fund the group account ahead of its creation
+	addr, err := sdk.AccAddressFromBech32(addrRes.Address)
+	s.Require().NoError(err)
+	coins := sdk.Coins{sdk.NewInt64Coin("test", 100)}
+	s.Require().NoError(fundAccount(s.bankKeeper, s.sdkCtx, addr, coins))
+
+	fundedRes, err := s.queryClient.GroupAccountAddress(s.ctx, &group.QueryGroupAccountAddressRequest{GroupId: myGroupID})
+	s.Require().NoError(err)
+	s.Assert().Equal(addrRes, fundedRes)
+
+	// creating a group account for another group doesn't change the address
+	otherGroupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr1.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	otherReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: otherGroupRes.GroupId,
+	}
+	err = otherReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		"1",
+		gogotypes.Duration{Seconds: 1},
+	))
+	s.Require().NoError(err)
+	_, err = s.msgClient.CreateGroupAccount(s.ctx, otherReq)
+	s.Require().NoError(err)
+
+	unchangedRes, err := s.queryClient.GroupAccountAddress(s.ctx, &group.QueryGroupAccountAddressRequest{GroupId: myGroupID})
+	s.Require().NoError(err)
+	s.Assert().Equal(addrRes, unchangedRes)
+
+	req := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: myGroupID,
+	}
+	err = req.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		"1",
+		gogotypes.Duration{Seconds: 1},
+	))
+	s.Require().NoError(err)
+	res, err := s.msgClient.CreateGroupAccount(s.ctx, req)
+	s.Require().NoError(err)
+	s.Assert().Equal(addrRes.Address, res.Address)
+
+	// then the group account was created at the funded address
+	infoRes, err := s.queryClient.GroupAccountInfo(s.ctx, &group.QueryGroupAccountInfoRequest{Address: res.Address})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.GroupAccountDerivationKey(myGroupID, addrRes.Salt), infoRes.Info.DerivationKey)
+	s.Assert().Equal(coins, s.bankKeeper.GetAllBalances(s.sdkCtx, addr))
+	_, ok := s.accountKeeper.GetAccount(s.sdkCtx, addr).(*authtypes.ModuleAccount)
+	s.Assert().True(ok)
+
+	// and the next address differs
+	nextRes, err := s.queryClient.GroupAccountAddress(s.ctx, &group.QueryGroupAccountAddressRequest{GroupId: myGroupID})
+	s.Require().NoError(err)
+	s.Assert().NotEqual(addrRes.Address, nextRes.Address)
+	s.Assert().Greater(nextRes.Salt, addrRes.Salt)
+}
+
 func (s *IntegrationTestSuite) TestUpdateGroupAccountAdmin() {
 	admin, newAdmin := s.addr1, s.addr2
 	groupAccountAddr, myGroupID, policy, derivationKey := createGroupAndGroupAccount(admin, s)
@@ -983,6 +1175,82 @@ func (s *IntegrationTestSuite) TestUpdateGroupAccountMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateGroupAccountDecisionPolicyMetadata() {
+	admin := s.addr1
+	groupAccountAddr, myGroupID, policy, derivationKey := createGroupAndGroupAccount(admin, s)
+
+	specs := map[string]struct {
+		req             *group.MsgUpdateGroupAccountDecisionPolicyMetadata
+		expGroupAccount *group.GroupAccountInfo
+		expErr          bool
+	}{
+		"with wrong admin": {
+			req: &group.MsgUpdateGroupAccountDecisionPolicyMetadata{
+				Admin:    s.addr5.String(),
+				Address:  groupAccountAddr,
+				Metadata: []byte("hello"),
+			},
+			expGroupAccount: &group.GroupAccountInfo{},
+			expErr:          true,
+		},
+		"with wrong group account": {
+			req: &group.MsgUpdateGroupAccountDecisionPolicyMetadata{
+				Admin:    admin.String(),
+				Address:  s.addr5.String(),
+				Metadata: []byte("hello"),
+			},
+			expGroupAccount: &group.GroupAccountInfo{},
+			expErr:          true,
+		},
+		"with metadata too long": {
+			req: &group.MsgUpdateGroupAccountDecisionPolicyMetadata{
+				Admin:    admin.String(),
+				Address:  groupAccountAddr,
+				Metadata: []byte(strings.Repeat("a", 256)),
+			},
+			expGroupAccount: &group.GroupAccountInfo{},
+			expErr:          true,
+		},
+		"correct data": {
+			req: &group.MsgUpdateGroupAccountDecisionPolicyMetadata{
+				Admin:    admin.String(),
+				Address:  groupAccountAddr,
+				Metadata: []byte("majority of members"),
+			},
+			expGroupAccount: &group.GroupAccountInfo{
+				Admin:                  admin.String(),
+				Address:                groupAccountAddr,
+				GroupId:                myGroupID,
+				Version:                1,
+				DecisionPolicy:         nil,
+				DerivationKey:          derivationKey,
+				DecisionPolicyMetadata: []byte("majority of members"),
+			},
+			expErr: false,
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		err := spec.expGroupAccount.SetDecisionPolicy(policy)
+		s.Require().NoError(err)
+
+		s.Run(msg, func() {
+			_, err := s.msgClient.UpdateGroupAccountDecisionPolicyMetadata(s.ctx, spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			res, err := s.queryClient.GroupAccountInfo(s.ctx, &group.QueryGroupAccountInfoRequest{
+				Address: groupAccountAddr,
+			})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expGroupAccount, res.Info)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestUpdateGroupAccountDecisionPolicy() {
 	admin := s.addr1
 	groupAccountAddr, myGroupID, policy, derivationKey := createGroupAndGroupAccount(admin, s)
@@ -1011,6 +1279,18 @@ func (s *IntegrationTestSuite) TestUpdateGroupAccountDecisionPolicy() {
 			expGroupAccount: &group.GroupAccountInfo{},
 			expErr:          true,
 		},
+		"decision policy threshold > total group weight": {
+			req: &group.MsgUpdateGroupAccountDecisionPolicy{
+				Admin:   admin.String(),
+				Address: groupAccountAddr,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"3",
+				gogotypes.Duration{Seconds: 1},
+			),
+			expGroupAccount: &group.GroupAccountInfo{},
+			expErr:          true,
+		},
 		"correct data": {
 			req: &group.MsgUpdateGroupAccountDecisionPolicy{
 				Admin:   admin.String(),
@@ -1061,7 +1341,7 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 	admin := s.addr2
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
 		Admin:    admin.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: admin.String(), Weight: "10"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
@@ -1142,7 +1422,6 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 }
 
 func (s *IntegrationTestSuite) TestCreateProposal() {
-	myGroupID := s.groupID
 	accountAddr := s.groupAccountAddr
 
 	msgSend := &banktypes.MsgSend{
@@ -1151,20 +1430,33 @@ func (s *IntegrationTestSuite) TestCreateProposal() {
 		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
 	}
 
+	// The threshold is reachable when the group account is created but
+	// becomes unreachable once the member weight is lowered.
+	bigGroupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "100"}},
+	})
+	s.Require().NoError(err)
 	accountReq := &group.MsgCreateGroupAccount{
 		Admin:    s.addr1.String(),
-		GroupId:  myGroupID,
+		GroupId:  bigGroupRes.GroupId,
 		Metadata: nil,
 	}
 	policy := group.NewThresholdDecisionPolicy(
 		"100",
 		gogotypes.Duration{Seconds: 1},
 	)
-	err := accountReq.SetDecisionPolicy(policy)
+	err = accountReq.SetDecisionPolicy(policy)
 	s.Require().NoError(err)
 	bigThresholdRes, err := s.msgClient.CreateGroupAccount(s.ctx, accountReq)
 	s.Require().NoError(err)
 	bigThresholdAddr := bigThresholdRes.Address
+	_, err = s.msgClient.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addr1.String(),
+		GroupId:       bigGroupRes.GroupId,
+		MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "2"}},
+	})
+	s.Require().NoError(err)
 
 	defaultProposal := group.Proposal{
 		Status: group.ProposalStatusSubmitted,
@@ -1272,109 +1564,911 @@ func (s *IntegrationTestSuite) TestCreateProposal() {
 			expErr:  true,
 			postRun: func(sdkCtx sdk.Context) {},
 		},
-		"reject msgs that are not authz by group account": {
-			req: &group.MsgCreateProposal{
-				Address:   accountAddr.String(),
-				Metadata:  nil,
-				Proposers: []string{s.addr2.String()},
-			},
-			msgs:    []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.addr1}}},
-			expErr:  true,
-			postRun: func(sdkCtx sdk.Context) {},
+		"reject msgs that are not authz by group account": {
+			req: &group.MsgCreateProposal{
+				Address:   accountAddr.String(),
+				Metadata:  nil,
+				Proposers: []string{s.addr2.String()},
+			},
+			msgs:    []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.addr1}}},
+			expErr:  true,
+			postRun: func(sdkCtx sdk.Context) {},
+		},
+		"with try exec": {
+			req: &group.MsgCreateProposal{
+				Address:   accountAddr.String(),
+				Proposers: []string{s.addr2.String()},
+				Exec:      group.Exec_EXEC_TRY,
+			},
+			msgs: []sdk.Msg{msgSend},
+			expProposal: group.Proposal{
+				Status: group.ProposalStatusClosed,
+				Result: group.ProposalResultAccepted,
+				VoteState: group.Tally{
+					YesCount:     "2",
+					NoCount:      "0",
+					AbstainCount: "0",
+					VetoCount:    "0",
+				},
+				ExecutorResult: group.ProposalExecutorResultSuccess,
+			},
+			postRun: func(sdkCtx sdk.Context) {
+				fromBalances := s.bankKeeper.GetAllBalances(sdkCtx, accountAddr)
+				s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 9900)}, fromBalances)
+				toBalances := s.bankKeeper.GetAllBalances(sdkCtx, s.addr2)
+				s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 100)}, toBalances)
+			},
+		},
+		"with try exec, not enough yes votes for proposal to pass": {
+			req: &group.MsgCreateProposal{
+				Address:   accountAddr.String(),
+				Proposers: []string{s.addr5.String()},
+				Exec:      group.Exec_EXEC_TRY,
+			},
+			msgs: []sdk.Msg{msgSend},
+			expProposal: group.Proposal{
+				Status: group.ProposalStatusSubmitted,
+				Result: group.ProposalResultUnfinalized,
+				VoteState: group.Tally{
+					YesCount:     "1",
+					NoCount:      "0",
+					AbstainCount: "0",
+					VetoCount:    "0",
+				},
+				ExecutorResult: group.ProposalExecutorResultNotRun,
+			},
+			postRun: func(sdkCtx sdk.Context) {},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			err := spec.req.SetMsgs(spec.msgs)
+			s.Require().NoError(err)
+
+			res, err := s.msgClient.CreateProposal(s.ctx, spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			id := res.ProposalId
+
+			// then all data persisted
+			proposalRes, err := s.queryClient.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
+			s.Require().NoError(err)
+			proposal := proposalRes.Proposal
+
+			s.Assert().Equal(accountAddr.String(), proposal.Address)
+			s.Assert().Equal(spec.req.Metadata, proposal.Metadata)
+			s.Assert().Equal(spec.req.Proposers, proposal.Proposers)
+
+			submittedAt, err := gogotypes.TimestampFromProto(&proposal.SubmittedAt)
+			s.Require().NoError(err)
+			s.Assert().Equal(s.blockTime, submittedAt)
+
+			s.Assert().Equal(uint64(1), proposal.GroupVersion)
+			s.Assert().Equal(uint64(1), proposal.GroupAccountVersion)
+			s.Assert().Equal(spec.expProposal.Status, proposal.Status)
+			s.Assert().Equal(spec.expProposal.Result, proposal.Result)
+			s.Assert().Equal(spec.expProposal.VoteState, proposal.VoteState)
+			s.Assert().Equal(spec.expProposal.ExecutorResult, proposal.ExecutorResult)
+
+			timeout, err := gogotypes.TimestampFromProto(&proposal.Timeout)
+			s.Require().NoError(err)
+			s.Assert().Equal(s.blockTime.Add(time.Second), timeout)
+
+			if spec.msgs == nil { // then empty list is ok
+				s.Assert().Len(proposal.GetMsgs(), 0)
+			} else {
+				s.Assert().Equal(spec.msgs, proposal.GetMsgs())
+			}
+
+			spec.postRun(s.sdkCtx)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestProposalProposers() {
+	proposers := []string{s.addr2.String(), s.addr5.String()}
+	res, err := s.msgClient.CreateProposal(s.ctx, &group.MsgCreateProposal{
+		Address:   s.groupAccountAddr.String(),
+		Proposers: proposers,
+	})
+	s.Require().NoError(err)
+
+	proposersRes, err := s.queryClient.ProposalProposers(s.ctx, &group.QueryProposalProposersRequest{
+		ProposalId: res.ProposalId,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(proposers, proposersRes.Proposers)
+
+	// unknown proposal
+	_, err = s.queryClient.ProposalProposers(s.ctx, &group.QueryProposalProposersRequest{
+		ProposalId: res.ProposalId + 1000,
+	})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "not found")
+}
+
+func (s *IntegrationTestSuite) TestGroupParticipation() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	// create a group with a total weight of 4
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	// create proposals with a participation of 1, 0.25, 0.75 and 0
+	voters := [][]string{
+		{s.addr3.String(), s.addr4.String()},
+		{s.addr3.String()},
+		{s.addr4.String()},
+		{},
+	}
+	for _, proposalVoters := range voters {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr3.String()},
+		})
+		s.Require().NoError(err)
+		for _, voter := range proposalVoters {
+			_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+				ProposalId: res.ProposalId,
+				Voter:      voter,
+				Choice:     group.Choice_CHOICE_YES,
+			})
+			s.Require().NoError(err)
+		}
+	}
+
+	// create a proposal with a participation of 1 in a second group account
+	accountRes2, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+		Address:   accountRes2.Address,
+		Proposers: []string{s.addr3.String()},
+	})
+	s.Require().NoError(err)
+	for _, voter := range []string{s.addr3.String(), s.addr4.String()} {
+		_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      voter,
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+	}
+
+	specs := map[string]struct {
+		groupID          uint64
+		window           uint64
+		expErr           bool
+		expRate          string
+		expProposalCount uint64
+	}{
+		"all proposals": {
+			groupID:          groupRes.GroupId,
+			window:           5,
+			expRate:          "0.6",
+			expProposalCount: 5,
+		},
+		"most recent proposals across group accounts": {
+			groupID:          groupRes.GroupId,
+			window:           2,
+			expRate:          "0.5",
+			expProposalCount: 2,
+		},
+		"window larger than the number of proposals": {
+			groupID:          groupRes.GroupId,
+			window:           10,
+			expRate:          "0.6",
+			expProposalCount: 5,
+		},
+		"window required": {
+			groupID: groupRes.GroupId,
+			expErr:  true,
+		},
+		"window exceeds the maximum": {
+			groupID: groupRes.GroupId,
+			window:  group.MaxParticipationWindow + 1,
+			expErr:  true,
+		},
+		"unknown group": {
+			groupID: 9999,
+			window:  4,
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			res, err := s.queryClient.GroupParticipation(ctx, &group.QueryGroupParticipationRequest{
+				GroupId: spec.groupID,
+				Window:  spec.window,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expRate, res.ParticipationRate)
+			s.Assert().Equal(spec.expProposalCount, res.ProposalCount)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestProposalFull() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	policy := group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 1})
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+		Address:   accountRes.Address,
+		Proposers: []string{s.addr3.String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr3.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr4.String(),
+		Choice:     group.Choice_CHOICE_NO,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.ProposalFull(ctx, &group.QueryProposalFullRequest{
+		ProposalId: proposalRes.ProposalId,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	s.Require().NotNil(res.Proposal)
+	s.Assert().Equal(proposalRes.ProposalId, res.Proposal.ProposalId)
+	s.Assert().Equal(accountRes.Address, res.Proposal.Address)
+
+	s.Require().NotNil(res.DecisionPolicy)
+	s.Assert().Equal(policy, res.DecisionPolicy.GetCachedValue())
+
+	s.Assert().Equal(group.Tally{YesCount: "1", NoCount: "3", AbstainCount: "0", VetoCount: "0"}, res.Tally)
+
+	s.Require().Len(res.Votes, 1)
+	s.Assert().Equal(proposalRes.ProposalId, res.Votes[0].ProposalId)
+	s.Require().NotNil(res.Pagination)
+	s.Assert().Equal(uint64(2), res.Pagination.Total)
+
+	// unknown proposal
+	_, err = s.queryClient.ProposalFull(ctx, &group.QueryProposalFullRequest{ProposalId: 9999})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "not found")
+}
+
+func (s *IntegrationTestSuite) TestRequiredYesWeight() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "2"},
+			{Address: s.addr5.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+		Address:   accountRes.Address,
+		Proposers: []string{s.addr3.String()},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.RequiredYesWeight(ctx, &group.QueryRequiredYesWeightRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Assert().Equal("4", res.RequiredYesWeight)
+
+	// mid tally
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr3.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr4.String(),
+		Choice:     group.Choice_CHOICE_NO,
+	})
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.RequiredYesWeight(ctx, &group.QueryRequiredYesWeightRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", res.RequiredYesWeight)
+
+	// passing
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr5.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.RequiredYesWeight(ctx, &group.QueryRequiredYesWeightRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Assert().Equal("0", res.RequiredYesWeight)
+
+	// the decision policy of the group account is updated after submission
+	policyReq := &group.MsgUpdateGroupAccountDecisionPolicy{
+		Admin:   s.addr1.String(),
+		Address: accountRes.Address,
+	}
+	err = policyReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("6", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	_, err = s.msgClient.UpdateGroupAccountDecisionPolicy(ctx, policyReq)
+	s.Require().NoError(err)
+
+	_, err = s.queryClient.RequiredYesWeight(ctx, &group.QueryRequiredYesWeightRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "group account was modified")
+
+	// unknown proposal
+	_, err = s.queryClient.RequiredYesWeight(ctx, &group.QueryRequiredYesWeightRequest{ProposalId: 9999})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "not found")
+}
+
+func (s *IntegrationTestSuite) TestTallyResult() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		"4",
+		gogotypes.Duration{Seconds: 100},
+	))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() uint64 {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr4.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter string) {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+	}
+	tallyResult := func(proposalID uint64) *group.QueryTallyResultResponse {
+		res, err := s.queryClient.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res
+	}
+
+	_, err = s.queryClient.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: 9999})
+	s.Require().Error(err)
+
+	// open proposals are tallied from their votes
+	proposalID := createProposal()
+	res := tallyResult(proposalID)
+	s.Assert().Equal("0", res.Tally.YesCount)
+	s.Assert().False(res.Passing)
+	s.Assert().False(res.Final)
+
+	vote(proposalID, s.addr3.String())
+	res = tallyResult(proposalID)
+	s.Assert().Equal("1", res.Tally.YesCount)
+	s.Assert().Equal("0", res.Tally.NoCount)
+	s.Assert().False(res.Passing)
+	s.Assert().False(res.Final)
+
+	// the vote snapshot weights are used after a membership change
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addr1.String(),
+		GroupId:       groupRes.GroupId,
+		MemberUpdates: []group.Member{{Address: s.addr3.String(), Weight: "2"}},
+	})
+	s.Require().NoError(err)
+	res = tallyResult(proposalID)
+	s.Assert().Equal("1", res.Tally.YesCount)
+
+	// closed proposals return their final tally result
+	vote(proposalID, s.addr4.String())
+	proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusClosed, proposalRes.Proposal.Status)
+	res = tallyResult(proposalID)
+	s.Assert().Equal(proposalRes.Proposal.FinalTallyResult, res.Tally)
+	s.Assert().Equal("4", res.Tally.YesCount)
+	s.Assert().True(res.Passing)
+	s.Assert().True(res.Final)
+
+	// proposals of a modified group account can't pass anymore
+	proposalID = createProposal()
+	_, err = s.msgClient.UpdateGroupAccountMetadata(ctx, &group.MsgUpdateGroupAccountMetadata{
+		Admin:    s.addr1.String(),
+		Address:  accountRes.Address,
+		Metadata: []byte("updated"),
+	})
+	s.Require().NoError(err)
+	res = tallyResult(proposalID)
+	s.Assert().False(res.Passing)
+	s.Assert().True(res.Final)
+}
+
+func (s *IntegrationTestSuite) TestGroupExport() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	proposalReq := &group.MsgCreateProposal{
+		Address:   accountRes.Address,
+		Proposers: []string{s.addr3.String()},
+	}
+	err = proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: accountRes.Address,
+		ToAddress:   s.addr5.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}})
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, proposalReq)
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr3.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.GroupExport(ctx, &group.QueryGroupExportRequest{GroupId: groupRes.GroupId})
+	s.Require().NoError(err)
+
+	// only the state of the requested group is exported
+	genesis := res.Genesis
+	s.Require().Len(genesis.Groups, 1)
+	s.Assert().Equal(groupRes.GroupId, genesis.Groups[0].GroupId)
+	s.Require().Len(genesis.GroupMembers, 2)
+	for _, member := range genesis.GroupMembers {
+		s.Assert().Equal(groupRes.GroupId, member.GroupId)
+	}
+	s.Require().Len(genesis.GroupAccounts, 1)
+	s.Assert().Equal(accountRes.Address, genesis.GroupAccounts[0].Address)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Assert().Equal(proposalRes.ProposalId, genesis.Proposals[0].ProposalId)
+	s.Require().Len(genesis.Votes, 1)
+	s.Assert().Equal(s.addr3.String(), genesis.Votes[0].Voter)
+
+	// the exported state round-trips through the genesis JSON format
+	cdc := s.fixture.Codec()
+	bz, err := cdc.MarshalJSON(genesis)
+	s.Require().NoError(err)
+	var imported group.GenesisState
+	s.Require().NoError(cdc.UnmarshalJSON(bz, &imported))
+	s.Require().NoError(imported.Validate())
+	reexported, err := cdc.MarshalJSON(&imported)
+	s.Require().NoError(err)
+	s.Assert().JSONEq(string(bz), string(reexported))
+	s.Require().Len(imported.Proposals, 1)
+	msgs := imported.Proposals[0].GetMsgs()
+	s.Require().Len(msgs, 1)
+	s.Assert().Equal(accountRes.Address, msgs[0].(*banktypes.MsgSend).FromAddress)
+
+	// unknown group
+	_, err = s.queryClient.GroupExport(ctx, &group.QueryGroupExportRequest{GroupId: 9999})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestVoteGracePeriod() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold:       "4",
+		Timeout:         gogotypes.Duration{Seconds: 1},
+		VoteGracePeriod: gogotypes.Duration{Seconds: 2},
+	})
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() uint64 {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr3.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(blockTime time.Time, proposalID uint64, voter string) error {
+		_, err := s.msgClient.Vote(types.Context{Context: ctx.WithBlockTime(blockTime)}, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		return err
+	}
+
+	// votes within the grace period are accepted and counted
+	proposalID := createProposal()
+	s.Require().NoError(vote(s.blockTime.Add(time.Second), proposalID, s.addr3.String()))
+	proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposalRes.Proposal.Status)
+
+	s.Require().NoError(vote(s.blockTime.Add(2*time.Second), proposalID, s.addr4.String()))
+	proposalRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, proposalRes.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposalRes.Proposal.Result)
+	s.Assert().Equal("4", proposalRes.Proposal.VoteState.YesCount)
+
+	// votes at or beyond the end of the grace period are rejected
+	proposalID = createProposal()
+	err = vote(s.blockTime.Add(3*time.Second), proposalID, s.addr3.String())
+	s.Require().ErrorIs(err, group.ErrExpired)
+	err = vote(s.blockTime.Add(4*time.Second), proposalID, s.addr3.String())
+	s.Require().ErrorIs(err, group.ErrExpired)
+}
+
+func (s *IntegrationTestSuite) TestVoteVeto() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold:     "3",
+		Timeout:       gogotypes.Duration{Seconds: 1},
+		VetoThreshold: "0.5",
+	})
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+		Address:   accountRes.Address,
+		Proposers: []string{s.addr4.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// a veto above the veto threshold rejects the proposal even though the
+	// remaining yes votes could still reach the threshold
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      s.addr3.String(),
+		Choice:     group.Choice_CHOICE_VETO,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	proposal := res.Proposal
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+	s.Assert().Equal(group.ProposalReasonVetoed, proposal.Reason)
+	s.Assert().Equal("1", proposal.FinalTallyResult.VetoCount)
+
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      s.addr4.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestProposalsByGroupAccountStatus() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr3.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() uint64 {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr3.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	closedID := createProposal()
+	submittedID := createProposal()
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+		ProposalId: closedID,
+		Voter:      s.addr3.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().NoError(err)
+
+	specs := map[string]struct {
+		status group.Proposal_Status
+		expIDs []uint64
+	}{
+		"unspecified status returns all proposals": {
+			status: group.ProposalStatusInvalid,
+			expIDs: []uint64{closedID, submittedID},
+		},
+		"submitted": {
+			status: group.ProposalStatusSubmitted,
+			expIDs: []uint64{submittedID},
 		},
-		"with try exec": {
-			req: &group.MsgCreateProposal{
-				Address:   accountAddr.String(),
-				Proposers: []string{s.addr2.String()},
-				Exec:      group.Exec_EXEC_TRY,
-			},
-			msgs: []sdk.Msg{msgSend},
-			expProposal: group.Proposal{
-				Status: group.ProposalStatusClosed,
-				Result: group.ProposalResultAccepted,
-				VoteState: group.Tally{
-					YesCount:     "2",
-					NoCount:      "0",
-					AbstainCount: "0",
-					VetoCount:    "0",
-				},
-				ExecutorResult: group.ProposalExecutorResultSuccess,
-			},
-			postRun: func(sdkCtx sdk.Context) {
-				fromBalances := s.bankKeeper.GetAllBalances(sdkCtx, accountAddr)
-				s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 9900)}, fromBalances)
-				toBalances := s.bankKeeper.GetAllBalances(sdkCtx, s.addr2)
-				s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 100)}, toBalances)
-			},
+		"closed": {
+			status: group.ProposalStatusClosed,
+			expIDs: []uint64{closedID},
 		},
-		"with try exec, not enough yes votes for proposal to pass": {
-			req: &group.MsgCreateProposal{
-				Address:   accountAddr.String(),
-				Proposers: []string{s.addr5.String()},
-				Exec:      group.Exec_EXEC_TRY,
-			},
-			msgs: []sdk.Msg{msgSend},
-			expProposal: group.Proposal{
-				Status: group.ProposalStatusSubmitted,
-				Result: group.ProposalResultUnfinalized,
-				VoteState: group.Tally{
-					YesCount:     "1",
-					NoCount:      "0",
-					AbstainCount: "0",
-					VetoCount:    "0",
-				},
-				ExecutorResult: group.ProposalExecutorResultNotRun,
-			},
-			postRun: func(sdkCtx sdk.Context) {},
+		"aborted": {
+			status: group.ProposalStatusAborted,
 		},
 	}
 	for msg, spec := range specs {
 		spec := spec
 		s.Run(msg, func() {
-			err := spec.req.SetMsgs(spec.msgs)
+			res, err := s.queryClient.ProposalsByGroupAccount(ctx, &group.QueryProposalsByGroupAccountRequest{
+				Address: accountRes.Address,
+				Status:  spec.status,
+			})
 			s.Require().NoError(err)
-
-			res, err := s.msgClient.CreateProposal(s.ctx, spec.req)
-			if spec.expErr {
-				s.Require().Error(err)
-				return
+			var ids []uint64
+			for _, p := range res.Proposals {
+				ids = append(ids, p.ProposalId)
 			}
-			s.Require().NoError(err)
-			id := res.ProposalId
+			s.Assert().Equal(spec.expIDs, ids)
+		})
+	}
+}
 
-			// then all data persisted
-			proposalRes, err := s.queryClient.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
-			s.Require().NoError(err)
-			proposal := proposalRes.Proposal
+func (s *IntegrationTestSuite) TestVoteSnapshot() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
 
-			s.Assert().Equal(accountAddr.String(), proposal.Address)
-			s.Assert().Equal(spec.req.Metadata, proposal.Metadata)
-			s.Assert().Equal(spec.req.Proposers, proposal.Proposers)
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "2"},
+			{Address: s.addr4.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
 
-			submittedAt, err := gogotypes.TimestampFromProto(&proposal.SubmittedAt)
-			s.Require().NoError(err)
-			s.Assert().Equal(s.blockTime, submittedAt)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
 
-			s.Assert().Equal(uint64(1), proposal.GroupVersion)
-			s.Assert().Equal(uint64(1), proposal.GroupAccountVersion)
-			s.Assert().Equal(spec.expProposal.Status, proposal.Status)
-			s.Assert().Equal(spec.expProposal.Result, proposal.Result)
-			s.Assert().Equal(spec.expProposal.VoteState, proposal.VoteState)
-			s.Assert().Equal(spec.expProposal.ExecutorResult, proposal.ExecutorResult)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+		Address:   accountRes.Address,
+		Proposers: []string{s.addr3.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
 
-			timeout, err := gogotypes.TimestampFromProto(&proposal.Timeout)
-			s.Require().NoError(err)
-			s.Assert().Equal(s.blockTime.Add(time.Second), timeout)
+	vote := func(voter string) error {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		return err
+	}
 
-			if spec.msgs == nil { // then empty list is ok
-				s.Assert().Len(proposal.GetMsgs(), 0)
-			} else {
-				s.Assert().Equal(spec.msgs, proposal.GetMsgs())
-			}
+	s.Require().NoError(vote(s.addr3.String()))
 
-			spec.postRun(s.sdkCtx)
+	// remove the member that voted yes and add a new member
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+		MemberUpdates: []group.Member{
+			{Address: s.addr3.String(), Weight: "0"},
+			{Address: s.addr5.String(), Weight: "5"},
+		},
+	})
+	s.Require().NoError(err)
+
+	// members added after submission can't vote
+	s.Require().Error(vote(s.addr5.String()))
+
+	// the yes vote of the removed member still counts towards the threshold
+	s.Require().NoError(vote(s.addr4.String()))
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", res.Proposal.VoteState.YesCount)
+	s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestVotesByVoter() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr6.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		"2",
+		gogotypes.Duration{Seconds: 1},
+	))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	// an address that never voted has no votes
+	neverVoted := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	votesRes, err := s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{Voter: neverVoted.String()})
+	s.Require().NoError(err)
+	s.Assert().Empty(votesRes.Votes)
+
+	// votes cast on different proposals are returned page by page
+	voter := s.addr6
+
+	choices := []group.Choice{group.Choice_CHOICE_YES, group.Choice_CHOICE_NO}
+	proposalIDs := make([]uint64, len(choices))
+	for i, choice := range choices {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr3.String()},
 		})
+		s.Require().NoError(err)
+		proposalIDs[i] = res.ProposalId
+
+		_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      voter.String(),
+			Choice:     choice,
+		})
+		s.Require().NoError(err)
 	}
+
+	votesRes, err = s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{
+		Voter:      voter.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes.Votes, 1)
+	s.Assert().Equal(uint64(2), votesRes.Pagination.Total)
+	s.Assert().Equal(proposalIDs[0], votesRes.Votes[0].ProposalId)
+	s.Assert().Equal(choices[0], votesRes.Votes[0].Choice)
+
+	votesRes, err = s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{
+		Voter:      voter.String(),
+		Pagination: &query.PageRequest{Key: votesRes.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes.Votes, 1)
+	s.Assert().Equal(proposalIDs[1], votesRes.Votes[0].ProposalId)
+	s.Assert().Equal(choices[1], votesRes.Votes[0].Choice)
 }
 
 func (s *IntegrationTestSuite) TestVote() {
@@ -1736,8 +2830,16 @@ func (s *IntegrationTestSuite) TestVote() {
 				})
 				s.Require().NoError(err)
 			},
-			expErr:  true,
-			postRun: func(sdkCtx sdk.Context) {},
+			expVoteState: group.Tally{
+				YesCount:     "0",
+				NoCount:      "1",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUnfinalized,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+			postRun:           func(sdkCtx sdk.Context) {},
 		},
 		"with policy modified": {
 			req: &group.MsgVote{
@@ -1866,6 +2968,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 		expErr            bool
 		expProposalStatus group.Proposal_Status
 		expProposalResult group.Proposal_Result
+		expProposalReason group.Proposal_Reason
 		expExecutorResult group.Proposal_ExecutorResult
 		expFromBalances   sdk.Coins
 		expToBalances     sdk.Coins
@@ -1899,6 +3002,17 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			},
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultRejected,
+			expProposalReason: group.ProposalReasonRejected,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"proposal not executed when voting period ended": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
+			},
+			srcBlockTime:      s.blockTime.Add(time.Second),
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultRejected,
+			expProposalReason: group.ProposalReasonVotingPeriodEnded,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
 		"open proposal must not fail": {
@@ -1923,6 +3037,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			srcBlockTime:      s.blockTime.Add(time.Second),
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultRejected,
+			expProposalReason: group.ProposalReasonRejected,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
 		"Decision policy also applied after timeout": {
@@ -1933,6 +3048,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			srcBlockTime:      s.blockTime.Add(time.Second).Add(time.Millisecond),
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultRejected,
+			expProposalReason: group.ProposalReasonRejected,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
 		"with group modified before tally": {
@@ -1948,7 +3064,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 				s.Require().NoError(err)
 				return myProposalID
 			},
-			expProposalStatus: group.ProposalStatusAborted,
+			expProposalStatus: group.ProposalStatusSubmitted,
 			expProposalResult: group.ProposalResultUnfinalized,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
@@ -2035,6 +3151,10 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			got := group.Proposal_Result_name[int32(proposal.Result)]
 			s.Assert().Equal(exp, got)
 
+			exp = group.Proposal_Reason_name[int32(spec.expProposalReason)]
+			got = group.Proposal_Reason_name[int32(proposal.Reason)]
+			s.Assert().Equal(exp, got)
+
 			exp = group.Proposal_Status_name[int32(spec.expProposalStatus)]
 			got = group.Proposal_Status_name[int32(proposal.Status)]
 			s.Assert().Equal(exp, got)
@@ -2043,6 +3163,10 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			got = group.Proposal_ExecutorResult_name[int32(proposal.ExecutorResult)]
 			s.Assert().Equal(exp, got)
 
+			if proposal.Status == group.ProposalStatusClosed {
+				s.Assert().Equal(proposal.VoteState, proposal.FinalTallyResult)
+			}
+
 			if spec.expFromBalances != nil {
 				fromBalances := s.bankKeeper.GetAllBalances(sdkCtx, s.groupAccountAddr)
 				s.Require().Equal(spec.expFromBalances, fromBalances)
@@ -2055,6 +3179,118 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestExecProposalWithPredicate() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addr2.String()}
+
+	createProposalWithPredicate := func(ctx context.Context, predicate *group.ExecPredicate) uint64 {
+		req := &group.MsgCreateProposal{
+			Address:       s.groupAccountAddr.String(),
+			Proposers:     proposers,
+			ExecPredicate: predicate,
+		}
+		s.Require().NoError(req.SetMsgs([]sdk.Msg{msgSend}))
+
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+
+		_, err = s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      proposers[0],
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	specs := map[string]struct {
+		predicate         *group.ExecPredicate
+		fundAmount        int64
+		expErr            string
+		expProposalStatus group.Proposal_Status
+		expExecutorResult group.Proposal_ExecutorResult
+		expSpent          int64
+	}{
+		"proposal executed when predicate holds": {
+			predicate: &group.ExecPredicate{
+				Address:    s.groupAccountAddr.String(),
+				Denom:      "test",
+				Comparison: group.ComparisonGTE,
+				Amount:     "100",
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expSpent:          100,
+		},
+		"proposal execution blocked when predicate fails": {
+			predicate: &group.ExecPredicate{
+				Address:    s.groupAccountAddr.String(),
+				Denom:      "test",
+				Comparison: group.ComparisonGTE,
+				Amount:     "1000000000",
+			},
+			expErr:            "is not COMPARISON_GTE 1000000000test",
+			expProposalStatus: group.ProposalStatusClosed,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"proposal execution blocked when lte predicate fails": {
+			predicate: &group.ExecPredicate{
+				Address:    s.addr2.String(),
+				Denom:      "test",
+				Comparison: group.ComparisonLTE,
+				Amount:     "50",
+			},
+			fundAmount:        100,
+			expErr:            "execution predicate not met",
+			expProposalStatus: group.ProposalStatusClosed,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: sdkCtx}
+
+			if spec.fundAmount != 0 {
+				s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, s.addr2, sdk.Coins{sdk.NewInt64Coin("test", spec.fundAmount)}))
+			}
+
+			balance := s.bankKeeper.GetBalance(sdkCtx, s.groupAccountAddr, "test")
+			proposalID := createProposalWithPredicate(ctx, spec.predicate)
+
+			_, err := s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+			if spec.expErr != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			proposal := res.Proposal
+			s.Require().Equal(spec.predicate, proposal.ExecPredicate)
+
+			exp := group.Proposal_Status_name[int32(spec.expProposalStatus)]
+			got := group.Proposal_Status_name[int32(proposal.Status)]
+			s.Assert().Equal(exp, got)
+
+			exp = group.Proposal_ExecutorResult_name[int32(spec.expExecutorResult)]
+			got = group.Proposal_ExecutorResult_name[int32(proposal.ExecutorResult)]
+			s.Assert().Equal(exp, got)
+
+			// the proposal msgs are not executed when the predicate fails
+			expBalance := balance.SubAmount(sdk.NewInt(spec.expSpent))
+			s.Require().Equal(expBalance, s.bankKeeper.GetBalance(sdkCtx, s.groupAccountAddr, "test"))
+		})
+	}
+}
+
 func createProposal(
 	ctx context.Context, s *IntegrationTestSuite, msgs []sdk.Msg,
 	proposers []string) uint64 {
@@ -2092,7 +3328,7 @@ func createGroupAndGroupAccount(
 ) (string, uint64, group.DecisionPolicy, []byte) {
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
 		Admin:    admin.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: admin.String(), Weight: "2"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
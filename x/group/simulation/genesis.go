@@ -74,13 +74,12 @@ func getGroupAccounts(r *rand.Rand, simState *module.SimulationState) []*group.G
 	return groupAccounts
 }
 
-func getProposals(r *rand.Rand, simState *module.SimulationState) []*group.Proposal {
+func getProposals(r *rand.Rand, simState *module.SimulationState, groupAccounts []*group.GroupAccountInfo) []*group.Proposal {
 	proposals := make([]*group.Proposal, 3)
 	proposers := []string{simState.Accounts[0].Address.String(), simState.Accounts[1].Address.String()}
 	for i := 0; i < 3; i++ {
-		from, _ := simtypes.RandomAcc(r, simState.Accounts)
 		to, _ := simtypes.RandomAcc(r, simState.Accounts)
-		fromAddr := from.Address.String()
+		fromAddr := groupAccounts[i%len(groupAccounts)].Address
 
 		proposal := &group.Proposal{
 			ProposalId:          uint64(i + 1),
@@ -173,7 +172,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var proposals []*group.Proposal
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, GroupProposals, &proposals, simState.Rand,
-		func(r *rand.Rand) { proposals = getProposals(r, simState) },
+		func(r *rand.Rand) { proposals = getProposals(r, simState, groupAccounts) },
 	)
 
 	// votes
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	gogotypes "github.com/gogo/protobuf/types"
@@ -47,6 +48,7 @@ const (
 	OpMsgCreateProposal                   = "op_weight_msg_create_proposal"
 	OpMsgVote                             = "op_weight_msg_vote"
 	OpMsgExec                             = "ops_weight_msg_exec"
+	OpProposalLifecycle                   = "op_weight_proposal_lifecycle"
 )
 
 //  If update group or group account txn's executed, `SimulateMsgVote` & `SimulateMsgExec` txn's returns `noOp`.
@@ -63,6 +65,7 @@ const (
 	WeightUpdateGroupAccountAdmin          = 5
 	WeightUpdateGroupAccountDecisionPolicy = 5
 	WeightUpdateGroupAccountMetadata       = 5
+	WeightProposalLifecycle                = 20
 	GroupMemberWeight                      = 40
 )
 
@@ -82,6 +85,7 @@ func WeightedOperations(
 		weightMsgCreateProposal                   int
 		weightMsgVote                             int
 		weightMsgExec                             int
+		weightProposalLifecycle                   int
 	)
 
 	appParams.GetOrGenerate(cdc, OpMsgCreateGroup, &weightMsgCreateGroup, nil,
@@ -109,6 +113,11 @@ func WeightedOperations(
 			weightMsgExec = WeightMsgExec
 		},
 	)
+	appParams.GetOrGenerate(cdc, OpProposalLifecycle, &weightProposalLifecycle, nil,
+		func(_ *rand.Rand) {
+			weightProposalLifecycle = WeightProposalLifecycle
+		},
+	)
 	appParams.GetOrGenerate(cdc, OpMsgUpdateGroupMetadata, &weightMsgUpdateGroupMetadata, nil,
 		func(_ *rand.Rand) {
 			weightMsgUpdateGroupMetadata = WeightUpdateGroupMetadata
@@ -161,6 +170,10 @@ func WeightedOperations(
 			weightMsgExec,
 			SimulateMsgExec(ak, bk, qryClient, protoCdc),
 		),
+		simulation.NewWeightedOperation(
+			weightProposalLifecycle,
+			SimulateProposalLifecycle(ak, bk, qryClient, protoCdc),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgUpdateGroupMetadata,
 			SimulateMsgUpdateGroupMetadata(ak, bk, qryClient, protoCdc),
@@ -262,14 +275,20 @@ func SimulateMsgCreateGroupAccount(ak exported.AccountKeeper, bk exported.BankKe
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "fail to decode acc address"), nil, err
 		}
 
+		policy := &group.ThresholdDecisionPolicy{
+			Threshold: "20",
+			Timeout:   gogotypes.Duration{Seconds: int64(30 * 24 * 60 * 60)},
+		}
+		opMsg, err := validateGroupPolicy(regentypes.Context{Context: ctx}, qryClient, groupID, policy)
+		if opMsg != "" {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, opMsg), nil, err
+		}
+
 		msg, err := group.NewMsgCreateGroupAccount(
 			addr,
 			groupID,
 			[]byte(simtypes.RandStringOfLength(r, 10)),
-			&group.ThresholdDecisionPolicy{
-				Threshold: "20",
-				Timeout:   gogotypes.Duration{Seconds: int64(30 * 24 * 60 * 60)},
-			},
+			policy,
 		)
 		if err != nil {
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, err.Error()), nil, err
@@ -654,10 +673,16 @@ func SimulateMsgUpdateGroupAccountDecisionPolicy(ak exported.AccountKeeper,
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupAccountDecisionPolicy, fmt.Sprintf("fail to decide bech32 address: %s", err.Error())), nil, nil
 		}
 
-		msg, err := group.NewMsgUpdateGroupAccountDecisionPolicyRequest(adminBech32, groupAccountBech32, &group.ThresholdDecisionPolicy{
+		policy := &group.ThresholdDecisionPolicy{
 			Threshold: fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 20)),
 			Timeout:   gogotypes.Duration{Seconds: int64(simtypes.RandIntBetween(r, 100, 1000))},
-		})
+		}
+		opMsg, err = validateGroupPolicy(ctx, queryClient, groupAccounts[0].GroupId, policy)
+		if opMsg != "" {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupAccountDecisionPolicy, opMsg), nil, err
+		}
+
+		msg, err := group.NewMsgUpdateGroupAccountDecisionPolicyRequest(adminBech32, groupAccountBech32, policy)
 		if err != nil {
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupAccountDecisionPolicy, err.Error()), nil, err
 		}
@@ -919,6 +944,169 @@ func SimulateMsgExec(ak exported.AccountKeeper,
 	}
 }
 
+// SimulateProposalLifecycle creates a group with random members and weights, a group account with a random
+// threshold decision policy and a proposal, then has each member vote until the proposal is closed and finally
+// executes the proposal.
+func SimulateProposalLifecycle(ak exported.AccountKeeper,
+	bk exported.BankKeeper, queryClient group.QueryClient, protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		ctx := regentypes.Context{Context: sdkCtx}
+
+		deliver := func(acc simtypes.Account, msg sdk.Msg, msgType string) (simtypes.OperationMsg, error) {
+			op, _, err := simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+				R:             r,
+				App:           app,
+				TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+				Cdc:           protoCdc,
+				Msg:           msg,
+				MsgType:       msgType,
+				Context:       sdkCtx,
+				SimAccount:    acc,
+				AccountKeeper: ak,
+				Bankkeeper:    bk,
+				ModuleName:    group.ModuleName,
+			})
+			return op, err
+		}
+
+		// pick random members with random weights, the first member being the admin
+		numMembers := simtypes.RandIntBetween(r, 1, min(len(accounts), 5)+1)
+		memberAccs := make([]simtypes.Account, numMembers)
+		members := make([]group.Member, numMembers)
+		totalWeight := 0
+		for i, j := range r.Perm(len(accounts))[:numMembers] {
+			weight := simtypes.RandIntBetween(r, 1, GroupMemberWeight+1)
+			totalWeight += weight
+			memberAccs[i] = accounts[j]
+			members[i] = group.Member{
+				Address:  accounts[j].Address.String(),
+				Weight:   fmt.Sprintf("%d", weight),
+				Metadata: []byte(simtypes.RandStringOfLength(r, 10)),
+			}
+		}
+		admin := memberAccs[0]
+
+		createGroup := &group.MsgCreateGroup{
+			Admin:    admin.Address.String(),
+			Members:  members,
+			Metadata: []byte(simtypes.RandStringOfLength(r, 10)),
+		}
+		if op, err := deliver(admin, createGroup, TypeMsgCreateGroup); !op.OK {
+			return op, nil, err
+		}
+
+		groupID, err := lastGroupIDByAdmin(ctx, queryClient, admin.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroup, "fail to query groups"), nil, err
+		}
+
+		policy := &group.ThresholdDecisionPolicy{
+			Threshold: fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, totalWeight+1)),
+			Timeout:   gogotypes.Duration{Seconds: int64(30 * 24 * 60 * 60)},
+		}
+		createGroupAccount, err := group.NewMsgCreateGroupAccount(
+			admin.Address,
+			groupID,
+			[]byte(simtypes.RandStringOfLength(r, 10)),
+			policy,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, err.Error()), nil, err
+		}
+		if op, err := deliver(admin, createGroupAccount, TypeMsgCreateGroupAccount); !op.OK {
+			return op, nil, err
+		}
+
+		groupAccounts, err := queryClient.GroupAccountsByGroup(ctx, &group.QueryGroupAccountsByGroupRequest{GroupId: groupID})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "fail to query group accounts"), nil, err
+		}
+		if len(groupAccounts.GroupAccounts) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "no group account found"), nil, nil
+		}
+
+		proposer := memberAccs[r.Intn(numMembers)]
+		createProposal := &group.MsgCreateProposal{
+			Address:   groupAccounts.GroupAccounts[0].Address,
+			Proposers: []string{proposer.Address.String()},
+			Metadata:  []byte(simtypes.RandStringOfLength(r, 10)),
+		}
+		if op, err := deliver(proposer, createProposal, TypeMsgCreateProposal); !op.OK {
+			return op, nil, err
+		}
+
+		proposals, err := queryClient.ProposalsByGroupAccount(ctx, &group.QueryProposalsByGroupAccountRequest{Address: createProposal.Address})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateProposal, "fail to query proposals"), nil, err
+		}
+		if len(proposals.Proposals) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateProposal, "no proposals found"), nil, nil
+		}
+		proposalID := proposals.Proposals[0].ProposalId
+
+		choices := []group.Choice{group.Choice_CHOICE_YES, group.Choice_CHOICE_NO, group.Choice_CHOICE_ABSTAIN, group.Choice_CHOICE_VETO}
+		for _, voter := range memberAccs {
+			// a vote reaching the threshold closes the proposal for further votes
+			proposal, err := queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			if err != nil {
+				return simtypes.NoOpMsg(group.ModuleName, TypeMsgVote, "fail to query proposal"), nil, err
+			}
+			if proposal.Proposal.Status != group.ProposalStatusSubmitted {
+				break
+			}
+
+			vote := &group.MsgVote{
+				ProposalId: proposalID,
+				Voter:      voter.Address.String(),
+				Choice:     choices[r.Intn(len(choices))],
+				Metadata:   []byte(simtypes.RandStringOfLength(r, 10)),
+			}
+			if op, err := deliver(voter, vote, TypeMsgVote); !op.OK {
+				return op, nil, err
+			}
+		}
+
+		exec := &group.MsgExec{
+			ProposalId: proposalID,
+			Signer:     admin.Address.String(),
+		}
+		op, err := deliver(admin, exec, TypeMsgExec)
+		return op, nil, err
+	}
+}
+
+// lastGroupIDByAdmin returns the id of the most recently created group of the given admin.
+func lastGroupIDByAdmin(ctx regentypes.Context, qryClient group.QueryClient, admin string) (uint64, error) {
+	var groupID uint64
+	var nextKey []byte
+	for {
+		res, err := qryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{
+			Admin:      admin,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, g := range res.Groups {
+			if g.GroupId > groupID {
+				groupID = g.GroupId
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return groupID, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 func groupAccountsByAdmin(ctx regentypes.Context, qryClient group.QueryClient, admin string) ([]*group.GroupAccountInfo, string, error) {
 	result, err := qryClient.GroupAccountsByAdmin(ctx, &group.QueryGroupAccountsByAdminRequest{Admin: admin})
 	if err != nil {
@@ -931,3 +1119,17 @@ func groupAccountsByAdmin(ctx regentypes.Context, qryClient group.QueryClient, a
 	}
 	return groupAccounts, "", nil
 }
+
+// validateGroupPolicy returns a non-empty message if the decision policy can
+// not be used with the current total weight of the given group.
+func validateGroupPolicy(ctx regentypes.Context, qryClient group.QueryClient, groupID uint64, policy group.DecisionPolicy) (string, error) {
+	result, err := qryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+	if err != nil {
+		return "fail to query group info", err
+	}
+
+	if err := policy.Validate(*result.Info); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
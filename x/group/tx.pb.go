@@ -567,6 +567,111 @@ func (m *MsgCreateGroupAccountResponse) GetAddress() string {
 	return ""
 }
 
+// MsgCreateGroupWithPolicy is the Msg/CreateGroupWithPolicy request type.
+type MsgCreateGroupWithPolicy struct {
+	// admin is the account address of the group and group account admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// members defines the group members.
+	Members []Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// group_metadata is any arbitrary metadata to attached to the group.
+	GroupMetadata []byte `protobuf:"bytes,3,opt,name=group_metadata,json=groupMetadata,proto3" json:"group_metadata,omitempty"`
+	// group_account_metadata is any arbitrary metadata to attached to the group
+	// account.
+	GroupAccountMetadata []byte `protobuf:"bytes,4,opt,name=group_account_metadata,json=groupAccountMetadata,proto3" json:"group_account_metadata,omitempty"`
+	// decision_policy specifies the group account's decision policy.
+	DecisionPolicy *types.Any `protobuf:"bytes,5,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgCreateGroupWithPolicy) Reset()         { *m = MsgCreateGroupWithPolicy{} }
+func (m *MsgCreateGroupWithPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGroupWithPolicy) ProtoMessage()    {}
+func (*MsgCreateGroupWithPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{10}
+}
+func (m *MsgCreateGroupWithPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGroupWithPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGroupWithPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGroupWithPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGroupWithPolicy.Merge(m, src)
+}
+func (m *MsgCreateGroupWithPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGroupWithPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGroupWithPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGroupWithPolicy proto.InternalMessageInfo
+
+// MsgCreateGroupWithPolicyResponse is the Msg/CreateGroupWithPolicy response
+// type.
+type MsgCreateGroupWithPolicyResponse struct {
+	// group_id is the unique ID of the newly created group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// group_account_address is the account address of the newly created group
+	// account.
+	GroupAccountAddress string `protobuf:"bytes,2,opt,name=group_account_address,json=groupAccountAddress,proto3" json:"group_account_address,omitempty"`
+}
+
+func (m *MsgCreateGroupWithPolicyResponse) Reset()         { *m = MsgCreateGroupWithPolicyResponse{} }
+func (m *MsgCreateGroupWithPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGroupWithPolicyResponse) ProtoMessage()    {}
+func (*MsgCreateGroupWithPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{11}
+}
+func (m *MsgCreateGroupWithPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGroupWithPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGroupWithPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGroupWithPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGroupWithPolicyResponse.Merge(m, src)
+}
+func (m *MsgCreateGroupWithPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGroupWithPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGroupWithPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGroupWithPolicyResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGroupWithPolicyResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgCreateGroupWithPolicyResponse) GetGroupAccountAddress() string {
+	if m != nil {
+		return m.GroupAccountAddress
+	}
+	return ""
+}
+
 // MsgUpdateGroupAccountAdmin is the Msg/UpdateGroupAccountAdmin request type.
 type MsgUpdateGroupAccountAdmin struct {
 	// admin is the account address of the group admin.
@@ -581,7 +686,7 @@ func (m *MsgUpdateGroupAccountAdmin) Reset()         { *m = MsgUpdateGroupAccoun
 func (m *MsgUpdateGroupAccountAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountAdmin) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{10}
+	return fileDescriptor_b4673626e7797578, []int{12}
 }
 func (m *MsgUpdateGroupAccountAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +745,7 @@ func (m *MsgUpdateGroupAccountAdminResponse) Reset()         { *m = MsgUpdateGro
 func (m *MsgUpdateGroupAccountAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountAdminResponse) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{11}
+	return fileDescriptor_b4673626e7797578, []int{13}
 }
 func (m *MsgUpdateGroupAccountAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +789,7 @@ func (m *MsgUpdateGroupAccountDecisionPolicy) Reset()         { *m = MsgUpdateGr
 func (m *MsgUpdateGroupAccountDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountDecisionPolicy) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{12}
+	return fileDescriptor_b4673626e7797578, []int{14}
 }
 func (m *MsgUpdateGroupAccountDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +831,7 @@ func (m *MsgUpdateGroupAccountDecisionPolicyResponse) String() string {
 }
 func (*MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMessage() {}
 func (*MsgUpdateGroupAccountDecisionPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{13}
+	return fileDescriptor_b4673626e7797578, []int{15}
 }
 func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +875,7 @@ func (m *MsgUpdateGroupAccountMetadata) Reset()         { *m = MsgUpdateGroupAcc
 func (m *MsgUpdateGroupAccountMetadata) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountMetadata) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{14}
+	return fileDescriptor_b4673626e7797578, []int{16}
 }
 func (m *MsgUpdateGroupAccountMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +934,7 @@ func (m *MsgUpdateGroupAccountMetadataResponse) Reset()         { *m = MsgUpdate
 func (m *MsgUpdateGroupAccountMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountMetadataResponse) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{15}
+	return fileDescriptor_b4673626e7797578, []int{17}
 }
 func (m *MsgUpdateGroupAccountMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,6 +963,117 @@ func (m *MsgUpdateGroupAccountMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupAccountMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupAccountDecisionPolicyMetadata is the
+// Msg/UpdateGroupAccountDecisionPolicyMetadata request type.
+type MsgUpdateGroupAccountDecisionPolicyMetadata struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address is the group account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is the updated decision policy metadata.
+	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) Reset() {
+	*m = MsgUpdateGroupAccountDecisionPolicyMetadata{}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupAccountDecisionPolicyMetadata) ProtoMessage() {}
+func (*MsgUpdateGroupAccountDecisionPolicyMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{18}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadata.Merge(m, src)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// MsgUpdateGroupAccountDecisionPolicyMetadataResponse is the
+// Msg/UpdateGroupAccountDecisionPolicyMetadata response type.
+type MsgUpdateGroupAccountDecisionPolicyMetadataResponse struct {
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Reset() {
+	*m = MsgUpdateGroupAccountDecisionPolicyMetadataResponse{}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse) ProtoMessage() {}
+func (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{19}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyMetadataResponse proto.InternalMessageInfo
+
 // MsgCreateProposal is the Msg/CreateProposal request type.
 type MsgCreateProposal struct {
 	// address is the group account address.
@@ -873,13 +1089,16 @@ type MsgCreateProposal struct {
 	// whether it should be executed immediately on creation or not.
 	// If so, proposers signatures are considered as Yes votes.
 	Exec Exec `protobuf:"varint,5,opt,name=exec,proto3,enum=regen.group.v1alpha1.Exec" json:"exec,omitempty"`
+	// exec_predicate is an optional condition that must hold at execution time
+	// for the proposal msgs to be executed.
+	ExecPredicate *ExecPredicate `protobuf:"bytes,6,opt,name=exec_predicate,json=execPredicate,proto3" json:"exec_predicate,omitempty"`
 }
 
 func (m *MsgCreateProposal) Reset()         { *m = MsgCreateProposal{} }
 func (m *MsgCreateProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateProposal) ProtoMessage()    {}
 func (*MsgCreateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{16}
+	return fileDescriptor_b4673626e7797578, []int{20}
 }
 func (m *MsgCreateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1137,7 @@ func (m *MsgCreateProposalResponse) Reset()         { *m = MsgCreateProposalResp
 func (m *MsgCreateProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateProposalResponse) ProtoMessage()    {}
 func (*MsgCreateProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{17}
+	return fileDescriptor_b4673626e7797578, []int{21}
 }
 func (m *MsgCreateProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1192,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{18}
+	return fileDescriptor_b4673626e7797578, []int{22}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1264,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{19}
+	return fileDescriptor_b4673626e7797578, []int{23}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1305,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{20}
+	return fileDescriptor_b4673626e7797578, []int{24}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1356,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4673626e7797578, []int{21}
+	return fileDescriptor_b4673626e7797578, []int{25}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,12 +1397,16 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupMetadataResponse)(nil), "regen.group.v1alpha1.MsgUpdateGroupMetadataResponse")
 	proto.RegisterType((*MsgCreateGroupAccount)(nil), "regen.group.v1alpha1.MsgCreateGroupAccount")
 	proto.RegisterType((*MsgCreateGroupAccountResponse)(nil), "regen.group.v1alpha1.MsgCreateGroupAccountResponse")
+	proto.RegisterType((*MsgCreateGroupWithPolicy)(nil), "regen.group.v1alpha1.MsgCreateGroupWithPolicy")
+	proto.RegisterType((*MsgCreateGroupWithPolicyResponse)(nil), "regen.group.v1alpha1.MsgCreateGroupWithPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupAccountAdmin)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountAdmin")
 	proto.RegisterType((*MsgUpdateGroupAccountAdminResponse)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountAdminResponse")
 	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicy)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicy")
 	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyResponse)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupAccountMetadata)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountMetadata")
 	proto.RegisterType((*MsgUpdateGroupAccountMetadataResponse)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyMetadata)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadata")
+	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyMetadataResponse)(nil), "regen.group.v1alpha1.MsgUpdateGroupAccountDecisionPolicyMetadataResponse")
 	proto.RegisterType((*MsgCreateProposal)(nil), "regen.group.v1alpha1.MsgCreateProposal")
 	proto.RegisterType((*MsgCreateProposalResponse)(nil), "regen.group.v1alpha1.MsgCreateProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "regen.group.v1alpha1.MsgVote")
@@ -1195,68 +1418,78 @@ func init() {
 func init() { proto.RegisterFile("regen/group/v1alpha1/tx.proto", fileDescriptor_b4673626e7797578) }
 
 var fileDescriptor_b4673626e7797578 = []byte{
-	// 974 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x73, 0xdb, 0x54,
-	0x10, 0xf7, 0x8b, 0xd5, 0xfc, 0xd9, 0x14, 0x37, 0x55, 0x4d, 0x71, 0xd4, 0xc6, 0xf1, 0x88, 0x76,
-	0x6a, 0x9a, 0x46, 0x22, 0x49, 0x0f, 0x14, 0x7a, 0x49, 0x52, 0xd3, 0xf1, 0x0c, 0x66, 0x82, 0xa0,
-	0x0c, 0x70, 0xf1, 0x28, 0xd2, 0x43, 0x11, 0xb5, 0xf5, 0x34, 0x7a, 0x72, 0x12, 0xdf, 0xb8, 0x30,
-	0x03, 0x17, 0x86, 0x19, 0xbe, 0x40, 0x3f, 0x01, 0x27, 0xae, 0x5c, 0xb8, 0xd0, 0xe1, 0xd4, 0x23,
-	0x27, 0x86, 0x49, 0xbe, 0x08, 0xe3, 0x27, 0xe9, 0x55, 0x8a, 0x25, 0x59, 0xea, 0xe4, 0xe6, 0xd5,
-	0xfe, 0x76, 0xf7, 0xb7, 0x7f, 0xde, 0xee, 0x18, 0xd6, 0x3c, 0x6c, 0x61, 0x47, 0xb5, 0x3c, 0x32,
-	0x72, 0xd5, 0xe3, 0x2d, 0x7d, 0xe0, 0x1e, 0xe9, 0x5b, 0xaa, 0x7f, 0xaa, 0xb8, 0x1e, 0xf1, 0x89,
-	0x58, 0x67, 0x6a, 0x85, 0xa9, 0x95, 0x48, 0x2d, 0xd5, 0x2d, 0x62, 0x11, 0x06, 0x50, 0x27, 0xbf,
-	0x02, 0xac, 0xb4, 0x6a, 0x10, 0x3a, 0x24, 0xb4, 0x1f, 0x28, 0x02, 0x21, 0x52, 0x59, 0x84, 0x58,
-	0x03, 0xac, 0x32, 0xe9, 0x70, 0xf4, 0xad, 0xaa, 0x3b, 0xe3, 0x50, 0xd5, 0x4a, 0x27, 0x30, 0x76,
-	0x71, 0x68, 0x2c, 0x7f, 0x8f, 0xa0, 0xd6, 0xa3, 0xd6, 0xbe, 0x87, 0x75, 0x1f, 0x3f, 0x9d, 0xe0,
-	0xc4, 0x3a, 0x5c, 0xd1, 0xcd, 0xa1, 0xed, 0x34, 0x50, 0x0b, 0xb5, 0x97, 0xb4, 0x40, 0x10, 0x1f,
-	0xc3, 0xc2, 0x10, 0x0f, 0x0f, 0xb1, 0x47, 0x1b, 0x73, 0xad, 0x6a, 0x7b, 0x79, 0xfb, 0xb6, 0x92,
-	0x46, 0x5f, 0xe9, 0x31, 0xd0, 0x9e, 0xf0, 0xf2, 0xdf, 0xf5, 0x8a, 0x16, 0x99, 0x88, 0x12, 0x2c,
-	0x0e, 0xb1, 0xaf, 0x9b, 0xba, 0xaf, 0x37, 0xaa, 0x2d, 0xd4, 0xbe, 0xaa, 0x71, 0x59, 0xde, 0x81,
-	0x9b, 0x49, 0x06, 0x1a, 0xa6, 0x2e, 0x71, 0x28, 0x16, 0x57, 0x61, 0x91, 0x79, 0xef, 0xdb, 0x26,
-	0x23, 0x23, 0x68, 0x0b, 0x4c, 0xee, 0x9a, 0xf2, 0xaf, 0x08, 0xde, 0xee, 0x51, 0xeb, 0x99, 0x6b,
-	0x46, 0x56, 0xbd, 0x30, 0x54, 0x3a, 0xfd, 0xb8, 0xab, 0xb9, 0x84, 0x2b, 0xb1, 0x0b, 0xb5, 0x80,
-	0x66, 0x7f, 0xc4, 0xbc, 0xd1, 0x46, 0xb5, 0x70, 0x82, 0x6f, 0x05, 0x96, 0x01, 0x0d, 0x2a, 0xaf,
-	0xc3, 0x5a, 0x2a, 0xa9, 0x28, 0x23, 0xd9, 0x80, 0x1b, 0x49, 0xc0, 0x2e, 0x63, 0x57, 0x9a, 0xf3,
-	0x2d, 0x58, 0x72, 0xf0, 0x49, 0x3f, 0x30, 0xaa, 0x32, 0xa3, 0x45, 0x07, 0x9f, 0x30, 0x6f, 0xf2,
-	0x1a, 0xdc, 0x4a, 0x09, 0xc2, 0x39, 0x60, 0x56, 0xef, 0x04, 0xc9, 0xa0, 0x13, 0xe5, 0x69, 0xe4,
-	0xb5, 0xb5, 0x05, 0xcd, 0xf4, 0x30, 0x9c, 0xc8, 0x1f, 0x41, 0x0f, 0x63, 0x9d, 0xdf, 0x35, 0x0c,
-	0x32, 0x72, 0xfc, 0x4b, 0x25, 0x22, 0x7e, 0x06, 0xd7, 0x4c, 0x6c, 0xd8, 0xd4, 0x26, 0x4e, 0xdf,
-	0x25, 0x03, 0xdb, 0x18, 0x37, 0x84, 0x16, 0x6a, 0x2f, 0x6f, 0xd7, 0x95, 0xe0, 0xe5, 0x28, 0xd1,
-	0xcb, 0x51, 0x76, 0x9d, 0xf1, 0x9e, 0xf8, 0xf7, 0xef, 0x9b, 0xb5, 0x27, 0xa1, 0xc1, 0x01, 0xc3,
-	0x6b, 0x35, 0x33, 0x21, 0x7f, 0x28, 0xfc, 0xf8, 0x62, 0xbd, 0x22, 0x3f, 0x62, 0xdd, 0x9e, 0xa6,
-	0xcf, 0xe7, 0xb7, 0x01, 0x0b, 0xba, 0x69, 0x7a, 0x98, 0xd2, 0x30, 0x91, 0x48, 0x94, 0x6d, 0x90,
-	0x2e, 0xb4, 0x28, 0x30, 0xcd, 0x1b, 0x87, 0x98, 0xb7, 0xb9, 0x84, 0xb7, 0xfc, 0x69, 0xb8, 0x03,
-	0x72, 0x76, 0x28, 0xde, 0x8b, 0xdf, 0x10, 0xbc, 0x9b, 0x0a, 0x4b, 0x56, 0xa2, 0x34, 0xb5, 0x94,
-	0xe2, 0x57, 0x2f, 0xa5, 0xf8, 0x9b, 0xb0, 0x51, 0x80, 0x2f, 0xcf, 0xef, 0xf9, 0xc5, 0x97, 0x19,
-	0xc2, 0x67, 0xcc, 0x7e, 0x76, 0x62, 0x79, 0xa3, 0x7f, 0x0f, 0xee, 0xe6, 0x06, 0xe3, 0xac, 0xfe,
-	0x42, 0x70, 0x9d, 0x8f, 0xd0, 0x81, 0x47, 0x5c, 0x42, 0xf5, 0x41, 0xf6, 0xd8, 0x88, 0xb7, 0x61,
-	0xc9, 0x65, 0xa8, 0x68, 0x0d, 0x2f, 0x69, 0xaf, 0x3f, 0xe4, 0x3e, 0x82, 0x36, 0x08, 0x43, 0x6a,
-	0xd1, 0x86, 0xc0, 0x56, 0x5b, 0x6a, 0xf1, 0x35, 0x86, 0x10, 0x15, 0x10, 0xf0, 0x29, 0x36, 0x1a,
-	0x57, 0x5a, 0xa8, 0x5d, 0xdb, 0x96, 0xd2, 0x97, 0x60, 0xe7, 0x14, 0x1b, 0x1a, 0xc3, 0x85, 0xed,
-	0x78, 0x0c, 0xab, 0x53, 0x89, 0xf0, 0x77, 0xb0, 0x0e, 0xcb, 0x6e, 0xf8, 0xed, 0xf5, 0x2a, 0x87,
-	0xe8, 0x53, 0xd7, 0x94, 0xff, 0x44, 0xb0, 0xd0, 0xa3, 0xd6, 0x97, 0xc4, 0x9f, 0x0d, 0x9e, 0x74,
-	0xea, 0x98, 0xf8, 0xd8, 0x0b, 0x3b, 0x12, 0x08, 0xe2, 0x43, 0x98, 0x37, 0x8e, 0x88, 0x6d, 0x60,
-	0x96, 0x7a, 0x2d, 0x6b, 0x7b, 0xef, 0x33, 0x8c, 0x16, 0x62, 0x13, 0x25, 0x13, 0x2e, 0x94, 0xac,
-	0x64, 0x21, 0xe4, 0xeb, 0x70, 0x2d, 0xcc, 0x81, 0xf7, 0x77, 0x8f, 0xa5, 0x35, 0xc1, 0xcc, 0x4e,
-	0xeb, 0x26, 0xcc, 0x53, 0xdb, 0x72, 0x78, 0x5e, 0xa1, 0x14, 0xba, 0x65, 0x71, 0x42, 0xb7, 0xf7,
-	0xef, 0x83, 0xc0, 0x7c, 0xd6, 0x61, 0xa5, 0xf3, 0x55, 0x67, 0xbf, 0xff, 0xec, 0xd3, 0xcf, 0x0f,
-	0x3a, 0xfb, 0xdd, 0x8f, 0xbb, 0x9d, 0x27, 0x2b, 0x15, 0xf1, 0x2a, 0x2c, 0xb2, 0xaf, 0x5f, 0x68,
-	0x5f, 0xaf, 0xa0, 0xed, 0x9f, 0x00, 0xaa, 0x3d, 0x6a, 0x89, 0x3a, 0x2c, 0xc7, 0x8f, 0xfc, 0x9d,
-	0x8c, 0xe3, 0x96, 0xd8, 0x67, 0xd2, 0x83, 0x22, 0x28, 0xde, 0xe6, 0x63, 0x10, 0x53, 0xee, 0xf1,
-	0x46, 0xa6, 0x8f, 0x69, 0xb0, 0xb4, 0x53, 0x02, 0xcc, 0xe3, 0xba, 0xb0, 0x32, 0x75, 0x51, 0xdf,
-	0x2b, 0xe2, 0x88, 0x41, 0xa5, 0xad, 0xc2, 0x50, 0x1e, 0x71, 0x0c, 0x37, 0xd2, 0xee, 0xe7, 0x83,
-	0x62, 0xec, 0x03, 0xb4, 0xf4, 0xb0, 0x0c, 0x3a, 0x5e, 0xe4, 0x94, 0x83, 0xb9, 0x51, 0xa4, 0x51,
-	0x21, 0x38, 0xa7, 0xc8, 0x39, 0xb7, 0xec, 0x07, 0x04, 0xef, 0x64, 0xdd, 0xab, 0xf7, 0x0b, 0x55,
-	0x30, 0x66, 0x21, 0x7d, 0x50, 0xd6, 0x82, 0xf3, 0x78, 0x81, 0xa0, 0x35, 0xf3, 0x4a, 0x3d, 0x2a,
-	0xe1, 0x3e, 0x69, 0x2a, 0xed, 0xbe, 0xb1, 0x29, 0xa7, 0xf8, 0x33, 0x02, 0x29, 0xe7, 0xd2, 0xec,
-	0x94, 0x88, 0xc0, 0x87, 0xe5, 0xa3, 0x37, 0x30, 0xe2, 0x84, 0xbe, 0x83, 0xda, 0x85, 0x13, 0x73,
-	0x6f, 0xc6, 0x08, 0x44, 0x40, 0x49, 0x2d, 0x08, 0xe4, 0xb1, 0x3e, 0x01, 0x81, 0xad, 0xf1, 0xb5,
-	0x4c, 0xc3, 0x89, 0x5a, 0xba, 0x9b, 0xab, 0x8e, 0x7b, 0x63, 0x9b, 0x2e, 0xdb, 0xdb, 0x44, 0x9d,
-	0xe3, 0x2d, 0xbe, 0x37, 0xf7, 0x9e, 0xbe, 0x3c, 0x6b, 0xa2, 0x57, 0x67, 0x4d, 0xf4, 0xdf, 0x59,
-	0x13, 0xfd, 0x72, 0xde, 0xac, 0xbc, 0x3a, 0x6f, 0x56, 0xfe, 0x39, 0x6f, 0x56, 0xbe, 0xd9, 0xb4,
-	0x6c, 0xff, 0x68, 0x74, 0xa8, 0x18, 0x64, 0xa8, 0x32, 0x57, 0x9b, 0x0e, 0xf6, 0x4f, 0x88, 0xf7,
-	0x3c, 0x94, 0x06, 0xd8, 0xb4, 0xb0, 0xa7, 0x9e, 0x06, 0x7f, 0xa5, 0x0e, 0xe7, 0xd9, 0xdd, 0xdc,
-	0xf9, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x44, 0x4b, 0xbb, 0x9d, 0xe1, 0x0d, 0x00, 0x00,
+	// 1125 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x73, 0xdb, 0x54,
+	0x10, 0xb7, 0x6c, 0x35, 0x1f, 0x9b, 0xc4, 0x4d, 0x15, 0x27, 0x38, 0x6a, 0xe3, 0x78, 0xd4, 0x66,
+	0x6a, 0x9a, 0x46, 0x26, 0x71, 0x60, 0x28, 0xf4, 0xe2, 0xa4, 0xa6, 0x63, 0x06, 0x33, 0x41, 0x50,
+	0xbe, 0x2e, 0x1e, 0x45, 0x7a, 0x28, 0xa2, 0xb6, 0x9e, 0x90, 0xe4, 0x24, 0x3e, 0xc1, 0x85, 0x19,
+	0x4e, 0x0c, 0x33, 0xfd, 0x07, 0x7a, 0xe0, 0xcc, 0x89, 0x03, 0x17, 0x2e, 0xcc, 0x30, 0xd3, 0xe1,
+	0xd4, 0x23, 0x27, 0x86, 0x49, 0xfe, 0x11, 0xc6, 0x4f, 0xd2, 0x8b, 0xe4, 0x48, 0x8a, 0x14, 0xd2,
+	0x53, 0xb2, 0x7a, 0xbf, 0xdd, 0xfd, 0xed, 0xc7, 0xdb, 0xb7, 0x63, 0x58, 0xb1, 0x90, 0x86, 0x8c,
+	0xba, 0x66, 0xe1, 0x81, 0x59, 0x3f, 0xdc, 0x94, 0x7b, 0xe6, 0x81, 0xbc, 0x59, 0x77, 0x8e, 0x45,
+	0xd3, 0xc2, 0x0e, 0xe6, 0x4a, 0xe4, 0x58, 0x24, 0xc7, 0xa2, 0x7f, 0xcc, 0x97, 0x34, 0xac, 0x61,
+	0x02, 0xa8, 0x8f, 0xfe, 0x73, 0xb1, 0xfc, 0xb2, 0x82, 0xed, 0x3e, 0xb6, 0xbb, 0xee, 0x81, 0x2b,
+	0xf8, 0x47, 0x1a, 0xc6, 0x5a, 0x0f, 0xd5, 0x89, 0xb4, 0x3f, 0xf8, 0xaa, 0x2e, 0x1b, 0x43, 0xef,
+	0xa8, 0x1a, 0x4d, 0x60, 0x68, 0x22, 0x4f, 0x59, 0xf8, 0x8e, 0x81, 0x62, 0xc7, 0xd6, 0x76, 0x2d,
+	0x24, 0x3b, 0xe8, 0xf1, 0x08, 0xc7, 0x95, 0xe0, 0x9a, 0xac, 0xf6, 0x75, 0xa3, 0xcc, 0x54, 0x99,
+	0xda, 0xb4, 0xe4, 0x0a, 0xdc, 0x43, 0x98, 0xec, 0xa3, 0xfe, 0x3e, 0xb2, 0xec, 0x72, 0xbe, 0x5a,
+	0xa8, 0xcd, 0x6c, 0xdd, 0x12, 0xa3, 0xe8, 0x8b, 0x1d, 0x02, 0xda, 0x61, 0x5f, 0xfc, 0xb3, 0x9a,
+	0x93, 0x7c, 0x15, 0x8e, 0x87, 0xa9, 0x3e, 0x72, 0x64, 0x55, 0x76, 0xe4, 0x72, 0xa1, 0xca, 0xd4,
+	0x66, 0x25, 0x2a, 0x0b, 0x0d, 0x58, 0x0a, 0x33, 0x90, 0x90, 0x6d, 0x62, 0xc3, 0x46, 0xdc, 0x32,
+	0x4c, 0x11, 0xeb, 0x5d, 0x5d, 0x25, 0x64, 0x58, 0x69, 0x92, 0xc8, 0x6d, 0x55, 0x78, 0xc6, 0xc0,
+	0x62, 0xc7, 0xd6, 0x9e, 0x98, 0xaa, 0xaf, 0xd5, 0xf1, 0x5c, 0x45, 0xd3, 0x0f, 0x9a, 0xca, 0x87,
+	0x4c, 0x71, 0x6d, 0x28, 0xba, 0x34, 0xbb, 0x03, 0x62, 0xcd, 0x2e, 0x17, 0x52, 0x07, 0x38, 0xe7,
+	0x6a, 0xba, 0x34, 0x6c, 0x61, 0x15, 0x56, 0x22, 0x49, 0xf9, 0x11, 0x09, 0x0a, 0x2c, 0x84, 0x01,
+	0x4d, 0xc2, 0x2e, 0x33, 0xe7, 0x9b, 0x30, 0x6d, 0xa0, 0xa3, 0xae, 0xab, 0x54, 0x20, 0x4a, 0x53,
+	0x06, 0x3a, 0x22, 0xd6, 0x84, 0x15, 0xb8, 0x19, 0xe1, 0x84, 0x72, 0x40, 0x24, 0xdf, 0x21, 0x92,
+	0x6e, 0x25, 0xb2, 0xd3, 0x48, 0x2a, 0x6b, 0x15, 0x2a, 0xd1, 0x6e, 0x28, 0x91, 0xdf, 0xdd, 0x1a,
+	0x06, 0x2a, 0xdf, 0x54, 0x14, 0x3c, 0x30, 0x9c, 0x2b, 0x25, 0xc2, 0x7d, 0x04, 0xd7, 0x55, 0xa4,
+	0xe8, 0xb6, 0x8e, 0x8d, 0xae, 0x89, 0x7b, 0xba, 0x32, 0x2c, 0xb3, 0x55, 0xa6, 0x36, 0xb3, 0x55,
+	0x12, 0xdd, 0x9b, 0x23, 0xfa, 0x37, 0x47, 0x6c, 0x1a, 0xc3, 0x1d, 0xee, 0xaf, 0x5f, 0x37, 0x8a,
+	0x8f, 0x3c, 0x85, 0x3d, 0x82, 0x97, 0x8a, 0x6a, 0x48, 0x7e, 0x87, 0xfd, 0xe1, 0xf9, 0x6a, 0x4e,
+	0x78, 0x40, 0xaa, 0x7d, 0x9e, 0x3e, 0xed, 0xdf, 0x32, 0x4c, 0xca, 0xaa, 0x6a, 0x21, 0xdb, 0xf6,
+	0x02, 0xf1, 0x45, 0xe1, 0xe7, 0x3c, 0x94, 0xc3, 0xba, 0x9f, 0xe9, 0xce, 0x81, 0x6b, 0xfd, 0x95,
+	0x5c, 0xc0, 0x35, 0x28, 0xba, 0xb9, 0x1b, 0x4b, 0xd3, 0x9c, 0x16, 0xea, 0x80, 0x6d, 0x58, 0x72,
+	0x61, 0xb2, 0x1b, 0xca, 0x19, 0x9c, 0x25, 0xf0, 0x92, 0x16, 0x88, 0xb3, 0x93, 0x90, 0xe1, 0x6b,
+	0x57, 0x92, 0xe1, 0x6f, 0xa0, 0x1a, 0x97, 0xa5, 0x14, 0x43, 0x82, 0xdb, 0x82, 0xc5, 0x70, 0x34,
+	0x7e, 0x35, 0xf2, 0x24, 0xb1, 0x0b, 0xc1, 0x60, 0x9a, 0x5e, 0x65, 0x74, 0xe0, 0xc7, 0x2e, 0x8f,
+	0x7f, 0x1e, 0x7f, 0x51, 0x03, 0x75, 0xce, 0x87, 0xea, 0x9c, 0x7c, 0x4f, 0xef, 0x80, 0x10, 0xef,
+	0x8a, 0xde, 0x92, 0x5f, 0x18, 0xb8, 0x1d, 0x09, 0x0b, 0x67, 0x30, 0x33, 0xb5, 0x88, 0xa2, 0x15,
+	0xae, 0xa4, 0x68, 0x1b, 0xb0, 0x9e, 0x82, 0x2f, 0x8d, 0xef, 0xe9, 0xf8, 0xcc, 0x1c, 0xef, 0xae,
+	0xac, 0x81, 0x25, 0x0d, 0xa5, 0xbb, 0xb0, 0x96, 0xe8, 0x8c, 0xb2, 0x1a, 0xa6, 0x0a, 0xe2, 0x95,
+	0x70, 0x7c, 0x13, 0x1a, 0x19, 0x5c, 0x53, 0xc6, 0xcf, 0xf2, 0x70, 0x83, 0x5e, 0x96, 0x3d, 0x0b,
+	0x9b, 0xd8, 0x96, 0x7b, 0xf1, 0x23, 0x88, 0xbb, 0x05, 0xd3, 0x26, 0x41, 0xf9, 0x13, 0x65, 0x5a,
+	0x3a, 0xfb, 0x90, 0x38, 0x50, 0x6b, 0xc0, 0xf6, 0x6d, 0xcd, 0x2e, 0xb3, 0x64, 0x0c, 0x45, 0xb6,
+	0x8b, 0x44, 0x10, 0x9c, 0x08, 0x2c, 0x3a, 0x46, 0x0a, 0x99, 0x06, 0xc5, 0x2d, 0x3e, 0x7a, 0x60,
+	0xb5, 0x8e, 0x91, 0x22, 0x11, 0x1c, 0xf7, 0x3e, 0x14, 0x47, 0x7f, 0xbb, 0xa6, 0x85, 0x54, 0x5d,
+	0x91, 0x1d, 0x54, 0x9e, 0x20, 0x2d, 0x79, 0x3b, 0x5e, 0x73, 0xcf, 0x87, 0x4a, 0x73, 0x28, 0x28,
+	0x7a, 0xcd, 0xf8, 0x10, 0x96, 0xcf, 0x25, 0x85, 0x8e, 0x8e, 0x55, 0x98, 0x31, 0xbd, 0x6f, 0x67,
+	0xd3, 0x03, 0xfc, 0x4f, 0x6d, 0x55, 0xf8, 0x83, 0x81, 0xc9, 0x8e, 0xad, 0x7d, 0x8a, 0x9d, 0x8b,
+	0xc1, 0xa3, 0x1e, 0x38, 0xc4, 0x0e, 0xb2, 0xbc, 0x5a, 0xbb, 0x02, 0xb7, 0x0d, 0x13, 0xca, 0x01,
+	0xd6, 0x15, 0x44, 0xd2, 0x58, 0x8c, 0x9b, 0xda, 0xbb, 0x04, 0x23, 0x79, 0xd8, 0x50, 0xfa, 0xd9,
+	0xb1, 0xf4, 0x67, 0x4c, 0xaa, 0x70, 0x03, 0xae, 0x7b, 0x31, 0xd0, 0x5e, 0xd9, 0x21, 0x61, 0x8d,
+	0x30, 0x17, 0x87, 0xb5, 0x04, 0x13, 0xb6, 0xae, 0x19, 0x34, 0x2e, 0x4f, 0xf2, 0xcc, 0x12, 0x3f,
+	0x9e, 0xd9, 0x7b, 0xf7, 0x80, 0x25, 0x36, 0x4b, 0x30, 0xdf, 0xfa, 0xbc, 0xb5, 0xdb, 0x7d, 0xf2,
+	0xe1, 0xc7, 0x7b, 0xad, 0xdd, 0xf6, 0x7b, 0xed, 0xd6, 0xa3, 0xf9, 0x1c, 0x37, 0x0b, 0x53, 0xe4,
+	0xeb, 0x27, 0xd2, 0x17, 0xf3, 0xcc, 0xd6, 0x9f, 0xb3, 0x50, 0xe8, 0xd8, 0x1a, 0x27, 0xc3, 0x4c,
+	0x70, 0xf9, 0xbc, 0x13, 0xf3, 0xa8, 0x85, 0x5e, 0x01, 0xfe, 0x7e, 0x1a, 0x14, 0x2d, 0xf3, 0x21,
+	0x70, 0x11, 0x7b, 0xe2, 0x7a, 0xac, 0x8d, 0xf3, 0x60, 0xbe, 0x91, 0x01, 0x4c, 0xfd, 0x9a, 0x30,
+	0x7f, 0x6e, 0xd3, 0x7b, 0x3d, 0x8d, 0x21, 0x02, 0xe5, 0x37, 0x53, 0x43, 0xa9, 0xc7, 0x21, 0x2c,
+	0x44, 0xed, 0x75, 0xf7, 0xd3, 0xb1, 0x77, 0xd1, 0xfc, 0x76, 0x16, 0x74, 0x30, 0xc9, 0x11, 0x8b,
+	0xdc, 0x7a, 0x9a, 0x42, 0x79, 0xe0, 0x84, 0x24, 0x27, 0xec, 0x58, 0xdf, 0xc2, 0x62, 0xf4, 0x16,
+	0x25, 0xa6, 0xb1, 0x76, 0x86, 0xe7, 0xdf, 0xca, 0x86, 0xa7, 0x04, 0xbe, 0x67, 0xe0, 0xb5, 0xb8,
+	0x75, 0xe1, 0x8d, 0x54, 0x25, 0x0c, 0x68, 0xf0, 0x6f, 0x67, 0xd5, 0xa0, 0x3c, 0x9e, 0x33, 0x50,
+	0xbd, 0x70, 0x49, 0x78, 0x90, 0xc1, 0x7c, 0x58, 0x95, 0x6f, 0x5e, 0x5a, 0x95, 0x52, 0xfc, 0x91,
+	0x01, 0x3e, 0xe1, 0xa1, 0x6f, 0x64, 0xf0, 0x40, 0xbb, 0xf5, 0xdd, 0x4b, 0x28, 0x51, 0x42, 0xbf,
+	0x31, 0x50, 0x4b, 0xfd, 0xc6, 0x5f, 0x3e, 0x01, 0x94, 0x6c, 0xfb, 0x7f, 0x9b, 0xa0, 0xd4, 0xbf,
+	0x86, 0xe2, 0xd8, 0x53, 0x7f, 0xf7, 0x82, 0x06, 0xf6, 0x81, 0x7c, 0x3d, 0x25, 0x90, 0xfa, 0xfa,
+	0x00, 0x58, 0xf2, 0x04, 0xae, 0xc4, 0x2a, 0x8e, 0x8e, 0xf9, 0xb5, 0xc4, 0xe3, 0xa0, 0x35, 0xf2,
+	0x4a, 0xc4, 0x5b, 0x1b, 0x1d, 0x27, 0x58, 0x0b, 0xbe, 0x39, 0x3b, 0x8f, 0x5f, 0x9c, 0x54, 0x98,
+	0x97, 0x27, 0x15, 0xe6, 0xdf, 0x93, 0x0a, 0xf3, 0xd3, 0x69, 0x25, 0xf7, 0xf2, 0xb4, 0x92, 0xfb,
+	0xfb, 0xb4, 0x92, 0xfb, 0x72, 0x43, 0xd3, 0x9d, 0x83, 0xc1, 0xbe, 0xa8, 0xe0, 0x7e, 0x9d, 0x98,
+	0xda, 0x30, 0x90, 0x73, 0x84, 0xad, 0xa7, 0x9e, 0xd4, 0x43, 0xaa, 0x86, 0xac, 0xfa, 0xb1, 0xfb,
+	0xf3, 0xc8, 0xfe, 0x04, 0xd9, 0x5f, 0x1a, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x83, 0x55, 0x76,
+	0x88, 0xb5, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1285,6 +1518,9 @@ type MsgClient interface {
 	UpdateGroupMetadata(ctx context.Context, in *MsgUpdateGroupMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupMetadataResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccount, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error)
+	// CreateGroupWithPolicy creates a new group with a group account using the
+	// given DecisionPolicy in a single transaction.
+	CreateGroupWithPolicy(ctx context.Context, in *MsgCreateGroupWithPolicy, opts ...grpc.CallOption) (*MsgCreateGroupWithPolicyResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
 	UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error)
 	// UpdateGroupAccountDecisionPolicy allows a group account decision policy to
@@ -1292,6 +1528,9 @@ type MsgClient interface {
 	UpdateGroupAccountDecisionPolicy(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdateGroupAccountMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// UpdateGroupAccountDecisionPolicyMetadata updates the metadata of a group
+	// account decision policy.
+	UpdateGroupAccountDecisionPolicyMetadata(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(ctx context.Context, in *MsgCreateProposal, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -1353,6 +1592,15 @@ func (c *msgClient) CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAc
 	return out, nil
 }
 
+func (c *msgClient) CreateGroupWithPolicy(ctx context.Context, in *MsgCreateGroupWithPolicy, opts ...grpc.CallOption) (*MsgCreateGroupWithPolicyResponse, error) {
+	out := new(MsgCreateGroupWithPolicyResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CreateGroupWithPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error) {
 	out := new(MsgUpdateGroupAccountAdminResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/UpdateGroupAccountAdmin", in, out, opts...)
@@ -1380,6 +1628,15 @@ func (c *msgClient) UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdat
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupAccountDecisionPolicyMetadata(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error) {
+	out := new(MsgUpdateGroupAccountDecisionPolicyMetadataResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/UpdateGroupAccountDecisionPolicyMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateProposal(ctx context.Context, in *MsgCreateProposal, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error) {
 	out := new(MsgCreateProposalResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CreateProposal", in, out, opts...)
@@ -1423,6 +1680,9 @@ type MsgServer interface {
 	UpdateGroupMetadata(context.Context, *MsgUpdateGroupMetadata) (*MsgUpdateGroupMetadataResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(context.Context, *MsgCreateGroupAccount) (*MsgCreateGroupAccountResponse, error)
+	// CreateGroupWithPolicy creates a new group with a group account using the
+	// given DecisionPolicy in a single transaction.
+	CreateGroupWithPolicy(context.Context, *MsgCreateGroupWithPolicy) (*MsgCreateGroupWithPolicyResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
 	UpdateGroupAccountAdmin(context.Context, *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error)
 	// UpdateGroupAccountDecisionPolicy allows a group account decision policy to
@@ -1430,6 +1690,9 @@ type MsgServer interface {
 	UpdateGroupAccountDecisionPolicy(context.Context, *MsgUpdateGroupAccountDecisionPolicy) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(context.Context, *MsgUpdateGroupAccountMetadata) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// UpdateGroupAccountDecisionPolicyMetadata updates the metadata of a group
+	// account decision policy.
+	UpdateGroupAccountDecisionPolicyMetadata(context.Context, *MsgUpdateGroupAccountDecisionPolicyMetadata) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(context.Context, *MsgCreateProposal) (*MsgCreateProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -1457,6 +1720,9 @@ func (*UnimplementedMsgServer) UpdateGroupMetadata(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) CreateGroupAccount(ctx context.Context, req *MsgCreateGroupAccount) (*MsgCreateGroupAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupAccount not implemented")
 }
+func (*UnimplementedMsgServer) CreateGroupWithPolicy(ctx context.Context, req *MsgCreateGroupWithPolicy) (*MsgCreateGroupWithPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupWithPolicy not implemented")
+}
 func (*UnimplementedMsgServer) UpdateGroupAccountAdmin(ctx context.Context, req *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountAdmin not implemented")
 }
@@ -1466,6 +1732,9 @@ func (*UnimplementedMsgServer) UpdateGroupAccountDecisionPolicy(ctx context.Cont
 func (*UnimplementedMsgServer) UpdateGroupAccountMetadata(ctx context.Context, req *MsgUpdateGroupAccountMetadata) (*MsgUpdateGroupAccountMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupAccountDecisionPolicyMetadata(ctx context.Context, req *MsgUpdateGroupAccountDecisionPolicyMetadata) (*MsgUpdateGroupAccountDecisionPolicyMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountDecisionPolicyMetadata not implemented")
+}
 func (*UnimplementedMsgServer) CreateProposal(ctx context.Context, req *MsgCreateProposal) (*MsgCreateProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProposal not implemented")
 }
@@ -1570,6 +1839,24 @@ func _Msg_CreateGroupAccount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGroupWithPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroupWithPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroupWithPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/CreateGroupWithPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroupWithPolicy(ctx, req.(*MsgCreateGroupWithPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateGroupAccountAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateGroupAccountAdmin)
 	if err := dec(in); err != nil {
@@ -1624,6 +1911,24 @@ func _Msg_UpdateGroupAccountMetadata_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupAccountDecisionPolicyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAccountDecisionPolicyMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicyMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/UpdateGroupAccountDecisionPolicyMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicyMetadata(ctx, req.(*MsgUpdateGroupAccountDecisionPolicyMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateProposal)
 	if err := dec(in); err != nil {
@@ -1702,6 +2007,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGroupAccount",
 			Handler:    _Msg_CreateGroupAccount_Handler,
 		},
+		{
+			MethodName: "CreateGroupWithPolicy",
+			Handler:    _Msg_CreateGroupWithPolicy_Handler,
+		},
 		{
 			MethodName: "UpdateGroupAccountAdmin",
 			Handler:    _Msg_UpdateGroupAccountAdmin_Handler,
@@ -1714,6 +2023,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupAccountMetadata",
 			Handler:    _Msg_UpdateGroupAccountMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupAccountDecisionPolicyMetadata",
+			Handler:    _Msg_UpdateGroupAccountDecisionPolicyMetadata_Handler,
+		},
 		{
 			MethodName: "CreateProposal",
 			Handler:    _Msg_CreateProposal_Handler,
@@ -2096,7 +2409,7 @@ func (m *MsgCreateGroupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupAccountAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroupWithPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2106,29 +2419,55 @@ func (m *MsgUpdateGroupAccountAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupAccountAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupWithPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupWithPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.GroupAccountMetadata) > 0 {
+		i -= len(m.GroupAccountMetadata)
+		copy(dAtA[i:], m.GroupAccountMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupAccountMetadata)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupMetadata) > 0 {
+		i -= len(m.GroupMetadata)
+		copy(dAtA[i:], m.GroupMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
@@ -2140,7 +2479,7 @@ func (m *MsgUpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupAccountAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroupWithPolicyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2150,20 +2489,32 @@ func (m *MsgUpdateGroupAccountAdminResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupAccountAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupWithPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupAccountAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupWithPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupAccountAddress) > 0 {
+		i -= len(m.GroupAccountAddress)
+		copy(dAtA[i:], m.GroupAccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupAccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicy) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupAccountAdmin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2173,12 +2524,79 @@ func (m *MsgUpdateGroupAccountDecisionPolicy) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupAccountAdmin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2302,6 +2720,73 @@ func (m *MsgUpdateGroupAccountMetadataResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2322,6 +2807,18 @@ func (m *MsgCreateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecPredicate != nil {
+		{
+			size, err := m.ExecPredicate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Exec != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
 		i--
@@ -2700,6 +3197,53 @@ func (m *MsgCreateGroupAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateGroupWithPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.GroupMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupAccountMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupWithPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.GroupAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgUpdateGroupAccountAdmin) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2790,6 +3334,36 @@ func (m *MsgUpdateGroupAccountMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2819,6 +3393,10 @@ func (m *MsgCreateProposal) Size() (n int) {
 	if m.Exec != 0 {
 		n += 1 + sovTx(uint64(m.Exec))
 	}
+	if m.ExecPredicate != nil {
+		l = m.ExecPredicate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3925,7 +4503,7 @@ func (m *MsgCreateGroupAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGroupWithPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,10 +4526,10 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGroupWithPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGroupWithPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3988,9 +4566,9 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4000,29 +4578,31 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMetadata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4032,39 +4612,358 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			m.GroupMetadata = append(m.GroupMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupMetadata == nil {
+				m.GroupMetadata = []byte{}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountMetadata", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccountMetadata = append(m.GroupAccountMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccountMetadata == nil {
+				m.GroupAccountMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupWithPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupWithPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupWithPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -4519,6 +5418,204 @@ func (m *MsgUpdateGroupAccountMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCreateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4699,6 +5796,42 @@ func (m *MsgCreateProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecPredicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecPredicate == nil {
+				m.ExecPredicate = &ExecPredicate{}
+			}
+			if err := m.ExecPredicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
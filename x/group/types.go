@@ -1,12 +1,14 @@
 package group
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/regen-network/regen-ledger/orm"
+	regentypes "github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,6 +47,9 @@ func (p ProposalID) Empty() bool {
 type DecisionPolicyResult struct {
 	Allow bool
 	Final bool
+	// Reason is the reason why the proposal was rejected. It is only set if
+	// the result is final and not allowed.
+	Reason Proposal_Reason
 }
 
 // DecisionPolicy is the persistent set of rules to determine the result of election on a proposal.
@@ -53,7 +58,9 @@ type DecisionPolicy interface {
 
 	orm.Validateable
 	GetTimeout() types.Duration
+	GetVoteGracePeriod() types.Duration
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
+	RequiredYesWeight(tally Tally) (string, error)
 	Validate(g GroupInfo) error
 }
 
@@ -62,29 +69,26 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, timeout types.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, timeout}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// Votes cast within the vote grace period after the timeout are still counted. If a veto threshold is set, a
+// proposal that reached the threshold is only accepted before the end of the voting period once the veto count
+// can no longer exceed the veto threshold, and is otherwise accepted at the end of the voting period if it was
+// not vetoed.
 func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
 	timeout, err := types.DurationFromProto(&p.Timeout)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if timeout <= votingDuration {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
-	}
-
-	threshold, err := math.NewPositiveDecFromString(p.Threshold)
-	if err != nil {
-		return DecisionPolicyResult{}, err
-	}
-	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	gracePeriod, err := types.DurationFromProto(&p.VoteGracePeriod)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if yesCount.Cmp(threshold) >= 0 {
-		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	ended := timeout+gracePeriod <= votingDuration
+	if ended && p.VetoThreshold == "" {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded}, nil
 	}
 
 	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
@@ -99,16 +103,79 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
+
+	// Vetoes reject the proposal regardless of the yes votes. As long as the
+	// undecided weight could still push the veto count over the veto threshold,
+	// the proposal is not accepted before the end of the voting period.
+	vetoPossible := false
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := math.NewPositiveDecFromString(p.VetoThreshold)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		vetoCount, err := math.NewNonNegativeDecFromString(tally.VetoCount)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if vetoCount.Cmp(vetoThreshold) > 0 {
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVetoed}, nil
+		}
+		maxVetoCount, err := vetoCount.Add(undecided)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		vetoPossible = !ended && maxVetoCount.Cmp(vetoThreshold) > 0
+	}
+
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesCount.Cmp(threshold) >= 0 {
+		if vetoPossible {
+			return DecisionPolicyResult{Allow: false, Final: false}, nil
+		}
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if ended {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded}, nil
+	}
+
 	sum, err := yesCount.Add(undecided)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
 	if sum.Cmp(threshold) < 0 {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonRejected}, nil
 	}
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// RequiredYesWeight returns the yes weight still needed on top of the given tally for the threshold to be reached,
+// or zero if it is already reached.
+func (p ThresholdDecisionPolicy) RequiredYesWeight(tally Tally) (string, error) {
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return "", err
+	}
+	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	if err != nil {
+		return "", err
+	}
+	if yesCount.Cmp(threshold) >= 0 {
+		return "0", nil
+	}
+	required, err := threshold.Sub(yesCount)
+	if err != nil {
+		return "", err
+	}
+	return required.String(), nil
+}
+
 // Validate returns an error if policy threshold is greater than the total group weight
 func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
 	threshold, err := math.NewPositiveDecFromString(p.Threshold)
@@ -138,6 +205,21 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if timeout <= time.Nanosecond {
 		return sdkerrors.Wrap(ErrInvalid, "timeout")
 	}
+
+	gracePeriod, err := types.DurationFromProto(&p.VoteGracePeriod)
+	if err != nil {
+		return sdkerrors.Wrap(err, "vote grace period")
+	}
+
+	if gracePeriod < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "vote grace period")
+	}
+
+	if p.VetoThreshold != "" {
+		if _, err := math.NewPositiveDecFromString(p.VetoThreshold); err != nil {
+			return sdkerrors.Wrap(err, "veto threshold")
+		}
+	}
 	return nil
 }
 
@@ -159,6 +241,28 @@ func (g GroupAccountInfo) PrimaryKeyFields() []interface{} {
 
 var _ orm.Validateable = GroupAccountInfo{}
 
+// GroupAccountDerivationKey returns the key from which the address of a group
+// account is derived. It is the big endian encoding of the group id followed
+// by the big endian encoding of the salt, so that keys of different groups
+// never overlap.
+func GroupAccountDerivationKey(groupID uint64, salt uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, groupID)
+	binary.BigEndian.PutUint64(key[8:], salt)
+	return key
+}
+
+// DeriveGroupAccountAddress returns the address of the group account derived
+// from the given group id and salt. The derivation only depends on its
+// arguments and the module name, so it can be computed ahead of the group
+// account creation.
+func DeriveGroupAccountAddress(groupID uint64, salt uint64) sdk.AccAddress {
+	return regentypes.ModuleID{
+		ModuleName: ModuleName,
+		Path:       GroupAccountDerivationKey(groupID, salt),
+	}.Address()
+}
+
 // NewGroupAccountInfo creates a new GroupAccountInfo instance
 func NewGroupAccountInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata []byte,
 	version uint64, decisionPolicy DecisionPolicy, derivationKey []byte) (GroupAccountInfo, error) {
@@ -238,6 +342,36 @@ func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) erro
 	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
 }
 
+func (v VoteSnapshot) PrimaryKeyFields() []interface{} {
+	return []interface{}{v.ProposalId}
+}
+
+var _ orm.Validateable = VoteSnapshot{}
+
+func (v VoteSnapshot) ValidateBasic() error {
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if _, err := math.NewNonNegativeDecFromString(v.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if err := (Members{Members: v.Members}).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+	return nil
+}
+
+// MemberWeight returns the weight of the member with the given address in the
+// snapshot and whether the address was a member at submission.
+func (v VoteSnapshot) MemberWeight(address string) (string, bool) {
+	for _, m := range v.Members {
+		if m.Address == address {
+			return m.Weight, true
+		}
+	}
+	return "", false
+}
+
 func (v Vote) PrimaryKeyFields() []interface{} {
 	addr, err := sdk.AccAddressFromBech32(v.Voter)
 	if err != nil {
@@ -283,11 +417,31 @@ func ChoiceFromString(str string) (Choice, error) {
 	return Choice(choice), nil
 }
 
+// ProposalStatusFromString returns a Proposal_Status from a string. It returns
+// an error if the string is invalid.
+func ProposalStatusFromString(str string) (Proposal_Status, error) {
+	status, ok := Proposal_Status_value[str]
+	if !ok {
+		return ProposalStatusInvalid, fmt.Errorf("'%s' is not a valid proposal status", str)
+	}
+	return Proposal_Status(status), nil
+}
+
 // MaxMetadataLength defines the max length of the metadata bytes field
 // for various entities within the group module
 // TODO: This could be used as params once x/params is upgraded to use protobuf
 const MaxMetadataLength = 255
 
+// MaxProposalMsgs defines the max number of messages a proposal can contain
+// so that proposals can't be used to grief execution gas
+// TODO: This could be used as params once x/params is upgraded to use protobuf
+const MaxProposalMsgs = 10
+
+// MaxParticipationWindow defines the max number of most recent proposals used
+// to compute the participation of a group so that the query reads a bounded
+// number of proposals
+const MaxParticipationWindow = 100
+
 var _ orm.Validateable = GroupInfo{}
 
 func (g GroupInfo) ValidateBasic() error {
@@ -324,6 +478,9 @@ func (g GroupMember) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrEmpty, "group")
 	}
 
+	if g.Member == nil {
+		return sdkerrors.Wrap(ErrEmpty, "member")
+	}
 	err := g.Member.ValidateBasic()
 	if err != nil {
 		return sdkerrors.Wrap(err, "member")
@@ -498,6 +655,26 @@ func (q QueryGroupAccountsByAdminResponse) UnpackInterfaces(unpacker codectypes.
 	return unpackGroupAccounts(unpacker, q.GroupAccounts)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryProposalFullResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if q.Proposal != nil {
+		if err := q.Proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(q.DecisionPolicy, &decisionPolicy)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryGroupExportResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if q.Genesis == nil {
+		return nil
+	}
+	return q.Genesis.UnpackInterfaces(unpacker)
+}
+
 func unpackGroupAccounts(unpacker codectypes.AnyUnpacker, accs []*GroupAccountInfo) error {
 	for _, g := range accs {
 		err := g.UnpackInterfaces(unpacker)
@@ -39,7 +39,8 @@ const (
 	Choice_CHOICE_YES Choice = 2
 	// CHOICE_ABSTAIN defines an abstaining voting choice.
 	Choice_CHOICE_ABSTAIN Choice = 3
-	// CHOICE_VETO defines a voting choice with veto.
+	// CHOICE_VETO defines a no voting choice with veto. Veto votes reject the
+	// proposal once they exceed the veto threshold of the decision policy.
 	Choice_CHOICE_VETO Choice = 4
 )
 
@@ -77,8 +78,8 @@ const (
 	ProposalStatusSubmitted Proposal_Status = 1
 	// Final status of a proposal when the final tally was executed.
 	ProposalStatusClosed Proposal_Status = 2
-	// Final status of a proposal when the group was modified before the final
-	// tally.
+	// Final status of a proposal when the group account (or, for proposals
+	// without a VoteSnapshot, the group) was modified before the final tally.
 	ProposalStatusAborted Proposal_Status = 3
 )
 
@@ -176,6 +177,75 @@ func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9b7906b115009838, []int{6, 2}
 }
 
+// Reason defines the reasons why a proposal was rejected.
+type Proposal_Reason int32
+
+const (
+	// The proposal was not rejected.
+	ProposalReasonUnspecified Proposal_Reason = 0
+	// The proposal was rejected by the votes before the end of the voting
+	// period, i.e. the yes votes can no longer reach the threshold.
+	ProposalReasonRejected Proposal_Reason = 1
+	// The voting period ended before the proposal was accepted by the votes.
+	ProposalReasonVotingPeriodEnded Proposal_Reason = 2
+	// The veto votes exceeded the veto threshold of the decision policy.
+	ProposalReasonVetoed Proposal_Reason = 3
+)
+
+var Proposal_Reason_name = map[int32]string{
+	0: "REASON_UNSPECIFIED",
+	1: "REASON_REJECTED",
+	2: "REASON_VOTING_PERIOD_ENDED",
+	3: "REASON_VETOED",
+}
+
+var Proposal_Reason_value = map[string]int32{
+	"REASON_UNSPECIFIED":         0,
+	"REASON_REJECTED":            1,
+	"REASON_VOTING_PERIOD_ENDED": 2,
+	"REASON_VETOED":              3,
+}
+
+func (x Proposal_Reason) String() string {
+	return proto.EnumName(Proposal_Reason_name, int32(x))
+}
+
+func (Proposal_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{6, 3}
+}
+
+// Comparison defines the comparison operators of an execution predicate.
+type ExecPredicate_Comparison int32
+
+const (
+	// An empty value is invalid and not allowed.
+	ComparisonInvalid ExecPredicate_Comparison = 0
+	// The queried balance must be greater than or equal to amount.
+	ComparisonGTE ExecPredicate_Comparison = 1
+	// The queried balance must be less than or equal to amount.
+	ComparisonLTE ExecPredicate_Comparison = 2
+)
+
+var ExecPredicate_Comparison_name = map[int32]string{
+	0: "COMPARISON_UNSPECIFIED",
+	1: "COMPARISON_GTE",
+	2: "COMPARISON_LTE",
+}
+
+var ExecPredicate_Comparison_value = map[string]int32{
+	"COMPARISON_UNSPECIFIED": 0,
+	"COMPARISON_GTE":         1,
+	"COMPARISON_LTE":         2,
+}
+
+func (x ExecPredicate_Comparison) String() string {
+	return proto.EnumName(ExecPredicate_Comparison_name, int32(x))
+}
+
+func (ExecPredicate_Comparison) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{7, 0}
+}
+
 // Member represents a group member with an account address,
 // non-zero weight and metadata.
 type Member struct {
@@ -295,6 +365,16 @@ type ThresholdDecisionPolicy struct {
 	// timeout is the duration from submission of a proposal to the end of voting
 	// period Within this times votes and exec messages can be submitted.
 	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// vote_grace_period is an optional duration after the timeout within which
+	// votes are still accepted and counted as long as the proposal has not been
+	// tallied and closed. A zero duration (the default) disables the grace
+	// period.
+	VoteGracePeriod types.Duration `protobuf:"bytes,3,opt,name=vote_grace_period,json=voteGracePeriod,proto3" json:"vote_grace_period"`
+	// veto_threshold is an optional weighted sum of veto votes which, once
+	// exceeded, rejects a proposal regardless of its yes votes. While a veto is
+	// still possible, a proposal that reached the threshold is only accepted at
+	// the end of the voting period. An empty value (the default) disables vetoes.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -344,6 +424,20 @@ func (m *ThresholdDecisionPolicy) GetTimeout() types.Duration {
 	return types.Duration{}
 }
 
+func (m *ThresholdDecisionPolicy) GetVoteGracePeriod() types.Duration {
+	if m != nil {
+		return m.VoteGracePeriod
+	}
+	return types.Duration{}
+}
+
+func (m *ThresholdDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// group_id is the unique ID of the group.
@@ -503,6 +597,9 @@ type GroupAccountInfo struct {
 	// derivation_key is the "derivation" key of the group account,
 	// which is needed to derive the group root module key and execute proposals.
 	DerivationKey []byte `protobuf:"bytes,7,opt,name=derivation_key,json=derivationKey,proto3" json:"derivation_key,omitempty"`
+	// decision_policy_metadata is any arbitrary metadata attached to the
+	// decision policy, e.g. a description of its voting rules.
+	DecisionPolicyMetadata []byte `protobuf:"bytes,8,opt,name=decision_policy_metadata,json=decisionPolicyMetadata,proto3" json:"decision_policy_metadata,omitempty"`
 }
 
 func (m *GroupAccountInfo) Reset()         { *m = GroupAccountInfo{} }
@@ -554,8 +651,10 @@ type Proposal struct {
 	// submitted_at is a timestamp specifying when a proposal was submitted.
 	SubmittedAt types.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
 	// group_version tracks the version of the group that this proposal
-	// corresponds to. When group membership is changed, existing proposals from
-	// previous group versions will become invalid.
+	// corresponds to. Votes are weighted using the VoteSnapshot recorded at
+	// submission, so group membership changes don't invalidate the proposal.
+	// Proposals submitted without a VoteSnapshot become invalid when the group
+	// membership is changed.
 	GroupVersion uint64 `protobuf:"varint,6,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
 	// group_account_version tracks the version of the group account that this
 	// proposal corresponds to. When a decision policy is changed, existing
@@ -581,6 +680,15 @@ type Proposal struct {
 	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,12,opt,name=executor_result,json=executorResult,proto3,enum=regen.group.v1alpha1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
 	// msgs is a list of Msgs that will be executed if the proposal passes.
 	Msgs []*types1.Any `protobuf:"bytes,13,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// exec_predicate is an optional condition that must hold at execution time
+	// for the proposal msgs to be executed.
+	ExecPredicate *ExecPredicate `protobuf:"bytes,14,opt,name=exec_predicate,json=execPredicate,proto3" json:"exec_predicate,omitempty"`
+	// reason is the reason why the proposal was rejected. It is only set when
+	// the result is rejected.
+	Reason Proposal_Reason `protobuf:"varint,15,opt,name=reason,proto3,enum=regen.group.v1alpha1.Proposal_Reason" json:"reason,omitempty"`
+	// final_tally_result contains the sums of all weighted votes at the time
+	// the proposal was closed. It is empty until the final tally has happened.
+	FinalTallyResult Tally `protobuf:"bytes,16,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -616,6 +724,82 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// ExecPredicate defines a condition evaluated when executing a proposal. It
+// compares the spendable balance of an account for a given denom against a
+// fixed amount.
+type ExecPredicate struct {
+	// address is the account address whose balance is queried.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denom of the queried balance.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// comparison is the comparison applied between the queried balance and
+	// amount.
+	Comparison ExecPredicate_Comparison `protobuf:"varint,3,opt,name=comparison,proto3,enum=regen.group.v1alpha1.ExecPredicate_Comparison" json:"comparison,omitempty"`
+	// amount is the integer amount the queried balance is compared against.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *ExecPredicate) Reset()         { *m = ExecPredicate{} }
+func (m *ExecPredicate) String() string { return proto.CompactTextString(m) }
+func (*ExecPredicate) ProtoMessage()    {}
+func (*ExecPredicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{7}
+}
+func (m *ExecPredicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecPredicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecPredicate.Merge(m, src)
+}
+func (m *ExecPredicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecPredicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecPredicate proto.InternalMessageInfo
+
+func (m *ExecPredicate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExecPredicate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ExecPredicate) GetComparison() ExecPredicate_Comparison {
+	if m != nil {
+		return m.Comparison
+	}
+	return ComparisonInvalid
+}
+
+func (m *ExecPredicate) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // Tally represents the sum of weighted votes.
 type Tally struct {
 	// yes_count is the weighted sum of yes votes.
@@ -632,7 +816,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{7}
+	return fileDescriptor_9b7906b115009838, []int{8}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,6 +845,72 @@ func (m *Tally) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Tally proto.InternalMessageInfo
 
+// VoteSnapshot is the group membership recorded when a proposal is submitted.
+// Votes on the proposal are weighted and tallied against the snapshot so that
+// changes to the group membership don't affect open proposals.
+type VoteSnapshot struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// total_weight is the sum of the group members' weights at submission.
+	TotalWeight string `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// members are the group members and their weights at submission.
+	Members []Member `protobuf:"bytes,3,rep,name=members,proto3" json:"members"`
+}
+
+func (m *VoteSnapshot) Reset()         { *m = VoteSnapshot{} }
+func (m *VoteSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VoteSnapshot) ProtoMessage()    {}
+func (*VoteSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{9}
+}
+func (m *VoteSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSnapshot.Merge(m, src)
+}
+func (m *VoteSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSnapshot proto.InternalMessageInfo
+
+func (m *VoteSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteSnapshot) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
+func (m *VoteSnapshot) GetMembers() []Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 // Vote represents a vote for a proposal.
 type Vote struct {
 	// proposal is the unique ID of the proposal.
@@ -679,7 +929,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{8}
+	return fileDescriptor_9b7906b115009838, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,6 +998,8 @@ func init() {
 	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
 	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Result", Proposal_Result_name, Proposal_Result_value)
 	proto.RegisterEnum("regen.group.v1alpha1.Proposal_ExecutorResult", Proposal_ExecutorResult_name, Proposal_ExecutorResult_value)
+	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Reason", Proposal_Reason_name, Proposal_Reason_value)
+	proto.RegisterEnum("regen.group.v1alpha1.ExecPredicate_Comparison", ExecPredicate_Comparison_name, ExecPredicate_Comparison_value)
 	proto.RegisterType((*Member)(nil), "regen.group.v1alpha1.Member")
 	proto.RegisterType((*Members)(nil), "regen.group.v1alpha1.Members")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "regen.group.v1alpha1.ThresholdDecisionPolicy")
@@ -755,94 +1007,119 @@ func init() {
 	proto.RegisterType((*GroupMember)(nil), "regen.group.v1alpha1.GroupMember")
 	proto.RegisterType((*GroupAccountInfo)(nil), "regen.group.v1alpha1.GroupAccountInfo")
 	proto.RegisterType((*Proposal)(nil), "regen.group.v1alpha1.Proposal")
+	proto.RegisterType((*ExecPredicate)(nil), "regen.group.v1alpha1.ExecPredicate")
 	proto.RegisterType((*Tally)(nil), "regen.group.v1alpha1.Tally")
+	proto.RegisterType((*VoteSnapshot)(nil), "regen.group.v1alpha1.VoteSnapshot")
 	proto.RegisterType((*Vote)(nil), "regen.group.v1alpha1.Vote")
 }
 
 func init() { proto.RegisterFile("regen/group/v1alpha1/types.proto", fileDescriptor_9b7906b115009838) }
 
 var fileDescriptor_9b7906b115009838 = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x1d, 0x3f, 0x27, 0x8e, 0x35, 0xa4, 0xad, 0xe3, 0xa4, 0xce, 0xd6, 0x55,
-	0xa5, 0x08, 0x14, 0x5b, 0x09, 0x70, 0x20, 0xa2, 0x08, 0x7b, 0xb3, 0x29, 0x86, 0xd4, 0x0e, 0xbb,
-	0x76, 0x80, 0x1e, 0xb0, 0xd6, 0xbb, 0x53, 0x67, 0xe9, 0x7a, 0xc7, 0xda, 0x1d, 0xa7, 0x35, 0x3f,
-	0x00, 0x15, 0x9f, 0xb8, 0x20, 0xc4, 0xc1, 0x52, 0x25, 0xfe, 0x02, 0x3f, 0xa2, 0xe2, 0xd4, 0x03,
-	0x07, 0xc4, 0x01, 0xa1, 0xf6, 0xc2, 0x85, 0xff, 0x80, 0x76, 0x66, 0x36, 0xc9, 0xb6, 0x8e, 0xdb,
-	0x03, 0x37, 0xbf, 0xf7, 0xbe, 0xef, 0xcd, 0x7b, 0xdf, 0x3c, 0xbf, 0x59, 0x90, 0x3d, 0xdc, 0xc7,
-	0x6e, 0xb5, 0xef, 0x91, 0xd1, 0xb0, 0x7a, 0xba, 0x63, 0x38, 0xc3, 0x13, 0x63, 0xa7, 0x4a, 0xc7,
-	0x43, 0xec, 0x57, 0x86, 0x1e, 0xa1, 0x04, 0xad, 0x32, 0x44, 0x85, 0x21, 0x2a, 0x21, 0xa2, 0xb8,
-	0xda, 0x27, 0x7d, 0xc2, 0x00, 0xd5, 0xe0, 0x17, 0xc7, 0x16, 0x4b, 0x7d, 0x42, 0xfa, 0x0e, 0xae,
-	0x32, 0xab, 0x37, 0xba, 0x5f, 0xb5, 0x46, 0x9e, 0x41, 0x6d, 0xe2, 0x8a, 0xf8, 0xe6, 0xcb, 0x71,
-	0x6a, 0x0f, 0xb0, 0x4f, 0x8d, 0xc1, 0x50, 0x00, 0xd6, 0x4c, 0xe2, 0x0f, 0x88, 0xdf, 0xe5, 0x99,
-	0xb9, 0x11, 0x86, 0x5e, 0xe6, 0x1a, 0xee, 0x98, 0x87, 0xca, 0xc7, 0x90, 0xba, 0x8b, 0x07, 0x3d,
-	0xec, 0xa1, 0x02, 0xa4, 0x0d, 0xcb, 0xf2, 0xb0, 0xef, 0x17, 0x24, 0x59, 0xda, 0xca, 0x68, 0xa1,
-	0x89, 0xae, 0x42, 0xea, 0x21, 0xb6, 0xfb, 0x27, 0xb4, 0x10, 0x67, 0x01, 0x61, 0xa1, 0x22, 0x2c,
-	0x0e, 0x30, 0x35, 0x2c, 0x83, 0x1a, 0x85, 0x84, 0x2c, 0x6d, 0x2d, 0x69, 0x67, 0x76, 0xf9, 0x0e,
-	0xa4, 0x79, 0x5e, 0x1f, 0x7d, 0x08, 0xe9, 0x01, 0xff, 0x59, 0x90, 0xe4, 0xc4, 0x56, 0x76, 0x77,
-	0xa3, 0x32, 0x4b, 0x97, 0x0a, 0xc7, 0xd7, 0x93, 0x4f, 0xff, 0xda, 0x8c, 0x69, 0x21, 0xa5, 0xfc,
-	0x9d, 0x04, 0xd7, 0xda, 0x27, 0x1e, 0xf6, 0x4f, 0x88, 0x63, 0xed, 0x63, 0xd3, 0xf6, 0x6d, 0xe2,
-	0x1e, 0x11, 0xc7, 0x36, 0xc7, 0x68, 0x03, 0x32, 0x34, 0x0c, 0x89, 0xa2, 0xcf, 0x1d, 0xe8, 0x03,
-	0x48, 0x07, 0x1a, 0x91, 0x11, 0xaf, 0x3b, 0xbb, 0xbb, 0x56, 0xe1, 0x3a, 0x54, 0x42, 0x1d, 0x2a,
-	0xfb, 0x42, 0xe3, 0xf0, 0x50, 0x81, 0xdf, 0x43, 0xbf, 0xfd, 0xba, 0x9d, 0x8b, 0x1e, 0x56, 0xfe,
-	0x51, 0x82, 0xcc, 0x9d, 0xa0, 0xe2, 0x86, 0x7b, 0x9f, 0xa0, 0x35, 0x58, 0x64, 0xe5, 0x77, 0x6d,
-	0x7e, 0x72, 0x52, 0x4b, 0x33, 0xbb, 0x61, 0xa1, 0x55, 0x58, 0x30, 0xac, 0x81, 0xed, 0x0a, 0xb5,
-	0xb8, 0x31, 0x4f, 0xac, 0x40, 0xfa, 0x53, 0xec, 0x05, 0x67, 0x15, 0x92, 0x3c, 0x97, 0x30, 0xd1,
-	0x0d, 0x58, 0xa2, 0x84, 0x1a, 0x4e, 0x57, 0x5c, 0xc0, 0x02, 0x4b, 0x99, 0x65, 0xbe, 0x2f, 0x98,
-	0xab, 0xfc, 0x35, 0x64, 0x59, 0x59, 0xe2, 0x1a, 0xe7, 0x14, 0xf6, 0x1e, 0xa4, 0xb8, 0xaa, 0x42,
-	0x8f, 0xb9, 0xf7, 0xa0, 0x09, 0x6c, 0xf9, 0xa7, 0x38, 0xe4, 0xd9, 0x01, 0x35, 0xd3, 0x24, 0x23,
-	0x97, 0xb2, 0xf6, 0x2f, 0x1f, 0x96, 0x8b, 0xe7, 0xc7, 0x2f, 0x11, 0x26, 0x71, 0x99, 0x30, 0xc9,
-	0xcb, 0x85, 0x59, 0x88, 0x0a, 0xf3, 0x39, 0xac, 0x58, 0xe2, 0x7e, 0xba, 0x43, 0x76, 0x41, 0x85,
-	0x14, 0x6b, 0x6a, 0xf5, 0x95, 0x4b, 0xae, 0xb9, 0xe3, 0xfa, 0x8c, 0x0b, 0xd5, 0x72, 0x56, 0x74,
-	0x9a, 0x6e, 0x41, 0xce, 0xc2, 0x9e, 0x7d, 0xca, 0x26, 0xa2, 0xfb, 0x00, 0x8f, 0x0b, 0x69, 0x56,
-	0xce, 0xf2, 0xb9, 0xf7, 0x33, 0x3c, 0xde, 0x5b, 0x7c, 0xfc, 0x64, 0x33, 0xf6, 0xcf, 0x93, 0x4d,
-	0xa9, 0xfc, 0x2f, 0xc0, 0xe2, 0x91, 0x47, 0x86, 0xc4, 0x37, 0x1c, 0xb4, 0x09, 0xd9, 0xa1, 0xf8,
-	0x7d, 0x2e, 0x3d, 0x84, 0xae, 0x86, 0x75, 0x51, 0xb2, 0x78, 0x54, 0xb2, 0x79, 0xa3, 0xb1, 0x01,
-	0x19, 0x9e, 0x23, 0xf8, 0xfb, 0x24, 0xe5, 0x44, 0x30, 0xe2, 0x67, 0x0e, 0xa4, 0xc0, 0x92, 0x3f,
-	0xea, 0x0d, 0x6c, 0x4a, 0xb1, 0xd5, 0x35, 0xf8, 0x78, 0x64, 0x77, 0x8b, 0xaf, 0x48, 0xd0, 0x0e,
-	0x77, 0x85, 0x18, 0xf4, 0xec, 0x19, 0xab, 0x46, 0xd1, 0x4d, 0x58, 0xe6, 0x37, 0x16, 0x4a, 0x9d,
-	0x62, 0xb5, 0x2f, 0x31, 0xe7, 0xb1, 0xd0, 0x7b, 0x17, 0xae, 0x70, 0x90, 0xc1, 0xa7, 0xe0, 0x0c,
-	0x9c, 0x66, 0xe0, 0xb7, 0xfa, 0x17, 0x26, 0x24, 0xe4, 0xdc, 0x86, 0x94, 0x4f, 0x0d, 0x3a, 0xf2,
-	0x0b, 0x8b, 0xb2, 0xb4, 0x95, 0xdb, 0xbd, 0x35, 0x7b, 0xde, 0x42, 0x09, 0x2b, 0x3a, 0x03, 0x6b,
-	0x82, 0x14, 0xd0, 0x3d, 0xec, 0x8f, 0x1c, 0x5a, 0xc8, 0xbc, 0x11, 0x5d, 0x63, 0x60, 0x4d, 0x90,
-	0xd0, 0xc7, 0x00, 0xa7, 0x84, 0xe2, 0x6e, 0x90, 0x0d, 0x17, 0x80, 0x29, 0xb3, 0x3e, 0x3b, 0x45,
-	0xdb, 0x70, 0x9c, 0xb1, 0x90, 0x26, 0x13, 0x90, 0x82, 0x4a, 0x30, 0xda, 0x3b, 0x5f, 0x20, 0xd9,
-	0x37, 0x14, 0x36, 0x24, 0xa0, 0x63, 0x58, 0xc1, 0x8f, 0xb0, 0x39, 0xa2, 0xc4, 0xeb, 0x8a, 0x2e,
-	0x96, 0x58, 0x17, 0xdb, 0xaf, 0xe9, 0x42, 0x15, 0x2c, 0xd1, 0x4d, 0x0e, 0x47, 0x6c, 0xb4, 0x05,
-	0xc9, 0x81, 0xdf, 0xf7, 0x0b, 0xcb, 0x6c, 0x93, 0xce, 0x1c, 0x76, 0x8d, 0x21, 0xca, 0xcf, 0x24,
-	0x48, 0x71, 0x45, 0xd1, 0x0e, 0x20, 0xbd, 0x5d, 0x6b, 0x77, 0xf4, 0x6e, 0xa7, 0xa9, 0x1f, 0xa9,
-	0x4a, 0xe3, 0xa0, 0xa1, 0xee, 0xe7, 0x63, 0xc5, 0xb5, 0xc9, 0x54, 0xbe, 0x12, 0x9e, 0xcc, 0xb1,
-	0x0d, 0xf7, 0xd4, 0x70, 0x6c, 0x0b, 0xed, 0x40, 0x5e, 0x50, 0xf4, 0x4e, 0xfd, 0x6e, 0xa3, 0xdd,
-	0x56, 0xf7, 0xf3, 0x52, 0x71, 0x7d, 0x32, 0x95, 0xaf, 0x45, 0x09, 0x7a, 0x38, 0x49, 0xe8, 0x1d,
-	0x58, 0x16, 0x14, 0xe5, 0xb0, 0xa5, 0xab, 0xfb, 0xf9, 0x78, 0xb1, 0x30, 0x99, 0xca, 0xab, 0x51,
-	0xbc, 0xe2, 0x10, 0x1f, 0x5b, 0x68, 0x1b, 0x72, 0x02, 0x5c, 0xab, 0xb7, 0xb4, 0x20, 0x7b, 0x62,
-	0x56, 0x39, 0xb5, 0x1e, 0xf1, 0x28, 0xb6, 0x8a, 0xc9, 0xc7, 0xbf, 0x94, 0x62, 0xe5, 0x3f, 0x25,
-	0x48, 0x09, 0x1d, 0x76, 0x00, 0x69, 0xaa, 0xde, 0x39, 0x6c, 0xcf, 0x6b, 0x89, 0x63, 0xc3, 0x96,
-	0xde, 0xbf, 0x40, 0x39, 0x68, 0x34, 0x6b, 0x87, 0x8d, 0x7b, 0xac, 0xa9, 0xeb, 0x93, 0xa9, 0xbc,
-	0x16, 0xa5, 0x74, 0xdc, 0xfb, 0xb6, 0x6b, 0x38, 0xf6, 0xb7, 0xd8, 0x42, 0x55, 0x58, 0x11, 0xb4,
-	0x9a, 0xa2, 0xa8, 0x47, 0x6d, 0xd6, 0x58, 0x71, 0x32, 0x95, 0xaf, 0x46, 0x39, 0x35, 0xd3, 0xc4,
-	0x43, 0x1a, 0x21, 0x68, 0xea, 0xa7, 0xaa, 0xc2, 0x7b, 0x9b, 0x41, 0xd0, 0xf0, 0x37, 0xd8, 0x3c,
-	0x6f, 0xee, 0xe7, 0x38, 0xe4, 0xa2, 0x97, 0x8f, 0xea, 0xb0, 0xae, 0x7e, 0xa9, 0x2a, 0x9d, 0x76,
-	0x4b, 0xeb, 0xce, 0xec, 0xf6, 0xc6, 0x64, 0x2a, 0x5f, 0x0f, 0xb3, 0x46, 0xc9, 0x61, 0xd7, 0xb7,
-	0xe1, 0xda, 0xcb, 0x39, 0x9a, 0xad, 0x76, 0x57, 0xeb, 0x34, 0xf3, 0x52, 0x51, 0x9e, 0x4c, 0xe5,
-	0x8d, 0xd9, 0xfc, 0x26, 0xa1, 0xda, 0xc8, 0x45, 0x1f, 0xbd, 0x4a, 0xd7, 0x3b, 0x8a, 0xa2, 0xea,
-	0x7a, 0x3e, 0x3e, 0xef, 0x78, 0x7d, 0x64, 0x9a, 0xc1, 0x6e, 0x9b, 0xc1, 0x3f, 0xa8, 0x35, 0x0e,
-	0x3b, 0x9a, 0x9a, 0x4f, 0xcc, 0xe3, 0x1f, 0x18, 0xb6, 0x33, 0xf2, 0x30, 0xd7, 0x66, 0x2f, 0x19,
-	0xec, 0xdc, 0xf2, 0xf7, 0x12, 0x2c, 0xb0, 0xbf, 0x2a, 0x5a, 0x87, 0xcc, 0x18, 0xfb, 0x5d, 0xb6,
-	0x6d, 0xc4, 0x03, 0xb4, 0x38, 0xc6, 0xbe, 0x12, 0xd8, 0xc1, 0x0b, 0xe4, 0x12, 0x11, 0x13, 0x9b,
-	0xd6, 0x25, 0x3c, 0x74, 0x13, 0x96, 0x8d, 0x9e, 0x4f, 0x0d, 0xdb, 0x15, 0x71, 0xfe, 0x12, 0x2d,
-	0x09, 0x27, 0x07, 0x5d, 0x07, 0x38, 0xc5, 0x34, 0xcc, 0x90, 0xe4, 0x9f, 0x15, 0x81, 0x87, 0x85,
-	0x45, 0x2d, 0xbf, 0x4b, 0x90, 0x3c, 0x26, 0x14, 0xbf, 0x7e, 0xef, 0xaf, 0xc2, 0x42, 0xb0, 0x52,
-	0xbc, 0xf0, 0x73, 0x80, 0x19, 0xc1, 0x5b, 0x6c, 0x9e, 0x10, 0xdb, 0xc4, 0xac, 0x84, 0xdc, 0x65,
-	0x6f, 0xb1, 0xc2, 0x30, 0x9a, 0xc0, 0xce, 0x7d, 0x2b, 0xff, 0x8f, 0xb7, 0xe0, 0x6d, 0x0b, 0x52,
-	0xfc, 0x48, 0x74, 0x15, 0x90, 0xf2, 0x49, 0xab, 0xa1, 0xa8, 0xd1, 0x91, 0x43, 0xcb, 0x90, 0x11,
-	0xfe, 0x66, 0x2b, 0x2f, 0xa1, 0x1c, 0x80, 0x30, 0xbf, 0x52, 0xf5, 0x7c, 0x1c, 0x21, 0xc8, 0x09,
-	0xbb, 0x56, 0xd7, 0xdb, 0xb5, 0x46, 0x33, 0x9f, 0x40, 0x2b, 0x90, 0x15, 0xbe, 0x63, 0xb5, 0xdd,
-	0xca, 0x27, 0xeb, 0x77, 0x9e, 0x3e, 0x2f, 0x49, 0xcf, 0x9e, 0x97, 0xa4, 0xbf, 0x9f, 0x97, 0xa4,
-	0x1f, 0x5e, 0x94, 0x62, 0xcf, 0x5e, 0x94, 0x62, 0x7f, 0xbc, 0x28, 0xc5, 0xee, 0x6d, 0xf7, 0x6d,
-	0x7a, 0x32, 0xea, 0x55, 0x4c, 0x32, 0xa8, 0x32, 0x41, 0xb6, 0x5d, 0x4c, 0x1f, 0x12, 0xef, 0x81,
-	0xb0, 0x1c, 0x6c, 0xf5, 0xb1, 0x57, 0x7d, 0xc4, 0xbf, 0xba, 0x7b, 0x29, 0xd6, 0xd5, 0xbb, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x30, 0x03, 0x28, 0x54, 0x8b, 0x0b, 0x00, 0x00,
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0x22, 0xc9,
+	0x15, 0x76, 0x03, 0xc6, 0xe6, 0x61, 0x70, 0x4f, 0xc5, 0xe3, 0xc1, 0xd8, 0x83, 0x7b, 0xb1, 0x2c,
+	0x59, 0x89, 0x0c, 0xb2, 0x93, 0x48, 0x89, 0x95, 0x8d, 0x82, 0xdb, 0x6d, 0x87, 0x5d, 0x1b, 0x48,
+	0xd3, 0x38, 0xc9, 0x1e, 0xd2, 0x6a, 0x77, 0x97, 0x71, 0x67, 0xa1, 0x0b, 0x75, 0x37, 0xde, 0x25,
+	0xbf, 0x60, 0xc3, 0x69, 0xa5, 0x28, 0x87, 0x1c, 0x90, 0x46, 0xca, 0x5f, 0x98, 0x6b, 0xee, 0xa3,
+	0x9c, 0x26, 0x52, 0x0e, 0x51, 0x0e, 0x51, 0x34, 0x73, 0x99, 0xdf, 0x90, 0x53, 0x54, 0xd5, 0xd5,
+	0x40, 0x63, 0xcc, 0x38, 0x52, 0x6e, 0xbc, 0xaa, 0xef, 0x7b, 0xf5, 0xde, 0xf7, 0x5e, 0x55, 0x3f,
+	0x40, 0x72, 0x71, 0x1b, 0x3b, 0xe5, 0xb6, 0x4b, 0xfa, 0xbd, 0xf2, 0xfd, 0x91, 0xd1, 0xe9, 0xdd,
+	0x19, 0x47, 0x65, 0x7f, 0xd0, 0xc3, 0x5e, 0xa9, 0xe7, 0x12, 0x9f, 0xa0, 0x0d, 0x86, 0x28, 0x31,
+	0x44, 0x29, 0x44, 0xe4, 0x37, 0xda, 0xa4, 0x4d, 0x18, 0xa0, 0x4c, 0x7f, 0x05, 0xd8, 0x7c, 0xa1,
+	0x4d, 0x48, 0xbb, 0x83, 0xcb, 0xcc, 0xba, 0xe9, 0xdf, 0x96, 0xad, 0xbe, 0x6b, 0xf8, 0x36, 0x71,
+	0xf8, 0xfe, 0xee, 0xec, 0xbe, 0x6f, 0x77, 0xb1, 0xe7, 0x1b, 0xdd, 0x1e, 0x07, 0x6c, 0x99, 0xc4,
+	0xeb, 0x12, 0x4f, 0x0f, 0x3c, 0x07, 0x46, 0xb8, 0x35, 0xcb, 0x35, 0x9c, 0x41, 0xb0, 0x55, 0xbc,
+	0x86, 0xe4, 0x15, 0xee, 0xde, 0x60, 0x17, 0xe5, 0x60, 0xc5, 0xb0, 0x2c, 0x17, 0x7b, 0x5e, 0x4e,
+	0x90, 0x84, 0x83, 0x94, 0x1a, 0x9a, 0x68, 0x13, 0x92, 0x5f, 0x61, 0xbb, 0x7d, 0xe7, 0xe7, 0x62,
+	0x6c, 0x83, 0x5b, 0x28, 0x0f, 0xab, 0x5d, 0xec, 0x1b, 0x96, 0xe1, 0x1b, 0xb9, 0xb8, 0x24, 0x1c,
+	0xac, 0xa9, 0x63, 0xbb, 0x78, 0x01, 0x2b, 0x81, 0x5f, 0x0f, 0xfd, 0x04, 0x56, 0xba, 0xc1, 0xcf,
+	0x9c, 0x20, 0xc5, 0x0f, 0xd2, 0xc7, 0x3b, 0xa5, 0x79, 0xba, 0x94, 0x02, 0xfc, 0x69, 0xe2, 0xcd,
+	0xbf, 0x76, 0x97, 0xd4, 0x90, 0x52, 0xfc, 0x8f, 0x00, 0x2f, 0xb4, 0x3b, 0x17, 0x7b, 0x77, 0xa4,
+	0x63, 0x9d, 0x61, 0xd3, 0xf6, 0x6c, 0xe2, 0x34, 0x48, 0xc7, 0x36, 0x07, 0x68, 0x07, 0x52, 0x7e,
+	0xb8, 0xc5, 0x83, 0x9e, 0x2c, 0xa0, 0x1f, 0xc3, 0x0a, 0xd5, 0x88, 0xf4, 0x83, 0xb8, 0xd3, 0xc7,
+	0x5b, 0xa5, 0x40, 0x87, 0x52, 0xa8, 0x43, 0xe9, 0x8c, 0x6b, 0x1c, 0x1e, 0xca, 0xf1, 0xe8, 0x73,
+	0x78, 0x76, 0x4f, 0x7c, 0xac, 0xb7, 0x5d, 0xc3, 0xc4, 0x7a, 0x0f, 0xbb, 0x36, 0xb1, 0x58, 0x8a,
+	0x4f, 0x70, 0xb2, 0x4e, 0x99, 0x17, 0x94, 0xd8, 0x60, 0x3c, 0xb4, 0x0f, 0xd9, 0x7b, 0xec, 0x13,
+	0x7d, 0x12, 0x6a, 0x82, 0x85, 0x9a, 0xa1, 0xab, 0xe3, 0xd4, 0x4e, 0xd0, 0x5f, 0x5f, 0x1f, 0x66,
+	0xa3, 0x09, 0x16, 0xff, 0x28, 0x40, 0xea, 0x82, 0xaa, 0x54, 0x75, 0x6e, 0x09, 0xda, 0x82, 0x55,
+	0x26, 0x99, 0x6e, 0x07, 0xd9, 0x26, 0xd4, 0x15, 0x66, 0x57, 0x2d, 0xb4, 0x01, 0xcb, 0x86, 0xd5,
+	0xb5, 0x1d, 0x5e, 0xa1, 0xc0, 0x58, 0x54, 0x20, 0x5a, 0xee, 0x7b, 0xec, 0xd2, 0xb3, 0x58, 0x38,
+	0x09, 0x35, 0x34, 0xd1, 0x27, 0xb0, 0xe6, 0x13, 0xdf, 0xe8, 0xe8, 0xbc, 0xe8, 0xcb, 0xcc, 0x65,
+	0x9a, 0xad, 0xfd, 0x92, 0x2d, 0x15, 0x7f, 0x03, 0x69, 0x16, 0x16, 0x6f, 0x9d, 0x05, 0x81, 0xfd,
+	0x00, 0x92, 0x41, 0x25, 0x79, 0x0d, 0x16, 0xd6, 0x5e, 0xe5, 0xd8, 0xe2, 0xdf, 0x62, 0x20, 0xb2,
+	0x03, 0x2a, 0xa6, 0x49, 0xfa, 0x8e, 0xcf, 0xd2, 0x7f, 0xbc, 0x41, 0xa7, 0xcf, 0x8f, 0x3d, 0x22,
+	0x4c, 0xfc, 0x31, 0x61, 0x12, 0x8f, 0x0b, 0xb3, 0x1c, 0x15, 0xe6, 0x17, 0xb0, 0x6e, 0xf1, 0xfa,
+	0xe8, 0x3d, 0x56, 0xa0, 0x5c, 0x92, 0x25, 0xb5, 0xf1, 0xa0, 0x27, 0x2a, 0xce, 0xe0, 0x74, 0x4e,
+	0x41, 0xd5, 0xac, 0x15, 0xed, 0xe0, 0x7d, 0xc8, 0x5a, 0xd8, 0xb5, 0xef, 0x59, 0x03, 0xe9, 0x5f,
+	0xe2, 0x41, 0x6e, 0x85, 0x85, 0x93, 0x99, 0xac, 0x7e, 0x8e, 0x07, 0xe8, 0x47, 0x90, 0x9b, 0x39,
+	0x59, 0x1f, 0xc7, 0xbf, 0xca, 0x08, 0x9b, 0x51, 0xc7, 0x57, 0x7c, 0xf7, 0x64, 0xf5, 0x9b, 0x57,
+	0xbb, 0x4b, 0x1f, 0x5e, 0xed, 0x0a, 0xc5, 0xd7, 0x59, 0x58, 0x6d, 0xb8, 0xa4, 0x47, 0x3c, 0xa3,
+	0x83, 0x76, 0x21, 0xdd, 0xe3, 0xbf, 0x27, 0x45, 0x83, 0x70, 0xa9, 0x6a, 0x4d, 0x8b, 0x1d, 0x8b,
+	0x8a, 0xbd, 0xa8, 0xa9, 0x76, 0x20, 0x15, 0xf8, 0xa0, 0x97, 0x3d, 0x21, 0xc5, 0xe9, 0x85, 0x1c,
+	0x2f, 0x20, 0x19, 0xd6, 0xbc, 0xfe, 0x4d, 0xd7, 0xf6, 0x7d, 0x6c, 0xe9, 0x46, 0xd0, 0x58, 0xe9,
+	0xe3, 0xfc, 0x03, 0xf1, 0xb4, 0xf0, 0x65, 0xe3, 0x37, 0x2a, 0x3d, 0x66, 0x55, 0x7c, 0xb4, 0x07,
+	0x99, 0xa0, 0xd6, 0x61, 0x91, 0x92, 0x2c, 0xf6, 0x35, 0xb6, 0x78, 0xcd, 0x2b, 0x75, 0x0c, 0xcf,
+	0x03, 0x90, 0x11, 0xf4, 0xcf, 0x18, 0xbc, 0xc2, 0xc0, 0xdf, 0x69, 0x4f, 0xf5, 0x56, 0xc8, 0xf9,
+	0x14, 0x92, 0x9e, 0x6f, 0xf8, 0x7d, 0x8f, 0x29, 0x9a, 0x3d, 0xde, 0x9f, 0xdf, 0xa9, 0xa1, 0x84,
+	0xa5, 0x26, 0x03, 0xab, 0x9c, 0x44, 0xe9, 0x2e, 0xf6, 0xfa, 0x1d, 0x3f, 0x97, 0x7a, 0x12, 0x5d,
+	0x65, 0x60, 0x95, 0x93, 0xd0, 0xcf, 0x00, 0xd8, 0x8b, 0x43, 0xbd, 0xe1, 0x1c, 0x30, 0x65, 0xb6,
+	0xe7, 0xbb, 0xd0, 0x8c, 0x4e, 0x67, 0xc0, 0xa5, 0x49, 0x51, 0x12, 0x8d, 0x04, 0xa3, 0x93, 0xc9,
+	0x73, 0x97, 0x7e, 0xa2, 0xb0, 0xe3, 0xf7, 0xee, 0x1a, 0xd6, 0xf1, 0xd7, 0xd8, 0xec, 0xfb, 0xc4,
+	0xd5, 0x79, 0x16, 0x6b, 0x2c, 0x8b, 0xc3, 0x8f, 0x64, 0xa1, 0x70, 0x16, 0xcf, 0x26, 0x8b, 0x23,
+	0x36, 0x3a, 0x80, 0x44, 0xd7, 0x6b, 0x7b, 0xb9, 0x0c, 0x7b, 0xf7, 0xe7, 0x5e, 0x13, 0x95, 0x21,
+	0xd0, 0x67, 0xc0, 0xb8, 0x7a, 0xcf, 0xc5, 0x96, 0x6d, 0x52, 0x0d, 0xb2, 0x2c, 0x89, 0xbd, 0xf9,
+	0x01, 0xd0, 0x73, 0x1b, 0x21, 0x54, 0xcd, 0xe0, 0x69, 0x33, 0x28, 0x85, 0xe1, 0x11, 0x27, 0xb7,
+	0xfe, 0xc4, 0x52, 0x50, 0xb0, 0xca, 0x49, 0xa8, 0x0e, 0xe8, 0xd6, 0x76, 0x8c, 0x8e, 0xee, 0x53,
+	0xa1, 0x43, 0x3d, 0xc4, 0xa7, 0x96, 0x44, 0x64, 0x64, 0xb6, 0x12, 0xa8, 0x50, 0x7c, 0x2b, 0x40,
+	0x32, 0xe8, 0x16, 0x74, 0x04, 0xa8, 0xa9, 0x55, 0xb4, 0x56, 0x53, 0x6f, 0xd5, 0x9a, 0x0d, 0x45,
+	0xae, 0x9e, 0x57, 0x95, 0x33, 0x71, 0x29, 0xbf, 0x35, 0x1c, 0x49, 0xcf, 0xc3, 0x80, 0x02, 0x6c,
+	0xd5, 0xb9, 0x37, 0x3a, 0xb6, 0x85, 0x8e, 0x40, 0xe4, 0x94, 0x66, 0xeb, 0xf4, 0xaa, 0xaa, 0x69,
+	0xca, 0x99, 0x28, 0xe4, 0xb7, 0x87, 0x23, 0xe9, 0x45, 0x94, 0xd0, 0x0c, 0x6f, 0x09, 0xfa, 0x1e,
+	0x64, 0x38, 0x45, 0xbe, 0xac, 0x37, 0x95, 0x33, 0x31, 0x96, 0xcf, 0x0d, 0x47, 0xd2, 0x46, 0x14,
+	0x2f, 0x77, 0x88, 0x87, 0x2d, 0x74, 0x08, 0x59, 0x0e, 0xae, 0x9c, 0xd6, 0x55, 0xea, 0x3d, 0x3e,
+	0x2f, 0x9c, 0xca, 0x0d, 0x71, 0x7d, 0x6c, 0xe5, 0x13, 0xdf, 0xfc, 0xb9, 0xb0, 0x54, 0xfc, 0xa7,
+	0x00, 0x49, 0x5e, 0xe3, 0x23, 0x40, 0xaa, 0xd2, 0x6c, 0x5d, 0x6a, 0x8b, 0x52, 0x0a, 0xb0, 0x61,
+	0x4a, 0x3f, 0x9c, 0xa2, 0x9c, 0x57, 0x6b, 0x95, 0xcb, 0xea, 0x17, 0x2c, 0xa9, 0x97, 0xc3, 0x91,
+	0xb4, 0x15, 0xa5, 0xb4, 0x1c, 0x26, 0xa7, 0xfd, 0x3b, 0x6c, 0xa1, 0x32, 0xac, 0x73, 0x5a, 0x45,
+	0x96, 0x95, 0x86, 0xc6, 0x12, 0xcb, 0x0f, 0x47, 0xd2, 0x66, 0x94, 0x53, 0x31, 0x4d, 0xdc, 0xf3,
+	0x23, 0x04, 0x55, 0xf9, 0x4c, 0x91, 0x83, 0xdc, 0xe6, 0x10, 0x54, 0xfc, 0x5b, 0x6c, 0x4e, 0x92,
+	0xfb, 0x53, 0x0c, 0xb2, 0xd1, 0xc6, 0x46, 0xa7, 0xb0, 0xad, 0xfc, 0x4a, 0x91, 0x5b, 0x5a, 0x5d,
+	0xd5, 0xe7, 0x66, 0xfb, 0xc9, 0x70, 0x24, 0xbd, 0x0c, 0xbd, 0x46, 0xc9, 0x61, 0xd6, 0x9f, 0xc2,
+	0x8b, 0x59, 0x1f, 0xb5, 0xba, 0xa6, 0xab, 0xad, 0x9a, 0x28, 0xe4, 0xa5, 0xe1, 0x48, 0xda, 0x99,
+	0xcf, 0xaf, 0x11, 0x5f, 0xed, 0x3b, 0xe8, 0xa7, 0x0f, 0xe9, 0xcd, 0x96, 0x2c, 0x2b, 0xcd, 0xa6,
+	0x18, 0x5b, 0x74, 0x7c, 0xb3, 0x6f, 0x9a, 0xf4, 0xdd, 0x9e, 0xc3, 0x3f, 0xaf, 0x54, 0x2f, 0x5b,
+	0xaa, 0x22, 0xc6, 0x17, 0xf1, 0xcf, 0x0d, 0xbb, 0xd3, 0x77, 0x31, 0xd7, 0xe6, 0x03, 0x2b, 0x3c,
+	0xbb, 0x27, 0xac, 0x8a, 0x95, 0x66, 0xbd, 0x36, 0x23, 0xc5, 0x4c, 0x15, 0x29, 0xb6, 0xe5, 0x78,
+	0x3d, 0x6c, 0xda, 0xb7, 0x76, 0x58, 0x14, 0x46, 0x1b, 0x17, 0x45, 0x98, 0x2d, 0x0a, 0xbb, 0x8f,
+	0xbc, 0x28, 0x48, 0x86, 0x3c, 0x27, 0x5c, 0xd7, 0xb5, 0x6a, 0xed, 0x42, 0x6f, 0x28, 0x6a, 0xb5,
+	0x7e, 0xa6, 0x2b, 0xb5, 0x33, 0xd6, 0x01, 0x7b, 0xc3, 0x91, 0xb4, 0x1b, 0xe5, 0x5e, 0x13, 0xdf,
+	0x76, 0xda, 0xc1, 0xfc, 0xa5, 0x38, 0x56, 0x70, 0x25, 0x42, 0x27, 0x8a, 0x56, 0x67, 0x8d, 0x10,
+	0xb9, 0x12, 0x9c, 0x87, 0x7d, 0x12, 0xb6, 0xc1, 0x49, 0x82, 0x7e, 0x3a, 0x8b, 0x7f, 0x89, 0x41,
+	0x26, 0xf2, 0xda, 0x2c, 0x98, 0x43, 0x36, 0x60, 0xd9, 0xc2, 0x0e, 0xe9, 0x86, 0x53, 0x18, 0x33,
+	0x50, 0x0d, 0xc0, 0x24, 0xdd, 0x9e, 0xe1, 0xda, 0xf4, 0x49, 0x8a, 0xb3, 0x27, 0xa9, 0xf4, 0x84,
+	0x67, 0xad, 0x24, 0x8f, 0x59, 0xea, 0x94, 0x07, 0x3a, 0x8e, 0x1b, 0x5d, 0xfa, 0xe5, 0xe2, 0x73,
+	0x24, 0xb7, 0x8a, 0x7f, 0x10, 0x00, 0x26, 0x14, 0x74, 0x04, 0x9b, 0x72, 0xfd, 0xaa, 0x51, 0x51,
+	0xab, 0x0f, 0x4b, 0xf4, 0x7c, 0x38, 0x92, 0x9e, 0x4d, 0xb0, 0x61, 0x87, 0xee, 0x43, 0x76, 0x8a,
+	0x72, 0xa1, 0x29, 0xa2, 0x90, 0x7f, 0x36, 0x1c, 0x49, 0x99, 0x09, 0xf4, 0x42, 0x53, 0x66, 0x60,
+	0x97, 0x9a, 0x22, 0xc6, 0x66, 0x61, 0x97, 0x9a, 0xc2, 0x1b, 0xe6, 0xf7, 0x02, 0x2c, 0xb3, 0xc7,
+	0x10, 0x6d, 0x43, 0x6a, 0x80, 0x3d, 0x9d, 0x7d, 0x74, 0xb9, 0x72, 0xab, 0x03, 0xec, 0xc9, 0xd4,
+	0xa6, 0x23, 0x9c, 0x43, 0xf8, 0x1e, 0x1f, 0x38, 0x1c, 0x12, 0x6c, 0xed, 0x41, 0xc6, 0xb8, 0xf1,
+	0x7c, 0xc3, 0x76, 0xf8, 0x7e, 0x30, 0xca, 0xad, 0xf1, 0xc5, 0x00, 0xf4, 0x12, 0x80, 0x0d, 0xd9,
+	0xe6, 0x94, 0x30, 0x29, 0xba, 0xc2, 0xb6, 0x79, 0x2d, 0xbf, 0x15, 0x60, 0xed, 0x9a, 0x7e, 0x30,
+	0x1d, 0xa3, 0xe7, 0xdd, 0x11, 0xff, 0xe3, 0x63, 0xd0, 0xec, 0x2c, 0x1c, 0x7b, 0x30, 0x0b, 0x4f,
+	0xff, 0xbd, 0x89, 0xff, 0xef, 0x7f, 0x6f, 0xfe, 0x2e, 0x40, 0x82, 0x86, 0xf4, 0xf1, 0x50, 0x36,
+	0x60, 0x99, 0x7e, 0xec, 0xdd, 0xb0, 0xb9, 0x98, 0x41, 0xe7, 0x6b, 0xf3, 0x8e, 0xd8, 0x26, 0xe6,
+	0x8d, 0xf5, 0xc8, 0xe1, 0x32, 0xc3, 0xa8, 0x1c, 0xbb, 0x70, 0xfe, 0xfd, 0x7f, 0x4c, 0x69, 0xdf,
+	0xb5, 0x20, 0x19, 0x1c, 0x89, 0x36, 0x01, 0xc9, 0x3f, 0xaf, 0x57, 0x65, 0x25, 0xda, 0x82, 0x28,
+	0x03, 0x29, 0xbe, 0x5e, 0xab, 0x8b, 0x02, 0xca, 0x02, 0x70, 0xf3, 0xd7, 0x4a, 0x53, 0x8c, 0x21,
+	0x04, 0x59, 0x6e, 0x57, 0x4e, 0x9b, 0x5a, 0xa5, 0x5a, 0x13, 0xe3, 0x68, 0x1d, 0xd2, 0x7c, 0x8d,
+	0xde, 0x61, 0x31, 0x71, 0x7a, 0xf1, 0xe6, 0x5d, 0x41, 0x78, 0xfb, 0xae, 0x20, 0xfc, 0xfb, 0x5d,
+	0x41, 0xf8, 0xf6, 0x7d, 0x61, 0xe9, 0xed, 0xfb, 0xc2, 0xd2, 0x3f, 0xde, 0x17, 0x96, 0xbe, 0x38,
+	0x6c, 0xdb, 0xfe, 0x5d, 0xff, 0xa6, 0x64, 0x92, 0x6e, 0x99, 0x09, 0x72, 0xe8, 0x60, 0xff, 0x2b,
+	0xe2, 0x7e, 0xc9, 0xad, 0x0e, 0xb6, 0xda, 0xd8, 0x2d, 0x7f, 0x1d, 0xfc, 0x7b, 0xbf, 0x49, 0xb2,
+	0xac, 0xbe, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x89, 0xf6, 0x8e, 0xd3, 0x0f, 0x00,
+	0x00,
 }
 
 func (this *GroupAccountInfo) Equal(that interface{}) bool {
@@ -885,6 +1162,9 @@ func (this *GroupAccountInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.DerivationKey, that1.DerivationKey) {
 		return false
 	}
+	if !bytes.Equal(this.DecisionPolicyMetadata, that1.DecisionPolicyMetadata) {
+		return false
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -988,6 +1268,23 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.VoteGracePeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1122,6 +1419,13 @@ func (m *GroupAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecisionPolicyMetadata) > 0 {
+		i -= len(m.DecisionPolicyMetadata)
+		copy(dAtA[i:], m.DecisionPolicyMetadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DecisionPolicyMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DerivationKey) > 0 {
 		i -= len(m.DerivationKey)
 		copy(dAtA[i:], m.DerivationKey)
@@ -1195,6 +1499,35 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ExecPredicate != nil {
+		{
+			size, err := m.ExecPredicate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1295,6 +1628,55 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecPredicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecPredicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecPredicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Comparison != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Comparison))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Tally) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1346,6 +1728,55 @@ func (m *Tally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1462,6 +1893,12 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	}
 	l = m.Timeout.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = m.VoteGracePeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1540,6 +1977,10 @@ func (m *GroupAccountInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.DecisionPolicyMetadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1593,35 +2034,90 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.ExecPredicate != nil {
+		l = m.ExecPredicate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	l = m.FinalTallyResult.Size()
+	n += 2 + l + sovTypes(uint64(l))
 	return n
 }
 
-func (m *Tally) Size() (n int) {
+func (m *ExecPredicate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.YesCount)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.NoCount)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Comparison != 0 {
+		n += 1 + sovTypes(uint64(m.Comparison))
 	}
-	l = len(m.VetoCount)
+	l = len(m.Amount)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
 
-func (m *Vote) Size() (n int) {
+func (m *Tally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1978,6 +2474,71 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2525,6 +3086,40 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 				m.DerivationKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicyMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecisionPolicyMetadata = append(m.DecisionPolicyMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.DecisionPolicyMetadata == nil {
+				m.DecisionPolicyMetadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2920,6 +3515,259 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecPredicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecPredicate == nil {
+				m.ExecPredicate = &ExecPredicate{}
+			}
+			if err := m.ExecPredicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= Proposal_Reason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecPredicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecPredicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecPredicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+			}
+			m.Comparison = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Comparison |= ExecPredicate_Comparison(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3119,6 +3967,141 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
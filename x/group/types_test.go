@@ -1,6 +1,7 @@
 package group
 
 import (
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -59,7 +60,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "0", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Millisecond,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonRejected},
 		},
 		"expired when on timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -69,7 +70,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "2"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Second,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded},
 		},
 		"expired when after timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -79,7 +80,29 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "2"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Second + time.Nanosecond,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded},
+		},
+		"accept within vote grace period": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "1",
+				Timeout:         proto.Duration{Seconds: 1},
+				VoteGracePeriod: proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Second + time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"expired when after vote grace period": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "1",
+				Timeout:         proto.Duration{Seconds: 1},
+				VoteGracePeriod: proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2"},
+			srcTotalPower:     "3",
+			srcVotingDuration: 2 * time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded},
 		},
 		"abstain has no impact": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -101,6 +124,83 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcVotingDuration: time.Millisecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: false},
 		},
+		"reject as final when veto count greater than veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVetoed},
+		},
+		"accept when veto count equal to veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"pending while veto count can still exceed veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"accept when veto count can no longer exceed veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"accept at end of voting period when not vetoed": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"expired with veto threshold when threshold not reached": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "3",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ProposalReasonVotingPeriodEnded},
+		},
+		"invalid veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "1",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "-1",
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expErr:            ErrInvalid,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -140,6 +240,53 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 	}
 }
 
+func TestThresholdDecisionPolicyRequiredYesWeight(t *testing.T) {
+	specs := map[string]struct {
+		srcThreshold string
+		srcYesCount  string
+		exp          string
+		expErr       bool
+	}{
+		"no votes": {
+			srcThreshold: "3",
+			srcYesCount:  "0",
+			exp:          "3",
+		},
+		"some yes votes": {
+			srcThreshold: "3",
+			srcYesCount:  "1.5",
+			exp:          "1.5",
+		},
+		"threshold reached": {
+			srcThreshold: "3",
+			srcYesCount:  "3",
+			exp:          "0",
+		},
+		"threshold exceeded": {
+			srcThreshold: "3",
+			srcYesCount:  "4",
+			exp:          "0",
+		},
+		"invalid yes count": {
+			srcThreshold: "3",
+			srcYesCount:  "-1",
+			expErr:       true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			policy := ThresholdDecisionPolicy{Threshold: spec.srcThreshold, Timeout: proto.Duration{Seconds: 1}}
+			res, err := policy.RequiredYesWeight(Tally{YesCount: spec.srcYesCount, NoCount: "0", AbstainCount: "0", VetoCount: "0"})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, res)
+		})
+	}
+}
+
 func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
 	maxSeconds := int64(10000 * 365.25 * 24 * 60 * 60)
 	specs := map[string]struct {
@@ -183,6 +330,37 @@ func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
 		},
 			expErr: true,
 		},
+		"with vote grace period": {src: ThresholdDecisionPolicy{
+			Threshold:       "1",
+			Timeout:         proto.Duration{Seconds: 1},
+			VoteGracePeriod: proto.Duration{Seconds: 1},
+		}},
+		"no negative vote grace periods": {src: ThresholdDecisionPolicy{
+			Threshold:       "1",
+			Timeout:         proto.Duration{Seconds: 1},
+			VoteGracePeriod: proto.Duration{Seconds: -1},
+		},
+			expErr: true,
+		},
+		"with veto threshold": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			VetoThreshold: "0.5",
+		}},
+		"no zero veto thresholds": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			VetoThreshold: "0",
+		},
+			expErr: true,
+		},
+		"no negative veto thresholds": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			VetoThreshold: "-1",
+		},
+			expErr: true,
+		},
 		"no negative timeouts": {src: ThresholdDecisionPolicy{
 			Threshold: "1",
 			Timeout:   proto.Duration{Seconds: -1},
@@ -207,6 +385,22 @@ func TestVotePrimaryKey(t *testing.T) {
 	assert.Equal(t, append([]byte{0, 0, 0, 0, 0, 0, 0, 1}, addr.Bytes()...), orm.PrimaryKey(&v))
 }
 
+func TestGroupAccountDerivationKey(t *testing.T) {
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2}, GroupAccountDerivationKey(1, 2))
+	assert.NotEqual(t, GroupAccountDerivationKey(1, 2), GroupAccountDerivationKey(2, 1))
+}
+
+func TestDeriveGroupAccountAddress(t *testing.T) {
+	// the derived addresses must not change across restarts or releases as
+	// integrators rely on them to fund group accounts ahead of their creation
+	assert.Equal(t, "b78ec02bfc24965afd12b842e5b3fdcde12c041e", hex.EncodeToString(DeriveGroupAccountAddress(1, 1)))
+	assert.Equal(t, "3b8227220fab66207faa074b8aa6a0af8a2bd395", hex.EncodeToString(DeriveGroupAccountAddress(2, 1)))
+
+	assert.Equal(t, DeriveGroupAccountAddress(1, 1), DeriveGroupAccountAddress(1, 1))
+	assert.NotEqual(t, DeriveGroupAccountAddress(1, 1), DeriveGroupAccountAddress(1, 2))
+	assert.NotEqual(t, DeriveGroupAccountAddress(1, 2), DeriveGroupAccountAddress(2, 1))
+}
+
 func TestGroupInfoValidation(t *testing.T) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	adminAddr := addr.String()